@@ -35,12 +35,36 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
+	"os"
 
 	"wazmeow/internal/app"
 )
 
 func main() {
+	migrateOnly := flag.Bool("migrate-only", false, "run the database migrations, upgrade the WhatsApp store schema and exit")
+	upgradeStore := flag.Bool("upgrade-store", false, "create or upgrade the WhatsApp store schema, print its version and exit")
+	flag.Parse()
+
+	if *migrateOnly {
+		schema, err := app.RunMigrations(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to run migrations: %v", err)
+		}
+		log.Printf("Database migrations applied, WhatsApp store schema ready (version %d)", schema.Version)
+		os.Exit(0)
+	}
+
+	if *upgradeStore {
+		schema, err := app.UpgradeWhatsAppStore(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to upgrade WhatsApp store: %v", err)
+		}
+		log.Printf("WhatsApp store schema ready (version %d)", schema.Version)
+		os.Exit(0)
+	}
 
 	// Initialize and start the application
 	application, err := app.New()
@@ -6,7 +6,6 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"wazmeow/internal/app/container"
 	"wazmeow/internal/infra/config"
@@ -28,6 +27,9 @@ func New(opts ...container.AppOption) (*App, error) {
 		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
 
+	// Configuration provides the defaults; explicit options take precedence
+	opts = append([]container.AppOption{container.WithAutoReconnect(cfg.WhatsApp.AutoReconnect)}, opts...)
+
 	// Create application container with options
 	appContainer, err := container.NewAppContainer(cfg, opts...)
 	if err != nil {
@@ -56,11 +58,15 @@ func (a *App) Start() error {
 	return a.startServerAndWaitForShutdown()
 }
 
-// Stop stops the application
+// Stop stops the application, giving in-flight message sends up to the graceful
+// shutdown timeout to finish
 func (a *App) Stop() error {
 	a.logger.Info("Stopping WazMeow application")
 
-	if err := a.container.Close(); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), a.container.GetOptions().GracefulShutdownTimeout)
+	defer cancel()
+
+	if err := a.container.Shutdown(ctx); err != nil {
 		return fmt.Errorf("failed to close app container: %w", err)
 	}
 
@@ -107,8 +113,14 @@ func (a *App) startWhatsAppManager() error {
 
 // performAutoReconnection handles automatic session reconnection during startup
 func (a *App) performAutoReconnection() {
+	options := a.container.GetOptions()
+	if !options.EnableAutoReconnect {
+		a.logger.Info("automatic session reconnection disabled")
+		return
+	}
+
 	a.logger.Info("🔄 Starting automatic session reconnection")
-	reconnectCtx, reconnectCancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	reconnectCtx, reconnectCancel := context.WithTimeout(context.Background(), options.AutoReconnectTimeout)
 	defer reconnectCancel()
 
 	reconnectResult, err := a.container.AutoReconnectSessions(reconnectCtx)
@@ -125,7 +137,7 @@ func (a *App) performAutoReconnection() {
 func (a *App) logReconnectionResults(result interface{}) {
 	// Import the session use case package to access the types
 	if reconnectResult, ok := result.(*sessionUC.AutoReconnectResponse); ok {
-		a.logger.InfoWithFields("automatic reconnection completed", logger.Fields{
+		a.logger.InfoWithFields("automatic reconnection completed, sessions restored", logger.Fields{
 			"total_sessions":           reconnectResult.TotalSessions,
 			"eligible_sessions":        reconnectResult.EligibleSessions,
 			"successful_reconnections": reconnectResult.SuccessfulReconnections,
@@ -120,6 +120,11 @@ func (c *AppContainer) GetConfig() *config.Config {
 	return c.config
 }
 
+// GetOptions returns the application options
+func (c *AppContainer) GetOptions() *AppOptions {
+	return c.options
+}
+
 // GetServerManager returns the server manager
 func (c *AppContainer) GetServerManager() *server.ServerManager {
 	return c.httpContainer.GetServerManager()
@@ -135,8 +140,15 @@ func (c *AppContainer) IsInitialized() bool {
 	return c.isInitialized
 }
 
-// Close gracefully shuts down all dependencies
+// Close gracefully shuts down all dependencies, waiting for in-flight message sends
+// without a deadline
 func (c *AppContainer) Close() error {
+	return c.Shutdown(context.Background())
+}
+
+// Shutdown gracefully shuts down all dependencies. In-flight message sends are given
+// until ctx is done to finish.
+func (c *AppContainer) Shutdown(ctx context.Context) error {
 	if !c.isInitialized {
 		return nil
 	}
@@ -145,9 +157,14 @@ func (c *AppContainer) Close() error {
 
 	var errors []error
 
+	// Stop the HTTP layer background work
+	if c.httpContainer != nil {
+		c.httpContainer.Close()
+	}
+
 	// Close infrastructure container
 	if c.infraContainer != nil {
-		if err := c.infraContainer.Close(); err != nil {
+		if err := c.infraContainer.Shutdown(ctx); err != nil {
 			errors = append(errors, fmt.Errorf("failed to close infrastructure container: %w", err))
 		}
 	}
@@ -207,7 +224,7 @@ func (c *AppContainer) AutoReconnectSessions(ctx context.Context) (*session.Auto
 	sessionUseCases := c.useCaseContainer.GetSessionUseCases()
 
 	req := session.AutoReconnectRequest{
-		MaxConcurrentReconnections: 5, // Limit concurrent reconnections
+		MaxConcurrentReconnections: c.options.MaxConcurrentReconnects,
 		ReconnectionTimeout:        30 * time.Second,
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"wazmeow/internal/http/dto"
 	"wazmeow/internal/http/handler"
 	"wazmeow/internal/http/routes"
 	"wazmeow/internal/http/server"
@@ -14,13 +15,23 @@ import (
 
 // httpContainer implements HTTPContainer interface
 type httpContainer struct {
-	sessionHandler *handler.SessionHandler
-	healthHandler  *handler.HealthHandler
-	router         *routes.Router
-	httpServer     *server.Server
-	serverManager  *server.ServerManager
-	logger         logger.Logger
-	isInitialized  bool
+	sessionHandler    *handler.SessionHandler
+	groupHandler      *handler.GroupHandler
+	newsletterHandler *handler.NewsletterHandler
+	contactHandler    *handler.ContactHandler
+	presenceHandler   *handler.PresenceHandler
+	profileHandler    *handler.ProfileHandler
+	chatHandler       *handler.ChatHandler
+	labelHandler      *handler.LabelHandler
+	messageHandler    *handler.MessageHandler
+	healthHandler     *handler.HealthHandler
+	authHandler       *handler.AuthHandler
+	adminHandler      *handler.AdminHandler
+	router            *routes.Router
+	httpServer        *server.Server
+	serverManager     *server.ServerManager
+	logger            logger.Logger
+	isInitialized     bool
 }
 
 // NewHTTPContainer creates a new HTTP container
@@ -52,17 +63,122 @@ func (hc *httpContainer) initialize(
 	sessionUseCases := useCaseContainer.GetSessionUseCases()
 	whatsappUseCases := useCaseContainer.GetWhatsAppUseCases()
 
+	// Session lists are converted with the configured parallelism
+	sessionConverter := dto.NewBatchConverter(cfg.Server.ConversionParallelThreshold, cfg.Server.ConversionWorkers)
+
 	// Create handlers
 	hc.sessionHandler = handler.NewSessionHandler(
 		sessionUseCases.Create,
 		sessionUseCases.Connect,
+		sessionUseCases.ConnectStatus,
 		sessionUseCases.Disconnect,
+		sessionUseCases.Restart,
+		sessionUseCases.LiveStatus,
+		sessionUseCases.ConnectAll,
+		sessionUseCases.DisconnectAll,
 		sessionUseCases.List,
+		sessionUseCases.BatchCreate,
+		sessionUseCases.Export,
 		sessionUseCases.Delete,
 		sessionUseCases.Resolve,
 		sessionUseCases.SetProxy,
+		sessionUseCases.RotateProxy,
+		sessionUseCases.SetDisplayName,
+		sessionUseCases.Rename,
+		sessionUseCases.PairingHistory,
+		sessionUseCases.SetSenders,
+		sessionUseCases.SetSendRate,
+		sessionUseCases.ExportBackup,
+		sessionUseCases.ImportBackup,
 		whatsappUseCases.GenerateQR,
 		whatsappUseCases.PairPhone,
+		whatsappUseCases.GetSyncStatus,
+		whatsappUseCases.GetHealth,
+		sessionConverter,
+		logger,
+		validator,
+	)
+
+	hc.groupHandler = handler.NewGroupHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.GetGroups,
+		whatsappUseCases.UpdateGroupParticipants,
+		logger,
+		validator,
+	)
+
+	hc.newsletterHandler = handler.NewNewsletterHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.GetNewsletter,
+		whatsappUseCases.SendNewsletterMessage,
+		logger,
+		validator,
+	)
+
+	hc.contactHandler = handler.NewContactHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.GetContacts,
+		whatsappUseCases.CheckNumbers,
+		whatsappUseCases.GetProfilePicture,
+		whatsappUseCases.GetBusinessProfile,
+		logger,
+		validator,
+	)
+
+	hc.presenceHandler = handler.NewPresenceHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.SubscribePresence,
+		whatsappUseCases.SendPresence,
+		whatsappUseCases.SendChatPresence,
+		logger,
+		validator,
+	)
+
+	hc.profileHandler = handler.NewProfileHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.SetProfilePicture,
+		logger,
+		validator,
+	)
+
+	hc.chatHandler = handler.NewChatHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.MarkRead,
+		whatsappUseCases.MarkUnread,
+		whatsappUseCases.ArchiveChat,
+		whatsappUseCases.MuteChat,
+		whatsappUseCases.DeleteChat,
+		whatsappUseCases.ClearChat,
+		whatsappUseCases.GetMessageContext,
+		whatsappUseCases.ListChatMessages,
+		whatsappUseCases.ListChats,
+		logger,
+		validator,
+	)
+
+	hc.labelHandler = handler.NewLabelHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.ListLabels,
+		whatsappUseCases.CreateLabel,
+		whatsappUseCases.LabelChat,
+		logger,
+		validator,
+	)
+
+	hc.messageHandler = handler.NewMessageHandler(
+		sessionUseCases.Resolve,
+		whatsappUseCases.DownloadMedia,
+		whatsappUseCases.SendPoll,
+		whatsappUseCases.SendSticker,
+		whatsappUseCases.SendButtons,
+		whatsappUseCases.SendList,
+		whatsappUseCases.ForwardMessage,
+		whatsappUseCases.SendBroadcast,
+		whatsappUseCases.ScheduleMessage,
+		whatsappUseCases.ListScheduledMessages,
+		whatsappUseCases.CancelScheduledMessage,
+		whatsappUseCases.GetMessageStatus,
+		whatsappUseCases.GetRecentMessages,
 		logger,
 		validator,
 	)
@@ -72,13 +188,38 @@ func (hc *httpContainer) initialize(
 		logger,
 	)
 
+	hc.authHandler = handler.NewAuthHandler(
+		cfg.Auth.BasicAuth.Username,
+		cfg.Auth.BasicAuth.Password,
+		cfg.Security.JWTSecret,
+		cfg.Auth.TokenTTL,
+		logger,
+	)
+
+	reloader := newConfigReloader(infraContainer, useCaseContainer.GetSendLimiter(), cfg)
+	hc.adminHandler = handler.NewAdminHandler(
+		reloader,
+		logger,
+	)
+
 	// Create router
 	hc.router = routes.NewRouter(
 		hc.sessionHandler,
+		hc.groupHandler,
+		hc.newsletterHandler,
+		hc.contactHandler,
+		hc.presenceHandler,
+		hc.profileHandler,
+		hc.chatHandler,
+		hc.labelHandler,
+		hc.messageHandler,
 		hc.healthHandler,
+		hc.authHandler,
+		hc.adminHandler,
 		cfg,
 		logger,
 	)
+	reloader.rateLimiter = hc.router.RateLimiter()
 
 	// Create HTTP server
 	hc.httpServer = server.New(
@@ -123,3 +264,10 @@ func (hc *httpContainer) StartServer(ctx context.Context) error {
 
 	return hc.serverManager.StartWithGracefulShutdown(ctx)
 }
+
+// Close stops the background work of the HTTP layer
+func (hc *httpContainer) Close() {
+	if hc.router != nil {
+		hc.router.Stop()
+	}
+}
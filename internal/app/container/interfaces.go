@@ -23,6 +23,7 @@ type Container interface {
 type UseCaseContainer interface {
 	GetSessionUseCases() SessionUseCases
 	GetWhatsAppUseCases() WhatsAppUseCases
+	GetSendLimiter() *whatsappUC.SendLimiter
 }
 
 // HTTPContainer defines the interface for HTTP layer management
@@ -30,23 +31,77 @@ type HTTPContainer interface {
 	GetServerManager() *server.ServerManager
 	GetServerInfo() server.ServerInfo
 	StartServer(ctx context.Context) error
+	Close()
 }
 
 // SessionUseCases groups all session-related use cases
 type SessionUseCases struct {
-	Create        *sessionUC.CreateUseCase
-	Connect       *sessionUC.ConnectUseCase
-	Disconnect    *sessionUC.DisconnectUseCase
-	List          *sessionUC.ListUseCase
-	Delete        *sessionUC.DeleteUseCase
-	Resolve       *sessionUC.ResolveUseCase
-	SetProxy      *sessionUC.SetProxyUseCase
-	AutoReconnect *sessionUC.AutoReconnectUseCase
+	Create         *sessionUC.CreateUseCase
+	Connect        *sessionUC.ConnectUseCase
+	ConnectStatus  *sessionUC.ConnectStatusUseCase
+	Disconnect     *sessionUC.DisconnectUseCase
+	Restart        *sessionUC.RestartUseCase
+	LiveStatus     *sessionUC.LiveStatusUseCase
+	ConnectAll     *sessionUC.ConnectAllUseCase
+	DisconnectAll  *sessionUC.DisconnectAllUseCase
+	List           *sessionUC.ListUseCase
+	BatchCreate    *sessionUC.BatchCreateUseCase
+	Export         *sessionUC.ExportUseCase
+	Delete         *sessionUC.DeleteUseCase
+	Resolve        *sessionUC.ResolveUseCase
+	SetProxy       *sessionUC.SetProxyUseCase
+	RotateProxy    *sessionUC.RotateProxyUseCase
+	SetDisplayName *sessionUC.SetDisplayNameUseCase
+	Rename         *sessionUC.RenameUseCase
+	AutoReconnect  *sessionUC.AutoReconnectUseCase
+	PairingHistory *sessionUC.PairingHistoryUseCase
+	SetSenders     *sessionUC.SetAllowedSendersUseCase
+	SetSendRate    *sessionUC.SetSendRateLimitUseCase
+	ExportBackup   *sessionUC.ExportBackupUseCase
+	ImportBackup   *sessionUC.ImportBackupUseCase
 }
 
 // WhatsAppUseCases groups all WhatsApp-related use cases
 type WhatsAppUseCases struct {
-	GenerateQR  *whatsappUC.GenerateQRUseCase
-	PairPhone   *whatsappUC.PairPhoneUseCase
-	SendMessage *whatsappUC.SendMessageUseCase
+	GenerateQR              *whatsappUC.GenerateQRUseCase
+	PairPhone               *whatsappUC.PairPhoneUseCase
+	SendMessage             *whatsappUC.SendMessageUseCase
+	SendPoll                *whatsappUC.SendPollUseCase
+	SendSticker             *whatsappUC.SendStickerUseCase
+	ForwardMessage          *whatsappUC.ForwardMessageUseCase
+	SendButtons             *whatsappUC.SendButtonsUseCase
+	SendList                *whatsappUC.SendListUseCase
+	SendBroadcast           *whatsappUC.SendBroadcastUseCase
+	ScheduleMessage         *whatsappUC.ScheduleMessageUseCase
+	ListScheduledMessages   *whatsappUC.ListScheduledMessagesUseCase
+	CancelScheduledMessage  *whatsappUC.CancelScheduledMessageUseCase
+	GetMessageStatus        *whatsappUC.GetMessageStatusUseCase
+	GetRecentMessages       *whatsappUC.GetRecentMessagesUseCase
+	GetSyncStatus           *whatsappUC.GetSyncStatusUseCase
+	GetHealth               *whatsappUC.GetHealthUseCase
+	GetGroups               *whatsappUC.GetGroupsUseCase
+	UpdateGroupParticipants *whatsappUC.UpdateGroupParticipantsUseCase
+	GetNewsletter           *whatsappUC.GetNewsletterUseCase
+	SendNewsletterMessage   *whatsappUC.SendNewsletterMessageUseCase
+	GetContacts             *whatsappUC.GetContactsUseCase
+	CheckNumbers            *whatsappUC.CheckNumbersUseCase
+	GetProfilePicture       *whatsappUC.GetProfilePictureUseCase
+	ListLabels              *whatsappUC.ListLabelsUseCase
+	CreateLabel             *whatsappUC.CreateLabelUseCase
+	LabelChat               *whatsappUC.LabelChatUseCase
+	GetBusinessProfile      *whatsappUC.GetBusinessProfileUseCase
+	SetProfilePicture       *whatsappUC.SetProfilePictureUseCase
+	SubscribePresence       *whatsappUC.SubscribePresenceUseCase
+	SendPresence            *whatsappUC.SendPresenceUseCase
+	SendChatPresence        *whatsappUC.SendChatPresenceUseCase
+	MarkRead                *whatsappUC.MarkReadUseCase
+	MarkUnread              *whatsappUC.MarkUnreadUseCase
+	ArchiveChat             *whatsappUC.ArchiveChatUseCase
+	MuteChat                *whatsappUC.MuteChatUseCase
+	DeleteChat              *whatsappUC.DeleteChatUseCase
+	ClearChat               *whatsappUC.ClearChatUseCase
+	DownloadMedia           *whatsappUC.DownloadMediaUseCase
+	GetMessageContext       *whatsappUC.GetMessageContextUseCase
+	ListChatMessages        *whatsappUC.ListChatMessagesUseCase
+	ListChats               *whatsappUC.ListChatsUseCase
 }
@@ -0,0 +1,86 @@
+package container
+
+import (
+	"fmt"
+	"sync"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/http/middleware"
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/container"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// configReloader re-reads the configuration and applies the settings that can change
+// without restarting the process to the running components
+type configReloader struct {
+	infraContainer *container.Container
+	sendLimiter    *whatsappUC.SendLimiter
+	rateLimiter    *middleware.RateLimiter
+	logger         logger.Logger
+
+	// current is the configuration in effect: reloadable settings are updated as they are
+	// applied, the others keep their startup value until the process restarts
+	mu      sync.Mutex
+	current config.Config
+}
+
+// newConfigReloader creates a reloader of the given running configuration
+func newConfigReloader(infraContainer *container.Container, sendLimiter *whatsappUC.SendLimiter, cfg *config.Config) *configReloader {
+	return &configReloader{
+		infraContainer: infraContainer,
+		sendLimiter:    sendLimiter,
+		logger:         infraContainer.Logger,
+		current:        *cfg,
+	}
+}
+
+// ReloadConfig loads the environment and .env file again and applies the reloadable
+// settings that changed. Nothing is applied when the new configuration is invalid.
+func (r *configReloader) ReloadConfig() (*config.ReloadPlan, error) {
+	next, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := logger.ParseLevel(next.Log.Level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	plan := config.PlanReload(&r.current, next)
+
+	if next.Log.Level != r.current.Log.Level {
+		r.logger.SetLevel(level)
+	}
+
+	if next.Server.RateLimit != r.current.Server.RateLimit && r.rateLimiter != nil {
+		r.rateLimiter.SetLimits(next.Server.RateLimit.RequestsPerMinute, next.Server.RateLimit.BurstSize)
+	}
+
+	if next.WhatsApp.SendRatePerSecond != r.current.WhatsApp.SendRatePerSecond ||
+		next.WhatsApp.SendRateBurst != r.current.WhatsApp.SendRateBurst ||
+		next.WhatsApp.SendRateMaxWait != r.current.WhatsApp.SendRateMaxWait {
+		r.sendLimiter.SetGlobal(session.SendRateLimit{
+			PerSecond: next.WhatsApp.SendRatePerSecond,
+			Burst:     next.WhatsApp.SendRateBurst,
+		}, next.WhatsApp.SendRateMaxWait)
+	}
+
+	if next.WhatsApp.DisconnectGracePeriod != r.current.WhatsApp.DisconnectGracePeriod {
+		r.infraContainer.SetDisconnectGracePeriod(next.WhatsApp.DisconnectGracePeriod)
+	}
+
+	r.current.ApplyReloadable(next)
+
+	r.logger.InfoWithFields("configuration reloaded", logger.Fields{
+		"applied":          len(plan.Reloadable),
+		"restart_required": len(plan.RestartRequired),
+	})
+
+	return plan, nil
+}
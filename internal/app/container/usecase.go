@@ -3,6 +3,8 @@ package container
 import (
 	"fmt"
 
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 	"wazmeow/internal/infra/container"
 	sessionUC "wazmeow/internal/usecases/session"
 	whatsappUC "wazmeow/internal/usecases/whatsapp"
@@ -13,6 +15,7 @@ import (
 type useCaseContainer struct {
 	sessionUseCases  SessionUseCases
 	whatsappUseCases WhatsAppUseCases
+	sendLimiter      *whatsappUC.SendLimiter
 	logger           logger.Logger
 	isInitialized    bool
 }
@@ -47,18 +50,54 @@ func (uc *useCaseContainer) initialize(infraContainer *container.Container) erro
 			infraContainer.WhatsAppManager,
 			logger,
 		),
+		ConnectStatus: sessionUC.NewConnectStatusUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
 		Disconnect: sessionUC.NewDisconnectUseCase(
 			infraContainer.SessionRepo,
 			infraContainer.WhatsAppManager,
 			logger,
 		),
+		Restart: sessionUC.NewRestartUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		LiveStatus: sessionUC.NewLiveStatusUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		ConnectAll: sessionUC.NewConnectAllUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		DisconnectAll: sessionUC.NewDisconnectAllUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
 		List: sessionUC.NewListUseCase(
 			infraContainer.SessionRepo,
 			logger,
 		),
+		Export: sessionUC.NewExportUseCase(
+			infraContainer.SessionRepo,
+			logger,
+		),
 		Delete: sessionUC.NewDeleteUseCase(
 			infraContainer.SessionRepo,
 			infraContainer.WhatsAppManager,
+			infraContainer.DeviceStore,
+			[]whatsapp.SessionDataRepository{
+				infraContainer.MessageRepo,
+				infraContainer.ReceiptRepo,
+				infraContainer.ChatRepo,
+				infraContainer.ScheduledRepo,
+			},
 			logger,
 		),
 		Resolve: sessionUC.NewResolveUseCase(
@@ -70,13 +109,71 @@ func (uc *useCaseContainer) initialize(infraContainer *container.Container) erro
 			logger,
 			validator,
 		),
+		RotateProxy: sessionUC.NewRotateProxyUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.Proxy.Pool,
+		),
+		SetDisplayName: sessionUC.NewSetDisplayNameUseCase(
+			infraContainer.SessionRepo,
+			logger,
+			validator,
+		),
+		Rename: sessionUC.NewRenameUseCase(
+			infraContainer.SessionRepo,
+			logger,
+		),
+		SetSenders: sessionUC.NewSetAllowedSendersUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			validator,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		SetSendRate: sessionUC.NewSetSendRateLimitUseCase(
+			infraContainer.SessionRepo,
+			logger,
+			validator,
+		),
 		AutoReconnect: sessionUC.NewAutoReconnectUseCase(
 			infraContainer.SessionRepo,
 			infraContainer.WhatsAppManager,
 			logger,
 		),
+		PairingHistory: sessionUC.NewPairingHistoryUseCase(
+			infraContainer.PairingAuditRepo,
+			logger,
+		),
+		ExportBackup: sessionUC.NewExportBackupUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.DeviceStore,
+			logger,
+			validator,
+		),
+		ImportBackup: sessionUC.NewImportBackupUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.DeviceStore,
+			logger,
+			validator,
+		),
 	}
 
+	uc.sessionUseCases.BatchCreate = sessionUC.NewBatchCreateUseCase(
+		uc.sessionUseCases.Create,
+		uc.sessionUseCases.SetProxy,
+		logger,
+		validator,
+	)
+
+	// One limiter paces the sends of every use case, so each session shares its budget
+	whatsappConfig := infraContainer.Config.WhatsApp
+	sendLimiter := whatsappUC.NewSendLimiter(session.SendRateLimit{
+		PerSecond: whatsappConfig.SendRatePerSecond,
+		Burst:     whatsappConfig.SendRateBurst,
+	}, whatsappConfig.SendRateMaxWait)
+	uc.sendLimiter = sendLimiter
+
 	// Initialize WhatsApp use cases
 	uc.whatsappUseCases = WhatsAppUseCases{
 		GenerateQR: whatsappUC.NewGenerateQRUseCase(
@@ -89,12 +186,240 @@ func (uc *useCaseContainer) initialize(infraContainer *container.Container) erro
 			infraContainer.WhatsAppManager,
 			logger,
 			validator,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
 		),
 		SendMessage: whatsappUC.NewSendMessageUseCase(
 			infraContainer.SessionRepo,
 			infraContainer.WhatsAppManager,
 			logger,
 			validator,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			sendLimiter,
+		),
+		SendPoll: whatsappUC.NewSendPollUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			sendLimiter,
+		),
+		SendSticker: whatsappUC.NewSendStickerUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			sendLimiter,
+		),
+		ForwardMessage: whatsappUC.NewForwardMessageUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.MessageRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			sendLimiter,
+		),
+		SendButtons: whatsappUC.NewSendButtonsUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			sendLimiter,
+		),
+		SendList: whatsappUC.NewSendListUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			sendLimiter,
+		),
+		SendBroadcast: whatsappUC.NewSendBroadcastUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			infraContainer.Config.WhatsApp.BroadcastDelay,
+			infraContainer.Config.WhatsApp.BroadcastMaxRecipients,
+			sendLimiter,
+		),
+		ScheduleMessage: whatsappUC.NewScheduleMessageUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.ScheduledRepo,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		ListScheduledMessages: whatsappUC.NewListScheduledMessagesUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.ScheduledRepo,
+			logger,
+		),
+		CancelScheduledMessage: whatsappUC.NewCancelScheduledMessageUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.ScheduledRepo,
+			logger,
+		),
+		GetMessageStatus: whatsappUC.NewGetMessageStatusUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.ReceiptRepo,
+			logger,
+		),
+		GetRecentMessages: whatsappUC.NewGetRecentMessagesUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		GetSyncStatus: whatsappUC.NewGetSyncStatusUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		GetHealth: whatsappUC.NewGetHealthUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		GetGroups: whatsappUC.NewGetGroupsUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		UpdateGroupParticipants: whatsappUC.NewUpdateGroupParticipantsUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			validator,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		GetNewsletter: whatsappUC.NewGetNewsletterUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		SendNewsletterMessage: whatsappUC.NewSendNewsletterMessageUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			sendLimiter,
+		),
+		GetContacts: whatsappUC.NewGetContactsUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		CheckNumbers: whatsappUC.NewCheckNumbersUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		ListLabels: whatsappUC.NewListLabelsUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		CreateLabel: whatsappUC.NewCreateLabelUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		LabelChat: whatsappUC.NewLabelChatUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		GetProfilePicture: whatsappUC.NewGetProfilePictureUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		GetBusinessProfile: whatsappUC.NewGetBusinessProfileUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		SetProfilePicture: whatsappUC.NewSetProfilePictureUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		SubscribePresence: whatsappUC.NewSubscribePresenceUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			infraContainer.Config.WhatsApp.AutoSendPresence,
+		),
+		SendPresence: whatsappUC.NewSendPresenceUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		SendChatPresence: whatsappUC.NewSendChatPresenceUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+			infraContainer.Config.WhatsApp.AutoSendPresence,
+		),
+		MarkRead: whatsappUC.NewMarkReadUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		MarkUnread: whatsappUC.NewMarkUnreadUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		ArchiveChat: whatsappUC.NewArchiveChatUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		MuteChat: whatsappUC.NewMuteChatUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		DeleteChat: whatsappUC.NewDeleteChatUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		ClearChat: whatsappUC.NewClearChatUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		DownloadMedia: whatsappUC.NewDownloadMediaUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
+		),
+		GetMessageContext: whatsappUC.NewGetMessageContextUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.MessageRepo,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		ListChatMessages: whatsappUC.NewListChatMessagesUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.MessageRepo,
+			logger,
+			infraContainer.Config.WhatsApp.DefaultCountryCode,
+		),
+		ListChats: whatsappUC.NewListChatsUseCase(
+			infraContainer.SessionRepo,
+			infraContainer.WhatsAppManager,
+			logger,
 		),
 	}
 
@@ -112,3 +437,8 @@ func (uc *useCaseContainer) GetSessionUseCases() SessionUseCases {
 func (uc *useCaseContainer) GetWhatsAppUseCases() WhatsAppUseCases {
 	return uc.whatsappUseCases
 }
+
+// GetSendLimiter returns the limiter shared by the send use cases
+func (uc *useCaseContainer) GetSendLimiter() *whatsappUC.SendLimiter {
+	return uc.sendLimiter
+}
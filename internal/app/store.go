@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/database"
+	"wazmeow/internal/infra/database/migrations"
+	infraLogger "wazmeow/internal/infra/logger"
+	"wazmeow/internal/infra/whats"
+)
+
+// UpgradeWhatsAppStore creates or upgrades the WhatsApp store schema without starting the
+// application, so operators can prepare the store before the instance accepts traffic
+func UpgradeWhatsAppStore(ctx context.Context) (*whats.StoreSchema, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log := infraLogger.New(&cfg.Log)
+
+	store, db, err := whats.OpenStore(cfg.Database.Driver, cfg.Database.URL, log)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return whats.UpgradeStore(ctx, store, db)
+}
+
+// RunMigrations applies the application migrations and upgrades the WhatsApp store schema
+// without starting the application, regardless of DB_AUTO_MIGRATE
+func RunMigrations(ctx context.Context) (*whats.StoreSchema, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log := infraLogger.New(&cfg.Log)
+
+	conn, err := database.New(&cfg.Database, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database connection: %w", err)
+	}
+	defer conn.Close()
+
+	if err := migrations.NewMigrator(conn.GetDB(), log).Migrate(ctx); err != nil {
+		return nil, fmt.Errorf("failed to run database migrations: %w", err)
+	}
+
+	store, db, err := whats.OpenStore(cfg.Database.Driver, cfg.Database.URL, log)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return whats.UpgradeStore(ctx, store, db)
+}
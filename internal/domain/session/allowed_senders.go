@@ -0,0 +1,109 @@
+package session
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxAllowedSenders is the maximum number of senders in a session allow-list
+const MaxAllowedSenders = 500
+
+// AllowedSenders returns the JIDs whose messages the session accepts. Empty means every sender.
+func (s *Session) AllowedSenders() []string {
+	if len(s.allowedSenders) == 0 {
+		return nil
+	}
+	return append([]string(nil), s.allowedSenders...)
+}
+
+// HasSenderFilter returns true if the session only accepts messages from its allowed senders
+func (s *Session) HasSenderFilter() bool {
+	return len(s.allowedSenders) > 0
+}
+
+// SetAllowedSenders replaces the sender allow-list. Entries must be JIDs; device suffixes
+// are dropped and duplicates removed. An empty list accepts every sender.
+func (s *Session) SetAllowedSenders(senders []string) error {
+	normalized, err := normalizeAllowedSenders(senders)
+	if err != nil {
+		return err
+	}
+
+	s.allowedSenders = normalized
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// RestoreAllowedSenders sets the sender allow-list loaded from persistence
+func (s *Session) RestoreAllowedSenders(senders []string) {
+	s.allowedSenders = append([]string(nil), senders...)
+}
+
+// AllowsSender reports whether a message from any of the given sender JIDs is accepted
+func (s *Session) AllowsSender(senderJIDs ...string) bool {
+	return SenderAllowed(s.allowedSenders, senderJIDs...)
+}
+
+// SenderAllowed reports whether any of the sender JIDs (e.g. phone number and LID of the
+// same user) is in the allow-list. An empty allow-list accepts every sender.
+func SenderAllowed(allowed []string, senderJIDs ...string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, sender := range senderJIDs {
+		sender = normalizeSenderJID(sender)
+		if sender == "" {
+			continue
+		}
+		for _, jid := range allowed {
+			if jid == sender {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeAllowedSenders validates, normalizes and deduplicates allow-list entries
+func normalizeAllowedSenders(senders []string) ([]string, error) {
+	if len(senders) > MaxAllowedSenders {
+		return nil, ErrTooManyAllowedSenders
+	}
+
+	seen := make(map[string]bool, len(senders))
+	normalized := make([]string, 0, len(senders))
+	for _, sender := range senders {
+		jid := normalizeSenderJID(sender)
+		if jid == "" {
+			return nil, ErrInvalidAllowedSender
+		}
+		if seen[jid] {
+			continue
+		}
+		seen[jid] = true
+		normalized = append(normalized, jid)
+	}
+
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	return normalized, nil
+}
+
+// normalizeSenderJID strips the agent and device parts of a JID (user.agent:device@server),
+// returning an empty string when it is not a JID
+func normalizeSenderJID(jid string) string {
+	user, server, found := strings.Cut(strings.TrimSpace(jid), "@")
+	if !found || server == "" {
+		return ""
+	}
+
+	user, _, _ = strings.Cut(user, ":")
+	user, _, _ = strings.Cut(user, ".")
+	if user == "" {
+		return ""
+	}
+
+	return user + "@" + strings.ToLower(server)
+}
@@ -4,19 +4,54 @@ import (
 	"net/url"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
+// MaxDisplayNameLength is the maximum length (in characters) of a session display name
+const MaxDisplayNameLength = 100
+
+// QRCodeLifetime is how long a stored QR code can be scanned. WhatsApp renews the
+// code before it expires while pairing is in progress.
+const QRCodeLifetime = 60 * time.Second
+
+// allowedTransitions lists the statuses each status may move to. Any session may disconnect
+// or fail, and whatsmeow may report a connection from any state it reconnects from on its
+// own, but a connected session has to disconnect before it can connect again.
+var allowedTransitions = map[Status][]Status{
+	StatusDisconnected: {StatusDisconnected, StatusConnecting, StatusConnected, StatusError},
+	StatusConnecting:   {StatusDisconnected, StatusConnecting, StatusConnected, StatusError},
+	StatusConnected:    {StatusDisconnected, StatusError},
+	StatusError:        {StatusDisconnected, StatusConnecting, StatusConnected, StatusError},
+}
+
 // Session represents a WhatsApp session entity
 type Session struct {
-	id        SessionID
-	name      string
-	status    Status
-	waJID     string
-	qrCode    string
-	proxyURL  string
-	isActive  bool
-	createdAt time.Time
-	updatedAt time.Time
+	id          SessionID
+	name        string
+	displayName string
+	status      Status
+	waJID       string
+	qrCode      string
+	proxyURL    string
+	isActive    bool
+	createdAt   time.Time
+	updatedAt   time.Time
+
+	// Senders whose messages are stored and delivered; empty accepts every sender
+	allowedSenders []string
+
+	// Why the session entered the error state; empty in every other state
+	errorReason string
+
+	// Why the session was last disconnected; cleared once it connects again
+	disconnectReason DisconnectReason
+
+	// When the stored QR code stops being scannable; zero when there is none
+	qrCodeExpiresAt time.Time
+
+	// Outbound message limit overriding the global one; nil uses the global limit
+	sendRateLimit *SendRateLimit
 }
 
 // NewSession creates a new session with the given name
@@ -39,18 +74,30 @@ func NewSession(name string) *Session {
 }
 
 // RestoreSession restores a session from persistence
-func RestoreSession(id SessionID, name string, status Status, waJID string, qrCode string, proxyURL string, isActive bool, createdAt, updatedAt time.Time) *Session {
+func RestoreSession(id SessionID, name string, displayName string, status Status, waJID string, qrCode string, proxyURL string, isActive bool, createdAt, updatedAt time.Time) *Session {
 	return &Session{
-		id:        id,
-		name:      name,
-		status:    status,
-		waJID:     waJID,
-		qrCode:    qrCode,
-		proxyURL:  proxyURL,
-		isActive:  isActive,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:          id,
+		name:        name,
+		displayName: displayName,
+		status:      status,
+		waJID:       waJID,
+		qrCode:      qrCode,
+		proxyURL:    proxyURL,
+		isActive:    isActive,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// CanTransitionTo returns ErrSessionInvalidState if the session may not move from its
+// current status to the given one
+func (s *Session) CanTransitionTo(status Status) error {
+	for _, allowed := range allowedTransitions[s.status] {
+		if allowed == status {
+			return nil
+		}
 	}
+	return ErrSessionInvalidState
 }
 
 // Connect marks the session as connected with the given WhatsApp JID
@@ -58,6 +105,9 @@ func (s *Session) Connect(waJID string) error {
 	if s.status == StatusConnected {
 		return ErrSessionAlreadyConnected
 	}
+	if err := s.CanTransitionTo(StatusConnected); err != nil {
+		return err
+	}
 
 	if waJID == "" {
 		return ErrInvalidWhatsAppJID
@@ -66,40 +116,99 @@ func (s *Session) Connect(waJID string) error {
 	s.waJID = waJID
 	s.status = StatusConnected
 	s.isActive = true
+	s.errorReason = ""
+	s.disconnectReason = ""
 	s.updatedAt = time.Now()
 
 	return nil
 }
 
-// Disconnect marks the session as disconnected
+// Disconnect marks the session as disconnected without a recorded reason
 func (s *Session) Disconnect() {
+	s.DisconnectWithReason(DisconnectReasonUnknown)
+}
+
+// DisconnectWithReason marks the session as disconnected and records why
+func (s *Session) DisconnectWithReason(reason DisconnectReason) {
 	s.status = StatusDisconnected
+	s.disconnectReason = reason
 	s.isActive = false
+	s.errorReason = ""
 	s.updatedAt = time.Now()
 }
 
-// SetConnecting marks the session as connecting
-func (s *Session) SetConnecting() {
+// Logout marks the session as logged out and forgets its WhatsApp JID, since the
+// device credentials it was paired with are no longer valid
+func (s *Session) Logout() {
+	s.DisconnectWithReason(DisconnectReasonLoggedOut)
+	s.waJID = ""
+	s.qrCode = ""
+	s.qrCodeExpiresAt = time.Time{}
+}
+
+// SetConnecting marks the session as connecting. A connected session must disconnect first.
+func (s *Session) SetConnecting() error {
+	if err := s.CanTransitionTo(StatusConnecting); err != nil {
+		return err
+	}
+
 	s.status = StatusConnecting
+	s.errorReason = ""
 	s.updatedAt = time.Now()
+	return nil
+}
+
+// SetError marks the session as failed with the given reason. The session is no
+// longer connected and stays in this state until it connects or disconnects again.
+func (s *Session) SetError(reason string) {
+	s.status = StatusError
+	s.isActive = false
+	s.errorReason = reason
+	s.updatedAt = time.Now()
+}
+
+// RestoreErrorReason sets the error reason loaded from persistence
+func (s *Session) RestoreErrorReason(reason string) {
+	s.errorReason = reason
 }
 
-// SetQRCode updates the session QR code
+// RestoreDisconnectReason sets the disconnect reason loaded from persistence
+func (s *Session) RestoreDisconnectReason(reason DisconnectReason) {
+	s.disconnectReason = reason
+}
+
+// RestoreQRCodeExpiresAt sets the QR code expiry loaded from persistence
+func (s *Session) RestoreQRCodeExpiresAt(expiresAt time.Time) {
+	s.qrCodeExpiresAt = expiresAt
+}
+
+// SetQRCode updates the session QR code, which expires after QRCodeLifetime
 func (s *Session) SetQRCode(qrCode string) {
+	now := time.Now()
 	s.qrCode = qrCode
-	s.updatedAt = time.Now()
+	s.qrCodeExpiresAt = time.Time{}
+	if qrCode != "" {
+		s.qrCodeExpiresAt = now.Add(QRCodeLifetime)
+	}
+	s.updatedAt = now
 }
 
-// ClearQRCode clears the session QR code
+// ClearQRCode clears the session QR code and its expiry
 func (s *Session) ClearQRCode() {
 	s.qrCode = ""
+	s.qrCodeExpiresAt = time.Time{}
 	s.updatedAt = time.Now()
 }
 
-// UpdateName updates the session name
+// HasValidQRCode reports whether the session holds a QR code that has not expired
+func (s *Session) HasValidQRCode() bool {
+	return s.qrCode != "" && time.Now().Before(s.qrCodeExpiresAt)
+}
+
+// UpdateName updates the session name, enforcing the session naming rules
 func (s *Session) UpdateName(name string) error {
-	if name == "" {
-		return ErrInvalidSessionName
+	if err := validateSessionName(name); err != nil {
+		return err
 	}
 
 	s.name = name
@@ -107,6 +216,25 @@ func (s *Session) UpdateName(name string) error {
 	return nil
 }
 
+// SetDisplayName updates the free-form label of the session. An empty value clears it.
+func (s *Session) SetDisplayName(displayName string) error {
+	displayName = strings.TrimSpace(displayName)
+
+	if utf8.RuneCountInString(displayName) > MaxDisplayNameLength {
+		return ErrDisplayNameTooLong
+	}
+
+	for _, char := range displayName {
+		if unicode.IsControl(char) {
+			return ErrInvalidDisplayName
+		}
+	}
+
+	s.displayName = displayName
+	s.updatedAt = time.Now()
+	return nil
+}
+
 // SetProxyURL updates the session proxy URL with validation
 func (s *Session) SetProxyURL(proxyURL string) error {
 	if proxyURL != "" {
@@ -238,9 +366,8 @@ func (s *Session) validateProxyURL(proxyURL string) error {
 
 // CanConnect returns true if the session can be connected
 func (s *Session) CanConnect() bool {
-	// Allow connection for disconnected and connecting states
-	// Only prevent connection if already connected
-	return s.status != StatusConnected
+	// Only a connected session has to disconnect before connecting again
+	return s.CanTransitionTo(StatusConnecting) == nil
 }
 
 // IsConnected returns true if the session is connected
@@ -253,6 +380,11 @@ func (s *Session) IsConnecting() bool {
 	return s.status == StatusConnecting
 }
 
+// HasError returns true if the session is in error state
+func (s *Session) HasError() bool {
+	return s.status == StatusError
+}
+
 // Getters
 func (s *Session) ID() SessionID {
 	return s.id
@@ -262,6 +394,18 @@ func (s *Session) Name() string {
 	return s.name
 }
 
+func (s *Session) DisplayName() string {
+	return s.displayName
+}
+
+// Label returns the display name, falling back to the unique name
+func (s *Session) Label() string {
+	if s.displayName != "" {
+		return s.displayName
+	}
+	return s.name
+}
+
 func (s *Session) Status() Status {
 	return s.status
 }
@@ -274,6 +418,10 @@ func (s *Session) QRCode() string {
 	return s.qrCode
 }
 
+func (s *Session) QRCodeExpiresAt() time.Time {
+	return s.qrCodeExpiresAt
+}
+
 func (s *Session) IsActive() bool {
 	return s.isActive
 }
@@ -290,6 +438,14 @@ func (s *Session) ProxyURL() string {
 	return s.proxyURL
 }
 
+func (s *Session) ErrorReason() string {
+	return s.errorReason
+}
+
+func (s *Session) DisconnectReason() DisconnectReason {
+	return s.disconnectReason
+}
+
 // Validate validates the session entity
 func (s *Session) Validate() error {
 	if s.name == "" {
@@ -300,5 +456,9 @@ func (s *Session) Validate() error {
 		return ErrInvalidSessionName
 	}
 
+	if !s.status.IsValid() {
+		return ErrInvalidStatus
+	}
+
 	return nil
 }
@@ -13,6 +13,7 @@ var (
 	ErrSessionAlreadyConnected = errors.New("session already connected")
 	ErrSessionNotConnected     = errors.New("session not connected")
 	ErrSessionInvalidState     = errors.New("session in invalid state")
+	ErrConnectionNotStarted    = errors.New("no connection attempt for session")
 
 	// SessionID errors
 	ErrInvalidSessionID = errors.New("invalid session ID")
@@ -28,18 +29,40 @@ var (
 	ErrInvalidSessionNameChars = errors.New("session name contains invalid characters")
 	ErrSessionNameRequired     = errors.New("session name is required")
 
+	// Session display name errors
+	ErrDisplayNameTooLong = errors.New("display name too long (maximum 100 characters)")
+	ErrInvalidDisplayName = errors.New("display name contains control characters")
+
+	// Allowed sender errors
+	ErrInvalidAllowedSender  = errors.New("invalid allowed sender JID")
+	ErrTooManyAllowedSenders = errors.New("too many allowed senders (maximum 500)")
+
+	// Send rate limit errors
+	ErrInvalidSendRateLimit = errors.New("invalid send rate limit (per_second must be between 0 and 100 and burst must not be negative)")
+
 	// WhatsApp JID errors
 	ErrInvalidWhatsAppJID = errors.New("invalid WhatsApp JID")
 	ErrEmptyWhatsAppJID   = errors.New("WhatsApp JID cannot be empty")
 
+	// Backup errors
+	ErrSessionNotPaired         = errors.New("session has no paired WhatsApp device")
+	ErrInvalidSessionBackup     = errors.New("invalid session backup")
+	ErrUnsupportedBackupVersion = errors.New("unsupported session backup version")
+
 	// Proxy URL errors
 	ErrInvalidProxyURL        = errors.New("invalid proxy URL")
 	ErrUnsupportedProxyScheme = errors.New("unsupported proxy scheme")
 	ErrInvalidProxyHost       = errors.New("invalid proxy host")
+	ErrNoProxyAvailable       = errors.New("no proxy available for rotation")
 
 	// Status errors
 	ErrInvalidStatus = errors.New("invalid session status")
 
+	// Listing errors
+	ErrInvalidSortField = errors.New("invalid sort field (expected created_at, name or updated_at)")
+	ErrInvalidSortOrder = errors.New("invalid sort order (expected asc or desc)")
+	ErrInvalidDateRange = errors.New("created_after must not be later than created_before")
+
 	// Repository errors
 	ErrRepositoryConnection = errors.New("repository connection error")
 	ErrRepositoryTimeout    = errors.New("repository operation timeout")
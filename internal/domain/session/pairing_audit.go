@@ -0,0 +1,75 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PairingOutcome represents the result of a pairing attempt
+type PairingOutcome string
+
+const (
+	// PairingOutcomeSuccess indicates the device was paired successfully
+	PairingOutcomeSuccess PairingOutcome = "success"
+	// PairingOutcomeFailed indicates the pairing was rejected or errored
+	PairingOutcomeFailed PairingOutcome = "failed"
+	// PairingOutcomeTimeout indicates the QR code expired without being scanned
+	PairingOutcomeTimeout PairingOutcome = "timeout"
+)
+
+// String returns the string representation of the outcome
+func (o PairingOutcome) String() string {
+	return string(o)
+}
+
+// PairingMethod represents how a pairing attempt was initiated
+type PairingMethod string
+
+const (
+	// PairingMethodQR indicates pairing via QR code scan
+	PairingMethodQR PairingMethod = "qr"
+	// PairingMethodPhone indicates pairing via phone number code
+	PairingMethodPhone PairingMethod = "phone"
+)
+
+// String returns the string representation of the method
+func (m PairingMethod) String() string {
+	return string(m)
+}
+
+// PairingAttempt is an audit record of a single pairing attempt
+type PairingAttempt struct {
+	ID        string
+	SessionID SessionID
+	Outcome   PairingOutcome
+	Method    PairingMethod
+	JID       string
+	RequestID string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// NewPairingAttempt creates a new pairing audit record
+func NewPairingAttempt(sessionID SessionID, outcome PairingOutcome, method PairingMethod, jid, requestID, reason string) *PairingAttempt {
+	return &PairingAttempt{
+		ID:        uuid.New().String(),
+		SessionID: sessionID,
+		Outcome:   outcome,
+		Method:    method,
+		JID:       jid,
+		RequestID: requestID,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+}
+
+// PairingAuditRepository defines the interface for pairing audit persistence
+type PairingAuditRepository interface {
+	// Create stores a new pairing attempt
+	Create(ctx context.Context, attempt *PairingAttempt) error
+
+	// ListBySessionID retrieves pairing attempts of a session, newest first, with pagination
+	ListBySessionID(ctx context.Context, sessionID SessionID, limit, offset int) ([]*PairingAttempt, int, error)
+}
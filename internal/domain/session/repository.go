@@ -1,6 +1,9 @@
 package session
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository defines the interface for session persistence operations
 type Repository interface {
@@ -16,6 +19,10 @@ type Repository interface {
 	// List retrieves sessions with pagination
 	List(ctx context.Context, limit, offset int) ([]*Session, int, error)
 
+	// ListWithFilter retrieves the sessions matching the filter, sorted and paginated by
+	// the options, along with the total number of matching sessions
+	ListWithFilter(ctx context.Context, filter ListFilter, options ListOptions) ([]*Session, int, error)
+
 	// Update updates an existing session
 	Update(ctx context.Context, session *Session) error
 
@@ -38,13 +45,28 @@ type Repository interface {
 	ExistsByName(ctx context.Context, name string) (bool, error)
 }
 
-// ListFilter represents filters for listing sessions
+// ListFilter represents filters for listing sessions. Nil and empty fields match every session.
 type ListFilter struct {
-	Status   *Status
-	IsActive *bool
-	Search   string
+	Status        *Status
+	IsActive      *bool
+	NameContains  string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
 }
 
+// Sort fields supported when listing sessions
+const (
+	SortByCreatedAt = "created_at"
+	SortByName      = "name"
+	SortByUpdatedAt = "updated_at"
+)
+
+// Sort orders supported when listing sessions
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
 // ListOptions represents options for listing sessions
 type ListOptions struct {
 	Limit  int
@@ -53,13 +75,17 @@ type ListOptions struct {
 	Order  string
 }
 
-// RepositoryWithFilters extends Repository with advanced filtering capabilities
-type RepositoryWithFilters interface {
-	Repository
-
-	// ListWithFilter retrieves sessions with advanced filtering
-	ListWithFilter(ctx context.Context, filter ListFilter, options ListOptions) ([]*Session, int, error)
+// IsValidSortField returns true if sessions can be sorted by the given field
+func IsValidSortField(field string) bool {
+	switch field {
+	case SortByCreatedAt, SortByName, SortByUpdatedAt:
+		return true
+	default:
+		return false
+	}
+}
 
-	// CountWithFilter counts sessions matching the filter
-	CountWithFilter(ctx context.Context, filter ListFilter) (int, error)
+// IsValidSortOrder returns true if the given sort order is supported
+func IsValidSortOrder(order string) bool {
+	return order == SortOrderAsc || order == SortOrderDesc
 }
@@ -0,0 +1,75 @@
+package session
+
+import (
+	"math"
+	"time"
+)
+
+// MaxSendRatePerSecond is the highest send rate a session can be configured with
+const MaxSendRatePerSecond = 100
+
+// SendRateLimit caps the outbound messages of a session with a token bucket: up to Burst
+// messages go out at once, then PerSecond more each second. A zero PerSecond sends without limit.
+type SendRateLimit struct {
+	PerSecond float64 `json:"per_second"`
+	Burst     int     `json:"burst"`
+}
+
+// Enabled reports whether sends are limited
+func (l SendRateLimit) Enabled() bool {
+	return l.PerSecond > 0
+}
+
+// Validate checks the rate and burst. A zero burst is accepted and means one message.
+func (l SendRateLimit) Validate() error {
+	if math.IsNaN(l.PerSecond) || l.PerSecond < 0 || l.PerSecond > MaxSendRatePerSecond || l.Burst < 0 {
+		return ErrInvalidSendRateLimit
+	}
+	return nil
+}
+
+// normalized returns the limit with a burst of at least one message when enabled
+func (l SendRateLimit) normalized() SendRateLimit {
+	if l.Enabled() && l.Burst < 1 {
+		l.Burst = 1
+	}
+	return l
+}
+
+// SendRateLimit returns the send rate limit of the session, or nil when it uses the global one
+func (s *Session) SendRateLimit() *SendRateLimit {
+	if s.sendRateLimit == nil {
+		return nil
+	}
+	limit := *s.sendRateLimit
+	return &limit
+}
+
+// SetSendRateLimit overrides the global send rate limit for the session. A nil limit
+// goes back to the global one; a zero rate lets the session send without limit.
+func (s *Session) SetSendRateLimit(limit *SendRateLimit) error {
+	if limit == nil {
+		s.sendRateLimit = nil
+		s.updatedAt = time.Now()
+		return nil
+	}
+
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+
+	normalized := limit.normalized()
+	s.sendRateLimit = &normalized
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// RestoreSendRateLimit sets the send rate limit loaded from persistence
+func (s *Session) RestoreSendRateLimit(limit *SendRateLimit) {
+	if limit == nil {
+		s.sendRateLimit = nil
+		return
+	}
+	restored := *limit
+	s.sendRateLimit = &restored
+}
@@ -56,6 +56,8 @@ const (
 	StatusConnecting
 	// StatusConnected indicates the session is connected and active
 	StatusConnected
+	// StatusError indicates the connection failed in a way that is not retried automatically
+	StatusError
 )
 
 // String returns the string representation of the Status
@@ -67,6 +69,8 @@ func (s Status) String() string {
 		return "connecting"
 	case StatusConnected:
 		return "connected"
+	case StatusError:
+		return "error"
 	default:
 		return "unknown"
 	}
@@ -74,7 +78,7 @@ func (s Status) String() string {
 
 // IsValid returns true if the status is valid
 func (s Status) IsValid() bool {
-	return s >= StatusDisconnected && s <= StatusConnected
+	return s >= StatusDisconnected && s <= StatusError
 }
 
 // StatusFromString creates a Status from a string value
@@ -86,11 +90,36 @@ func StatusFromString(s string) (Status, error) {
 		return StatusConnecting, nil
 	case "connected":
 		return StatusConnected, nil
+	case "error":
+		return StatusError, nil
 	default:
 		return StatusDisconnected, fmt.Errorf("invalid status: %s", s)
 	}
 }
 
+// DisconnectReason describes why a session was disconnected
+type DisconnectReason string
+
+const (
+	// DisconnectReasonUnknown is used when the cause of the disconnection was not recorded
+	DisconnectReasonUnknown DisconnectReason = "unknown"
+	// DisconnectReasonRequested indicates the session was disconnected through the API
+	DisconnectReasonRequested DisconnectReason = "requested"
+	// DisconnectReasonLoggedOut indicates the device was logged out from the phone or by WhatsApp
+	DisconnectReasonLoggedOut DisconnectReason = "logged_out"
+	// DisconnectReasonConnectionLost indicates the connection dropped and did not come back
+	DisconnectReasonConnectionLost DisconnectReason = "connection_lost"
+	// DisconnectReasonQRTimeout indicates the QR code expired without being scanned
+	DisconnectReasonQRTimeout DisconnectReason = "qr_timeout"
+	// DisconnectReasonConnectFailed indicates a connection attempt failed
+	DisconnectReasonConnectFailed DisconnectReason = "connect_failed"
+)
+
+// String returns the string representation of the DisconnectReason
+func (r DisconnectReason) String() string {
+	return string(r)
+}
+
 // SessionName represents a session name with validation
 type SessionName struct {
 	value string
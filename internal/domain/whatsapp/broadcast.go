@@ -0,0 +1,50 @@
+package whatsapp
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTextMessageLength is the longest text message accepted for sending
+	MaxTextMessageLength = 4096
+	// DefaultBroadcastMaxRecipients is the recipient limit of a broadcast unless configured
+	DefaultBroadcastMaxRecipients = 50
+)
+
+// Broadcast domain errors
+var (
+	ErrInvalidBroadcastMessage    = errors.New("broadcast message is required and must have up to 4096 characters")
+	ErrNoBroadcastRecipients      = errors.New("at least one broadcast recipient is required")
+	ErrTooManyBroadcastRecipients = errors.New("too many broadcast recipients")
+	ErrInvalidRecipient           = errors.New("invalid recipient (must be a phone number with country code or a contact or group JID)")
+	ErrDuplicateRecipient         = errors.New("duplicate recipient")
+)
+
+// phoneUserPattern matches the user part of phone number JIDs: an international number
+// of up to 15 digits (E.164), without the leading +
+var phoneUserPattern = regexp.MustCompile(`^[1-9]\d{7,14}$`)
+
+// ValidateBroadcastMessage checks the text sent to every recipient of a broadcast
+func ValidateBroadcastMessage(message string) error {
+	if strings.TrimSpace(message) == "" || utf8.RuneCountInString(message) > MaxTextMessageLength {
+		return ErrInvalidBroadcastMessage
+	}
+	return nil
+}
+
+// ValidateRecipient checks a formatted recipient JID. Phone number JIDs must hold a
+// full international number.
+func ValidateRecipient(jid string) error {
+	if err := ValidateChatJID(jid); err != nil {
+		return ErrInvalidRecipient
+	}
+
+	user, server, _ := strings.Cut(jid, "@")
+	if server == "s.whatsapp.net" && !phoneUserPattern.MatchString(user) {
+		return ErrInvalidRecipient
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package whatsapp
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// MaxReadReceiptMessages is the maximum number of messages marked as read in a single receipt
+const MaxReadReceiptMessages = 500
+
+// Chat domain errors
+var (
+	ErrNoMessageIDs        = errors.New("at least one message ID is required")
+	ErrTooManyMessageIDs   = errors.New("too many message IDs")
+	ErrMessageSenderNeeded = errors.New("sender JID is required for group chats")
+	ErrChatNotFound        = errors.New("chat not found")
+	ErrInvalidChatJID      = errors.New("invalid chat JID (must be a contact or group JID)")
+	ErrInvalidMuteUntil    = errors.New("mute end time must be in the future")
+	ErrAppStateNotReady    = errors.New("app state keys not synced yet, retry once the session finishes syncing")
+	ErrAppStateRejected    = errors.New("WhatsApp rejected the app state update")
+)
+
+// chatServers are the JID servers of chats that can be archived or muted
+var chatServers = map[string]bool{
+	"s.whatsapp.net": true,
+	"g.us":           true,
+	"lid":            true,
+}
+
+// ValidateChatJID checks that a JID identifies a contact or group chat
+func ValidateChatJID(jid string) error {
+	user, server, found := strings.Cut(jid, "@")
+	if !found || user == "" || !chatServers[server] {
+		return ErrInvalidChatJID
+	}
+	return nil
+}
+
+// ValidateMuteUntil checks the end of a chat mute. A zero time unmutes the chat.
+func ValidateMuteUntil(until, now time.Time) error {
+	if !until.IsZero() && !until.After(now) {
+		return ErrInvalidMuteUntil
+	}
+	return nil
+}
+
+// ChatState represents the local settings of a chat synced through app state
+type ChatState struct {
+	JID        string
+	Unread     bool
+	Archived   bool
+	Pinned     bool
+	MutedUntil time.Time
+}
+
+// IsMuted reports whether the chat is muted at the given time
+func (s *ChatState) IsMuted(now time.Time) bool {
+	return s.MutedUntil.After(now)
+}
+
+// ChatStateChangeKind identifies which chat setting changed
+type ChatStateChangeKind string
+
+// Chat settings changed through app state, e.g. from the phone
+const (
+	ChatStateChangeMute    ChatStateChangeKind = "mute"
+	ChatStateChangePin     ChatStateChangeKind = "pin"
+	ChatStateChangeArchive ChatStateChangeKind = "archive"
+)
+
+// ChatStateChange represents a chat setting changed on another device
+type ChatStateChange struct {
+	Kind      ChatStateChangeKind
+	State     ChatState
+	Timestamp time.Time
+}
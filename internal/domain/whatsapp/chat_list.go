@@ -0,0 +1,48 @@
+package whatsapp
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/internal/domain/session"
+)
+
+// ChatSummary is an entry of the chat list of a session, seeded by the history sync
+// that follows pairing and kept current by live messages
+type ChatSummary struct {
+	SessionID     session.SessionID
+	JID           string
+	Name          string // Group subject or contact name; may be empty for unknown contacts
+	LastMessageAt time.Time
+	UnreadCount   int
+}
+
+// ChatList is the chat list of a session, most recent chat first
+type ChatList struct {
+	Chats []*ChatSummary
+	// SyncComplete is false while the history sync is still delivering conversations,
+	// in which case Chats holds only what arrived so far
+	SyncComplete bool
+}
+
+// ChatRepository defines persistence operations for the chat list
+type ChatRepository interface {
+	SessionDataRepository
+
+	// SaveAll stores chats received from a history sync. A stored name is kept when
+	// the new one is empty, and the last message time never moves back.
+	SaveAll(ctx context.Context, chats []*ChatSummary) error
+
+	// RecordMessage moves a chat's last message time forward, counting the message as
+	// unread when it is incoming. Unknown chats are added.
+	RecordMessage(ctx context.Context, sessionID session.SessionID, chatJID string, at time.Time, incoming bool) error
+
+	// MarkRead resets the unread count of a chat
+	MarkRead(ctx context.Context, sessionID session.SessionID, chatJID string) error
+
+	// ListBySession retrieves the chats of a session, most recent first
+	ListBySession(ctx context.Context, sessionID session.SessionID) ([]*ChatSummary, error)
+
+	// DeleteBefore deletes the chats of every session whose last message is older than the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -12,27 +12,88 @@ type Client interface {
 	// Connection management
 	Connect(ctx context.Context) (*ConnectionResult, error)
 	Disconnect(ctx context.Context) error
+	Logout(ctx context.Context) error // unregisters the device and deletes its credentials
 	IsConnected() bool
 	GetConnectionStatus() ConnectionStatus
 
 	// Authentication
 	GenerateQR(ctx context.Context) (string, error)
-	PairPhone(ctx context.Context, phoneNumber string) error
+	GetQRCode() string          // raw pairing string of the current QR code, empty when none
+	QRCodeExpiresAt() time.Time // when the current QR code expires, zero when none
+	PairPhone(ctx context.Context, phoneNumber string, opts PairPhoneOptions) (string, error)
 	IsAuthenticated() bool
 
+	// Network
+	SetProxy(ctx context.Context, proxyURL string) error
+
 	// Session information
 	GetSessionID() session.SessionID
 	GetJID() string
 	GetDeviceInfo() *DeviceInfo
+	GetSyncStatus() *SyncStatus
+	GetPairingStatus() *PairingStatus
+	GetHealth() *ClientHealth
 
 	// Messaging
-	SendMessage(ctx context.Context, to, message string) error
+	SendMessage(ctx context.Context, to, message string) (string, error) // returns the message ID
 	SendImage(ctx context.Context, to, imagePath, caption string) error
 	SendDocument(ctx context.Context, to, documentPath, filename string) error
+	SendPoll(ctx context.Context, to, question string, options []string, selectableCount int) (string, error) // returns the poll message ID
+	SendSticker(ctx context.Context, to string, stickerData []byte) (string, error)                           // returns the sticker message ID
+	SendButtons(ctx context.Context, to, body string, buttons []Button) (string, error)                       // returns the message ID
+	SendList(ctx context.Context, to, body, buttonText string, sections []ListSection) (string, error)        // returns the message ID
+	MarkRead(ctx context.Context, chatJID, senderJID string, messageIDs []string) error
+	DownloadMedia(ctx context.Context, messageID string) (*MediaContent, error)
+	ForwardMessage(ctx context.Context, fromChat, messageID, toChat string) (string, error) // returns the forwarded message ID
+	MarkChatUnread(ctx context.Context, chatJID string) (*ChatState, error)
+	ArchiveChat(ctx context.Context, chatJID string, archive bool) (*ChatState, error)
+	MuteChat(ctx context.Context, chatJID string, until time.Time) (*ChatState, error) // a zero time unmutes
+	DeleteChat(ctx context.Context, chatJID string) error
+	ClearChat(ctx context.Context, chatJID string) error
+	GetChats(ctx context.Context) (*ChatList, error)
+
+	// Labels (WhatsApp Business only)
+	GetLabels(ctx context.Context) ([]*Label, error)
+	CreateLabel(ctx context.Context, name string, color int) (*Label, error)
+	LabelChat(ctx context.Context, chatJID, labelID string, labeled bool) error
+	LabelMessage(ctx context.Context, chatJID, messageID, labelID string, labeled bool) error
+
+	// Groups
+	GetJoinedGroups(ctx context.Context) ([]*GroupInfo, error)
+	GetGroupInfo(ctx context.Context, groupJID string) (*GroupInfo, error)
+	UpdateGroupParticipants(ctx context.Context, groupJID string, participants []string, action string) ([]*ParticipantUpdateResult, error)
+
+	// Newsletters (channels)
+	GetNewsletterInfo(ctx context.Context, newsletterJID string) (*NewsletterInfo, error)
+	SendNewsletterMessage(ctx context.Context, newsletterJID, text string) error
+
+	// Contacts
+	GetContacts(ctx context.Context) ([]*ContactInfo, error)
+	IsOnWhatsApp(ctx context.Context, phones []string) ([]*NumberCheckResult, error)
+
+	// Profile
+	GetProfilePicture(ctx context.Context, jid string, preview bool) (*ProfilePictureInfo, error)
+	GetBusinessProfile(ctx context.Context, jid string) (*BusinessProfile, error)
+	SetProfilePicture(ctx context.Context, imageData []byte) (string, error)
+
+	// Presence
+	SendPresence(ctx context.Context, state PresenceState) error
+	HasSentPresence() bool
+	SubscribePresence(ctx context.Context, jid string) error
+	SendChatPresence(ctx context.Context, chatJID string, state ChatPresenceState) error
+
+	// RecentMessages returns the incoming messages buffered in memory that are newer than the since cursor
+	RecentMessages(since uint64) (*RecentMessages, error)
 
 	// Event handling
+	// SetEventHandler replaces every event handler of the client with handler
 	SetEventHandler(handler EventHandler)
+	// AddEventHandler adds a handler notified after the existing ones and returns a function that removes it
+	AddEventHandler(handler EventHandler) (remove func())
+	// RemoveEventHandler removes every event handler of the client
 	RemoveEventHandler()
+	// SetAllowedSenders limits stored and delivered incoming messages to the given sender JIDs; empty accepts all
+	SetAllowedSenders(senders []string)
 
 	// Lifecycle
 	Close() error
@@ -48,11 +109,31 @@ type Manager interface {
 
 	// Lifecycle
 	Start(ctx context.Context) error
-	Stop() error
+	// Stop waits until ctx is done for in-flight message sends before closing every client
+	Stop(ctx context.Context) error
 	IsRunning() bool
 
 	// Health check
 	HealthCheck() error
+
+	// Bulk operations
+	ConnectAll(ctx context.Context) ([]BulkResult, error)
+	DisconnectAll(ctx context.Context) ([]BulkResult, error)
+
+	// Recovery
+	RestartClient(sessionID session.SessionID) error
+
+	// Connection guard: AcquireConnect reserves driving Connect for a session and fails
+	// with ErrConnectInProgress while another connect of the same session runs
+	AcquireConnect(sessionID session.SessionID) (release func(), err error)
+}
+
+// BulkResult is the outcome of a bulk operation for a single client
+type BulkResult struct {
+	SessionID session.SessionID
+	Changed   bool // true if the operation changed the client state
+	Status    ConnectionStatus
+	Error     error
 }
 
 // ConnectionResult represents the result of a connection attempt
@@ -108,20 +189,28 @@ type DeviceInfo struct {
 // EventHandler defines the interface for handling WhatsApp events
 type EventHandler interface {
 	OnConnected(sessionID session.SessionID, jid string)
-	OnDisconnected(sessionID session.SessionID, reason string)
+	OnDisconnected(sessionID session.SessionID, reason session.DisconnectReason)
 	OnQRCode(sessionID session.SessionID, qrCode string)
 	OnAuthenticated(sessionID session.SessionID, jid string)
 	OnAuthenticationFailed(sessionID session.SessionID, reason string)
+	OnPairingAttempt(sessionID session.SessionID, attempt *session.PairingAttempt)
 	OnMessage(sessionID session.SessionID, message *Message)
 	OnError(sessionID session.SessionID, err error)
+	// OnConnectionFailed is called when the connection fails and will not be retried automatically
+	OnConnectionFailed(sessionID session.SessionID, err error)
+	// OnSessionReplaced is called when another client logged in with the same credentials and took over
+	OnSessionReplaced(sessionID session.SessionID)
+	OnHealthIssue(sessionID session.SessionID, issue *HealthIssue)
+	OnChatStateChanged(sessionID session.SessionID, change *ChatStateChange)
+	OnPollVote(sessionID session.SessionID, vote *PollVote)
 }
 
-// Message represents a WhatsApp message
+// Message represents a WhatsApp message passed to event handlers
 type Message struct {
 	ID        string
-	From      string
-	To        string
-	Body      string
+	Chat      string // JID of the conversation (contact or group)
+	Sender    string // JID of the author, without device suffix; differs from Chat in groups
+	Body      string // Text or media caption
 	Type      MessageType
 	Timestamp time.Time
 	IsFromMe  bool
@@ -139,8 +228,26 @@ const (
 	MessageTypeSticker
 	MessageTypeLocation
 	MessageTypeContact
+	MessageTypePoll
+	MessageTypeButtons
+	MessageTypeList
 )
 
+// MessageTypes lists every message type, in a fixed order
+var MessageTypes = []MessageType{
+	MessageTypeText,
+	MessageTypeImage,
+	MessageTypeDocument,
+	MessageTypeAudio,
+	MessageTypeVideo,
+	MessageTypeSticker,
+	MessageTypeLocation,
+	MessageTypeContact,
+	MessageTypePoll,
+	MessageTypeButtons,
+	MessageTypeList,
+}
+
 // String returns the string representation of MessageType
 func (t MessageType) String() string {
 	switch t {
@@ -160,6 +267,12 @@ func (t MessageType) String() string {
 		return "location"
 	case MessageTypeContact:
 		return "contact"
+	case MessageTypePoll:
+		return "poll"
+	case MessageTypeButtons:
+		return "buttons"
+	case MessageTypeList:
+		return "list"
 	default:
 		return "unknown"
 	}
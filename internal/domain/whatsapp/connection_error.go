@@ -0,0 +1,51 @@
+package whatsapp
+
+import "fmt"
+
+// ConnectionFailureReason is the machine-readable cause of a failed connection attempt
+type ConnectionFailureReason string
+
+const (
+	// ConnectionFailureBanned means WhatsApp banned the account, temporarily or for good
+	ConnectionFailureBanned ConnectionFailureReason = "BANNED"
+	// ConnectionFailureNotPaired means the device is no longer linked and must be paired again
+	ConnectionFailureNotPaired ConnectionFailureReason = "NOT_PAIRED"
+	// ConnectionFailureNetwork means the WhatsApp servers could not be reached
+	ConnectionFailureNetwork ConnectionFailureReason = "NETWORK"
+	// ConnectionFailureProxy means the session proxy refused or failed the connection
+	ConnectionFailureProxy ConnectionFailureReason = "PROXY_FAILED"
+	// ConnectionFailureClientOutdated means WhatsApp rejected the client version
+	ConnectionFailureClientOutdated ConnectionFailureReason = "CLIENT_OUTDATED"
+	// ConnectionFailureUnknown covers failures WhatsApp did not explain
+	ConnectionFailureUnknown ConnectionFailureReason = "UNKNOWN"
+)
+
+// String returns the string representation of the reason
+func (r ConnectionFailureReason) String() string {
+	return string(r)
+}
+
+// ConnectionError reports why connecting a session to WhatsApp failed, so callers can
+// react to the reason (e.g. pair again on NOT_PAIRED) instead of parsing the message
+type ConnectionError struct {
+	Reason ConnectionFailureReason
+	Err    error
+}
+
+// NewConnectionError creates a connection error with the given reason and cause
+func NewConnectionError(reason ConnectionFailureReason, err error) *ConnectionError {
+	return &ConnectionError{Reason: reason, Err: err}
+}
+
+// Error implements the error interface
+func (e *ConnectionError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("connection failed (%s)", e.Reason)
+	}
+	return fmt.Sprintf("connection failed (%s): %v", e.Reason, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ConnectionError) Unwrap() error {
+	return e.Err
+}
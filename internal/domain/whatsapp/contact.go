@@ -0,0 +1,28 @@
+package whatsapp
+
+import "errors"
+
+// ContactInfo represents a contact stored for a WhatsApp session
+type ContactInfo struct {
+	JID          string
+	PushName     string
+	BusinessName string
+	FirstName    string
+	FullName     string
+}
+
+// NumberCheckResult represents whether a phone number is registered on WhatsApp
+type NumberCheckResult struct {
+	Query  string
+	Exists bool
+	JID    string
+}
+
+// MaxNumbersPerCheck is the maximum amount of phone numbers checked in a single request
+const MaxNumbersPerCheck = 100
+
+// Contact domain errors
+var (
+	ErrNoPhoneNumbers      = errors.New("at least one phone number is required")
+	ErrTooManyPhoneNumbers = errors.New("too many phone numbers in a single check")
+)
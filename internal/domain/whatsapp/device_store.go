@@ -0,0 +1,26 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+)
+
+// Device store errors
+var (
+	ErrDeviceNotFound      = errors.New("device credentials not found")
+	ErrDeviceAlreadyExists = errors.New("device credentials already exist")
+	ErrInvalidDeviceBackup = errors.New("invalid device backup")
+)
+
+// DeviceStore exports and restores the credentials of paired WhatsApp devices, so a session
+// can move to another instance without pairing again
+type DeviceStore interface {
+	// ExportDevice serializes the device identity, keys and signal state of the device JID
+	ExportDevice(ctx context.Context, jid string) ([]byte, error)
+
+	// ImportDevice restores a device serialized by ExportDevice and returns its JID
+	ImportDevice(ctx context.Context, data []byte) (string, error)
+
+	// DeleteDevice removes a device and all of its state
+	DeleteDevice(ctx context.Context, jid string) error
+}
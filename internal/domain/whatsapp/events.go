@@ -33,6 +33,10 @@ const (
 	EventTypeContactUpdate
 	EventTypeGroupUpdate
 	EventTypePresenceUpdate
+	EventTypeHealthIssue
+	EventTypeChatStateChanged
+	EventTypeSessionReplaced
+	EventTypePollVote
 )
 
 // String returns the string representation of EventType
@@ -66,6 +70,14 @@ func (t EventType) String() string {
 		return "group_update"
 	case EventTypePresenceUpdate:
 		return "presence_update"
+	case EventTypeHealthIssue:
+		return "health_issue"
+	case EventTypeChatStateChanged:
+		return "chat.state_changed"
+	case EventTypeSessionReplaced:
+		return "session.replaced"
+	case EventTypePollVote:
+		return "poll.vote"
 	default:
 		return "unknown"
 	}
@@ -205,4 +217,62 @@ type WebhookStats struct {
 	AverageLatency time.Duration
 	LastSentAt    time.Time
 	LastError     error
+
+	// TotalSent and TotalFailed count events; TotalRetried counts the attempts repeated after a failure
+	TotalRetried int64
+	BySession    map[session.SessionID]*WebhookSessionStats
+	Latency      WebhookLatencyHistogram
+
+	// Events waiting in the delivery queue, and how many it holds before new events are dropped
+	QueueLength   int
+	QueueCapacity int
+}
+
+// WebhookSessionStats counts the webhook deliveries of the events of one session
+type WebhookSessionStats struct {
+	Delivered int64
+	Failed    int64
+	Retried   int64
+}
+
+// WebhookLatencyBuckets are the upper bounds of the webhook delivery latency histogram
+var WebhookLatencyBuckets = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// WebhookLatencyHistogram counts webhook delivery attempts by latency
+type WebhookLatencyHistogram struct {
+	// Counts has one entry per bucket of WebhookLatencyBuckets, not cumulative, plus a last
+	// entry for attempts slower than every bucket
+	Counts []int64
+	Sum    time.Duration
+	Count  int64
+}
+
+// Observe counts an attempt of the given latency
+func (h *WebhookLatencyHistogram) Observe(latency time.Duration) {
+	if h.Counts == nil {
+		h.Counts = make([]int64, len(WebhookLatencyBuckets)+1)
+	}
+
+	bucket := len(WebhookLatencyBuckets)
+	for i, bound := range WebhookLatencyBuckets {
+		if latency <= bound {
+			bucket = i
+			break
+		}
+	}
+	h.Counts[bucket]++
+	h.Sum += latency
+	h.Count++
 }
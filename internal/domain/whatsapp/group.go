@@ -0,0 +1,70 @@
+package whatsapp
+
+import (
+	"errors"
+	"time"
+)
+
+// GroupInfo represents metadata of a WhatsApp group
+type GroupInfo struct {
+	JID          string
+	Name         string
+	Description  string
+	OwnerJID     string
+	Participants []GroupParticipant
+	CreatedAt    time.Time
+}
+
+// GroupParticipant represents a member of a WhatsApp group
+type GroupParticipant struct {
+	JID          string
+	PhoneNumber  string
+	IsAdmin      bool
+	IsSuperAdmin bool
+}
+
+// Group domain errors
+var (
+	ErrInvalidGroupJID = errors.New("invalid group JID")
+	ErrGroupNotFound   = errors.New("group not found")
+	ErrNotGroupMember  = errors.New("not a member of the group")
+
+	ErrInvalidParticipantAction = errors.New("invalid participant action (must be add, remove, promote or demote)")
+	ErrInvalidParticipantJID    = errors.New("invalid participant JID")
+	ErrNoParticipants           = errors.New("at least one participant is required")
+	ErrNotGroupAdmin            = errors.New("not an admin of the group")
+)
+
+// ParticipantAction represents a change applied to group participants
+type ParticipantAction string
+
+const (
+	ParticipantActionAdd     ParticipantAction = "add"
+	ParticipantActionRemove  ParticipantAction = "remove"
+	ParticipantActionPromote ParticipantAction = "promote"
+	ParticipantActionDemote  ParticipantAction = "demote"
+)
+
+// ParseParticipantAction parses and validates a participant action
+func ParseParticipantAction(action string) (ParticipantAction, error) {
+	switch a := ParticipantAction(action); a {
+	case ParticipantActionAdd, ParticipantActionRemove, ParticipantActionPromote, ParticipantActionDemote:
+		return a, nil
+	default:
+		return "", ErrInvalidParticipantAction
+	}
+}
+
+// String returns the string representation of the action
+func (a ParticipantAction) String() string {
+	return string(a)
+}
+
+// ParticipantUpdateResult represents the outcome of a participant change for a single member.
+// WhatsApp reports the result of each participant individually.
+type ParticipantUpdateResult struct {
+	JID       string
+	Success   bool
+	ErrorCode int
+	Error     string
+}
@@ -0,0 +1,57 @@
+package whatsapp
+
+import "time"
+
+// HealthIssueKind identifies a condition that degrades a connected client
+type HealthIssueKind string
+
+const (
+	// HealthIssueCATRefreshFailed means WhatsApp rejected the refresh of the client auth token
+	HealthIssueCATRefreshFailed HealthIssueKind = "cat_refresh_failed"
+	// HealthIssuePreKeyUploadFailed means new encryption prekeys could not be uploaded
+	HealthIssuePreKeyUploadFailed HealthIssueKind = "prekey_upload_failed"
+	// HealthIssueKeepAliveTimeout means the connection stopped answering keepalive pings
+	HealthIssueKeepAliveTimeout HealthIssueKind = "keepalive_timeout"
+	// HealthIssueClientOutdated means WhatsApp rejected the client version
+	HealthIssueClientOutdated HealthIssueKind = "client_outdated"
+)
+
+// String returns the string representation of the health issue kind
+func (k HealthIssueKind) String() string {
+	return string(k)
+}
+
+// Remediation returns an operator hint for resolving the issue
+func (k HealthIssueKind) Remediation() string {
+	switch k {
+	case HealthIssueCATRefreshFailed:
+		return "WhatsApp rejected the session credentials; reconnect the session and re-pair it if the error persists"
+	case HealthIssuePreKeyUploadFailed:
+		return "new contacts may fail to receive messages until prekeys are uploaded; reconnect the session and re-pair it if the error persists"
+	case HealthIssueKeepAliveTimeout:
+		return "the connection is not answering keepalive pings; check the network or proxy, or reconnect the session"
+	case HealthIssueClientOutdated:
+		return "WhatsApp rejected the client version; update the server to a newer release"
+	default:
+		return "reconnect the session"
+	}
+}
+
+// HealthIssue represents an unresolved condition that degrades a client.
+// Repeated occurrences of the same kind are folded into a single issue.
+type HealthIssue struct {
+	Kind        HealthIssueKind
+	Error       string
+	Remediation string
+	Count       int
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+// ClientHealth represents the health of a client. A client may be connected
+// and still unhealthy, e.g. when sends silently fail for new contacts.
+type ClientHealth struct {
+	Healthy   bool
+	Issues    []HealthIssue
+	UpdatedAt time.Time
+}
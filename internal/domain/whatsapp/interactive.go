@@ -0,0 +1,113 @@
+package whatsapp
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Interactive message limits enforced by WhatsApp
+const (
+	MaxButtons          = 3
+	MaxButtonTextLength = 20
+	MaxListSections     = 10
+	MaxListRows         = 10 // across all sections
+	MaxListRowTitle     = 24
+)
+
+// Button is a quick reply button of a buttons message
+type Button struct {
+	ID   string // returned in the reply when the button is tapped
+	Text string
+}
+
+// ListRow is a selectable row of a list message
+type ListRow struct {
+	ID          string // returned in the reply when the row is selected
+	Title       string
+	Description string
+}
+
+// ListSection groups rows of a list message under an optional title
+type ListSection struct {
+	Title string
+	Rows  []ListRow
+}
+
+// Interactive message domain errors. WhatsApp accepts buttons and list messages from
+// whatsmeow without reporting whether they were rendered, and recent clients only
+// show them when sent by business accounts, so a successful send does not guarantee
+// the recipient can interact with them.
+var (
+	ErrInvalidInteractiveBody = errors.New("interactive message body is required")
+	ErrInvalidButtonCount     = errors.New("buttons message must have between 1 and 3 buttons")
+	ErrInvalidButton          = errors.New("buttons must have a unique non-empty ID and a text of up to 20 characters")
+	ErrInvalidListButtonText  = errors.New("list button text is required and must have up to 20 characters")
+	ErrInvalidListSections    = errors.New("list message must have between 1 and 10 sections, each with at least one row")
+	ErrInvalidListRowCount    = errors.New("list message must have at most 10 rows across all sections")
+	ErrInvalidListRow         = errors.New("list rows must have a unique non-empty ID and a title of up to 24 characters")
+)
+
+// ValidateButtons checks a buttons message before it is sent
+func ValidateButtons(body string, buttons []Button) error {
+	if strings.TrimSpace(body) == "" {
+		return ErrInvalidInteractiveBody
+	}
+
+	if len(buttons) == 0 || len(buttons) > MaxButtons {
+		return ErrInvalidButtonCount
+	}
+
+	seen := make(map[string]bool, len(buttons))
+	for _, button := range buttons {
+		if strings.TrimSpace(button.ID) == "" || seen[button.ID] || !validLabel(button.Text, MaxButtonTextLength) {
+			return ErrInvalidButton
+		}
+		seen[button.ID] = true
+	}
+
+	return nil
+}
+
+// ValidateList checks a list message before it is sent. The button text labels the
+// button that opens the list.
+func ValidateList(body, buttonText string, sections []ListSection) error {
+	if strings.TrimSpace(body) == "" {
+		return ErrInvalidInteractiveBody
+	}
+
+	if !validLabel(buttonText, MaxButtonTextLength) {
+		return ErrInvalidListButtonText
+	}
+
+	if len(sections) == 0 || len(sections) > MaxListSections {
+		return ErrInvalidListSections
+	}
+
+	rows := 0
+	seen := make(map[string]bool)
+	for _, section := range sections {
+		if len(section.Rows) == 0 {
+			return ErrInvalidListSections
+		}
+
+		rows += len(section.Rows)
+		if rows > MaxListRows {
+			return ErrInvalidListRowCount
+		}
+
+		for _, row := range section.Rows {
+			if strings.TrimSpace(row.ID) == "" || seen[row.ID] || !validLabel(row.Title, MaxListRowTitle) {
+				return ErrInvalidListRow
+			}
+			seen[row.ID] = true
+		}
+	}
+
+	return nil
+}
+
+// validLabel reports whether a label is non-empty and at most maxLength characters long
+func validLabel(label string, maxLength int) bool {
+	return strings.TrimSpace(label) != "" && utf8.RuneCountInString(label) <= maxLength
+}
@@ -0,0 +1,40 @@
+package whatsapp
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// MaxLabelColor is the highest color index of the WhatsApp Business label palette
+	MaxLabelColor = 19
+	// MaxLabelNameLength is the longest label name accepted
+	MaxLabelNameLength = 100
+)
+
+// Label domain errors
+var (
+	ErrNotBusinessSession = errors.New("session is not a WhatsApp Business account")
+	ErrLabelNotFound      = errors.New("label not found")
+	ErrInvalidLabelName   = errors.New("label name must have between 1 and 100 characters")
+	ErrInvalidLabelColor  = errors.New("label color must be between 0 and 19")
+)
+
+// Label represents a WhatsApp Business label synced through app state
+type Label struct {
+	ID    string
+	Name  string
+	Color int // index in the WhatsApp label palette
+}
+
+// ValidateLabel checks the name and color of a new label
+func ValidateLabel(name string, color int) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxLabelNameLength {
+		return ErrInvalidLabelName
+	}
+	if color < 0 || color > MaxLabelColor {
+		return ErrInvalidLabelColor
+	}
+	return nil
+}
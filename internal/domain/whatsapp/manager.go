@@ -35,7 +35,10 @@ type ManagerStats struct {
 	ConnectedClients  int
 	AuthenticatedClients int
 	ErrorClients      int
+	MaxSessions       int // limit of live clients, zero when unlimited
 	Uptime           int64
+	MessagesSent     int64
+	MessagesReceived int64
 }
 
 // ClientStats represents statistics for a WhatsApp client
@@ -51,6 +54,21 @@ type ClientStats struct {
 	Errors           int64
 }
 
+// MessageSendStats counts sent messages by type, in total and per session
+type MessageSendStats struct {
+	ByType    map[MessageType]int64
+	BySession map[session.SessionID]map[MessageType]int64
+}
+
+// Total returns the number of sent messages of all types
+func (s *MessageSendStats) Total() int64 {
+	var total int64
+	for _, count := range s.ByType {
+		total += count
+	}
+	return total
+}
+
 // WhatsApp domain errors
 var (
 	ErrClientNotFound      = errors.New("client not found")
@@ -62,6 +80,10 @@ var (
 	ErrQRTimeout           = errors.New("QR code timeout")
 	ErrInvalidPhoneNumber  = errors.New("invalid phone number")
 	ErrMessageSendFailed   = errors.New("message send failed")
+	ErrProxyRotationFailed = errors.New("proxy rotation failed")
+	ErrConnectInProgress   = errors.New("connect already in progress")
+	ErrQRNotReady          = errors.New("QR code not ready yet")
+	ErrSessionLimitReached = errors.New("session limit reached")
 )
 
 // AdvancedManager extends Manager with additional capabilities
@@ -76,13 +98,10 @@ type AdvancedManager interface {
 	UpdateConfig(config *ManagerConfig) error
 	GetConfig() *ManagerConfig
 
-	// Bulk operations
-	ConnectAll(ctx context.Context) error
-	DisconnectAll(ctx context.Context) error
-	RestartClient(sessionID session.SessionID) error
-
 	// Event handling
 	SetGlobalEventHandler(handler EventHandler)
+	// AddGlobalEventHandler chains a handler after the existing ones and returns a function that removes it
+	AddGlobalEventHandler(handler EventHandler) (remove func())
 	RemoveGlobalEventHandler()
 
 	// Health monitoring
@@ -0,0 +1,129 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wazmeow/internal/domain/session"
+)
+
+// Message store errors
+var (
+	ErrMessageNotFound   = errors.New("message not found")
+	ErrMessageHasNoMedia = errors.New("message has no downloadable media")
+	ErrMediaUnavailable  = errors.New("media is no longer available on WhatsApp servers")
+
+	// ErrMessageNotForwardable is returned for stored messages whose content cannot be rebuilt
+	ErrMessageNotForwardable = errors.New("message type cannot be forwarded")
+)
+
+const (
+	// DefaultMessageContextSize is how many messages are returned on each side of an anchor by default
+	DefaultMessageContextSize = 10
+	// MaxMessageContextSize is the largest number of messages returned on each side of an anchor
+	MaxMessageContextSize = 50
+
+	// DefaultChatMessagesPageSize is how many messages a chat history page holds by default
+	DefaultChatMessagesPageSize = 50
+	// MaxChatMessagesPageSize is the largest chat history page
+	MaxChatMessagesPageSize = 200
+)
+
+// ParseMessageType converts the string form of a message type back to a MessageType.
+// Unknown values map to MessageTypeText.
+func ParseMessageType(value string) MessageType {
+	for _, t := range MessageTypes {
+		if t.String() == value {
+			return t
+		}
+	}
+	return MessageTypeText
+}
+
+// MediaInfo holds the metadata required to download and decrypt a media attachment
+type MediaInfo struct {
+	MimeType      string
+	FileName      string
+	FileLength    uint64
+	URL           string
+	DirectPath    string
+	MediaKey      []byte
+	FileSHA256    []byte
+	FileEncSHA256 []byte
+}
+
+// StoredMessage is a message persisted from the WhatsApp event stream
+type StoredMessage struct {
+	SessionID session.SessionID
+	ID        string
+	ChatJID   string
+	SenderJID string
+	IsFromMe  bool
+	Type      MessageType
+	Body      string
+	Timestamp time.Time
+	Media     *MediaInfo
+	CreatedAt time.Time
+}
+
+// Direction returns "outgoing" for messages sent by the session and "incoming" otherwise
+func (m *StoredMessage) Direction() string {
+	if m.IsFromMe {
+		return "outgoing"
+	}
+	return "incoming"
+}
+
+// HasMedia returns true if the message carries a downloadable attachment
+func (m *StoredMessage) HasMedia() bool {
+	return m.Media != nil && m.Media.DirectPath != "" && len(m.Media.MediaKey) > 0
+}
+
+// MessageCursor is a position in a chat history: the timestamp and ID of a message.
+// Histories are ordered by timestamp, with ties broken by message ID.
+type MessageCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// MediaContent is a decrypted media attachment
+type MediaContent struct {
+	Data     []byte
+	MimeType string
+	FileName string
+}
+
+// SessionDataRepository is implemented by repositories holding data owned by a session,
+// which is purged when the session is deleted
+type SessionDataRepository interface {
+	// DeleteBySession deletes all the stored data of a session, returning how many rows were deleted
+	DeleteBySession(ctx context.Context, sessionID session.SessionID) (int, error)
+}
+
+// MessageRepository defines persistence operations for received and sent messages
+type MessageRepository interface {
+	SessionDataRepository
+
+	// Save stores a message, ignoring duplicates of an already stored message
+	Save(ctx context.Context, message *StoredMessage) error
+
+	// GetByID retrieves a message of a session by its WhatsApp message ID
+	GetByID(ctx context.Context, sessionID session.SessionID, messageID string) (*StoredMessage, error)
+
+	// GetLatestByChat retrieves the most recent message of a chat
+	GetLatestByChat(ctx context.Context, sessionID session.SessionID, chatJID string) (*StoredMessage, error)
+
+	// ListBefore retrieves up to limit messages of the anchor's chat sent before it, oldest first
+	ListBefore(ctx context.Context, anchor *StoredMessage, limit int) ([]*StoredMessage, error)
+
+	// ListAfter retrieves up to limit messages of the anchor's chat sent after it, oldest first
+	ListAfter(ctx context.Context, anchor *StoredMessage, limit int) ([]*StoredMessage, error)
+
+	// ListByChat retrieves up to limit messages of a chat, newest first, starting right
+	// before the cursor or with the latest message when the cursor is nil
+	ListByChat(ctx context.Context, sessionID session.SessionID, chatJID string, before *MessageCursor, limit int) ([]*StoredMessage, error)
+
+	// DeleteBefore deletes the messages of every session sent before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
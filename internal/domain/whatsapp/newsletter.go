@@ -0,0 +1,39 @@
+package whatsapp
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// NewsletterServer is the JID server of WhatsApp channels (newsletters)
+const NewsletterServer = "newsletter"
+
+// NewsletterInfo represents metadata of a WhatsApp channel (newsletter)
+type NewsletterInfo struct {
+	JID               string
+	Name              string
+	Description       string
+	InviteCode        string
+	SubscriberCount   int
+	State             string
+	VerificationState string
+	Role              string // role of the session in the channel, empty when unknown
+	CreatedAt         time.Time
+}
+
+// Newsletter domain errors
+var (
+	ErrInvalidNewsletterJID = errors.New("invalid newsletter JID (must end with @newsletter)")
+	ErrNewsletterNotFound   = errors.New("newsletter not found")
+	ErrEmptyNewsletterText  = errors.New("newsletter message text is required")
+)
+
+// ValidateNewsletterJID checks that a JID belongs to the newsletter server
+func ValidateNewsletterJID(jid string) error {
+	user, server, found := strings.Cut(jid, "@")
+	if !found || user == "" || server != NewsletterServer {
+		return ErrInvalidNewsletterJID
+	}
+	return nil
+}
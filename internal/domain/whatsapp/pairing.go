@@ -0,0 +1,115 @@
+package whatsapp
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+// PairingState represents the progress of a connection attempt
+type PairingState string
+
+const (
+	// PairingStatePending indicates the connection was started and no QR or code is available yet
+	PairingStatePending PairingState = "pending"
+	// PairingStateQRReady indicates a QR code is waiting to be scanned
+	PairingStateQRReady PairingState = "qr-ready"
+	// PairingStateCodeReady indicates a phone pairing code is waiting to be entered
+	PairingStateCodeReady PairingState = "code-ready"
+	// PairingStateAuthenticated indicates the device is paired and logged in
+	PairingStateAuthenticated PairingState = "authenticated"
+	// PairingStateFailed indicates the attempt failed or expired
+	PairingStateFailed PairingState = "failed"
+)
+
+// String returns the string representation of the pairing state
+func (s PairingState) String() string {
+	return string(s)
+}
+
+// IsFinal returns true if the attempt has finished, successfully or not
+func (s PairingState) IsFinal() bool {
+	return s == PairingStateAuthenticated || s == PairingStateFailed
+}
+
+// PairingCodeValidity is how long a phone pairing code can be entered. WhatsApp does not
+// publish the code expiry, but the login websocket closes once the QR codes run out,
+// which bounds every pairing attempt to 160 seconds.
+const PairingCodeValidity = 160 * time.Second
+
+// PairingStatus represents the current state of a connection attempt.
+// QRCode and PairingCode are only set while the matching state is active.
+type PairingStatus struct {
+	State       PairingState
+	QRCode      string // Base64 PNG data URL
+	PairingCode string
+	JID         string
+	Error       string
+	UpdatedAt   time.Time
+}
+
+// PairClientType is the client type announced when pairing with a phone code.
+// The values mirror the whatsmeow PairClient* constants.
+type PairClientType string
+
+const (
+	PairClientChrome         PairClientType = "chrome"
+	PairClientEdge           PairClientType = "edge"
+	PairClientFirefox        PairClientType = "firefox"
+	PairClientIE             PairClientType = "ie"
+	PairClientOpera          PairClientType = "opera"
+	PairClientSafari         PairClientType = "safari"
+	PairClientElectron       PairClientType = "electron"
+	PairClientUWP            PairClientType = "uwp"
+	PairClientOtherWebClient PairClientType = "other"
+)
+
+// PairClientTypes lists every supported pairing client type
+var PairClientTypes = []PairClientType{
+	PairClientChrome,
+	PairClientEdge,
+	PairClientFirefox,
+	PairClientIE,
+	PairClientOpera,
+	PairClientSafari,
+	PairClientElectron,
+	PairClientUWP,
+	PairClientOtherWebClient,
+}
+
+// Phone pairing domain errors
+var (
+	ErrInvalidPairClientType  = errors.New("invalid pair client type (must be chrome, edge, firefox, ie, opera, safari, electron, uwp or other)")
+	ErrInvalidPairDisplayName = errors.New("invalid pair display name (must be formatted as \"Browser (OS)\", e.g. \"Chrome (Linux)\")")
+)
+
+// pairDisplayNamePattern matches "Browser (OS)" names, the only format WhatsApp accepts
+var pairDisplayNamePattern = regexp.MustCompile(`^[^()]+ \([^()]+\)$`)
+
+// PairPhoneOptions customizes how the device is presented while pairing with a phone code.
+// Empty fields keep the configured defaults.
+type PairPhoneOptions struct {
+	ClientType  PairClientType
+	DisplayName string
+}
+
+// Validate checks the client type and display name when they are set
+func (o PairPhoneOptions) Validate() error {
+	if o.ClientType != "" && !o.ClientType.IsValid() {
+		return ErrInvalidPairClientType
+	}
+	if o.DisplayName != "" && !pairDisplayNamePattern.MatchString(o.DisplayName) {
+		return ErrInvalidPairDisplayName
+	}
+	return nil
+}
+
+// IsValid reports whether the client type is supported
+func (t PairClientType) IsValid() bool {
+	for _, clientType := range PairClientTypes {
+		if t == clientType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package whatsapp
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Poll limits enforced by WhatsApp
+const (
+	MinPollOptions = 2
+	MaxPollOptions = 12
+)
+
+// PollVote represents a vote cast on a poll. Votes only carry hashes of the chosen
+// options, so SelectedOptions is filled only when the poll options are known
+// (polls sent or seen while the client was running).
+type PollVote struct {
+	PollMessageID   string
+	ChatJID         string
+	VoterJID        string
+	SelectedOptions []string // option names, empty when the poll is unknown or the vote was cleared
+	OptionHashes    []string // hex SHA-256 of each selected option name
+	Timestamp       time.Time
+}
+
+// Poll domain errors
+var (
+	ErrInvalidPollQuestion        = errors.New("poll question is required")
+	ErrInvalidPollOptionCount     = errors.New("poll must have between 2 and 12 options")
+	ErrInvalidPollOption          = errors.New("poll options must be non-empty and unique")
+	ErrInvalidPollSelectableCount = errors.New("poll selectable count must be between 0 (any number) and the number of options")
+)
+
+// ValidatePoll checks a poll before it is sent. A selectable count of zero lets
+// voters pick any number of options.
+func ValidatePoll(question string, options []string, selectableCount int) error {
+	if strings.TrimSpace(question) == "" {
+		return ErrInvalidPollQuestion
+	}
+
+	if len(options) < MinPollOptions || len(options) > MaxPollOptions {
+		return ErrInvalidPollOptionCount
+	}
+
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		if strings.TrimSpace(option) == "" || seen[option] {
+			return ErrInvalidPollOption
+		}
+		seen[option] = true
+	}
+
+	if selectableCount < 0 || selectableCount > len(options) {
+		return ErrInvalidPollSelectableCount
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package whatsapp
+
+import "errors"
+
+// PresenceState represents the global online state of the session
+type PresenceState string
+
+const (
+	PresenceAvailable   PresenceState = "available"
+	PresenceUnavailable PresenceState = "unavailable"
+)
+
+// ParsePresenceState parses and validates a global presence state
+func ParsePresenceState(state string) (PresenceState, error) {
+	switch p := PresenceState(state); p {
+	case PresenceAvailable, PresenceUnavailable:
+		return p, nil
+	default:
+		return "", ErrInvalidPresenceState
+	}
+}
+
+// String returns the string representation of the presence state
+func (p PresenceState) String() string {
+	return string(p)
+}
+
+// ChatPresenceState represents the typing state of the session in a chat
+type ChatPresenceState string
+
+const (
+	ChatPresenceComposing ChatPresenceState = "composing"
+	ChatPresencePaused    ChatPresenceState = "paused"
+)
+
+// ParseChatPresenceState parses and validates a chat presence state
+func ParseChatPresenceState(state string) (ChatPresenceState, error) {
+	switch p := ChatPresenceState(state); p {
+	case ChatPresenceComposing, ChatPresencePaused:
+		return p, nil
+	default:
+		return "", ErrInvalidChatPresenceState
+	}
+}
+
+// String returns the string representation of the chat presence state
+func (p ChatPresenceState) String() string {
+	return string(p)
+}
+
+// Presence domain errors
+var (
+	ErrPresenceNotSent = errors.New("presence must be sent as available before subscribing to contact presence")
+	ErrPushNameNotSet  = errors.New("push name not synced yet, presence cannot be sent")
+
+	ErrInvalidPresenceState     = errors.New("invalid presence state (must be available or unavailable)")
+	ErrInvalidChatPresenceState = errors.New("invalid chat presence state (must be composing or paused)")
+)
@@ -0,0 +1,44 @@
+package whatsapp
+
+import "errors"
+
+// ProfilePictureInfo represents the profile picture of a contact or group
+type ProfilePictureInfo struct {
+	ID   string
+	URL  string
+	Type string // "image" (full resolution) or "preview" (thumbnail)
+}
+
+// Profile picture domain errors
+var (
+	ErrProfilePictureNotSet     = errors.New("contact or group has no profile picture")
+	ErrProfilePictureRestricted = errors.New("profile picture hidden by privacy settings")
+	ErrInvalidProfilePicture    = errors.New("invalid profile picture image")
+)
+
+// BusinessProfile represents the public profile of a WhatsApp Business account
+type BusinessProfile struct {
+	JID           string
+	Categories    []BusinessCategory
+	Email         string
+	Address       string
+	HoursTimeZone string
+	Hours         []BusinessHours
+}
+
+// BusinessCategory is a category chosen by a business, such as "Retail"
+type BusinessCategory struct {
+	ID   string
+	Name string
+}
+
+// BusinessHours are the opening hours of a business on one day of the week
+type BusinessHours struct {
+	DayOfWeek string // "sun" to "sat"
+	Mode      string // "specific_hours", "open_24h" or "appointment_only"
+	OpenTime  string // as sent by WhatsApp; only set for specific hours
+	CloseTime string
+}
+
+// ErrNotBusinessAccount is returned when the profile requested is not a WhatsApp Business account
+var ErrNotBusinessAccount = errors.New("contact is not a business account")
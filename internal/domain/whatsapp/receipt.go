@@ -0,0 +1,101 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wazmeow/internal/domain/session"
+)
+
+// ReceiptStatus represents how far a sent message progressed with a recipient
+type ReceiptStatus string
+
+const (
+	// ReceiptDelivered indicates the message reached the recipient's device
+	ReceiptDelivered ReceiptStatus = "delivered"
+	// ReceiptRead indicates the recipient opened the message
+	ReceiptRead ReceiptStatus = "read"
+	// ReceiptPlayed indicates the recipient played a voice message or video
+	ReceiptPlayed ReceiptStatus = "played"
+)
+
+// String returns the string representation of the status
+func (s ReceiptStatus) String() string {
+	return string(s)
+}
+
+// Rank orders the statuses by progress; unknown statuses rank 0.
+// A receipt never moves a recipient back to a lower status.
+func (s ReceiptStatus) Rank() int {
+	switch s {
+	case ReceiptDelivered:
+		return 1
+	case ReceiptRead:
+		return 2
+	case ReceiptPlayed:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// ErrReceiptNotFound is returned when no receipt was recorded for a message
+var ErrReceiptNotFound = errors.New("no receipt recorded for message")
+
+// MessageReceipt is the latest receipt of a sent message from one recipient
+type MessageReceipt struct {
+	SessionID    session.SessionID
+	MessageID    string
+	ChatJID      string
+	RecipientJID string // Who sent the receipt; a group participant in group chats
+	Status       ReceiptStatus
+	Timestamp    time.Time
+}
+
+// MessageStatus summarizes the receipts of a sent message
+type MessageStatus struct {
+	MessageID string
+	ChatJID   string
+	Status    ReceiptStatus // Furthest status reached by any recipient
+	UpdatedAt time.Time     // Time of the latest receipt
+	Receipts  []*MessageReceipt
+}
+
+// NewMessageStatus summarizes the receipts of a message, returning
+// ErrReceiptNotFound when there are none
+func NewMessageStatus(messageID string, receipts []*MessageReceipt) (*MessageStatus, error) {
+	if len(receipts) == 0 {
+		return nil, ErrReceiptNotFound
+	}
+
+	status := &MessageStatus{
+		MessageID: messageID,
+		ChatJID:   receipts[0].ChatJID,
+		Receipts:  receipts,
+	}
+	for _, receipt := range receipts {
+		if receipt.Status.Rank() > status.Status.Rank() {
+			status.Status = receipt.Status
+		}
+		if receipt.Timestamp.After(status.UpdatedAt) {
+			status.UpdatedAt = receipt.Timestamp
+		}
+	}
+
+	return status, nil
+}
+
+// ReceiptRepository defines persistence operations for message receipts
+type ReceiptRepository interface {
+	SessionDataRepository
+
+	// Save stores a receipt unless the recipient already reached the same or a further status
+	Save(ctx context.Context, receipt *MessageReceipt) error
+
+	// ListByMessage retrieves the receipts of a message, one per recipient
+	ListByMessage(ctx context.Context, sessionID session.SessionID, messageID string) ([]*MessageReceipt, error)
+
+	// DeleteBefore deletes the receipts of every session received before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,23 @@
+package whatsapp
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrRecentMessagesDisabled is returned when incoming messages are not buffered in memory
+var ErrRecentMessagesDisabled = errors.New("recent message buffer is disabled")
+
+// RecentMessage is an incoming message kept in memory for polling
+type RecentMessage struct {
+	Cursor     uint64 // Increases with every buffered message of the client
+	Message    *Message
+	ReceivedAt time.Time
+}
+
+// RecentMessages is the page of buffered messages newer than a cursor
+type RecentMessages struct {
+	Messages []*RecentMessage
+	// NextCursor is the cursor to poll with next to get only newer messages
+	NextCursor uint64
+}
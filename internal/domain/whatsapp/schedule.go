@@ -0,0 +1,150 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"wazmeow/internal/domain/session"
+)
+
+// ScheduledMessageStatus represents the delivery state of a scheduled message
+type ScheduledMessageStatus string
+
+const (
+	// ScheduledMessagePending indicates the message is waiting for its send time
+	ScheduledMessagePending ScheduledMessageStatus = "pending"
+	// ScheduledMessageSending indicates the scheduler is sending the message
+	ScheduledMessageSending ScheduledMessageStatus = "sending"
+	// ScheduledMessageSent indicates the message was sent
+	ScheduledMessageSent ScheduledMessageStatus = "sent"
+	// ScheduledMessageFailed indicates the message could not be sent
+	ScheduledMessageFailed ScheduledMessageStatus = "failed"
+	// ScheduledMessageCanceled indicates the message was canceled before being sent
+	ScheduledMessageCanceled ScheduledMessageStatus = "canceled"
+)
+
+// ScheduledMessageStatuses lists every scheduled message status
+var ScheduledMessageStatuses = []ScheduledMessageStatus{
+	ScheduledMessagePending,
+	ScheduledMessageSending,
+	ScheduledMessageSent,
+	ScheduledMessageFailed,
+	ScheduledMessageCanceled,
+}
+
+// String returns the string representation of the status
+func (s ScheduledMessageStatus) String() string {
+	return string(s)
+}
+
+// IsValid reports whether the status is known
+func (s ScheduledMessageStatus) IsValid() bool {
+	for _, status := range ScheduledMessageStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// MaxScheduleAhead is how far in the future a message can be scheduled
+	MaxScheduleAhead = 365 * 24 * time.Hour
+	// MaxScheduledMessageAttempts is how many times sending is tried before the message fails
+	MaxScheduledMessageAttempts = 3
+	// ScheduledMessageRetryDelay is the wait before the first retry of a failed send; it
+	// doubles on every further attempt
+	ScheduledMessageRetryDelay = 30 * time.Second
+)
+
+// Scheduled message domain errors
+var (
+	ErrScheduledMessageNotFound   = errors.New("scheduled message not found")
+	ErrScheduledMessageNotPending = errors.New("scheduled message is no longer pending")
+	ErrInvalidScheduledBody       = errors.New("scheduled message body is required and must have up to 4096 characters")
+	ErrInvalidSendAt              = errors.New("send time must be in the future and at most one year ahead")
+	ErrInvalidScheduledStatus     = errors.New("invalid scheduled message status (must be pending, sending, sent, failed or canceled)")
+)
+
+// ScheduledMessage is a text message queued to be sent at a given time
+type ScheduledMessage struct {
+	ID        string
+	SessionID session.SessionID
+	To        string // Recipient JID
+	Body      string
+	SendAt    time.Time
+	Status    ScheduledMessageStatus
+	Attempts  int
+	MessageID string // WhatsApp message ID once sent
+	Error     string // Reason of the last failed attempt
+	// NextAttemptAt holds back the retry of a failed send; zero means the message is sent once due
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewScheduledMessage creates a pending scheduled message for a recipient JID
+func NewScheduledMessage(sessionID session.SessionID, to, body string, sendAt, now time.Time) (*ScheduledMessage, error) {
+	if err := ValidateRecipient(to); err != nil {
+		return nil, err
+	}
+	if ValidateBroadcastMessage(body) != nil {
+		return nil, ErrInvalidScheduledBody
+	}
+	if !sendAt.After(now) || sendAt.Sub(now) > MaxScheduleAhead {
+		return nil, ErrInvalidSendAt
+	}
+
+	return &ScheduledMessage{
+		ID:        uuid.New().String(),
+		SessionID: sessionID,
+		To:        to,
+		Body:      body,
+		SendAt:    sendAt.UTC(),
+		Status:    ScheduledMessagePending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// IsPending reports whether the message is still waiting to be sent
+func (m *ScheduledMessage) IsPending() bool {
+	return m.Status == ScheduledMessagePending
+}
+
+// RetryAfterFailure records a failed send attempt at now. The message goes back to pending
+// with a growing delay until MaxScheduledMessageAttempts is reached, and then fails.
+func (m *ScheduledMessage) RetryAfterFailure(err error, now time.Time) {
+	m.Error = err.Error()
+	m.UpdatedAt = now
+	if m.Attempts >= MaxScheduledMessageAttempts {
+		m.Status = ScheduledMessageFailed
+		m.NextAttemptAt = time.Time{}
+		return
+	}
+
+	m.Status = ScheduledMessagePending
+	m.NextAttemptAt = now.Add(ScheduledMessageRetryDelay << (m.Attempts - 1)).UTC()
+}
+
+// ScheduledMessageRepository defines persistence operations for scheduled messages.
+// Status changes are conditional on the current status so that the scheduler and
+// cancellations never overwrite each other.
+type ScheduledMessageRepository interface {
+	SessionDataRepository
+	Create(ctx context.Context, message *ScheduledMessage) error
+	GetByID(ctx context.Context, sessionID session.SessionID, id string) (*ScheduledMessage, error)
+	// ListBySession returns the messages of a session by send time; an empty status returns all
+	ListBySession(ctx context.Context, sessionID session.SessionID, status ScheduledMessageStatus) ([]*ScheduledMessage, error)
+	// ListDue returns up to limit pending messages of the given sessions whose send time and
+	// retry delay have passed, oldest first
+	ListDue(ctx context.Context, sessionIDs []session.SessionID, now time.Time, limit int) ([]*ScheduledMessage, error)
+	// Transition persists the message if its stored status is still from,
+	// returning ErrScheduledMessageNotPending otherwise
+	Transition(ctx context.Context, message *ScheduledMessage, from ScheduledMessageStatus) error
+	// FailInterrupted marks messages left in sending state by a previous run as failed
+	FailInterrupted(ctx context.Context, reason string) (int, error)
+}
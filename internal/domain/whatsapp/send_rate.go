@@ -0,0 +1,26 @@
+package whatsapp
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrSendRateLimited is returned when a session sends faster than its send rate limit allows
+var ErrSendRateLimited = errors.New("send rate limit exceeded")
+
+// SendRateLimitError reports a send refused by the session's send rate limit and when
+// the next message can go out. It matches ErrSendRateLimited with errors.Is.
+type SendRateLimitError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *SendRateLimitError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", ErrSendRateLimited, e.RetryAfter.Round(time.Millisecond))
+}
+
+// Unwrap returns ErrSendRateLimited
+func (e *SendRateLimitError) Unwrap() error {
+	return ErrSendRateLimited
+}
@@ -0,0 +1,89 @@
+package whatsapp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// StickerSize is the side length (in pixels) WhatsApp requires for stickers
+	StickerSize = 512
+	// MaxStickerBytes is the largest static sticker accepted by WhatsApp
+	MaxStickerBytes = 100 << 10
+	// MaxAnimatedStickerBytes is the largest animated sticker accepted by WhatsApp
+	MaxAnimatedStickerBytes = 500 << 10
+)
+
+// Sticker domain errors
+var (
+	ErrInvalidSticker  = errors.New("sticker must be a 512x512 WebP image")
+	ErrStickerTooLarge = errors.New("sticker exceeds the maximum size (100 KB static, 500 KB animated)")
+)
+
+// StickerInfo describes a WebP image validated as a sticker
+type StickerInfo struct {
+	Width    int
+	Height   int
+	Animated bool
+}
+
+// ValidateSticker checks that data is a 512x512 WebP image within the WhatsApp size limits.
+// Only the WebP headers are read, so the image itself is not decoded.
+func ValidateSticker(data []byte) (*StickerInfo, error) {
+	info, ok := parseWebP(data)
+	if !ok || info.Width != StickerSize || info.Height != StickerSize {
+		return nil, ErrInvalidSticker
+	}
+
+	limit := MaxStickerBytes
+	if info.Animated {
+		limit = MaxAnimatedStickerBytes
+	}
+	if len(data) > limit {
+		return nil, ErrStickerTooLarge
+	}
+
+	return info, nil
+}
+
+// parseWebP reads the canvas size of a WebP image from its first chunk
+func parseWebP(data []byte) (*StickerInfo, bool) {
+	if len(data) < 30 || !bytes.Equal(data[0:4], []byte("RIFF")) || !bytes.Equal(data[8:12], []byte("WEBP")) {
+		return nil, false
+	}
+
+	payload := data[20:]
+	switch string(data[12:16]) {
+	case "VP8 ": // Lossy: frame tag, start code, then 14-bit width and height
+		if !bytes.Equal(payload[3:6], []byte{0x9d, 0x01, 0x2a}) {
+			return nil, false
+		}
+		return &StickerInfo{
+			Width:  int(binary.LittleEndian.Uint16(payload[6:8]) & 0x3fff),
+			Height: int(binary.LittleEndian.Uint16(payload[8:10]) & 0x3fff),
+		}, true
+	case "VP8L": // Lossless: signature, then 14-bit width and height minus one
+		if payload[0] != 0x2f {
+			return nil, false
+		}
+		bits := binary.LittleEndian.Uint32(payload[1:5])
+		return &StickerInfo{
+			Width:  int(bits&0x3fff) + 1,
+			Height: int(bits>>14&0x3fff) + 1,
+		}, true
+	case "VP8X": // Extended: flags, reserved bytes, then 24-bit canvas width and height minus one
+		return &StickerInfo{
+			Width:    int(uint24(payload[4:7])) + 1,
+			Height:   int(uint24(payload[7:10])) + 1,
+			Animated: payload[0]&0x02 != 0,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// uint24 decodes a little-endian 24-bit integer
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
@@ -0,0 +1,33 @@
+package whatsapp
+
+import "time"
+
+// SyncProgress represents the progress of a single kind of initial sync
+type SyncProgress struct {
+	Completed   bool
+	Percent     int
+	Processed   int
+	Total       int
+	UpdatedAt   time.Time
+	CompletedAt time.Time
+}
+
+// SyncStatus represents the initial data sync state of a client after pairing.
+// WhatsApp delivers contacts, app state and chat history asynchronously after
+// the device is linked, so front-ends should wait for Completed before trusting
+// contact or chat lists.
+type SyncStatus struct {
+	OfflineSync SyncProgress
+	AppState    SyncProgress
+	Contacts    SyncProgress
+	HistorySync SyncProgress
+	StartedAt   time.Time
+}
+
+// IsCompleted returns true if every kind of initial sync has completed
+func (s *SyncStatus) IsCompleted() bool {
+	return s.OfflineSync.Completed &&
+		s.AppState.Completed &&
+		s.Contacts.Completed &&
+		s.HistorySync.Completed
+}
@@ -0,0 +1,16 @@
+package dto
+
+// SettingChangeResponse represents a setting changed by a configuration reload
+// @Description Configuração alterada
+type SettingChangeResponse struct {
+	Name     string `json:"name" example:"LOG_LEVEL" description:"Variável de ambiente"`
+	Previous string `json:"previous,omitempty" example:"info" description:"Valor em uso antes do reload (omitido para valores sensíveis)"`
+	Current  string `json:"current,omitempty" example:"debug" description:"Valor lido no reload (omitido para valores sensíveis)"`
+}
+
+// ConfigReloadResponse represents the HTTP response of a configuration reload
+// @Description Resultado do reload da configuração
+type ConfigReloadResponse struct {
+	Applied         []SettingChangeResponse `json:"applied" description:"Configurações alteradas e aplicadas sem reiniciar o processo"`
+	RestartRequired []SettingChangeResponse `json:"restart_required" description:"Configurações alteradas que só terão efeito após reiniciar o processo"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// TokenResponse represents an issued bearer token
+// @Description Token de acesso emitido para autenticação JWT
+type TokenResponse struct {
+	AccessToken string    `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"Token JWT a ser enviado no header Authorization: Bearer"`
+	TokenType   string    `json:"token_type" example:"Bearer" description:"Tipo do token"`
+	ExpiresIn   int64     `json:"expires_in" example:"3600" description:"Validade do token em segundos"`
+	ExpiresAt   time.Time `json:"expires_at" example:"2024-01-01T13:00:00Z" description:"Data e hora de expiração do token"`
+}
+
+// ToTokenResponse converts an issued token to HTTP response
+func ToTokenResponse(token string, expiresAt time.Time) *TokenResponse {
+	return &TokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Until(expiresAt).Round(time.Second).Seconds()),
+		ExpiresAt:   expiresAt,
+	}
+}
@@ -74,11 +74,22 @@ func (b *SessionResponseBuilder) WithTimestamps(createdAt, updatedAt time.Time)
 func (b *SessionResponseBuilder) FromDomainSession(sess *session.Session) *SessionResponseBuilder {
 	b.response.ID = sess.ID().String()
 	b.response.Name = sess.Name()
+	b.response.DisplayName = sess.DisplayName()
 	b.response.Status = sess.Status().String()
+	b.response.ErrorReason = sess.ErrorReason()
+	b.response.DisconnectReason = sess.DisconnectReason().String()
 	b.response.WaJID = sess.WaJID()
 	b.response.IsActive = sess.IsActive()
 	b.response.CreatedAt = sess.CreatedAt()
 	b.response.UpdatedAt = sess.UpdatedAt()
+	b.response.AllowedSenders = sess.AllowedSenders()
+
+	if limit := sess.SendRateLimit(); limit != nil {
+		b.response.SendRateLimit = &SendRateLimitResponse{
+			PerSecond: limit.PerSecond,
+			Burst:     limit.Burst,
+		}
+	}
 
 	// Add proxy configuration if present
 	if sess.HasProxy() {
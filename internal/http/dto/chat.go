@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"time"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// MarkReadRequest represents the HTTP request to mark messages of a chat as read
+// @Description Mensagens a serem marcadas como lidas
+type MarkReadRequest struct {
+	MessageIDs  []string `json:"message_ids" validate:"required,min=1,max=500,dive,required" example:"3EB0C127D7BACB8323A4,3EB0A1B2C3D4E5F60718" description:"IDs das mensagens recebidas (todas do mesmo remetente)"`
+	Sender      string   `json:"sender,omitempty" example:"5511988888888" description:"Número ou JID de quem enviou as mensagens (obrigatório em grupos)"`
+	CountryCode string   `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// MarkReadResponse represents the HTTP response for marking messages as read
+// @Description Resultado da confirmação de leitura
+type MarkReadResponse struct {
+	Chat  string `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	Count int    `json:"count" example:"2" description:"Quantidade de mensagens marcadas como lidas"`
+}
+
+// MarkUnreadRequest represents the optional HTTP request body to mark a chat as unread
+// @Description Opções para marcar o chat como não lido
+type MarkUnreadRequest struct {
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// ArchiveChatRequest represents the optional HTTP request body to archive or unarchive a chat
+// @Description Opções de arquivamento do chat
+type ArchiveChatRequest struct {
+	Archive     *bool  `json:"archive,omitempty" example:"true" description:"true arquiva (padrão), false desarquiva"`
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// MuteChatRequest represents the HTTP request to mute or unmute a chat
+// @Description Opções de silenciamento do chat
+type MuteChatRequest struct {
+	Mute        *bool      `json:"mute,omitempty" example:"true" description:"true silencia (padrão), false remove o silenciamento"`
+	Until       *time.Time `json:"until,omitempty" example:"2024-01-01T12:00:00Z" description:"Silencia até esta data (obrigatório ao silenciar, deve estar no futuro)"`
+	CountryCode string     `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// ClearChatRequest represents the optional HTTP request body to clear the history of a chat
+// @Description Opções para limpar o histórico do chat
+type ClearChatRequest struct {
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// ChatActionResponse represents the HTTP response for a chat deletion or clearing accepted by WhatsApp
+// @Description Alteração do chat aceita e propagada de forma assíncrona
+type ChatActionResponse struct {
+	Chat   string `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	Status string `json:"status" example:"accepted" description:"Sempre accepted: os aparelhos da conta aplicam a alteração de forma assíncrona"`
+}
+
+// ChatSummaryResponse represents an entry of the chat list
+// @Description Conversa da lista de chats
+type ChatSummaryResponse struct {
+	Chat          string     `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	Name          string     `json:"name,omitempty" example:"Maria Silva" description:"Nome do grupo ou do contato (ausente quando desconhecido)"`
+	LastMessageAt *time.Time `json:"last_message_at,omitempty" example:"2024-01-01T12:00:00Z" description:"Data da última mensagem"`
+	UnreadCount   int        `json:"unread_count" example:"3" description:"Mensagens não lidas"`
+}
+
+// ChatListResponse represents the chat list of a session
+// @Description Lista de chats da sessão, do mais recente para o mais antigo
+type ChatListResponse struct {
+	Chats        []ChatSummaryResponse `json:"chats" description:"Chats conhecidos até o momento"`
+	Total        int                   `json:"total" example:"42" description:"Quantidade de chats"`
+	SyncComplete bool                  `json:"sync_complete" example:"true" description:"false enquanto a sincronização do histórico ainda está em andamento; a lista pode estar incompleta"`
+}
+
+// ToChatListResponse converts a domain chat list to its HTTP representation
+func ToChatListResponse(chats []*whatsapp.ChatSummary, syncComplete bool) *ChatListResponse {
+	response := &ChatListResponse{
+		Chats:        make([]ChatSummaryResponse, 0, len(chats)),
+		Total:        len(chats),
+		SyncComplete: syncComplete,
+	}
+	for _, chat := range chats {
+		summary := ChatSummaryResponse{
+			Chat:        chat.JID,
+			Name:        chat.Name,
+			UnreadCount: chat.UnreadCount,
+		}
+		if !chat.LastMessageAt.IsZero() {
+			lastMessageAt := chat.LastMessageAt
+			summary.LastMessageAt = &lastMessageAt
+		}
+		response.Chats = append(response.Chats, summary)
+	}
+	return response
+}
+
+// ChatStateResponse represents the local state of a chat
+// @Description Estado do chat sincronizado entre os aparelhos
+type ChatStateResponse struct {
+	Chat       string     `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	Unread     bool       `json:"unread" example:"true" description:"Chat marcado como não lido"`
+	Archived   bool       `json:"archived" example:"false" description:"Chat arquivado"`
+	Pinned     bool       `json:"pinned" example:"false" description:"Chat fixado"`
+	MutedUntil *time.Time `json:"muted_until,omitempty" example:"2024-01-01T12:00:00Z" description:"Silenciado até esta data"`
+}
+
+// ToChatStateResponse converts a domain chat state to its HTTP representation
+func ToChatStateResponse(state *whatsapp.ChatState) *ChatStateResponse {
+	response := &ChatStateResponse{
+		Chat:     state.JID,
+		Unread:   state.Unread,
+		Archived: state.Archived,
+		Pinned:   state.Pinned,
+	}
+	if !state.MutedUntil.IsZero() {
+		mutedUntil := state.MutedUntil
+		response.MutedUntil = &mutedUntil
+	}
+	return response
+}
@@ -134,6 +134,7 @@ type MetricsResponse struct {
 	Sessions  SessionMetrics  `json:"sessions" description:"Métricas das sessões"`
 	WhatsApp  WhatsAppMetrics `json:"whatsapp" description:"Métricas do WhatsApp"`
 	System    SystemMetrics   `json:"system" description:"Métricas do sistema"`
+	Webhook   *WebhookMetrics `json:"webhook,omitempty" description:"Métricas de entrega de webhooks (ausente quando os webhooks estão desabilitados)"`
 	Timestamp time.Time       `json:"timestamp" example:"2024-01-01T12:00:00Z" description:"Timestamp da coleta das métricas"`
 }
 
@@ -154,8 +155,34 @@ type WhatsAppMetrics struct {
 	ConnectedClients     int `json:"connected_clients" example:"3" description:"Clientes conectados"`
 	AuthenticatedClients int `json:"authenticated_clients" example:"2" description:"Clientes autenticados"`
 	ErrorClients         int `json:"error_clients" example:"1" description:"Clientes com erro"`
+	MaxSessions          int `json:"max_sessions" example:"10" description:"Limite de sessões com cliente ativo ao mesmo tempo, comparável a total_clients (MAX_SESSIONS; 0 = ilimitado)"`
 	MessagesSent         int `json:"messages_sent" example:"150" description:"Total de mensagens enviadas"`
 	MessagesReceived     int `json:"messages_received" example:"75" description:"Total de mensagens recebidas"`
+
+	MessagesSentByType    map[string]int64            `json:"messages_sent_by_type" description:"Mensagens enviadas por tipo (text, image, document, audio, video, sticker, location, contact)"`
+	MessagesSentBySession map[string]map[string]int64 `json:"messages_sent_by_session,omitempty" description:"Mensagens enviadas por sessão (ID) e por tipo"`
+}
+
+// WebhookMetrics represents webhook delivery metrics
+// @Description Métricas de entrega de webhooks
+type WebhookMetrics struct {
+	Delivered        int64   `json:"delivered" example:"120" description:"Eventos entregues"`
+	Failed           int64   `json:"failed" example:"3" description:"Eventos descartados após esgotar as tentativas ou com a fila cheia"`
+	Retried          int64   `json:"retried" example:"5" description:"Tentativas repetidas após uma falha"`
+	AverageLatencyMs float64 `json:"average_latency_ms" example:"42.5" description:"Latência média das entregas bem-sucedidas em milissegundos"`
+	QueueLength      int     `json:"queue_length" example:"2" description:"Eventos aguardando na fila de entrega"`
+	QueueCapacity    int     `json:"queue_capacity" example:"1000" description:"Capacidade da fila; eventos novos são descartados quando está cheia"`
+
+	LatencyBuckets map[string]int64                  `json:"latency_buckets" description:"Tentativas de entrega por faixa de latência (limite superior em segundos, cumulativo como no Prometheus)"`
+	BySession      map[string]*WebhookSessionMetrics `json:"by_session,omitempty" description:"Entregas por sessão (ID)"`
+}
+
+// WebhookSessionMetrics represents the webhook deliveries of one session
+// @Description Entregas de webhooks de uma sessão
+type WebhookSessionMetrics struct {
+	Delivered int64 `json:"delivered" example:"40" description:"Eventos entregues"`
+	Failed    int64 `json:"failed" example:"1" description:"Eventos descartados"`
+	Retried   int64 `json:"retried" example:"2" description:"Tentativas repetidas após uma falha"`
 }
 
 // SystemMetrics represents system-related metrics
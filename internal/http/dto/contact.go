@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"encoding/base64"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// ContactResponse represents a contact in HTTP responses
+// @Description Contato armazenado da sessão WhatsApp
+type ContactResponse struct {
+	JID          string `json:"jid" example:"5511999999999@s.whatsapp.net" description:"JID do contato"`
+	PushName     string `json:"push_name,omitempty" example:"João" description:"Nome definido pelo próprio contato no WhatsApp"`
+	BusinessName string `json:"business_name,omitempty" example:"Padaria do João" description:"Nome comercial (contas WhatsApp Business)"`
+	FirstName    string `json:"first_name,omitempty" example:"João" description:"Primeiro nome na agenda do telefone"`
+	FullName     string `json:"full_name,omitempty" example:"João da Silva" description:"Nome completo na agenda do telefone"`
+}
+
+// ContactListResponse represents the HTTP response for listing contacts
+// @Description Página de contatos da sessão
+type ContactListResponse struct {
+	SessionID  string             `json:"session_id" example:"550e8400-e29b-41d4-a716-446655440000" description:"ID da sessão"`
+	Contacts   []*ContactResponse `json:"contacts" description:"Contatos da página (ordenados por JID)"`
+	Total      int                `json:"total" example:"250" description:"Total de contatos armazenados"`
+	Limit      int                `json:"limit" example:"100" description:"Limite por página"`
+	Offset     int                `json:"offset" example:"0" description:"Deslocamento da página"`
+	NextCursor string             `json:"next_cursor,omitempty" example:"eyJpZCI6IjU1MTE5OTk5OTk5OTlAcy53aGF0c2FwcC5uZXQifQ" description:"Cursor da próxima página (ausente na última); preferível ao offset para listas grandes"`
+}
+
+// ToContactListResponse converts domain contacts to HTTP response
+func ToContactListResponse(sessionID string, contacts []*whatsapp.ContactInfo, total, limit, offset int) *ContactListResponse {
+	responses := make([]*ContactResponse, 0, len(contacts))
+	for _, contact := range contacts {
+		responses = append(responses, &ContactResponse{
+			JID:          contact.JID,
+			PushName:     contact.PushName,
+			BusinessName: contact.BusinessName,
+			FirstName:    contact.FirstName,
+			FullName:     contact.FullName,
+		})
+	}
+
+	return &ContactListResponse{
+		SessionID: sessionID,
+		Contacts:  responses,
+		Total:     total,
+		Limit:     limit,
+		Offset:    offset,
+	}
+}
+
+// CheckNumbersRequest represents the HTTP request to check phone numbers on WhatsApp
+// @Description Números de telefone a verificar no WhatsApp
+type CheckNumbersRequest struct {
+	Phones      []string `json:"phones" validate:"required,min=1,max=100" example:"5511999999999,+55 11 98888-8888" description:"Números de telefone (até 100); caracteres não numéricos são ignorados"`
+	CountryCode string   `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// NumberCheckResponse represents the check result of a single phone number
+// @Description Resultado da verificação de um número
+type NumberCheckResponse struct {
+	Query  string `json:"query" example:"+55 11 98888-8888" description:"Número como enviado na requisição"`
+	Exists bool   `json:"exists" example:"true" description:"Indica se o número possui conta no WhatsApp"`
+	JID    string `json:"jid,omitempty" example:"5511988888888@s.whatsapp.net" description:"JID canônico (quando registrado)"`
+}
+
+// CheckNumbersResponse represents the HTTP response for a phone number check
+// @Description Resultado da verificação, na mesma ordem da requisição
+type CheckNumbersResponse struct {
+	Results []*NumberCheckResponse `json:"results" description:"Resultado por número"`
+}
+
+// ToCheckNumbersResponse converts number check results to HTTP response
+func ToCheckNumbersResponse(results []*whatsapp.NumberCheckResult) *CheckNumbersResponse {
+	responses := make([]*NumberCheckResponse, 0, len(results))
+	for _, result := range results {
+		responses = append(responses, &NumberCheckResponse{
+			Query:  result.Query,
+			Exists: result.Exists,
+			JID:    result.JID,
+		})
+	}
+
+	return &CheckNumbersResponse{
+		Results: responses,
+	}
+}
+
+// ProfilePictureResponse represents the HTTP response for a profile picture
+// @Description Foto de perfil de um contato ou grupo
+type ProfilePictureResponse struct {
+	JID         string `json:"jid" example:"5511999999999@s.whatsapp.net" description:"JID do contato ou grupo"`
+	PictureID   string `json:"picture_id" example:"1718000000" description:"ID da foto de perfil"`
+	URL         string `json:"url" example:"https://pps.whatsapp.net/v/t61.24694-24/..." description:"URL temporária da imagem no CDN do WhatsApp"`
+	Type        string `json:"type" example:"image" enums:"image,preview" description:"Tipo da imagem: image (resolução completa) ou preview (miniatura)"`
+	ContentType string `json:"content_type,omitempty" example:"image/jpeg" description:"Tipo MIME da imagem (quando download=true)"`
+	Data        string `json:"data,omitempty" description:"Imagem codificada em base64 (quando download=true)"`
+}
+
+// ToProfilePictureResponse converts a domain profile picture to HTTP response
+func ToProfilePictureResponse(jid string, picture *whatsapp.ProfilePictureInfo, data []byte, contentType string) *ProfilePictureResponse {
+	response := &ProfilePictureResponse{
+		JID:       jid,
+		PictureID: picture.ID,
+		URL:       picture.URL,
+		Type:      picture.Type,
+	}
+
+	if len(data) > 0 {
+		response.ContentType = contentType
+		response.Data = base64.StdEncoding.EncodeToString(data)
+	}
+
+	return response
+}
+
+// BusinessProfileResponse represents the HTTP response for a business profile
+// @Description Perfil de uma conta WhatsApp Business
+type BusinessProfileResponse struct {
+	JID           string                     `json:"jid" example:"5511999999999@s.whatsapp.net" description:"JID do contato"`
+	Categories    []BusinessCategoryResponse `json:"categories" description:"Categorias do negócio"`
+	Email         string                     `json:"email,omitempty" example:"contato@loja.com.br" description:"E-mail do negócio"`
+	Address       string                     `json:"address,omitempty" example:"Av. Paulista, 1000 - São Paulo" description:"Endereço do negócio"`
+	HoursTimeZone string                     `json:"hours_time_zone,omitempty" example:"America/Sao_Paulo" description:"Fuso horário do horário de funcionamento"`
+	Hours         []BusinessHoursResponse    `json:"hours" description:"Horário de funcionamento por dia da semana"`
+}
+
+// BusinessCategoryResponse represents a category of a business
+// @Description Categoria do negócio
+type BusinessCategoryResponse struct {
+	ID   string `json:"id" example:"1223524174334504" description:"ID da categoria"`
+	Name string `json:"name" example:"Loja de varejo" description:"Nome da categoria"`
+}
+
+// BusinessHoursResponse represents the opening hours of a business on one day
+// @Description Horário de funcionamento em um dia da semana
+type BusinessHoursResponse struct {
+	DayOfWeek string `json:"day_of_week" example:"mon" description:"Dia da semana (sun a sat)"`
+	Mode      string `json:"mode" example:"specific_hours" description:"Modo: specific_hours, open_24h ou appointment_only"`
+	OpenTime  string `json:"open_time,omitempty" example:"540" description:"Abertura, no formato enviado pelo WhatsApp (somente em specific_hours)"`
+	CloseTime string `json:"close_time,omitempty" example:"1080" description:"Fechamento, no formato enviado pelo WhatsApp (somente em specific_hours)"`
+}
+
+// ToBusinessProfileResponse converts a domain business profile to HTTP response
+func ToBusinessProfileResponse(jid string, profile *whatsapp.BusinessProfile) *BusinessProfileResponse {
+	categories := make([]BusinessCategoryResponse, 0, len(profile.Categories))
+	for _, category := range profile.Categories {
+		categories = append(categories, BusinessCategoryResponse{ID: category.ID, Name: category.Name})
+	}
+
+	hours := make([]BusinessHoursResponse, 0, len(profile.Hours))
+	for _, day := range profile.Hours {
+		hours = append(hours, BusinessHoursResponse{
+			DayOfWeek: day.DayOfWeek,
+			Mode:      day.Mode,
+			OpenTime:  day.OpenTime,
+			CloseTime: day.CloseTime,
+		})
+	}
+
+	return &BusinessProfileResponse{
+		JID:           jid,
+		Categories:    categories,
+		Email:         profile.Email,
+		Address:       profile.Address,
+		HoursTimeZone: profile.HoursTimeZone,
+		Hours:         hours,
+	}
+}
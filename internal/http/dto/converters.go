@@ -164,15 +164,38 @@ func (pc *ProxyConverter) BuildProxyURL(config *ProxyConfigResponse) (string, er
 	return u.String(), nil
 }
 
+const (
+	// DefaultParallelConversionThreshold is the largest batch converted sequentially by default
+	DefaultParallelConversionThreshold = 10
+	// DefaultConversionWorkers is the default number of goroutines converting a large batch
+	DefaultConversionWorkers = 4
+)
+
 // BatchConverter handles batch conversions efficiently
 type BatchConverter struct {
 	pool *ConverterPool
+
+	// Batches up to parallelThreshold sessions are converted sequentially, larger ones by
+	// workers goroutines
+	parallelThreshold int
+	workers           int
 }
 
-// NewBatchConverter creates a new batch converter
-func NewBatchConverter() *BatchConverter {
+// NewBatchConverter creates a new batch converter. Batches of up to parallelThreshold
+// sessions are converted sequentially and larger ones by the given number of workers;
+// non-positive values use DefaultParallelConversionThreshold and DefaultConversionWorkers.
+func NewBatchConverter(parallelThreshold, workers int) *BatchConverter {
+	if parallelThreshold <= 0 {
+		parallelThreshold = DefaultParallelConversionThreshold
+	}
+	if workers <= 0 {
+		workers = DefaultConversionWorkers
+	}
+
 	return &BatchConverter{
-		pool: NewConverterPool(),
+		pool:              NewConverterPool(),
+		parallelThreshold: parallelThreshold,
+		workers:           workers,
 	}
 }
 
@@ -183,7 +206,7 @@ func (bc *BatchConverter) ConvertSessions(sessions []*session.Session) []*Sessio
 	}
 
 	// For small batches, use sequential processing
-	if len(sessions) <= 10 {
+	if len(sessions) <= bc.parallelThreshold {
 		return bc.convertSessionsSequential(sessions)
 	}
 
@@ -209,13 +232,12 @@ func (bc *BatchConverter) convertSessionsParallel(sessions []*session.Session) [
 	responses := make([]*SessionResponse, len(sessions))
 
 	// Use worker pool pattern
-	const numWorkers = 4
 	jobs := make(chan int, len(sessions))
 
 	var wg sync.WaitGroup
 
 	// Start workers
-	for w := 0; w < numWorkers; w++ {
+	for w := 0; w < bc.workers; w++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -319,7 +341,7 @@ func (sc *StreamingConverter) ConvertSessionsStream(sessions <-chan *session.Ses
 
 // Global converter instances for convenience
 var (
-	defaultConverter = NewBatchConverter()
+	defaultConverter = NewBatchConverter(DefaultParallelConversionThreshold, DefaultConversionWorkers)
 	cachedConverter  = NewCachedConverter()
 	streamConverter  = NewStreamingConverter()
 )
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor was not issued by the API
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// Cursor is the keyset position of the last item of a page, from which the next page
+// starts. Lists ordered by time set Time; lists ordered by ID only leave it zero.
+//
+// Cursors are preferred over offsets for high-volume lists (contacts, messages): each
+// page costs the same however deep it is, and items added meanwhile do not shift pages.
+type Cursor struct {
+	Time time.Time
+	ID   string
+}
+
+// cursorPayload is the encoded form of a cursor
+type cursorPayload struct {
+	Time int64  `json:"t,omitempty"` // Unix nanoseconds
+	ID   string `json:"id"`
+}
+
+// EncodeCursor encodes a cursor into the opaque string returned as next_cursor
+func EncodeCursor(cursor Cursor) string {
+	payload := cursorPayload{ID: cursor.ID}
+	if !cursor.Time.IsZero() {
+		payload.Time = cursor.Time.UnixNano()
+	}
+
+	data, _ := json.Marshal(payload)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor sent back by a client. An empty value means the first
+// page and decodes to nil.
+func DecodeCursor(value string) (*Cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil || payload.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := &Cursor{ID: payload.ID}
+	if payload.Time != 0 {
+		cursor.Time = time.Unix(0, payload.Time).UTC()
+	}
+	return cursor, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 )
 
 // ErrorCode represents standardized error codes for DTOs
@@ -26,6 +27,7 @@ const (
 	ErrorCodeSessionInvalidState  ErrorCode = "SESSION_INVALID_STATE"
 	ErrorCodeSessionConnected     ErrorCode = "SESSION_ALREADY_CONNECTED"
 	ErrorCodeSessionDisconnected  ErrorCode = "SESSION_DISCONNECTED"
+	ErrorCodeSessionLimitReached  ErrorCode = "SESSION_LIMIT_REACHED"
 
 	// Proxy error codes
 	ErrorCodeInvalidProxy          ErrorCode = "INVALID_PROXY"
@@ -37,6 +39,21 @@ const (
 	ErrorCodeWhatsAppAuthFailed   ErrorCode = "WHATSAPP_AUTH_FAILED"
 	ErrorCodeWhatsAppQRExpired    ErrorCode = "WHATSAPP_QR_EXPIRED"
 
+	// Connection failure codes, one per whatsapp.ConnectionFailureReason
+	ErrorCodeSessionBanned          ErrorCode = "SESSION_BANNED"
+	ErrorCodeSessionNotPaired       ErrorCode = "SESSION_NOT_PAIRED"
+	ErrorCodeWhatsAppUnreachable    ErrorCode = "WHATSAPP_UNREACHABLE"
+	ErrorCodeWhatsAppClientOutdated ErrorCode = "WHATSAPP_CLIENT_OUTDATED"
+	ErrorCodeConnectionFailed       ErrorCode = "CONNECTION_FAILED"
+
+	// Profile picture error codes
+	ErrorCodeProfilePictureNotSet     ErrorCode = "PROFILE_PICTURE_NOT_SET"
+	ErrorCodeProfilePictureRestricted ErrorCode = "PROFILE_PICTURE_RESTRICTED"
+
+	// Business account error codes
+	ErrorCodeNotBusinessAccount ErrorCode = "NOT_BUSINESS_ACCOUNT"
+	ErrorCodeSessionNotBusiness ErrorCode = "SESSION_NOT_BUSINESS"
+
 	// General error codes
 	ErrorCodeInternalError      ErrorCode = "INTERNAL_ERROR"
 	ErrorCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
@@ -56,14 +73,17 @@ func (ec ErrorCode) HTTPStatusCode() int {
 		ErrorCodeInvalidFormat, ErrorCodeInvalidLength, ErrorCodeInvalidCharacters,
 		ErrorCodeInvalidProxy:
 		return http.StatusBadRequest
-	case ErrorCodeSessionNotFound:
+	case ErrorCodeSessionNotFound, ErrorCodeProfilePictureNotSet, ErrorCodeNotBusinessAccount:
 		return http.StatusNotFound
-	case ErrorCodeSessionAlreadyExists:
+	case ErrorCodeProfilePictureRestricted, ErrorCodeSessionBanned:
+		return http.StatusForbidden
+	case ErrorCodeSessionAlreadyExists, ErrorCodeSessionNotPaired:
 		return http.StatusConflict
 	case ErrorCodeSessionInvalidState, ErrorCodeSessionConnected, ErrorCodeSessionDisconnected,
-		ErrorCodeWhatsAppNotConnected, ErrorCodeWhatsAppAuthFailed:
+		ErrorCodeWhatsAppNotConnected, ErrorCodeWhatsAppAuthFailed, ErrorCodeSessionNotBusiness:
 		return http.StatusUnprocessableEntity
-	case ErrorCodeProxyConnectionFailed, ErrorCodeProxyAuthFailed:
+	case ErrorCodeProxyConnectionFailed, ErrorCodeProxyAuthFailed, ErrorCodeWhatsAppUnreachable,
+		ErrorCodeWhatsAppClientOutdated, ErrorCodeConnectionFailed:
 		return http.StatusBadGateway
 	case ErrorCodeWhatsAppQRExpired:
 		return http.StatusGone
@@ -71,13 +91,31 @@ func (ec ErrorCode) HTTPStatusCode() int {
 		return http.StatusServiceUnavailable
 	case ErrorCodeTimeout:
 		return http.StatusRequestTimeout
-	case ErrorCodeRateLimited:
+	case ErrorCodeRateLimited, ErrorCodeSessionLimitReached:
 		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
 }
 
+// ConnectionErrorCode returns the error code reported for a connection failure reason
+func ConnectionErrorCode(reason whatsapp.ConnectionFailureReason) ErrorCode {
+	switch reason {
+	case whatsapp.ConnectionFailureBanned:
+		return ErrorCodeSessionBanned
+	case whatsapp.ConnectionFailureNotPaired:
+		return ErrorCodeSessionNotPaired
+	case whatsapp.ConnectionFailureNetwork:
+		return ErrorCodeWhatsAppUnreachable
+	case whatsapp.ConnectionFailureProxy:
+		return ErrorCodeProxyConnectionFailed
+	case whatsapp.ConnectionFailureClientOutdated:
+		return ErrorCodeWhatsAppClientOutdated
+	default:
+		return ErrorCodeConnectionFailed
+	}
+}
+
 // DTOError represents a structured error for DTOs
 type DTOError struct {
 	Code       ErrorCode              `json:"code"`
@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"time"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// GroupParticipantResponse represents a group member in HTTP responses
+// @Description Participante de um grupo WhatsApp
+type GroupParticipantResponse struct {
+	JID          string `json:"jid" example:"5511999999999@s.whatsapp.net" description:"JID do participante"`
+	PhoneNumber  string `json:"phone_number,omitempty" example:"5511999999999" description:"Número de telefone (quando disponível)"`
+	IsAdmin      bool   `json:"is_admin" example:"false" description:"Indica se o participante é administrador"`
+	IsSuperAdmin bool   `json:"is_super_admin" example:"false" description:"Indica se o participante é o criador do grupo"`
+}
+
+// GroupResponse represents group metadata in HTTP responses
+// @Description Metadados de um grupo WhatsApp
+type GroupResponse struct {
+	JID              string                     `json:"jid" example:"120363025246125486@g.us" description:"JID do grupo"`
+	Name             string                     `json:"name" example:"Equipe de Suporte" description:"Assunto (nome) do grupo"`
+	Description      string                     `json:"description,omitempty" example:"Grupo da equipe de suporte" description:"Descrição do grupo"`
+	OwnerJID         string                     `json:"owner_jid,omitempty" example:"5511999999999@s.whatsapp.net" description:"JID do dono do grupo"`
+	Participants     []GroupParticipantResponse `json:"participants" description:"Lista de participantes"`
+	ParticipantCount int                        `json:"participant_count" example:"12" description:"Quantidade de participantes"`
+	CreatedAt        *time.Time                 `json:"created_at,omitempty" description:"Data de criação do grupo"`
+}
+
+// GroupListResponse represents the HTTP response for listing groups
+// @Description Lista de grupos dos quais a sessão participa
+type GroupListResponse struct {
+	Groups []*GroupResponse `json:"groups" description:"Lista de grupos"`
+	Total  int              `json:"total" example:"3" description:"Total de grupos"`
+}
+
+// ToGroupResponse converts domain group info to HTTP response
+func ToGroupResponse(group *whatsapp.GroupInfo) *GroupResponse {
+	participants := make([]GroupParticipantResponse, 0, len(group.Participants))
+	for _, p := range group.Participants {
+		participants = append(participants, GroupParticipantResponse{
+			JID:          p.JID,
+			PhoneNumber:  p.PhoneNumber,
+			IsAdmin:      p.IsAdmin,
+			IsSuperAdmin: p.IsSuperAdmin,
+		})
+	}
+
+	return &GroupResponse{
+		JID:              group.JID,
+		Name:             group.Name,
+		Description:      group.Description,
+		OwnerJID:         group.OwnerJID,
+		Participants:     participants,
+		ParticipantCount: len(participants),
+		CreatedAt:        timePtr(group.CreatedAt),
+	}
+}
+
+// ToGroupListResponse converts a list of domain groups to HTTP response
+func ToGroupListResponse(groups []*whatsapp.GroupInfo) *GroupListResponse {
+	responses := make([]*GroupResponse, 0, len(groups))
+	for _, group := range groups {
+		responses = append(responses, ToGroupResponse(group))
+	}
+
+	return &GroupListResponse{
+		Groups: responses,
+		Total:  len(responses),
+	}
+}
+
+// UpdateGroupParticipantsRequest represents the HTTP request to change group participants
+// @Description Alteração de participantes de um grupo
+type UpdateGroupParticipantsRequest struct {
+	Action       string   `json:"action" validate:"required,oneof=add remove promote demote" example:"add" enums:"add,remove,promote,demote" description:"Ação a aplicar: add, remove, promote ou demote"`
+	Participants []string `json:"participants" validate:"required,min=1" example:"5511999999999,5511888888888@s.whatsapp.net" description:"Números de telefone ou JIDs dos participantes"`
+	CountryCode  string   `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// ParticipantResultResponse represents the outcome of a participant change in HTTP responses
+// @Description Resultado da alteração para um participante
+type ParticipantResultResponse struct {
+	JID       string `json:"jid" example:"5511999999999@s.whatsapp.net" description:"JID do participante"`
+	Success   bool   `json:"success" example:"true" description:"Indica se a alteração foi aplicada"`
+	ErrorCode int    `json:"error_code,omitempty" example:"403" description:"Código de erro retornado pelo WhatsApp"`
+	Error     string `json:"error,omitempty" example:"not allowed by the user's privacy settings, an invite is required" description:"Descrição do erro"`
+}
+
+// UpdateGroupParticipantsResponse represents the HTTP response for a participant change
+// @Description Resultado individual da alteração de participantes do grupo
+type UpdateGroupParticipantsResponse struct {
+	GroupJID     string                       `json:"group_jid" example:"120363025246125486@g.us" description:"JID do grupo"`
+	Action       string                       `json:"action" example:"add" description:"Ação aplicada"`
+	Results      []*ParticipantResultResponse `json:"results" description:"Resultado por participante"`
+	SuccessCount int                          `json:"success_count" example:"1" description:"Quantidade de participantes alterados com sucesso"`
+	FailureCount int                          `json:"failure_count" example:"1" description:"Quantidade de participantes com falha"`
+}
+
+// ToUpdateGroupParticipantsResponse converts participant update results to HTTP response
+func ToUpdateGroupParticipantsResponse(groupJID, action string, results []*whatsapp.ParticipantUpdateResult) *UpdateGroupParticipantsResponse {
+	response := &UpdateGroupParticipantsResponse{
+		GroupJID: groupJID,
+		Action:   action,
+		Results:  make([]*ParticipantResultResponse, 0, len(results)),
+	}
+
+	for _, result := range results {
+		response.Results = append(response.Results, &ParticipantResultResponse{
+			JID:       result.JID,
+			Success:   result.Success,
+			ErrorCode: result.ErrorCode,
+			Error:     result.Error,
+		})
+		if result.Success {
+			response.SuccessCount++
+		} else {
+			response.FailureCount++
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,64 @@
+package dto
+
+import "wazmeow/internal/domain/whatsapp"
+
+// CreateLabelRequest represents the HTTP request to create a label
+// @Description Etiqueta a criar
+type CreateLabelRequest struct {
+	Name  string `json:"name" validate:"required,max=100" example:"Novo cliente" description:"Nome da etiqueta (até 100 caracteres)"`
+	Color int    `json:"color" validate:"min=0,max=19" example:"3" description:"Índice da cor na paleta de etiquetas do WhatsApp (0 a 19)"`
+}
+
+// LabelChatRequest represents the HTTP request to label a chat or one of its messages
+// @Description Etiqueta a aplicar ou remover
+type LabelChatRequest struct {
+	LabelID     string `json:"label_id" validate:"required" example:"3" description:"ID da etiqueta"`
+	MessageID   string `json:"message_id,omitempty" example:"3EB0C127D7BACB8323A4" description:"ID de uma mensagem do chat; quando informado a etiqueta é aplicada à mensagem em vez do chat"`
+	Labeled     *bool  `json:"labeled,omitempty" example:"true" description:"true aplica a etiqueta (padrão), false remove"`
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// LabelResponse represents a label in HTTP responses
+// @Description Etiqueta do WhatsApp Business
+type LabelResponse struct {
+	ID    string `json:"id" example:"3" description:"ID da etiqueta"`
+	Name  string `json:"name" example:"Novo cliente" description:"Nome da etiqueta"`
+	Color int    `json:"color" example:"3" description:"Índice da cor na paleta de etiquetas do WhatsApp"`
+}
+
+// LabelListResponse represents the labels of a session
+// @Description Etiquetas da conta WhatsApp Business
+type LabelListResponse struct {
+	Labels []LabelResponse `json:"labels" description:"Etiquetas ordenadas por ID"`
+	Total  int             `json:"total" example:"5" description:"Quantidade de etiquetas"`
+}
+
+// LabelChatResponse represents the HTTP response after labeling a chat or message
+// @Description Etiqueta aplicada ou removida
+type LabelChatResponse struct {
+	Chat      string `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	LabelID   string `json:"label_id" example:"3" description:"ID da etiqueta"`
+	MessageID string `json:"message_id,omitempty" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem etiquetada, quando aplicável"`
+	Labeled   bool   `json:"labeled" example:"true" description:"true quando a etiqueta foi aplicada, false quando foi removida"`
+}
+
+// ToLabelResponse converts a domain label to its HTTP representation
+func ToLabelResponse(label *whatsapp.Label) LabelResponse {
+	return LabelResponse{
+		ID:    label.ID,
+		Name:  label.Name,
+		Color: label.Color,
+	}
+}
+
+// ToLabelListResponse converts domain labels to their HTTP representation
+func ToLabelListResponse(labels []*whatsapp.Label) *LabelListResponse {
+	response := &LabelListResponse{
+		Labels: make([]LabelResponse, 0, len(labels)),
+		Total:  len(labels),
+	}
+	for _, label := range labels {
+		response.Labels = append(response.Labels, ToLabelResponse(label))
+	}
+	return response
+}
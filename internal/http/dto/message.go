@@ -0,0 +1,393 @@
+package dto
+
+import (
+	"time"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// MessageResponse represents a stored message in HTTP responses
+// @Description Mensagem armazenada
+type MessageResponse struct {
+	ID        string    `json:"id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem no WhatsApp"`
+	Chat      string    `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	Sender    string    `json:"sender" example:"5511999999999@s.whatsapp.net" description:"JID de quem enviou a mensagem"`
+	FromMe    bool      `json:"from_me" example:"false" description:"Mensagem enviada pela própria sessão"`
+	Direction string    `json:"direction" example:"incoming" enums:"incoming,outgoing" description:"Direção da mensagem"`
+	Type      string    `json:"type" example:"text" description:"Tipo da mensagem (text, image, video, audio, document, sticker, location, contact, poll)"`
+	Body      string    `json:"body,omitempty" example:"Olá!" description:"Texto ou legenda da mensagem"`
+	HasMedia  bool      `json:"has_media" example:"false" description:"Mensagem possui mídia disponível para download"`
+	MimeType  string    `json:"mime_type,omitempty" example:"image/jpeg" description:"Tipo MIME da mídia"`
+	FileName  string    `json:"file_name,omitempty" example:"contrato.pdf" description:"Nome do arquivo (documentos)"`
+	Timestamp time.Time `json:"timestamp" example:"2024-01-01T12:00:00Z" description:"Data e hora da mensagem"`
+}
+
+// ToMessageResponse converts a stored message to its HTTP representation
+func ToMessageResponse(message *whatsapp.StoredMessage) MessageResponse {
+	response := MessageResponse{
+		ID:        message.ID,
+		Chat:      message.ChatJID,
+		Sender:    message.SenderJID,
+		FromMe:    message.IsFromMe,
+		Direction: message.Direction(),
+		Type:      message.Type.String(),
+		Body:      message.Body,
+		HasMedia:  message.HasMedia(),
+		Timestamp: message.Timestamp,
+	}
+
+	if message.Media != nil {
+		response.MimeType = message.Media.MimeType
+		response.FileName = message.Media.FileName
+	}
+
+	return response
+}
+
+// ContextMessageResponse represents a message of a conversation context
+// @Description Mensagem do contexto da conversa
+type ContextMessageResponse struct {
+	MessageResponse
+	IsAnchor bool `json:"is_anchor" example:"false" description:"Indica a mensagem usada como referência"`
+}
+
+// MessageContextResponse represents the messages surrounding an anchor message
+// @Description Mensagens ao redor de uma mensagem de referência, em ordem cronológica
+type MessageContextResponse struct {
+	Chat     string                   `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	AnchorID string                   `json:"anchor_id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem de referência"`
+	Before   int                      `json:"before" example:"10" description:"Quantidade de mensagens anteriores retornadas"`
+	After    int                      `json:"after" example:"10" description:"Quantidade de mensagens posteriores retornadas"`
+	Messages []ContextMessageResponse `json:"messages" description:"Mensagens em ordem cronológica, incluindo a de referência"`
+}
+
+// ToMessageContextResponse converts a message context to its HTTP representation
+func ToMessageContextResponse(chat string, anchor *whatsapp.StoredMessage, messages []*whatsapp.StoredMessage) *MessageContextResponse {
+	response := &MessageContextResponse{
+		Chat:     chat,
+		AnchorID: anchor.ID,
+		Messages: make([]ContextMessageResponse, 0, len(messages)),
+	}
+
+	anchorSeen := false
+	for _, message := range messages {
+		isAnchor := message.ID == anchor.ID
+		switch {
+		case isAnchor:
+			anchorSeen = true
+		case anchorSeen:
+			response.After++
+		default:
+			response.Before++
+		}
+
+		response.Messages = append(response.Messages, ContextMessageResponse{
+			MessageResponse: ToMessageResponse(message),
+			IsAnchor:        isAnchor,
+		})
+	}
+
+	return response
+}
+
+// ChatMessagesResponse represents a page of the stored history of a chat
+// @Description Página do histórico de mensagens do chat, da mais recente para a mais antiga
+type ChatMessagesResponse struct {
+	Chat       string            `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	Messages   []MessageResponse `json:"messages" description:"Mensagens da página, da mais recente para a mais antiga"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJ0IjoxNzA0MTEwNDAwMDAwMDAwMDAwLCJpZCI6IjNFQjBDMTI3RDdCQUNCODMyM0E0In0" description:"Cursor da página seguinte, com mensagens mais antigas (ausente na última)"`
+}
+
+// ToChatMessagesResponse converts a page of a chat history to its HTTP representation
+func ToChatMessagesResponse(chat string, messages []*whatsapp.StoredMessage, next *whatsapp.MessageCursor) *ChatMessagesResponse {
+	response := &ChatMessagesResponse{
+		Chat:     chat,
+		Messages: make([]MessageResponse, 0, len(messages)),
+	}
+	for _, message := range messages {
+		response.Messages = append(response.Messages, ToMessageResponse(message))
+	}
+	if next != nil {
+		response.NextCursor = EncodeCursor(Cursor{Time: next.Timestamp, ID: next.ID})
+	}
+	return response
+}
+
+// SendPollRequest represents the HTTP request to send a poll
+// @Description Enquete a enviar
+type SendPollRequest struct {
+	To              string   `json:"to" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do destinatário (contato ou grupo)"`
+	CountryCode     string   `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+	Question        string   `json:"question" validate:"required" example:"Qual o melhor horário?" description:"Pergunta da enquete"`
+	Options         []string `json:"options" validate:"required,min=2,max=12" example:"Manhã,Tarde,Noite" description:"Opções da enquete (de 2 a 12, sem repetição)"`
+	SelectableCount int      `json:"selectable_count" validate:"min=0" example:"1" description:"Quantidade máxima de opções por voto (0 permite escolher qualquer quantidade)"`
+}
+
+// SendPollResponse represents the HTTP response after sending a poll
+// @Description Enquete enviada
+type SendPollResponse struct {
+	To        string `json:"to" example:"5511999999999@s.whatsapp.net" description:"JID do destinatário"`
+	MessageID string `json:"message_id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem da enquete, referenciado nos eventos poll.vote"`
+}
+
+// SendStickerRequest represents the form fields sent with a sticker upload
+// @Description Destinatário do sticker
+type SendStickerRequest struct {
+	To          string `json:"to" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do destinatário (contato ou grupo)"`
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// SendStickerResponse represents the HTTP response after sending a sticker
+// @Description Sticker enviado
+type SendStickerResponse struct {
+	To        string `json:"to" example:"5511999999999@s.whatsapp.net" description:"JID do destinatário"`
+	MessageID string `json:"message_id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem do sticker"`
+	Animated  bool   `json:"animated" example:"false" description:"Indica se o sticker é animado"`
+}
+
+// ForwardMessageRequest represents the HTTP request to forward a stored message
+// @Description Conversa de origem e destinatário do encaminhamento
+type ForwardMessageRequest struct {
+	Chat        string `json:"chat" validate:"required" example:"5511888888888" description:"Número de telefone ou JID da conversa em que a mensagem foi recebida ou enviada"`
+	To          string `json:"to" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do destinatário (contato ou grupo)"`
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// ForwardMessageResponse represents the HTTP response after forwarding a message
+// @Description Mensagem encaminhada
+type ForwardMessageResponse struct {
+	To                string `json:"to" example:"5511999999999@s.whatsapp.net" description:"JID do destinatário"`
+	OriginalMessageID string `json:"original_message_id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem encaminhada"`
+	MessageID         string `json:"message_id" example:"3EB0A9F2E1C4D5B6A7F8" description:"ID da nova mensagem"`
+}
+
+// ButtonRequest represents a quick reply button of a buttons message
+// @Description Botão de resposta rápida
+type ButtonRequest struct {
+	ID   string `json:"id" validate:"required" example:"confirm" description:"Identificador devolvido na resposta quando o botão é tocado"`
+	Text string `json:"text" validate:"required,max=20" example:"Confirmar" description:"Texto do botão (até 20 caracteres)"`
+}
+
+// SendButtonsRequest represents the HTTP request to send a buttons message
+// @Description Mensagem com botões a enviar
+type SendButtonsRequest struct {
+	To          string          `json:"to" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do destinatário (contato ou grupo)"`
+	CountryCode string          `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+	Body        string          `json:"body" validate:"required" example:"Confirma o agendamento?" description:"Texto da mensagem"`
+	Buttons     []ButtonRequest `json:"buttons" validate:"required,min=1,max=3,dive" description:"Botões (de 1 a 3, com IDs distintos)"`
+}
+
+// ToButtons converts the requested buttons to domain buttons
+func (r *SendButtonsRequest) ToButtons() []whatsapp.Button {
+	buttons := make([]whatsapp.Button, 0, len(r.Buttons))
+	for _, button := range r.Buttons {
+		buttons = append(buttons, whatsapp.Button{ID: button.ID, Text: button.Text})
+	}
+	return buttons
+}
+
+// ListRowRequest represents a selectable row of a list message
+// @Description Linha selecionável da lista
+type ListRowRequest struct {
+	ID          string `json:"id" validate:"required" example:"morning" description:"Identificador devolvido na resposta quando a linha é escolhida"`
+	Title       string `json:"title" validate:"required,max=24" example:"Manhã" description:"Título da linha (até 24 caracteres)"`
+	Description string `json:"description,omitempty" example:"Das 8h às 12h" description:"Descrição opcional da linha"`
+}
+
+// ListSectionRequest represents a section of a list message
+// @Description Seção da lista
+type ListSectionRequest struct {
+	Title string           `json:"title,omitempty" example:"Horários" description:"Título opcional da seção"`
+	Rows  []ListRowRequest `json:"rows" validate:"required,min=1,dive" description:"Linhas da seção"`
+}
+
+// SendListRequest represents the HTTP request to send a list message
+// @Description Mensagem com lista a enviar
+type SendListRequest struct {
+	To          string               `json:"to" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do destinatário (contato ou grupo)"`
+	CountryCode string               `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+	Body        string               `json:"body" validate:"required" example:"Escolha um horário" description:"Texto da mensagem"`
+	ButtonText  string               `json:"button_text" validate:"required,max=20" example:"Ver horários" description:"Texto do botão que abre a lista (até 20 caracteres)"`
+	Sections    []ListSectionRequest `json:"sections" validate:"required,min=1,max=10,dive" description:"Seções da lista (de 1 a 10, com no máximo 10 linhas no total e IDs distintos)"`
+}
+
+// ToSections converts the requested sections to domain list sections
+func (r *SendListRequest) ToSections() []whatsapp.ListSection {
+	sections := make([]whatsapp.ListSection, 0, len(r.Sections))
+	for _, section := range r.Sections {
+		rows := make([]whatsapp.ListRow, 0, len(section.Rows))
+		for _, row := range section.Rows {
+			rows = append(rows, whatsapp.ListRow{ID: row.ID, Title: row.Title, Description: row.Description})
+		}
+		sections = append(sections, whatsapp.ListSection{Title: section.Title, Rows: rows})
+	}
+	return sections
+}
+
+// SendInteractiveResponse represents the HTTP response after sending a buttons or list message
+// @Description Mensagem interativa enviada
+type SendInteractiveResponse struct {
+	To        string `json:"to" example:"5511999999999@s.whatsapp.net" description:"JID do destinatário"`
+	MessageID string `json:"message_id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem"`
+}
+
+// SendBroadcastRequest represents the HTTP request to send a message to several recipients
+// @Description Mensagem de texto a enviar para vários destinatários
+type SendBroadcastRequest struct {
+	To          []string `json:"to" validate:"required,min=1" example:"5511999999999,5511888888888" description:"Números de telefone ou JIDs dos destinatários (contatos ou grupos), limitados por WHATSAPP_BROADCAST_MAX_RECIPIENTS"`
+	CountryCode string   `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+	Message     string   `json:"message" validate:"required,max=4096" example:"Seu pedido foi enviado!" description:"Texto enviado a cada destinatário (até 4096 caracteres)"`
+}
+
+// BroadcastRecipientResponse represents the outcome of a broadcast for one recipient
+// @Description Resultado do envio para um destinatário
+type BroadcastRecipientResponse struct {
+	To        string `json:"to" example:"5511999999999" description:"Destinatário como informado na requisição"`
+	JID       string `json:"jid,omitempty" example:"5511999999999@s.whatsapp.net" description:"JID do destinatário"`
+	MessageID string `json:"message_id,omitempty" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem enviada"`
+	Success   bool   `json:"success" example:"true" description:"Indica se a mensagem foi enviada"`
+	Error     string `json:"error,omitempty" example:"invalid recipient (must be a phone number with country code or a contact or group JID)" description:"Motivo da falha"`
+}
+
+// SendBroadcastResponse represents the HTTP response after sending a broadcast
+// @Description Resultado do envio para vários destinatários
+type SendBroadcastResponse struct {
+	TotalCount   int                          `json:"total_count" example:"2" description:"Quantidade de destinatários"`
+	SuccessCount int                          `json:"success_count" example:"1" description:"Mensagens enviadas"`
+	FailedCount  int                          `json:"failed_count" example:"1" description:"Destinatários com falha"`
+	Results      []BroadcastRecipientResponse `json:"results" description:"Resultado por destinatário, na ordem da requisição"`
+}
+
+// ScheduleMessageRequest represents the HTTP request to schedule a text message
+// @Description Mensagem de texto a enviar em data e hora futuras
+type ScheduleMessageRequest struct {
+	To          string    `json:"to" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do destinatário (contato ou grupo)"`
+	CountryCode string    `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+	Body        string    `json:"body" validate:"required,max=4096" example:"Lembrete: sua consulta é amanhã às 10h" description:"Texto da mensagem (até 4096 caracteres)"`
+	SendAt      time.Time `json:"send_at" validate:"required" example:"2024-01-01T12:00:00Z" description:"Data e hora de envio em ISO 8601 (até um ano no futuro)"`
+}
+
+// ScheduledMessageResponse represents a scheduled message in HTTP responses
+// @Description Mensagem agendada
+type ScheduledMessageResponse struct {
+	ID            string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000" description:"ID do agendamento"`
+	To            string     `json:"to" example:"5511999999999@s.whatsapp.net" description:"JID do destinatário"`
+	Body          string     `json:"body" example:"Lembrete: sua consulta é amanhã às 10h" description:"Texto da mensagem"`
+	SendAt        time.Time  `json:"send_at" example:"2024-01-01T12:00:00Z" description:"Data e hora de envio"`
+	Status        string     `json:"status" example:"pending" enums:"pending,sending,sent,failed,canceled" description:"Situação do agendamento"`
+	Attempts      int        `json:"attempts" example:"0" description:"Tentativas de envio realizadas"`
+	MessageID     string     `json:"message_id,omitempty" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem no WhatsApp, após o envio"`
+	Error         string     `json:"error,omitempty" example:"failed to send message: timeout" description:"Motivo da última falha"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" example:"2024-01-01T12:00:30Z" description:"Data da próxima tentativa, após uma falha de envio"`
+	CreatedAt     time.Time  `json:"created_at" example:"2024-01-01T10:00:00Z" description:"Data de criação do agendamento"`
+	UpdatedAt     time.Time  `json:"updated_at" example:"2024-01-01T10:00:00Z" description:"Data da última alteração"`
+}
+
+// ToScheduledMessageResponse converts a scheduled message to its HTTP representation
+func ToScheduledMessageResponse(message *whatsapp.ScheduledMessage) ScheduledMessageResponse {
+	var nextAttemptAt *time.Time
+	if message.IsPending() && !message.NextAttemptAt.IsZero() {
+		nextAttemptAt = &message.NextAttemptAt
+	}
+
+	return ScheduledMessageResponse{
+		ID:            message.ID,
+		To:            message.To,
+		Body:          message.Body,
+		SendAt:        message.SendAt,
+		Status:        message.Status.String(),
+		Attempts:      message.Attempts,
+		MessageID:     message.MessageID,
+		Error:         message.Error,
+		NextAttemptAt: nextAttemptAt,
+		CreatedAt:     message.CreatedAt,
+		UpdatedAt:     message.UpdatedAt,
+	}
+}
+
+// ScheduledMessageListResponse represents the scheduled messages of a session
+// @Description Mensagens agendadas da sessão, por data de envio
+type ScheduledMessageListResponse struct {
+	Messages []ScheduledMessageResponse `json:"messages" description:"Mensagens agendadas"`
+	Total    int                        `json:"total" example:"1" description:"Quantidade de mensagens"`
+}
+
+// MessageReceiptResponse represents the receipt of one recipient in HTTP responses
+// @Description Confirmação de um destinatário
+type MessageReceiptResponse struct {
+	Recipient string    `json:"recipient" example:"5511999999999@s.whatsapp.net" description:"JID de quem enviou a confirmação"`
+	Status    string    `json:"status" example:"read" enums:"delivered,read,played" description:"Situação mais avançada do destinatário"`
+	Timestamp time.Time `json:"timestamp" example:"2024-01-01T12:00:00Z" description:"Data da confirmação"`
+}
+
+// MessageStatusResponse represents the receipt state of a sent message
+// @Description Situação de entrega de uma mensagem enviada
+type MessageStatusResponse struct {
+	MessageID string                   `json:"message_id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem no WhatsApp"`
+	ChatJID   string                   `json:"chat_jid" example:"5511999999999@s.whatsapp.net" description:"JID da conversa"`
+	Status    string                   `json:"status" example:"read" enums:"delivered,read,played" description:"Situação mais avançada entre os destinatários"`
+	UpdatedAt time.Time                `json:"updated_at" example:"2024-01-01T12:00:00Z" description:"Data da confirmação mais recente"`
+	Receipts  []MessageReceiptResponse `json:"receipts" description:"Confirmação de cada destinatário"`
+}
+
+// ToMessageStatusResponse converts a message status to its HTTP representation
+func ToMessageStatusResponse(status *whatsapp.MessageStatus) MessageStatusResponse {
+	response := MessageStatusResponse{
+		MessageID: status.MessageID,
+		ChatJID:   status.ChatJID,
+		Status:    status.Status.String(),
+		UpdatedAt: status.UpdatedAt,
+		Receipts:  make([]MessageReceiptResponse, 0, len(status.Receipts)),
+	}
+	for _, receipt := range status.Receipts {
+		response.Receipts = append(response.Receipts, MessageReceiptResponse{
+			Recipient: receipt.RecipientJID,
+			Status:    receipt.Status.String(),
+			Timestamp: receipt.Timestamp,
+		})
+	}
+	return response
+}
+
+// RecentMessageResponse represents a buffered incoming message in HTTP responses
+// @Description Mensagem recebida mantida em memória
+type RecentMessageResponse struct {
+	Cursor     uint64    `json:"cursor" example:"42" description:"Posição da mensagem no buffer da sessão"`
+	ID         string    `json:"id" example:"3EB0C127D7BACB8323A4" description:"ID da mensagem no WhatsApp"`
+	From       string    `json:"from" example:"5511999999999@s.whatsapp.net" description:"JID do remetente"`
+	Chat       string    `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID da conversa"`
+	Type       string    `json:"type" example:"text" description:"Tipo da mensagem"`
+	Body       string    `json:"body,omitempty" example:"Olá!" description:"Texto ou legenda da mensagem"`
+	Timestamp  time.Time `json:"timestamp" example:"2024-01-01T12:00:00Z" description:"Data de envio da mensagem"`
+	ReceivedAt time.Time `json:"received_at" example:"2024-01-01T12:00:01Z" description:"Data de recebimento pela sessão"`
+}
+
+// RecentMessagesResponse represents the buffered incoming messages newer than a cursor
+// @Description Mensagens recebidas mais novas que o cursor, da mais antiga para a mais recente
+type RecentMessagesResponse struct {
+	Messages   []RecentMessageResponse `json:"messages" description:"Mensagens recebidas"`
+	NextCursor uint64                  `json:"next_cursor" example:"42" description:"Cursor a enviar em since na próxima consulta"`
+	Total      int                     `json:"total" example:"1" description:"Quantidade de mensagens"`
+}
+
+// ToRecentMessagesResponse converts buffered messages to their HTTP representation
+func ToRecentMessagesResponse(messages *whatsapp.RecentMessages) RecentMessagesResponse {
+	response := RecentMessagesResponse{
+		Messages:   make([]RecentMessageResponse, 0, len(messages.Messages)),
+		NextCursor: messages.NextCursor,
+		Total:      len(messages.Messages),
+	}
+	for _, recent := range messages.Messages {
+		response.Messages = append(response.Messages, RecentMessageResponse{
+			Cursor:     recent.Cursor,
+			ID:         recent.Message.ID,
+			From:       recent.Message.Sender,
+			Chat:       recent.Message.Chat,
+			Type:       recent.Message.Type.String(),
+			Body:       recent.Message.Body,
+			Timestamp:  recent.Message.Timestamp,
+			ReceivedAt: recent.ReceivedAt,
+		})
+	}
+	return response
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// NewsletterResponse represents channel (newsletter) metadata in HTTP responses
+// @Description Metadados de um canal (newsletter) do WhatsApp
+type NewsletterResponse struct {
+	JID               string     `json:"jid" example:"120363144038483540@newsletter" description:"JID do canal"`
+	Name              string     `json:"name" example:"Novidades da Loja" description:"Nome do canal"`
+	Description       string     `json:"description,omitempty" example:"Promoções e avisos" description:"Descrição do canal"`
+	InviteCode        string     `json:"invite_code,omitempty" example:"0029Va4K0PZ5a245NkngBA2M" description:"Código do link de convite do canal"`
+	SubscriberCount   int        `json:"subscriber_count" example:"1520" description:"Quantidade de seguidores"`
+	State             string     `json:"state,omitempty" example:"active" description:"Estado do canal (active, suspended, geosuspended)"`
+	VerificationState string     `json:"verification_state,omitempty" example:"unverified" description:"Verificação do canal (verified, unverified)"`
+	Role              string     `json:"role,omitempty" example:"owner" description:"Papel da sessão no canal (owner, admin, subscriber, guest)"`
+	CreatedAt         *time.Time `json:"created_at,omitempty" description:"Data de criação do canal"`
+}
+
+// ToNewsletterResponse converts domain newsletter info to HTTP response
+func ToNewsletterResponse(newsletter *whatsapp.NewsletterInfo) *NewsletterResponse {
+	return &NewsletterResponse{
+		JID:               newsletter.JID,
+		Name:              newsletter.Name,
+		Description:       newsletter.Description,
+		InviteCode:        newsletter.InviteCode,
+		SubscriberCount:   newsletter.SubscriberCount,
+		State:             newsletter.State,
+		VerificationState: newsletter.VerificationState,
+		Role:              newsletter.Role,
+		CreatedAt:         timePtr(newsletter.CreatedAt),
+	}
+}
+
+// SendNewsletterMessageRequest represents the HTTP request to post a message to a channel
+// @Description Mensagem de texto a publicar em um canal
+type SendNewsletterMessageRequest struct {
+	Text string `json:"text" validate:"required,max=4096" example:"Nova coleção disponível!" description:"Texto da mensagem"`
+}
+
+// SendNewsletterMessageResponse represents the HTTP response after posting a message to a channel
+// @Description Resultado da publicação em um canal
+type SendNewsletterMessageResponse struct {
+	NewsletterJID string `json:"newsletter_jid" example:"120363144038483540@newsletter" description:"JID do canal"`
+	Success       bool   `json:"success" example:"true" description:"Indica se a mensagem foi publicada"`
+}
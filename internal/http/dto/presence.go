@@ -0,0 +1,42 @@
+package dto
+
+// SubscribePresenceRequest represents the HTTP request to subscribe to a contact's presence
+// @Description Contato cuja presença será acompanhada
+type SubscribePresenceRequest struct {
+	JID         string `json:"jid" validate:"required" example:"5511999999999" description:"Número de telefone ou JID do contato"`
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// SubscribePresenceResponse represents the HTTP response for a presence subscription
+// @Description Resultado da inscrição na presença do contato
+type SubscribePresenceResponse struct {
+	JID          string `json:"jid" example:"5511999999999@s.whatsapp.net" description:"JID do contato"`
+	PresenceSent bool   `json:"presence_sent" example:"true" description:"Indica se a sessão foi marcada como disponível automaticamente para atender ao pré-requisito"`
+}
+
+// SendPresenceRequest represents the HTTP request to update the session presence
+// @Description Estado de presença global da sessão
+type SendPresenceRequest struct {
+	State string `json:"state" validate:"required,oneof=available unavailable" example:"available" description:"available (online) ou unavailable (offline)"`
+}
+
+// SendPresenceResponse represents the HTTP response for a presence update
+// @Description Presença global aplicada à sessão
+type SendPresenceResponse struct {
+	State string `json:"state" example:"available" description:"Estado de presença aplicado"`
+}
+
+// SendChatPresenceRequest represents the HTTP request to update the typing state in a chat
+// @Description Estado de digitação no chat
+type SendChatPresenceRequest struct {
+	State       string `json:"state" validate:"required,oneof=composing paused" example:"composing" description:"composing (digitando…) ou paused (parou de digitar)"`
+	CountryCode string `json:"country_code,omitempty" example:"55" description:"Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"`
+}
+
+// SendChatPresenceResponse represents the HTTP response for a chat presence update
+// @Description Estado de digitação aplicado ao chat
+type SendChatPresenceResponse struct {
+	Chat         string `json:"chat" example:"5511999999999@s.whatsapp.net" description:"JID do chat"`
+	State        string `json:"state" example:"composing" description:"Estado de digitação aplicado"`
+	PresenceSent bool   `json:"presence_sent" example:"false" description:"Indica se a sessão foi marcada como disponível automaticamente antes do envio"`
+}
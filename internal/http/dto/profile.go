@@ -0,0 +1,16 @@
+package dto
+
+// SetProfilePictureResponse represents the HTTP response for setting the session profile picture
+// @Description Resultado da atualização da foto de perfil da sessão
+type SetProfilePictureResponse struct {
+	SessionID string `json:"session_id" example:"550e8400-e29b-41d4-a716-446655440000" description:"ID da sessão"`
+	PictureID string `json:"picture_id" example:"1718000000" description:"ID da nova foto de perfil"`
+}
+
+// ToSetProfilePictureResponse converts a profile picture update result to HTTP response
+func ToSetProfilePictureResponse(sessionID, pictureID string) *SetProfilePictureResponse {
+	return &SetProfilePictureResponse{
+		SessionID: sessionID,
+		PictureID: pictureID,
+	}
+}
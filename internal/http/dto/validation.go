@@ -102,7 +102,7 @@ func (dv *DTOValidator) validateProxyConfig(host string, port int, proxyType Pro
 
 	// Validate proxy type
 	if !proxyType.IsValid() {
-		return NewValidationError("proxy_type", "invalid_type", proxyType.String(), "Proxy type must be 'http' or 'socks5'")
+		return NewValidationError("proxy_type", "invalid_type", proxyType.String(), "Proxy type must be 'http', 'socks4' or 'socks5'")
 	}
 
 	// Validate credentials if provided
@@ -254,10 +254,10 @@ func (puv *ProxyURLValidator) Validate(proxyURL string) error {
 
 	// Validate scheme
 	switch parsedURL.Scheme {
-	case "http", "https", "socks5":
+	case "http", "https", "socks4", "socks5":
 		// Valid schemes
 	default:
-		return fmt.Errorf("unsupported proxy scheme: %s (supported: http, https, socks5)", parsedURL.Scheme)
+		return fmt.Errorf("unsupported proxy scheme: %s (supported: http, https, socks4, socks5)", parsedURL.Scheme)
 	}
 
 	// Validate host
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net/http"
+
+	"wazmeow/internal/http/dto"
+	"wazmeow/internal/infra/config"
+	"wazmeow/pkg/logger"
+)
+
+// ConfigReloader re-reads the configuration and applies the settings that can change
+// without restarting the process
+type ConfigReloader interface {
+	ReloadConfig() (*config.ReloadPlan, error)
+}
+
+// AdminHandler handles operational requests restricted to the admin keys
+type AdminHandler struct {
+	baseHandler
+	reloader ConfigReloader
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(reloader ConfigReloader, logger logger.Logger) *AdminHandler {
+	return &AdminHandler{
+		baseHandler: newBaseHandler(nil, logger, nil),
+		reloader:    reloader,
+	}
+}
+
+// ReloadConfig handles POST /admin/config/reload
+// @Summary Recarregar configuração
+// @Description Lê novamente as variáveis de ambiente e o arquivo .env e aplica, sem reiniciar o processo, as configurações recarregáveis que mudaram:
+// @Description - `LOG_LEVEL`
+// @Description - `RATE_LIMIT_REQUESTS` e `RATE_LIMIT_BURST_SIZE` (os limites de todos os clientes recomeçam cheios)
+// @Description - `WHATSAPP_SEND_RATE_PER_SECOND`, `WHATSAPP_SEND_RATE_BURST` e `WHATSAPP_SEND_RATE_MAX_WAIT`
+// @Description - `WHATSAPP_DISCONNECT_GRACE_PERIOD` (tempo de espera pela reconexão antes de reportar a desconexão)
+// @Description
+// @Description Alterações em `DB_DRIVER`, `DB_URL`, `SERVER_HOST` e `SERVER_PORT` são listadas em `restart_required` e só têm efeito após reiniciar. As demais configurações não são recarregadas.
+// @Description
+// @Description Disponível apenas quando ADMIN_API_KEYS está configurado; envie uma das chaves no header de autenticação (AUTH_HEADER_NAME). Se a nova configuração for inválida nada é aplicado.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} dto.SuccessResponse{data=dto.ConfigReloadResponse} "Configuração recarregada"
+// @Failure 401 {object} dto.ErrorResponse "Chave de administração ausente ou inválida"
+// @Failure 422 {object} dto.ErrorResponse "Nova configuração inválida"
+// @Security ApiKeyAuth
+// @Router /admin/config/reload [post]
+func (h *AdminHandler) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	plan, err := h.reloader.ReloadConfig()
+	if err != nil {
+		h.writeErrorResponseWithCode(w, http.StatusUnprocessableEntity, dto.ErrorCodeValidationFailed, "Invalid configuration", err)
+		return
+	}
+
+	response := &dto.ConfigReloadResponse{
+		Applied:         toSettingChangeResponses(plan.Reloadable),
+		RestartRequired: toSettingChangeResponses(plan.RestartRequired),
+	}
+	message := "Configuration reloaded"
+	if !plan.HasChanges() {
+		message = "Configuration unchanged"
+	}
+	h.writeSuccessResponse(w, http.StatusOK, message, response)
+}
+
+// toSettingChangeResponses converts setting changes, returning an empty list rather than null
+func toSettingChangeResponses(changes []config.SettingChange) []dto.SettingChangeResponse {
+	responses := make([]dto.SettingChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		responses = append(responses, dto.SettingChangeResponse{
+			Name:     change.Name,
+			Previous: change.Previous,
+			Current:  change.Current,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"wazmeow/internal/http/dto"
+	"wazmeow/internal/http/middleware"
+	"wazmeow/pkg/logger"
+)
+
+// errInvalidCredentials is returned when token credentials are missing or wrong
+var errInvalidCredentials = errors.New("invalid credentials")
+
+// AuthHandler issues bearer tokens for the jwt auth mode
+type AuthHandler struct {
+	baseHandler
+	username string
+	password string
+	secret   string
+	tokenTTL time.Duration
+}
+
+// NewAuthHandler creates a new auth handler that exchanges the basic credentials for tokens
+func NewAuthHandler(username, password, secret string, tokenTTL time.Duration, logger logger.Logger) *AuthHandler {
+	return &AuthHandler{
+		baseHandler: newBaseHandler(nil, logger, nil),
+		username:    username,
+		password:    password,
+		secret:      secret,
+		tokenTTL:    tokenTTL,
+	}
+}
+
+// IssueToken handles POST /auth/token
+// @Summary Emitir token de acesso
+// @Description Troca as credenciais Basic configuradas (AUTH_BASIC_USERNAME/AUTH_BASIC_PASSWORD) por um token JWT assinado com JWT_SECRET.
+// @Description
+// @Description Disponível apenas quando AUTH_TYPE=jwt. Envie o token retornado no header `Authorization: Bearer <token>` nas demais rotas.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} dto.SuccessResponse{data=dto.TokenResponse} "Token emitido com sucesso"
+// @Failure 401 {object} dto.ErrorResponse "Credenciais ausentes ou inválidas"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security BasicAuth
+// @Router /auth/token [post]
+func (h *AuthHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok || !h.validCredentials(username, password) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="WazMeow API"`)
+		h.writeErrorResponse(w, http.StatusUnauthorized, "Invalid credentials", errInvalidCredentials)
+		return
+	}
+
+	token, expiresAt, err := middleware.IssueToken(h.secret, username, h.tokenTTL)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to issue token", err)
+		return
+	}
+
+	h.requestLogger(r).InfoWithFields("Token issued", logger.Fields{
+		"subject":    username,
+		"expires_at": expiresAt,
+	})
+
+	h.writeSuccessResponse(w, http.StatusOK, "Token issued successfully", dto.ToTokenResponse(token, expiresAt))
+}
+
+// validCredentials compares the credentials in constant time
+func (h *AuthHandler) validCredentials(username, password string) bool {
+	userMatch := subtle.ConstantTimeCompare([]byte(username), []byte(h.username)) == 1
+	passMatch := subtle.ConstantTimeCompare([]byte(password), []byte(h.password)) == 1
+	return userMatch && passMatch && h.username != "" && h.password != ""
+}
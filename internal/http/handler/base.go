@@ -0,0 +1,309 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+	"wazmeow/internal/http/middleware"
+	sessionUC "wazmeow/internal/usecases/session"
+	"wazmeow/pkg/crypto"
+	"wazmeow/pkg/errors"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// baseHandler holds the dependencies and helpers shared by session-scoped handlers
+type baseHandler struct {
+	resolveUC *sessionUC.ResolveUseCase
+	logger    logger.Logger
+	validator validator.Validator
+}
+
+// newBaseHandler creates a new base handler
+func newBaseHandler(resolveUC *sessionUC.ResolveUseCase, logger logger.Logger, validator validator.Validator) baseHandler {
+	return baseHandler{
+		resolveUC: resolveUC,
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+// Helper methods
+
+// requestLogger returns the handler logger carrying the correlation fields of the
+// request context, such as the request ID
+func (h *baseHandler) requestLogger(r *http.Request) logger.Logger {
+	return h.logger.WithContext(r.Context())
+}
+
+// resolveSessionByIdentifier resolves a session using the flexible identifier
+func (h *baseHandler) resolveSessionByIdentifier(r *http.Request, identifierStr string) (*session.Session, error) {
+	log := h.requestLogger(r)
+
+	// Validate input
+	if identifierStr == "" {
+		log.WarnWithFields("empty session identifier provided", logger.Fields{
+			"request_path": r.URL.Path,
+		})
+		return nil, session.ErrInvalidSessionIdentifier
+	}
+
+	// Create SessionIdentifier with automatic type detection
+	identifier, err := session.NewSessionIdentifier(identifierStr)
+	if err != nil {
+		log.ErrorWithError("invalid session identifier format", err, logger.Fields{
+			"identifier":     identifierStr,
+			"request_path":   r.URL.Path,
+			"request_method": r.Method,
+		})
+		return nil, err
+	}
+
+	// Use resolve use case to get the session
+	ucReq := sessionUC.ResolveRequest{Identifier: identifier}
+	result, err := h.resolveUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		log.ErrorWithError("failed to resolve session", err, logger.Fields{
+			"identifier":      identifierStr,
+			"identifier_type": identifier.Type().String(),
+			"request_path":    r.URL.Path,
+			"request_method":  r.Method,
+		})
+		return nil, err
+	}
+
+	log.InfoWithFields("session resolved successfully", logger.Fields{
+		"session_id":      result.Session.ID().String(),
+		"session_name":    result.Session.Name(),
+		"identifier":      identifierStr,
+		"identifier_type": result.IdentifierType,
+		"request_path":    r.URL.Path,
+	})
+
+	return result.Session, nil
+}
+
+// queryInt reads an integer query parameter, falling back to def when absent or invalid
+func queryInt(r *http.Request, key string, def int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+func (h *baseHandler) writeSuccessResponse(w http.ResponseWriter, statusCode int, message string, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	response := dto.NewSuccessResponse(message, data)
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeTypedSuccessResponse writes a success response whose data type is known at compile time.
+// The JSON shape is the same as writeSuccessResponse.
+func writeTypedSuccessResponse[T any](w http.ResponseWriter, statusCode int, message string, data T) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	response := dto.NewTypedSuccessResponse(message, data)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *baseHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, message string, err error) {
+	h.writeErrorResponseWithCode(w, statusCode, "", message, err)
+}
+
+// writeErrorResponseWithCode writes an error response carrying a machine-readable error code
+func (h *baseHandler) writeErrorResponseWithCode(w http.ResponseWriter, statusCode int, code dto.ErrorCode, message string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	var details string
+	if err != nil {
+		details = err.Error()
+	}
+
+	response := dto.NewErrorResponse(message, code.String(), details)
+	json.NewEncoder(w).Encode(response)
+
+	// The request ID middleware echoes the ID in the response before handlers run,
+	// which correlates this log line without threading the request through every caller
+	h.logger.ErrorWithError("HTTP error response", err, logger.Fields{
+		"status_code": statusCode,
+		"message":     message,
+		"request_id":  w.Header().Get(middleware.RequestIDHeader),
+	})
+}
+
+// StatusClientClosedRequest is the non-standard status logged when the client goes away
+// before the response is written (nginx convention)
+const StatusClientClosedRequest = 499
+
+func (h *baseHandler) handleUseCaseError(w http.ResponseWriter, err error) {
+	if appErr, ok := err.(*errors.AppError); ok {
+		h.writeErrorResponse(w, appErr.GetHTTPStatus(), appErr.Message, err)
+		return
+	}
+
+	var rateErr *whatsapp.SendRateLimitError
+	if stderrors.As(err, &rateErr) {
+		retrySeconds := int(math.Ceil(rateErr.RetryAfter.Seconds()))
+		if retrySeconds < 1 {
+			retrySeconds = 1
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retrySeconds))
+		h.writeErrorResponseWithCode(w, http.StatusTooManyRequests, dto.ErrorCodeRateLimited, "Session send rate limit exceeded", err)
+		return
+	}
+
+	var connErr *whatsapp.ConnectionError
+	if stderrors.As(err, &connErr) {
+		code := dto.ConnectionErrorCode(connErr.Reason)
+		h.writeErrorResponseWithCode(w, code.HTTPStatusCode(), code, "Failed to connect to WhatsApp", err)
+		return
+	}
+
+	// Context errors are usually wrapped by the layer that hit them
+	switch {
+	case stderrors.Is(err, context.DeadlineExceeded):
+		h.writeErrorResponseWithCode(w, http.StatusGatewayTimeout, dto.ErrorCodeTimeout, "Request timed out", err)
+		return
+	case stderrors.Is(err, context.Canceled):
+		h.writeErrorResponse(w, StatusClientClosedRequest, "Request canceled by the client", err)
+		return
+	case stderrors.Is(err, whatsapp.ErrSessionLimitReached):
+		// The wrapped error carries the configured limit
+		h.writeErrorResponseWithCode(w, http.StatusTooManyRequests, dto.ErrorCodeSessionLimitReached, "Maximum number of concurrent sessions reached", err)
+		return
+	}
+
+	// Handle domain errors
+	switch err {
+	case session.ErrSessionNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Session not found", err)
+	case session.ErrSessionAlreadyExists:
+		h.writeErrorResponse(w, http.StatusConflict, "Session already exists", err)
+	case session.ErrSessionAlreadyConnected:
+		h.writeErrorResponse(w, http.StatusConflict, "Session already connected", err)
+	case session.ErrSessionNotConnected:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Session not connected", err)
+	case session.ErrConnectionNotStarted:
+		h.writeErrorResponse(w, http.StatusConflict, "No connection attempt in progress, call connect first", err)
+	case session.ErrSessionInvalidState:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Session in invalid state", err)
+	case session.ErrInvalidProxyURL:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid proxy URL", err)
+	case session.ErrInvalidSortField, session.ErrInvalidSortOrder, session.ErrInvalidDateRange:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid list parameters", err)
+	case session.ErrInvalidSessionName, session.ErrSessionNameTooShort, session.ErrSessionNameTooLong, session.ErrInvalidSessionNameChars:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid session name", err)
+	case session.ErrDisplayNameTooLong, session.ErrInvalidDisplayName:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid display name", err)
+	case session.ErrInvalidAllowedSender, session.ErrTooManyAllowedSenders:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid allowed senders", err)
+	case session.ErrInvalidSendRateLimit:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid send rate limit", err)
+	case session.ErrSessionNotPaired:
+		h.writeErrorResponse(w, http.StatusConflict, "Session has no paired WhatsApp device", err)
+	case session.ErrInvalidSessionBackup, session.ErrUnsupportedBackupVersion, whatsapp.ErrInvalidDeviceBackup,
+		crypto.ErrInvalidEnvelope, crypto.ErrPassphraseTooShort:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid session backup", err)
+	case crypto.ErrDecryptionFailed:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Wrong backup passphrase", err)
+	case whatsapp.ErrDeviceAlreadyExists:
+		h.writeErrorResponse(w, http.StatusConflict, "Device already exists in this instance", err)
+	case session.ErrNoProxyAvailable:
+		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "No proxy available for rotation", err)
+	case whatsapp.ErrClientNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "WhatsApp client not found", err)
+	case whatsapp.ErrConnectInProgress:
+		h.writeErrorResponse(w, http.StatusConflict, "Connect already in progress", err)
+	case whatsapp.ErrProxyRotationFailed:
+		h.writeErrorResponse(w, http.StatusBadGateway, "Failed to connect through the new proxy", err)
+	case whatsapp.ErrInvalidGroupJID:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid group JID", err)
+	case whatsapp.ErrGroupNotFound, whatsapp.ErrNotGroupMember:
+		h.writeErrorResponse(w, http.StatusNotFound, "Group not found", err)
+	case whatsapp.ErrInvalidPollQuestion, whatsapp.ErrInvalidPollOptionCount, whatsapp.ErrInvalidPollOption, whatsapp.ErrInvalidPollSelectableCount:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid poll", err)
+	case whatsapp.ErrInvalidInteractiveBody, whatsapp.ErrInvalidButtonCount, whatsapp.ErrInvalidButton,
+		whatsapp.ErrInvalidListButtonText, whatsapp.ErrInvalidListSections, whatsapp.ErrInvalidListRowCount, whatsapp.ErrInvalidListRow:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid interactive message", err)
+	case whatsapp.ErrInvalidPairClientType, whatsapp.ErrInvalidPairDisplayName:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid pairing options", err)
+	case whatsapp.ErrInvalidSticker, whatsapp.ErrStickerTooLarge:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid sticker", err)
+	case whatsapp.ErrInvalidNewsletterJID, whatsapp.ErrEmptyNewsletterText:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid newsletter request", err)
+	case whatsapp.ErrNewsletterNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Newsletter not found", err)
+	case whatsapp.ErrInvalidParticipantAction, whatsapp.ErrInvalidParticipantJID, whatsapp.ErrNoParticipants:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid participants request", err)
+	case whatsapp.ErrInvalidBroadcastMessage, whatsapp.ErrNoBroadcastRecipients, whatsapp.ErrTooManyBroadcastRecipients:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid broadcast", err)
+	case whatsapp.ErrInvalidRecipient:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid recipient", err)
+	case whatsapp.ErrInvalidScheduledBody, whatsapp.ErrInvalidSendAt, whatsapp.ErrInvalidScheduledStatus:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid scheduled message", err)
+	case whatsapp.ErrScheduledMessageNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Scheduled message not found", err)
+	case whatsapp.ErrRecentMessagesDisabled:
+		h.writeErrorResponse(w, http.StatusNotFound, "Recent message buffer is disabled (WHATSAPP_RECENT_MESSAGES_SIZE is 0)", err)
+	case whatsapp.ErrReceiptNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Message status not found", err)
+	case whatsapp.ErrScheduledMessageNotPending:
+		h.writeErrorResponse(w, http.StatusConflict, "Scheduled message is no longer pending", err)
+	case whatsapp.ErrNoPhoneNumbers, whatsapp.ErrTooManyPhoneNumbers:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid phone numbers request", err)
+	case whatsapp.ErrNoMessageIDs, whatsapp.ErrTooManyMessageIDs, whatsapp.ErrMessageSenderNeeded:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid read receipt request", err)
+	case whatsapp.ErrInvalidPresenceState, whatsapp.ErrInvalidChatPresenceState:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid presence state", err)
+	case whatsapp.ErrPresenceNotSent:
+		h.writeErrorResponse(w, http.StatusConflict, "Presence must be sent as available first (WHATSAPP_AUTO_SEND_PRESENCE is disabled)", err)
+	case whatsapp.ErrPushNameNotSet:
+		h.writeErrorResponse(w, http.StatusConflict, "Push name not synced yet, try again after the initial sync", err)
+	case whatsapp.ErrProfilePictureNotSet:
+		h.writeErrorResponseWithCode(w, http.StatusNotFound, dto.ErrorCodeProfilePictureNotSet, "No profile picture set", err)
+	case whatsapp.ErrProfilePictureRestricted:
+		h.writeErrorResponseWithCode(w, http.StatusForbidden, dto.ErrorCodeProfilePictureRestricted, "Profile picture hidden by privacy settings", err)
+	case whatsapp.ErrNotBusinessAccount:
+		h.writeErrorResponseWithCode(w, http.StatusNotFound, dto.ErrorCodeNotBusinessAccount, "Contact is not a business account", err)
+	case whatsapp.ErrInvalidProfilePicture:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid image (expected JPEG, PNG or GIF)", err)
+	case whatsapp.ErrInvalidChatJID, whatsapp.ErrInvalidMuteUntil:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid chat request", err)
+	case whatsapp.ErrNotBusinessSession:
+		h.writeErrorResponseWithCode(w, http.StatusUnprocessableEntity, dto.ErrorCodeSessionNotBusiness, "Session is not a WhatsApp Business account", err)
+	case whatsapp.ErrLabelNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Label not found", err)
+	case whatsapp.ErrInvalidLabelName, whatsapp.ErrInvalidLabelColor:
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid label", err)
+	case whatsapp.ErrAppStateNotReady:
+		h.writeErrorResponse(w, http.StatusConflict, "App state not synced yet", err)
+	case whatsapp.ErrAppStateRejected:
+		h.writeErrorResponse(w, http.StatusBadGateway, "WhatsApp rejected the change", err)
+	case whatsapp.ErrChatNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Chat not found", err)
+	case whatsapp.ErrMessageNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "Message not found", err)
+	case whatsapp.ErrMessageHasNoMedia:
+		h.writeErrorResponse(w, http.StatusNotFound, "Message has no downloadable media", err)
+	case whatsapp.ErrMessageNotForwardable:
+		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "Message type cannot be forwarded", err)
+	case whatsapp.ErrMediaUnavailable:
+		h.writeErrorResponse(w, http.StatusGone, "Media expired on WhatsApp servers", err)
+	case whatsapp.ErrNotGroupAdmin:
+		h.writeErrorResponse(w, http.StatusForbidden, "Not an admin of the group", err)
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,544 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// ChatHandler handles chat-related HTTP requests
+type ChatHandler struct {
+	markReadUC   *whatsappUC.MarkReadUseCase
+	markUnreadUC *whatsappUC.MarkUnreadUseCase
+	archiveUC    *whatsappUC.ArchiveChatUseCase
+	muteUC       *whatsappUC.MuteChatUseCase
+	deleteUC     *whatsappUC.DeleteChatUseCase
+	clearUC      *whatsappUC.ClearChatUseCase
+	getContextUC *whatsappUC.GetMessageContextUseCase
+	listUC       *whatsappUC.ListChatMessagesUseCase
+	listChatsUC  *whatsappUC.ListChatsUseCase
+
+	baseHandler
+}
+
+// NewChatHandler creates a new chat handler
+func NewChatHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	markReadUC *whatsappUC.MarkReadUseCase,
+	markUnreadUC *whatsappUC.MarkUnreadUseCase,
+	archiveUC *whatsappUC.ArchiveChatUseCase,
+	muteUC *whatsappUC.MuteChatUseCase,
+	deleteUC *whatsappUC.DeleteChatUseCase,
+	clearUC *whatsappUC.ClearChatUseCase,
+	getContextUC *whatsappUC.GetMessageContextUseCase,
+	listUC *whatsappUC.ListChatMessagesUseCase,
+	listChatsUC *whatsappUC.ListChatsUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *ChatHandler {
+	return &ChatHandler{
+		markReadUC:   markReadUC,
+		markUnreadUC: markUnreadUC,
+		archiveUC:    archiveUC,
+		muteUC:       muteUC,
+		deleteUC:     deleteUC,
+		clearUC:      clearUC,
+		getContextUC: getContextUC,
+		listUC:       listUC,
+		listChatsUC:  listChatsUC,
+		baseHandler:  newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// ListChats handles GET /sessions/{id}/chats
+// @Summary Listar chats
+// @Description Lista as conversas conhecidas da sessão com nome, data da última mensagem e quantidade de mensagens não lidas, da mais recente para a mais antiga.
+// @Description
+// @Description A lista é preenchida pela sincronização do histórico feita após o pareamento e atualizada pelas mensagens recebidas. Enquanto a sincronização não termina, a resposta traz apenas os chats recebidos até o momento com `sync_complete` igual a false.
+// @Tags Chats
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ChatListResponse} "Lista de chats"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats [get]
+func (h *ChatHandler) ListChats(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	result, err := h.listChatsUC.Execute(r.Context(), whatsappUC.ListChatsRequest{SessionID: sess.ID()})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToChatListResponse(result.Chats, result.SyncComplete)
+	h.writeSuccessResponse(w, http.StatusOK, "Chats retrieved", response)
+}
+
+// MarkRead handles POST /sessions/{id}/chats/{chat}/read
+// @Summary Marcar mensagens como lidas
+// @Description Envia a confirmação de leitura (tiques azuis) para mensagens recebidas em um chat, refletindo a leitura também no celular.
+// @Description
+// @Description Uma única confirmação só pode cobrir mensagens do mesmo remetente. Em grupos informe `sender` com o participante que enviou as mensagens; em chats individuais ele é ignorado.
+// @Description Se a confirmação de leitura estiver desativada na privacidade da conta, as mensagens são marcadas como lidas apenas nos próprios aparelhos.
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.MarkReadRequest true "Mensagens"
+// @Success 200 {object} dto.SuccessResponse{data=dto.MarkReadResponse} "Mensagens marcadas como lidas"
+// @Failure 400 {object} dto.ErrorResponse "Dados inválidos, remetente ausente em grupo ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/read [post]
+func (h *ChatHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.MarkReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.MarkReadRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		Sender:      req.Sender,
+		MessageIDs:  req.MessageIDs,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.markReadUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.MarkReadResponse{
+		Chat:  result.Chat,
+		Count: result.Count,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Messages marked as read", response)
+}
+
+// MarkUnread handles POST /sessions/{id}/chats/{chat}/unread
+// @Summary Marcar chat como não lido
+// @Description Marca o chat como não lido em todos os aparelhos da conta, sinalizando-o para atendimento humano.
+// @Description
+// @Description O chat precisa ter mensagens armazenadas ou configurações sincronizadas (app state); caso contrário retorna 404.
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.MarkUnreadRequest false "Opções"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ChatStateResponse} "Chat marcado como não lido"
+// @Failure 400 {object} dto.ErrorResponse "Dados inválidos ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou chat não encontrado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/unread [post]
+func (h *ChatHandler) MarkUnread(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// The body is optional
+	var req dto.MarkUnreadRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+			return
+		}
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.MarkUnreadRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.markUnreadUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToChatStateResponse(result.State)
+	h.writeSuccessResponse(w, http.StatusOK, "Chat marked as unread", response)
+}
+
+// ArchiveChat handles POST /sessions/{id}/chats/{chat}/archive
+// @Summary Arquivar chat
+// @Description Arquiva ou desarquiva o chat em todos os aparelhos da conta. Arquivar também desafixa o chat.
+// @Description
+// @Description O corpo é opcional: sem ele o chat é arquivado. Envie `{"archive": false}` para desarquivar.
+// @Description O chat precisa ter mensagens armazenadas ou configurações sincronizadas (app state); caso contrário retorna 404.
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.ArchiveChatRequest false "Opções"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ChatStateResponse} "Arquivamento atualizado"
+// @Failure 400 {object} dto.ErrorResponse "Dados inválidos ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou chat não encontrado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/archive [post]
+func (h *ChatHandler) ArchiveChat(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// The body is optional
+	var req dto.ArchiveChatRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+			return
+		}
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ArchiveChatRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		Archive:     req.Archive == nil || *req.Archive,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.archiveUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToChatStateResponse(result.State)
+	message := "Chat archived"
+	if !ucReq.Archive {
+		message = "Chat unarchived"
+	}
+	h.writeSuccessResponse(w, http.StatusOK, message, response)
+}
+
+// MuteChat handles POST /sessions/{id}/chats/{chat}/mute
+// @Summary Silenciar chat
+// @Description Silencia o chat até a data informada em todos os aparelhos da conta.
+// @Description
+// @Description Para silenciar informe `until` com uma data no futuro. Envie `{"mute": false}` para remover o silenciamento.
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.MuteChatRequest true "Opções"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ChatStateResponse} "Silenciamento atualizado"
+// @Failure 400 {object} dto.ErrorResponse "Dados inválidos, data no passado ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/mute [post]
+func (h *ChatHandler) MuteChat(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.MuteChatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	// A zero end time unmutes the chat
+	var until time.Time
+	if req.Mute == nil || *req.Mute {
+		if req.Until == nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Field 'until' is required to mute a chat", nil)
+			return
+		}
+		until = *req.Until
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.MuteChatRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		Until:       until,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.muteUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToChatStateResponse(result.State)
+	message := "Chat muted"
+	if until.IsZero() {
+		message = "Chat unmuted"
+	}
+	h.writeSuccessResponse(w, http.StatusOK, message, response)
+}
+
+// DeleteChat handles DELETE /sessions/{id}/chats/{chat}
+// @Summary Apagar chat
+// @Description Apaga o chat da lista de conversas da conta WhatsApp vinculada, em todos os aparelhos (celular, WhatsApp Web etc.).
+// @Description
+// @Description A alteração é feita na conta, não em um armazenamento local: as mensagens guardadas por esta API continuam disponíveis. O WhatsApp propaga a exclusão para os aparelhos de forma assíncrona, por isso a resposta é 202.
+// @Description O chat precisa ter mensagens armazenadas ou configurações sincronizadas (app state); caso contrário retorna 404. Logo após o pareamento as chaves de app state podem ainda não estar sincronizadas (409).
+// @Tags Chats
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param country_code query string false "Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"
+// @Success 202 {object} dto.SuccessResponse{data=dto.ChatActionResponse} "Exclusão aceita"
+// @Failure 400 {object} dto.ErrorResponse "JID inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou chat não encontrado"
+// @Failure 409 {object} dto.ErrorResponse "App state ainda não sincronizado"
+// @Failure 502 {object} dto.ErrorResponse "Alteração rejeitada pelo WhatsApp"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat} [delete]
+func (h *ChatHandler) DeleteChat(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ChatActionRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		CountryCode: r.URL.Query().Get("country_code"),
+	}
+	result, err := h.deleteUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.ChatActionResponse{Chat: result.Chat, Status: "accepted"}
+	h.writeSuccessResponse(w, http.StatusAccepted, "Chat deletion accepted", response)
+}
+
+// ClearChat handles POST /sessions/{id}/chats/{chat}/clear
+// @Summary Limpar histórico do chat
+// @Description Apaga as mensagens do chat na conta WhatsApp vinculada, em todos os aparelhos, mantendo o chat na lista e as mensagens favoritadas.
+// @Description
+// @Description A alteração é feita na conta, não em um armazenamento local: as mensagens guardadas por esta API continuam disponíveis. O WhatsApp propaga a limpeza para os aparelhos de forma assíncrona, por isso a resposta é 202.
+// @Description O chat precisa ter mensagens armazenadas ou configurações sincronizadas (app state); caso contrário retorna 404. Logo após o pareamento as chaves de app state podem ainda não estar sincronizadas (409).
+// @Tags Chats
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.ClearChatRequest false "Opções"
+// @Success 202 {object} dto.SuccessResponse{data=dto.ChatActionResponse} "Limpeza aceita"
+// @Failure 400 {object} dto.ErrorResponse "JID inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou chat não encontrado"
+// @Failure 409 {object} dto.ErrorResponse "App state ainda não sincronizado"
+// @Failure 502 {object} dto.ErrorResponse "Alteração rejeitada pelo WhatsApp"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/clear [post]
+func (h *ChatHandler) ClearChat(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// The body is optional
+	var req dto.ClearChatRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+			return
+		}
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ChatActionRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.clearUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.ChatActionResponse{Chat: result.Chat, Status: "accepted"}
+	h.writeSuccessResponse(w, http.StatusAccepted, "Chat clearing accepted", response)
+}
+
+// GetMessageContext handles GET /sessions/{id}/chats/{chat}/context
+// @Summary Obter contexto de uma mensagem
+// @Description Retorna as mensagens armazenadas antes e depois de uma mensagem de referência no mesmo chat, em ordem cronológica, para dar contexto de conversa a bots.
+// @Description
+// @Description Cada mensagem indica a direção (`incoming`/`outgoing`) e a mensagem de referência é marcada com `is_anchor`. Somente mensagens recebidas ou enviadas enquanto a sessão estava conectada ficam armazenadas.
+// @Tags Chats
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param message_id query string true "ID da mensagem de referência"
+// @Param before query int false "Mensagens anteriores (padrão 10, máximo 50)"
+// @Param after query int false "Mensagens posteriores (padrão 10, máximo 50)"
+// @Success 200 {object} dto.SuccessResponse{data=dto.MessageContextResponse} "Contexto da mensagem"
+// @Failure 400 {object} dto.ErrorResponse "ID da mensagem ausente"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada ou mensagem não armazenada neste chat"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/context [get]
+func (h *ChatHandler) GetMessageContext(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	messageID := r.URL.Query().Get("message_id")
+	if messageID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Query parameter message_id is required", nil)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetMessageContextRequest{
+		SessionID: sess.ID(),
+		Chat:      chi.URLParam(r, "chat"),
+		MessageID: messageID,
+		Before:    queryInt(r, "before", whatsapp.DefaultMessageContextSize),
+		After:     queryInt(r, "after", whatsapp.DefaultMessageContextSize),
+	}
+	result, err := h.getContextUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToMessageContextResponse(result.Chat, result.Anchor, result.Messages)
+	h.writeSuccessResponse(w, http.StatusOK, "Message context retrieved", response)
+}
+
+// ListMessages handles GET /sessions/{id}/chats/{chat}/messages
+// @Summary Listar mensagens do chat
+// @Description Percorre o histórico de mensagens armazenadas de um chat, da mais recente para a mais antiga.
+// @Description
+// @Description A paginação usa cursor: envie o `next_cursor` da resposta como `cursor` para obter mensagens mais antigas. Cada página custa o mesmo independentemente da profundidade, e mensagens novas não deslocam as páginas. Somente mensagens recebidas ou enviadas enquanto a sessão estava conectada ficam armazenadas.
+// @Tags Chats
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param limit query int false "Mensagens por página (padrão 50, máximo 200)"
+// @Param cursor query string false "Cursor retornado em next_cursor"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ChatMessagesResponse} "Página do histórico"
+// @Failure 400 {object} dto.ErrorResponse "Cursor inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/messages [get]
+func (h *ChatHandler) ListMessages(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Message cursors carry the timestamp of the last message of the previous page
+	cursor, err := dto.DecodeCursor(r.URL.Query().Get("cursor"))
+	if err != nil || (cursor != nil && cursor.Time.IsZero()) {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid cursor", dto.ErrInvalidCursor)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ListChatMessagesRequest{
+		SessionID: sess.ID(),
+		Chat:      chi.URLParam(r, "chat"),
+		Limit:     queryInt(r, "limit", whatsapp.DefaultChatMessagesPageSize),
+	}
+	if cursor != nil {
+		ucReq.Before = &whatsapp.MessageCursor{Timestamp: cursor.Time, ID: cursor.ID}
+	}
+	result, err := h.listUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToChatMessagesResponse(result.Chat, result.Messages, result.Next)
+	h.writeSuccessResponse(w, http.StatusOK, "Chat messages retrieved", response)
+}
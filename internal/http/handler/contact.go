@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// ContactHandler handles contact-related HTTP requests
+type ContactHandler struct {
+	getContactsUC  *whatsappUC.GetContactsUseCase
+	checkNumbersUC *whatsappUC.CheckNumbersUseCase
+	profilePicUC   *whatsappUC.GetProfilePictureUseCase
+	businessUC     *whatsappUC.GetBusinessProfileUseCase
+
+	baseHandler
+}
+
+// NewContactHandler creates a new contact handler
+func NewContactHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	getContactsUC *whatsappUC.GetContactsUseCase,
+	checkNumbersUC *whatsappUC.CheckNumbersUseCase,
+	profilePicUC *whatsappUC.GetProfilePictureUseCase,
+	businessUC *whatsappUC.GetBusinessProfileUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *ContactHandler {
+	return &ContactHandler{
+		getContactsUC:  getContactsUC,
+		checkNumbersUC: checkNumbersUC,
+		profilePicUC:   profilePicUC,
+		businessUC:     businessUC,
+		baseHandler:    newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// ListContacts handles GET /sessions/{id}/contacts
+// @Summary Listar contatos da sessão
+// @Description Lista os contatos armazenados da sessão com JID, nome definido pelo contato (push name), nome comercial e nomes da agenda.
+// @Description
+// @Description Os contatos são preenchidos pela sincronização inicial após o pareamento; uma sessão recém-autenticada pode retornar uma lista vazia.
+// @Tags Contacts
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param limit query int false "Limite por página (1-500, padrão 100)"
+// @Param offset query int false "Deslocamento da página (padrão 0; ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor retornado em next_cursor; preferível ao offset para listas grandes"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ContactListResponse} "Lista de contatos"
+// @Failure 400 {object} dto.ErrorResponse "Cursor inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/contacts [get]
+func (h *ContactHandler) ListContacts(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Contacts are ordered by JID, so their cursors carry no time
+	cursor, err := dto.DecodeCursor(r.URL.Query().Get("cursor"))
+	if err != nil || (cursor != nil && !cursor.Time.IsZero()) {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid cursor", dto.ErrInvalidCursor)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ListContactsRequest{
+		SessionID: sess.ID(),
+		Limit:     queryInt(r, "limit", 0),
+		Offset:    queryInt(r, "offset", 0),
+	}
+	if cursor != nil {
+		ucReq.After = cursor.ID
+	}
+	result, err := h.getContactsUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToContactListResponse(result.SessionID.String(), result.Contacts, result.Total, result.Limit, result.Offset)
+	if result.NextAfter != "" {
+		response.NextCursor = dto.EncodeCursor(dto.Cursor{ID: result.NextAfter})
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Contacts retrieved successfully", response)
+}
+
+// CheckNumbers handles POST /sessions/{id}/contacts/check
+// @Summary Verificar números no WhatsApp
+// @Description Verifica quais números de telefone possuem conta no WhatsApp, retornando o JID canônico de cada número registrado.
+// @Description Útil para validar números antes de enviar mensagens.
+// @Description
+// @Description Os números são normalizados antes da consulta (caracteres não numéricos removidos, prefixo `+` ou `00` tratado como internacional e código do país aplicado a números nacionais).
+// @Description
+// @Description **Exemplo:** `{"phones": ["5511999999999", "+55 11 98888-8888"]}`
+// @Tags Contacts
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param request body dto.CheckNumbersRequest true "Números a verificar"
+// @Success 200 {object} dto.SuccessResponse{data=dto.CheckNumbersResponse} "Resultado por número"
+// @Failure 400 {object} dto.ErrorResponse "Lista de números vazia ou acima do limite, ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/contacts/check [post]
+func (h *ContactHandler) CheckNumbers(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.CheckNumbersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.CheckNumbersRequest{
+		SessionID:   sess.ID(),
+		Phones:      req.Phones,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.checkNumbersUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToCheckNumbersResponse(result.Results)
+	h.writeSuccessResponse(w, http.StatusOK, "Numbers checked successfully", response)
+}
+
+// GetProfilePicture handles GET /sessions/{id}/contacts/{jid}/avatar
+// @Summary Obter foto de perfil
+// @Description Obtém a foto de perfil de um contato ou grupo, retornando a URL temporária e o ID da imagem.
+// @Description
+// @Description O `jid` aceita um número de telefone, um JID de usuário ou um JID de grupo completo (`...@g.us`).
+// @Description Use `preview=true` para obter a miniatura de baixa resolução e `download=true` para receber a imagem em base64.
+// @Description
+// @Description **Códigos de erro:**
+// @Description - `PROFILE_PICTURE_NOT_SET` (404): o contato ou grupo não possui foto de perfil
+// @Description - `PROFILE_PICTURE_RESTRICTED` (403): a foto está oculta pelas configurações de privacidade
+// @Tags Contacts
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param jid path string true "Número de telefone, JID do contato ou JID do grupo" example("5511999999999")
+// @Param preview query bool false "Retornar a miniatura em vez da imagem completa (padrão false)"
+// @Param download query bool false "Baixar a imagem e retorná-la em base64 (padrão false)"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ProfilePictureResponse} "Foto de perfil"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada"
+// @Failure 403 {object} dto.ErrorResponse "Foto oculta pelas configurações de privacidade"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada ou contato sem foto de perfil"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/contacts/{jid}/avatar [get]
+func (h *ContactHandler) GetProfilePicture(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	jid := chi.URLParam(r, "jid")
+	if jid == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Contact JID is required", nil)
+		return
+	}
+
+	preview, _ := strconv.ParseBool(r.URL.Query().Get("preview"))
+	download, _ := strconv.ParseBool(r.URL.Query().Get("download"))
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetProfilePictureRequest{
+		SessionID: sess.ID(),
+		JID:       jid,
+		Preview:   preview,
+		Download:  download,
+	}
+	result, err := h.profilePicUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToProfilePictureResponse(result.JID, result.Picture, result.Data, result.ContentType)
+	h.writeSuccessResponse(w, http.StatusOK, "Profile picture retrieved successfully", response)
+}
+
+// GetBusinessProfile handles GET /sessions/{id}/contacts/{jid}/business
+// @Summary Obter perfil comercial
+// @Description Obtém o perfil de uma conta WhatsApp Business: categorias, e-mail, endereço e horário de funcionamento.
+// @Description
+// @Description O `jid` aceita um número de telefone ou um JID de usuário. Descrição e site do perfil não são retornados pelo WhatsApp por esta consulta.
+// @Description
+// @Description **Códigos de erro:**
+// @Description - `NOT_BUSINESS_ACCOUNT` (404): o contato não é uma conta WhatsApp Business
+// @Tags Contacts
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param jid path string true "Número de telefone ou JID do contato" example("5511999999999")
+// @Success 200 {object} dto.SuccessResponse{data=dto.BusinessProfileResponse} "Perfil comercial"
+// @Failure 400 {object} dto.ErrorResponse "JID inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada ou contato não é uma conta comercial"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/contacts/{jid}/business [get]
+func (h *ContactHandler) GetBusinessProfile(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	jid := chi.URLParam(r, "jid")
+	if jid == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Contact JID is required", nil)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetBusinessProfileRequest{
+		SessionID: sess.ID(),
+		JID:       jid,
+	}
+	result, err := h.businessUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToBusinessProfileResponse(result.JID, result.Profile)
+	h.writeSuccessResponse(w, http.StatusOK, "Business profile retrieved successfully", response)
+}
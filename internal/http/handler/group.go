@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// GroupHandler handles group-related HTTP requests
+type GroupHandler struct {
+	getGroupsUC          *whatsappUC.GetGroupsUseCase
+	updateParticipantsUC *whatsappUC.UpdateGroupParticipantsUseCase
+
+	baseHandler
+}
+
+// NewGroupHandler creates a new group handler
+func NewGroupHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	getGroupsUC *whatsappUC.GetGroupsUseCase,
+	updateParticipantsUC *whatsappUC.UpdateGroupParticipantsUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *GroupHandler {
+	return &GroupHandler{
+		getGroupsUC:          getGroupsUC,
+		updateParticipantsUC: updateParticipantsUC,
+		baseHandler:          newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// ListGroups handles GET /sessions/{id}/groups
+// @Summary Listar grupos da sessão
+// @Description Lista todos os grupos dos quais a sessão participa, com assunto, descrição, dono, participantes (com flag de administrador) e data de criação.
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Success 200 {object} dto.SuccessResponse{data=dto.GroupListResponse} "Lista de grupos"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/groups [get]
+func (h *GroupHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ListGroupsRequest{SessionID: sess.ID()}
+	result, err := h.getGroupsUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToGroupListResponse(result.Groups)
+	h.writeSuccessResponse(w, http.StatusOK, "Groups retrieved successfully", response)
+}
+
+// GetGroup handles GET /sessions/{id}/groups/{groupId}
+// @Summary Obter metadados do grupo
+// @Description Retorna os metadados de um grupo específico. O identificador pode ser o JID completo (`120363025246125486@g.us`) ou apenas a parte numérica.
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param groupId path string true "JID do grupo" example("120363025246125486@g.us")
+// @Success 200 {object} dto.SuccessResponse{data=dto.GroupResponse} "Metadados do grupo"
+// @Failure 400 {object} dto.ErrorResponse "JID do grupo inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou grupo não encontrado (ou sessão não participa do grupo)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/groups/{groupId} [get]
+func (h *GroupHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetGroupInfoRequest{
+		SessionID: sess.ID(),
+		GroupJID:  chi.URLParam(r, "groupId"),
+	}
+	result, err := h.getGroupsUC.ExecuteGetInfo(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToGroupResponse(result.Group)
+	h.writeSuccessResponse(w, http.StatusOK, "Group retrieved successfully", response)
+}
+
+// UpdateParticipants handles POST /sessions/{id}/groups/{groupId}/participants
+// @Summary Gerenciar participantes do grupo
+// @Description Adiciona, remove, promove ou rebaixa participantes de um grupo. A sessão precisa ser administradora do grupo.
+// @Description
+// @Description O WhatsApp informa o resultado de cada participante individualmente, por isso a resposta traz o sucesso ou a falha de cada um (ex: privacidade impede a adição, participante já está no grupo).
+// @Description
+// @Description **Exemplo:** `{"action": "add", "participants": ["5511999999999", "5511888888888@s.whatsapp.net"]}`
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param groupId path string true "JID do grupo" example("120363025246125486@g.us")
+// @Param request body dto.UpdateGroupParticipantsRequest true "Ação e participantes"
+// @Success 200 {object} dto.SuccessResponse{data=dto.UpdateGroupParticipantsResponse} "Resultado por participante"
+// @Failure 400 {object} dto.ErrorResponse "Ação, JID do grupo ou participantes inválidos, ou sessão não conectada"
+// @Failure 403 {object} dto.ErrorResponse "Sessão não é administradora do grupo"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou grupo não encontrado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/groups/{groupId}/participants [post]
+func (h *GroupHandler) UpdateParticipants(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.UpdateGroupParticipantsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.UpdateGroupParticipantsRequest{
+		SessionID:    sess.ID(),
+		GroupJID:     chi.URLParam(r, "groupId"),
+		Action:       req.Action,
+		Participants: req.Participants,
+		CountryCode:  req.CountryCode,
+	}
+	result, err := h.updateParticipantsUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToUpdateGroupParticipantsResponse(result.GroupJID, result.Action.String(), result.Results)
+	h.writeSuccessResponse(w, http.StatusOK, "Group participants update processed", response)
+}
@@ -1,15 +1,29 @@
 package handler
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 	"wazmeow/internal/http/dto"
 	"wazmeow/internal/infra/container"
 	"wazmeow/pkg/logger"
 )
 
+// Version is the application version reported by the health endpoints.
+// It can be overridden at build time with -ldflags "-X wazmeow/internal/http/handler.Version=..."
+var Version = "1.0.0"
+
 // HealthHandler handles health check requests
 type HealthHandler struct {
 	container *container.Container
@@ -34,13 +48,14 @@ func NewHealthHandler(container *container.Container, logger logger.Logger) *Hea
 // @Description - Status geral da aplicação (healthy/unhealthy)
 // @Description - Versão da aplicação
 // @Description - Tempo de atividade (uptime)
-// @Description - Status individual de cada serviço
+// @Description - Status individual de cada serviço (incluindo a versão do schema do store do WhatsApp)
+// @Description - Latência de cada verificação em `metrics.latency_ms` (o banco é verificado com ping)
 // @Description - Timestamp da verificação
 // @Description
 // @Description **Status possíveis:**
 // @Description - `healthy`: Todos os serviços funcionando normalmente
-// @Description - `unhealthy`: Um ou mais serviços com problemas
-// @Description - `degraded`: Serviços funcionando com limitações
+// @Description - `unhealthy`: Um ou mais serviços com problemas (HTTP 503)
+// @Description - `degraded`: Serviços respondendo lentamente (acima de 500ms)
 // @Tags Health
 // @Accept json
 // @Produce json
@@ -48,61 +63,168 @@ func NewHealthHandler(container *container.Container, logger logger.Logger) *Hea
 // @Failure 503 {object} dto.ErrorResponse "Um ou mais serviços indisponíveis"
 // @Router /health [get]
 func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
-	services := make(map[string]interface{})
-
-	// Check database health
-	dbHealth := &dto.ServiceHealth{Status: "healthy"}
-	if h.container != nil && h.container.DBConnection != nil {
-		if err := h.container.Health(); err != nil {
-			dbHealth.Status = "unhealthy"
-			dbHealth.Message = err.Error()
-		}
-	} else {
-		dbHealth.Status = "unhealthy"
-		dbHealth.Message = "Database connection not initialized"
-	}
-	services["database"] = dbHealth
+	h.writeHealth(w, map[string]*dto.ServiceHealth{
+		"database":       h.checkDatabase(r.Context()),
+		"whatsapp":       h.checkWhatsAppManager(),
+		"whatsapp_store": h.checkWhatsAppStore(r.Context()),
+	})
+}
 
-	// Check WhatsApp manager health
-	waHealth := &dto.ServiceHealth{Status: "healthy"}
-	if h.container != nil && h.container.WhatsAppManager != nil {
-		if err := h.container.WhatsAppManager.HealthCheck(); err != nil {
-			waHealth.Status = "unhealthy"
-			waHealth.Message = err.Error()
-		}
-	} else {
-		waHealth.Status = "unhealthy"
-		waHealth.Message = "WhatsApp manager not initialized"
+// Live handles GET /health/live
+// @Summary Liveness probe
+// @Description Indica apenas que o processo está no ar e respondendo. Não consulta o banco de dados nem outros serviços,
+// @Description portanto uma indisponibilidade temporária de dependências não provoca reinicializações do container.
+// @Tags Health
+// @Produce json
+// @Success 200 {object} dto.HealthResponse "Processo no ar"
+// @Router /health/live [get]
+func (h *HealthHandler) Live(w http.ResponseWriter, r *http.Request) {
+	h.writeHealth(w, map[string]*dto.ServiceHealth{})
+}
+
+// Ready handles GET /health/ready
+// @Summary Readiness probe
+// @Description Indica se a aplicação está pronta para receber tráfego: o banco de dados responde ao ping e o
+// @Description gerenciador do WhatsApp está em execução. Retorna 503 enquanto alguma dessas dependências estiver indisponível.
+// @Tags Health
+// @Produce json
+// @Success 200 {object} dto.HealthResponse "Aplicação pronta"
+// @Failure 503 {object} dto.HealthResponse "Dependências indisponíveis"
+// @Router /health/ready [get]
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	h.writeHealth(w, map[string]*dto.ServiceHealth{
+		"database": h.checkDatabase(r.Context()),
+		"whatsapp": h.checkWhatsAppManager(),
+	})
+}
+
+// writeHealth writes the health response for the checked services, answering 503 when any is unhealthy
+func (h *HealthHandler) writeHealth(w http.ResponseWriter, services map[string]*dto.ServiceHealth) {
+	overallStatus := overallHealthStatus(services)
+	response := dto.CreateHealthResponse(overallStatus, Version, time.Since(h.startTime).Round(time.Second).String(), services)
+
+	statusCode := http.StatusOK
+	if overallStatus == dto.HealthStatusUnhealthy {
+		statusCode = http.StatusServiceUnavailable
 	}
-	services["whatsapp"] = waHealth
 
-	// Overall status
-	overallStatus := "healthy"
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
+}
+
+// overallHealthStatus combines the service statuses: every service is critical, so one
+// down makes the application unhealthy, and one slow makes it degraded
+func overallHealthStatus(services map[string]*dto.ServiceHealth) dto.HealthStatus {
+	overallStatus := dto.HealthStatusHealthy
 	for _, service := range services {
-		if serviceHealth, ok := service.(*dto.ServiceHealth); ok {
-			if serviceHealth.Status != "healthy" {
-				overallStatus = "unhealthy"
-				break
+		switch service.Status {
+		case dto.HealthStatusHealthy:
+		case dto.HealthStatusDegraded:
+			if overallStatus == dto.HealthStatusHealthy {
+				overallStatus = dto.HealthStatusDegraded
 			}
+		default:
+			overallStatus = dto.HealthStatusUnhealthy
 		}
 	}
+	return overallStatus
+}
 
-	response := &dto.HealthResponse{
-		Status:    overallStatus,
-		Timestamp: time.Now(),
-		Version:   "1.0.0", // Could be injected from build
-		Uptime:    time.Since(h.startTime).String(),
-		Services:  services,
+// Health check limits: a dependency answering slower than healthSlowThreshold is
+// degraded, and one not answering within healthCheckTimeout is unhealthy
+const (
+	healthCheckTimeout  = 2 * time.Second
+	healthSlowThreshold = 500 * time.Millisecond
+	healthLatencyMetric = "latency_ms"
+)
+
+// checkDatabase pings the application database
+func (h *HealthHandler) checkDatabase(ctx context.Context) *dto.ServiceHealth {
+	if h.container == nil || h.container.DBConnection == nil {
+		return dto.NewUnhealthyService("Database connection not initialized")
 	}
 
-	statusCode := http.StatusOK
-	if overallStatus != "healthy" {
-		statusCode = http.StatusServiceUnavailable
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := h.container.DBConnection.GetDB().PingContext(ctx)
+	latency := time.Since(start)
+
+	var health *dto.ServiceHealth
+	switch {
+	case err != nil:
+		health = dto.NewUnhealthyService(err.Error())
+	case latency > healthSlowThreshold:
+		health = dto.NewDegradedService("Database is responding slowly")
+	default:
+		health = dto.NewHealthyService("Database is reachable")
 	}
+	health.AddMetric(healthLatencyMetric, latencyMillis(latency))
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(response)
+	stats := h.container.DBConnection.Stats()
+	health.AddMetric("open_connections", stats.OpenConnections)
+	health.AddMetric("in_use_connections", stats.InUse)
+
+	return health
+}
+
+// checkWhatsAppManager checks that the WhatsApp client manager is running
+func (h *HealthHandler) checkWhatsAppManager() *dto.ServiceHealth {
+	if h.container == nil || h.container.WhatsAppManager == nil {
+		return dto.NewUnhealthyService("WhatsApp manager not initialized")
+	}
+
+	start := time.Now()
+	err := h.container.WhatsAppManager.HealthCheck()
+	latency := time.Since(start)
+
+	var health *dto.ServiceHealth
+	if err != nil {
+		health = dto.NewUnhealthyService(err.Error())
+	} else {
+		health = dto.NewHealthyService("WhatsApp manager is running")
+	}
+	health.AddMetric(healthLatencyMetric, latencyMillis(latency))
+
+	return health
+}
+
+// checkWhatsAppStore reads the schema version of the whatsmeow store
+func (h *HealthHandler) checkWhatsAppStore(ctx context.Context) *dto.ServiceHealth {
+	if h.container == nil {
+		return dto.NewUnhealthyService("WhatsApp store not initialized")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	schema, err := h.container.WhatsAppStoreSchema(ctx)
+	latency := time.Since(start)
+
+	var health *dto.ServiceHealth
+	switch {
+	case err != nil:
+		health = dto.NewUnhealthyService(err.Error())
+	case latency > healthSlowThreshold:
+		health = dto.NewDegradedService("WhatsApp store is responding slowly")
+	default:
+		health = dto.NewHealthyService("WhatsApp store is reachable")
+	}
+	if schema != nil {
+		health.AddDetail("version", schema.Version)
+		health.AddDetail("latest_version", schema.LatestVersion)
+	}
+	health.AddMetric(healthLatencyMetric, latencyMillis(latency))
+
+	return health
+}
+
+// latencyMillis converts a latency to fractional milliseconds
+func latencyMillis(latency time.Duration) float64 {
+	return float64(latency.Microseconds()) / 1000
 }
 
 // Metrics handles GET /metrics
@@ -121,7 +243,14 @@ func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
 // @Description - Total de clientes WhatsApp
 // @Description - Clientes conectados e autenticados
 // @Description - Mensagens enviadas e recebidas
+// @Description - Mensagens enviadas por tipo (text, image, video, ...), no total e por sessão
 // @Description - Clientes com erro
+// @Description - Limite de sessões simultâneas (`max_sessions`, 0 = ilimitado), comparável a `total_clients`
+// @Description
+// @Description **Webhooks** (apenas com os webhooks habilitados):
+// @Description - Eventos entregues, descartados e tentativas repetidas, no total e por sessão
+// @Description - Latência média e tentativas por faixa de latência
+// @Description - Tamanho e capacidade da fila de entrega
 // @Description
 // @Description **Sistema:**
 // @Description - Tempo de atividade (uptime)
@@ -136,41 +265,263 @@ func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
 // @Failure 500 {object} dto.ErrorResponse "Erro interno ao coletar métricas"
 // @Router /metrics [get]
 func (h *HealthHandler) Metrics(w http.ResponseWriter, r *http.Request) {
-	// Get database stats
-	_ = h.container.GetDatabaseStats() // TODO: Use database stats
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.collectMetrics(r.Context()))
+}
+
+// AppMetrics handles GET /metrics/app
+// @Summary Métricas da aplicação em JSON
+// @Description Retorna as métricas agregadas da aplicação em JSON (diferente do formato Prometheus):
+// @Description contagem de sessões por status, estatísticas dos clientes WhatsApp, memória e uptime do processo
+// @Description e conexões abertas no banco de dados.
+// @Description
+// @Description Disponível apenas quando `ENABLE_METRICS=true`.
+// @Tags Health
+// @Produce json
+// @Success 200 {object} dto.MetricsResponse "Métricas coletadas com sucesso"
+// @Router /metrics/app [get]
+func (h *HealthHandler) AppMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.collectMetrics(r.Context()))
+}
 
-	// Get WhatsApp stats
+// collectMetrics aggregates the session, WhatsApp and runtime metrics
+func (h *HealthHandler) collectMetrics(ctx context.Context) *dto.MetricsResponse {
 	waStats := h.container.GetWhatsAppStats()
+	if waStats == nil {
+		waStats = &whatsapp.ManagerStats{}
+	}
+	sendStats := h.container.GetMessageSendStats()
+	if sendStats == nil {
+		sendStats = &whatsapp.MessageSendStats{}
+	}
+
+	waMetrics := dto.WhatsAppMetrics{
+		TotalClients:          waStats.TotalClients,
+		ConnectedClients:      waStats.ConnectedClients,
+		AuthenticatedClients:  waStats.AuthenticatedClients,
+		ErrorClients:          waStats.ErrorClients,
+		MaxSessions:           waStats.MaxSessions,
+		MessagesSent:          int(sendStats.Total()),
+		MessagesReceived:      int(waStats.MessagesReceived),
+		MessagesSentByType:    messageCountsByType(sendStats.ByType),
+		MessagesSentBySession: make(map[string]map[string]int64, len(sendStats.BySession)),
+	}
+	for sessionID, counts := range sendStats.BySession {
+		waMetrics.MessagesSentBySession[sessionID.String()] = messageCountsByType(counts)
+	}
 
-	// Build metrics response
-	response := &dto.MetricsResponse{
-		Sessions: dto.SessionMetrics{
-			// These would be populated from actual metrics
-			Total:        0,
-			Connected:    0,
-			Disconnected: 0,
-			Error:        0,
-			Active:       0,
-		},
-		WhatsApp: dto.WhatsAppMetrics{
-			TotalClients:         waStats.TotalClients,
-			ConnectedClients:     waStats.ConnectedClients,
-			AuthenticatedClients: waStats.AuthenticatedClients,
-			ErrorClients:         waStats.ErrorClients,
-			MessagesSent:         0, // Would be tracked in real implementation
-			MessagesReceived:     0, // Would be tracked in real implementation
-		},
-		System: dto.SystemMetrics{
-			Uptime:              time.Since(h.startTime).String(),
-			MemoryUsage:         "N/A", // Would be calculated from runtime.MemStats
-			CPUUsage:            "N/A", // Would be calculated from system metrics
-			DatabaseStatus:      "healthy",
-			DatabaseConnections: 0, // Would be extracted from dbStats
-		},
-		Timestamp: time.Now(),
+	response := dto.CreateMetricsResponse(h.sessionMetrics(ctx), waMetrics, h.systemMetrics(ctx))
+	if webhookStats := h.container.GetWebhookStats(); webhookStats != nil {
+		response.Webhook = webhookMetrics(webhookStats)
 	}
+	return response
+}
 
-	w.Header().Set("Content-Type", "application/json")
+// webhookMetrics converts the webhook delivery statistics to their JSON metrics
+func webhookMetrics(stats *whatsapp.WebhookStats) *dto.WebhookMetrics {
+	metrics := &dto.WebhookMetrics{
+		Delivered:        stats.TotalSent,
+		Failed:           stats.TotalFailed,
+		Retried:          stats.TotalRetried,
+		AverageLatencyMs: latencyMillis(stats.AverageLatency),
+		QueueLength:      stats.QueueLength,
+		QueueCapacity:    stats.QueueCapacity,
+		LatencyBuckets:   make(map[string]int64, len(whatsapp.WebhookLatencyBuckets)+1),
+		BySession:        make(map[string]*dto.WebhookSessionMetrics, len(stats.BySession)),
+	}
+	for _, bucket := range cumulativeLatencyBuckets(&stats.Latency) {
+		metrics.LatencyBuckets[bucket.bound] = bucket.count
+	}
+	for sessionID, sessionStats := range stats.BySession {
+		metrics.BySession[sessionID.String()] = &dto.WebhookSessionMetrics{
+			Delivered: sessionStats.Delivered,
+			Failed:    sessionStats.Failed,
+			Retried:   sessionStats.Retried,
+		}
+	}
+	return metrics
+}
+
+// cumulativeLatencyBuckets returns the attempts at or below each bucket bound, in seconds,
+// ending with the "+Inf" bucket
+func cumulativeLatencyBuckets(histogram *whatsapp.WebhookLatencyHistogram) []latencyBucket {
+	buckets := make([]latencyBucket, 0, len(whatsapp.WebhookLatencyBuckets)+1)
+	var cumulative int64
+	for i, bound := range whatsapp.WebhookLatencyBuckets {
+		if i < len(histogram.Counts) {
+			cumulative += histogram.Counts[i]
+		}
+		buckets = append(buckets, latencyBucket{bound: strconv.FormatFloat(bound.Seconds(), 'g', -1, 64), count: cumulative})
+	}
+	return append(buckets, latencyBucket{bound: "+Inf", count: histogram.Count})
+}
+
+// latencyBucket is a histogram bucket with its upper bound formatted for Prometheus
+type latencyBucket struct {
+	bound string
+	count int64
+}
+
+// systemMetrics reads the process memory and uptime and the database connection pool
+func (h *HealthHandler) systemMetrics(ctx context.Context) dto.SystemMetrics {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	// The container returns the zero value when no database is configured
+	dbStats, _ := h.container.GetDatabaseStats().(sql.DBStats)
+
+	return dto.SystemMetrics{
+		Uptime:              time.Since(h.startTime).Round(time.Second).String(),
+		MemoryUsage:         fmt.Sprintf("%dMB", memStats.Alloc/1024/1024),
+		CPUUsage:            "N/A", // Not available from the Go runtime
+		DatabaseStatus:      h.checkDatabase(ctx).Status.String(),
+		DatabaseConnections: dbStats.OpenConnections,
+	}
+}
+
+// PrometheusMetrics handles GET /metrics/prometheus
+// @Summary Métricas no formato Prometheus
+// @Description Exporta os contadores de mensagens enviadas por tipo, no total e por sessão, e o total de mensagens recebidas,
+// @Description no formato de texto do Prometheus.
+// @Description Com os webhooks habilitados, exporta também as entregas de webhooks por resultado (no total e por sessão),
+// @Description o histograma de latência das tentativas e o tamanho da fila de entrega.
+// @Description O conjunto de tipos é fixo, então o número de séries é limitado pelo número de sessões.
+// @Description
+// @Description Disponível apenas quando `ENABLE_METRICS=true` e há chaves configuradas; envie uma chave de API (AUTH_API_KEYS)
+// @Description ou de administração (ADMIN_API_KEYS) no header de autenticação (AUTH_HEADER_NAME) ou como `Authorization: Bearer`.
+// @Tags Health
+// @Produce plain
+// @Success 200 {string} string "Métricas no formato de exposição do Prometheus"
+// @Failure 401 {object} dto.ErrorResponse "Chave ausente ou inválida"
+// @Security ApiKeyAuth
+// @Router /metrics/prometheus [get]
+func (h *HealthHandler) PrometheusMetrics(w http.ResponseWriter, r *http.Request) {
+	sendStats := h.container.GetMessageSendStats()
+	if sendStats == nil {
+		sendStats = &whatsapp.MessageSendStats{}
+	}
+	var receivedTotal int64
+	if waStats := h.container.GetWhatsAppStats(); waStats != nil {
+		receivedTotal = waStats.MessagesReceived
+	}
+
+	sessionIDs := make([]session.SessionID, 0, len(sendStats.BySession))
+	for sessionID := range sendStats.BySession {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+	sort.Slice(sessionIDs, func(i, j int) bool {
+		return sessionIDs[i].String() < sessionIDs[j].String()
+	})
+
+	var b strings.Builder
+	b.WriteString("# HELP wazmeow_messages_sent_total Messages sent, by message type.\n")
+	b.WriteString("# TYPE wazmeow_messages_sent_total counter\n")
+	for _, msgType := range whatsapp.MessageTypes {
+		fmt.Fprintf(&b, "wazmeow_messages_sent_total{type=%q} %d\n", msgType.String(), sendStats.ByType[msgType])
+	}
+
+	b.WriteString("# HELP wazmeow_messages_received_total Messages received.\n")
+	b.WriteString("# TYPE wazmeow_messages_received_total counter\n")
+	fmt.Fprintf(&b, "wazmeow_messages_received_total %d\n", receivedTotal)
+
+	b.WriteString("# HELP wazmeow_session_messages_sent_total Messages sent, by session and message type.\n")
+	b.WriteString("# TYPE wazmeow_session_messages_sent_total counter\n")
+	for _, sessionID := range sessionIDs {
+		counts := sendStats.BySession[sessionID]
+		for _, msgType := range whatsapp.MessageTypes {
+			fmt.Fprintf(&b, "wazmeow_session_messages_sent_total{session_id=%q,type=%q} %d\n", sessionID.String(), msgType.String(), counts[msgType])
+		}
+	}
+
+	if webhookStats := h.container.GetWebhookStats(); webhookStats != nil {
+		writeWebhookPrometheusMetrics(&b, webhookStats)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(response)
+	io.WriteString(w, b.String())
+}
+
+// writeWebhookPrometheusMetrics writes the webhook delivery counters, latency histogram and queue gauges
+func writeWebhookPrometheusMetrics(b *strings.Builder, stats *whatsapp.WebhookStats) {
+	b.WriteString("# HELP wazmeow_webhook_deliveries_total Webhook events delivered or given up on, and attempts retried, by outcome.\n")
+	b.WriteString("# TYPE wazmeow_webhook_deliveries_total counter\n")
+	fmt.Fprintf(b, "wazmeow_webhook_deliveries_total{outcome=\"delivered\"} %d\n", stats.TotalSent)
+	fmt.Fprintf(b, "wazmeow_webhook_deliveries_total{outcome=\"failed\"} %d\n", stats.TotalFailed)
+	fmt.Fprintf(b, "wazmeow_webhook_deliveries_total{outcome=\"retried\"} %d\n", stats.TotalRetried)
+
+	b.WriteString("# HELP wazmeow_webhook_delivery_duration_seconds Latency of webhook delivery attempts.\n")
+	b.WriteString("# TYPE wazmeow_webhook_delivery_duration_seconds histogram\n")
+	for _, bucket := range cumulativeLatencyBuckets(&stats.Latency) {
+		fmt.Fprintf(b, "wazmeow_webhook_delivery_duration_seconds_bucket{le=%q} %d\n", bucket.bound, bucket.count)
+	}
+	fmt.Fprintf(b, "wazmeow_webhook_delivery_duration_seconds_sum %s\n", strconv.FormatFloat(stats.Latency.Sum.Seconds(), 'g', -1, 64))
+	fmt.Fprintf(b, "wazmeow_webhook_delivery_duration_seconds_count %d\n", stats.Latency.Count)
+
+	b.WriteString("# HELP wazmeow_webhook_queue_length Webhook events waiting in the delivery queue.\n")
+	b.WriteString("# TYPE wazmeow_webhook_queue_length gauge\n")
+	fmt.Fprintf(b, "wazmeow_webhook_queue_length %d\n", stats.QueueLength)
+
+	b.WriteString("# HELP wazmeow_webhook_queue_capacity Webhook events the delivery queue holds before new events are dropped.\n")
+	b.WriteString("# TYPE wazmeow_webhook_queue_capacity gauge\n")
+	fmt.Fprintf(b, "wazmeow_webhook_queue_capacity %d\n", stats.QueueCapacity)
+
+	sessionIDs := make([]session.SessionID, 0, len(stats.BySession))
+	for sessionID := range stats.BySession {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+	sort.Slice(sessionIDs, func(i, j int) bool {
+		return sessionIDs[i].String() < sessionIDs[j].String()
+	})
+
+	b.WriteString("# HELP wazmeow_session_webhook_deliveries_total Webhook events delivered or given up on, and attempts retried, by session and outcome.\n")
+	b.WriteString("# TYPE wazmeow_session_webhook_deliveries_total counter\n")
+	for _, sessionID := range sessionIDs {
+		sessionStats := stats.BySession[sessionID]
+		fmt.Fprintf(b, "wazmeow_session_webhook_deliveries_total{session_id=%q,outcome=\"delivered\"} %d\n", sessionID.String(), sessionStats.Delivered)
+		fmt.Fprintf(b, "wazmeow_session_webhook_deliveries_total{session_id=%q,outcome=\"failed\"} %d\n", sessionID.String(), sessionStats.Failed)
+		fmt.Fprintf(b, "wazmeow_session_webhook_deliveries_total{session_id=%q,outcome=\"retried\"} %d\n", sessionID.String(), sessionStats.Retried)
+	}
+}
+
+// messageCountsByType converts message counters to a map keyed by type name, including every type
+func messageCountsByType(counts map[whatsapp.MessageType]int64) map[string]int64 {
+	result := make(map[string]int64, len(whatsapp.MessageTypes))
+	for _, msgType := range whatsapp.MessageTypes {
+		result[msgType.String()] = counts[msgType]
+	}
+	return result
+}
+
+// sessionMetrics counts the persisted sessions by status. Counts that cannot be read are reported as zero.
+func (h *HealthHandler) sessionMetrics(ctx context.Context) dto.SessionMetrics {
+	var metrics dto.SessionMetrics
+	if h.container == nil || h.container.SessionRepo == nil {
+		return metrics
+	}
+	repo := h.container.SessionRepo
+
+	countOrZero := func(total int, err error) int {
+		if err != nil {
+			h.logger.WithContext(ctx).ErrorWithError("failed to count sessions for metrics", err, nil)
+			return 0
+		}
+		return total
+	}
+	byStatus := func(status session.Status) int {
+		_, total, err := repo.GetByStatus(ctx, status, 1, 0)
+		return countOrZero(total, err)
+	}
+
+	_, total, err := repo.List(ctx, 1, 0)
+	metrics.Total = countOrZero(total, err)
+	metrics.Connected = byStatus(session.StatusConnected)
+	metrics.Disconnected = byStatus(session.StatusDisconnected)
+	metrics.Error = byStatus(session.StatusError)
+	metrics.Active = countOrZero(repo.GetActiveCount(ctx))
+
+	return metrics
 }
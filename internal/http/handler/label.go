@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// LabelHandler handles HTTP requests about WhatsApp Business labels
+type LabelHandler struct {
+	listUC   *whatsappUC.ListLabelsUseCase
+	createUC *whatsappUC.CreateLabelUseCase
+	applyUC  *whatsappUC.LabelChatUseCase
+
+	baseHandler
+}
+
+// NewLabelHandler creates a new label handler
+func NewLabelHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	listUC *whatsappUC.ListLabelsUseCase,
+	createUC *whatsappUC.CreateLabelUseCase,
+	applyUC *whatsappUC.LabelChatUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *LabelHandler {
+	return &LabelHandler{
+		listUC:      listUC,
+		createUC:    createUC,
+		applyUC:     applyUC,
+		baseHandler: newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// ListLabels handles GET /sessions/{id}/labels
+// @Summary Listar etiquetas
+// @Description Lista as etiquetas da conta WhatsApp Business da sessão.
+// @Description
+// @Description As etiquetas não são armazenadas pelo serviço: na primeira consulta após conectar elas são sincronizadas com o WhatsApp, e depois são mantidas atualizadas pelas alterações feitas em outros aparelhos.
+// @Description
+// @Description **Códigos de erro:**
+// @Description - `SESSION_NOT_BUSINESS` (422): a sessão não é uma conta WhatsApp Business
+// @Tags Labels
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Success 200 {object} dto.SuccessResponse{data=dto.LabelListResponse} "Lista de etiquetas"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "App state ainda não sincronizado"
+// @Failure 422 {object} dto.ErrorResponse "Sessão não é uma conta WhatsApp Business"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/labels [get]
+func (h *LabelHandler) ListLabels(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	result, err := h.listUC.Execute(r.Context(), whatsappUC.ListLabelsRequest{SessionID: sess.ID()})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToLabelListResponse(result.Labels)
+	h.writeSuccessResponse(w, http.StatusOK, "Labels retrieved successfully", response)
+}
+
+// CreateLabel handles POST /sessions/{id}/labels
+// @Summary Criar etiqueta
+// @Description Cria uma etiqueta na conta WhatsApp Business da sessão, visível em todos os aparelhos.
+// @Description
+// @Description **Códigos de erro:**
+// @Description - `SESSION_NOT_BUSINESS` (422): a sessão não é uma conta WhatsApp Business
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param request body dto.CreateLabelRequest true "Etiqueta"
+// @Success 201 {object} dto.SuccessResponse{data=dto.LabelResponse} "Etiqueta criada"
+// @Failure 400 {object} dto.ErrorResponse "Nome ou cor inválidos, ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "App state ainda não sincronizado"
+// @Failure 422 {object} dto.ErrorResponse "Sessão não é uma conta WhatsApp Business"
+// @Failure 502 {object} dto.ErrorResponse "WhatsApp rejeitou a alteração"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/labels [post]
+func (h *LabelHandler) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.CreateLabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.CreateLabelRequest{
+		SessionID: sess.ID(),
+		Name:      req.Name,
+		Color:     req.Color,
+	}
+	result, err := h.createUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToLabelResponse(result.Label)
+	h.writeSuccessResponse(w, http.StatusCreated, "Label created successfully", response)
+}
+
+// LabelChat handles POST /sessions/{id}/chats/{chat}/labels
+// @Summary Aplicar etiqueta a um chat
+// @Description Aplica uma etiqueta a um chat, ou a uma mensagem do chat quando `message_id` é informado, em todos os aparelhos da conta WhatsApp Business. Envie `{"labeled": false}` para remover a etiqueta.
+// @Description
+// @Description **Códigos de erro:**
+// @Description - `SESSION_NOT_BUSINESS` (422): a sessão não é uma conta WhatsApp Business
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.LabelChatRequest true "Etiqueta"
+// @Success 200 {object} dto.SuccessResponse{data=dto.LabelChatResponse} "Etiqueta atualizada"
+// @Failure 400 {object} dto.ErrorResponse "Chat inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou etiqueta não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "App state ainda não sincronizado"
+// @Failure 422 {object} dto.ErrorResponse "Sessão não é uma conta WhatsApp Business"
+// @Failure 502 {object} dto.ErrorResponse "WhatsApp rejeitou a alteração"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/labels [post]
+func (h *LabelHandler) LabelChat(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.LabelChatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.LabelChatRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		LabelID:     req.LabelID,
+		MessageID:   req.MessageID,
+		Labeled:     req.Labeled == nil || *req.Labeled,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.applyUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.LabelChatResponse{
+		Chat:      result.Chat,
+		LabelID:   result.LabelID,
+		MessageID: result.MessageID,
+		Labeled:   result.Labeled,
+	}
+	message := "Label applied"
+	if !result.Labeled {
+		message = "Label removed"
+	}
+	h.writeSuccessResponse(w, http.StatusOK, message, response)
+}
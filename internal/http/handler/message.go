@@ -0,0 +1,785 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// MessageHandler handles HTTP requests about stored messages and message sending
+type MessageHandler struct {
+	downloadMediaUC  *whatsappUC.DownloadMediaUseCase
+	sendPollUC       *whatsappUC.SendPollUseCase
+	sendStickerUC    *whatsappUC.SendStickerUseCase
+	sendButtonsUC    *whatsappUC.SendButtonsUseCase
+	sendListUC       *whatsappUC.SendListUseCase
+	forwardUC        *whatsappUC.ForwardMessageUseCase
+	sendBroadcastUC  *whatsappUC.SendBroadcastUseCase
+	scheduleUC       *whatsappUC.ScheduleMessageUseCase
+	listScheduledUC  *whatsappUC.ListScheduledMessagesUseCase
+	cancelScheduleUC *whatsappUC.CancelScheduledMessageUseCase
+	messageStatusUC  *whatsappUC.GetMessageStatusUseCase
+	recentUC         *whatsappUC.GetRecentMessagesUseCase
+
+	baseHandler
+}
+
+// NewMessageHandler creates a new message handler
+func NewMessageHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	downloadMediaUC *whatsappUC.DownloadMediaUseCase,
+	sendPollUC *whatsappUC.SendPollUseCase,
+	sendStickerUC *whatsappUC.SendStickerUseCase,
+	sendButtonsUC *whatsappUC.SendButtonsUseCase,
+	sendListUC *whatsappUC.SendListUseCase,
+	forwardUC *whatsappUC.ForwardMessageUseCase,
+	sendBroadcastUC *whatsappUC.SendBroadcastUseCase,
+	scheduleUC *whatsappUC.ScheduleMessageUseCase,
+	listScheduledUC *whatsappUC.ListScheduledMessagesUseCase,
+	cancelScheduleUC *whatsappUC.CancelScheduledMessageUseCase,
+	messageStatusUC *whatsappUC.GetMessageStatusUseCase,
+	recentUC *whatsappUC.GetRecentMessagesUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *MessageHandler {
+	return &MessageHandler{
+		downloadMediaUC:  downloadMediaUC,
+		sendPollUC:       sendPollUC,
+		sendStickerUC:    sendStickerUC,
+		sendButtonsUC:    sendButtonsUC,
+		sendListUC:       sendListUC,
+		forwardUC:        forwardUC,
+		sendBroadcastUC:  sendBroadcastUC,
+		scheduleUC:       scheduleUC,
+		listScheduledUC:  listScheduledUC,
+		cancelScheduleUC: cancelScheduleUC,
+		messageStatusUC:  messageStatusUC,
+		recentUC:         recentUC,
+		baseHandler:      newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// DownloadMedia handles GET /sessions/{id}/messages/{messageId}/media
+// @Summary Baixar mídia de uma mensagem
+// @Description Baixa e descriptografa a imagem, vídeo, áudio, documento ou sticker de uma mensagem recebida ou enviada pela sessão, retornando o arquivo com o Content-Type original.
+// @Description
+// @Description Somente mensagens recebidas enquanto a sessão estava conectada ficam armazenadas. O WhatsApp mantém as mídias por tempo limitado; após expirar o download retorna 410.
+// @Tags Messages
+// @Produce application/octet-stream
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param messageId path string true "ID da mensagem no WhatsApp"
+// @Success 200 {file} binary "Arquivo de mídia"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou mensagem não encontrada, ou mensagem sem mídia"
+// @Failure 410 {object} dto.ErrorResponse "Mídia expirada nos servidores do WhatsApp"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/{messageId}/media [get]
+func (h *MessageHandler) DownloadMedia(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	messageID := chi.URLParam(r, "messageId")
+	if messageID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Message ID is required", nil)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.DownloadMediaRequest{
+		SessionID: sess.ID(),
+		MessageID: messageID,
+	}
+	result, err := h.downloadMediaUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Stream the file itself instead of the JSON envelope
+	w.Header().Set("Content-Type", result.MimeType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(result.Data)))
+	if result.FileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": result.FileName}))
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(result.Data); err != nil {
+		h.requestLogger(r).ErrorWithError("failed to write media response", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"message_id": messageID,
+		})
+	}
+}
+
+// GetRecentMessages handles GET /sessions/{id}/messages/recent
+// @Summary Consultar mensagens recebidas recentemente
+// @Description Retorna as últimas mensagens recebidas pela sessão, mantidas em memória para quem não pode receber webhooks.
+// @Description
+// @Description Envie em `since` o `next_cursor` da consulta anterior para receber somente mensagens novas. Sem `since` todas as mensagens em memória são retornadas.
+// @Description São mantidas as últimas WHATSAPP_RECENT_MESSAGES_SIZE mensagens de cada sessão por até WHATSAPP_RECENT_MESSAGES_RETENTION; as mensagens são perdidas ao reiniciar a aplicação ou remover o cliente da sessão.
+// @Tags Messages
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param since query integer false "Cursor retornado pela consulta anterior"
+// @Success 200 {object} dto.SuccessResponse{data=dto.RecentMessagesResponse} "Mensagens recentes"
+// @Failure 400 {object} dto.ErrorResponse "Cursor inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou cliente não encontrado, ou buffer de mensagens desativado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/recent [get]
+func (h *MessageHandler) GetRecentMessages(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var since uint64
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		since, err = strconv.ParseUint(sinceStr, 10, 64)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid cursor", err)
+			return
+		}
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetRecentMessagesRequest{
+		SessionID: sess.ID(),
+		Since:     since,
+	}
+	result, err := h.recentUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToRecentMessagesResponse(result.Messages)
+	h.writeSuccessResponse(w, http.StatusOK, "Recent messages retrieved successfully", response)
+}
+
+// GetMessageStatus handles GET /sessions/{id}/messages/{messageId}/status
+// @Summary Consultar situação de entrega de uma mensagem
+// @Description Retorna até onde uma mensagem enviada pela sessão chegou: `delivered` (entregue), `read` (lida) ou `played` (áudio ou vídeo reproduzido).
+// @Description
+// @Description Em grupos cada participante envia sua própria confirmação; `status` é a situação mais avançada entre eles e `receipts` traz a confirmação de cada destinatário.
+// @Description Somente confirmações recebidas enquanto a sessão estava conectada ficam armazenadas; antes da primeira confirmação a consulta retorna 404.
+// @Tags Messages
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param messageId path string true "ID da mensagem no WhatsApp"
+// @Success 200 {object} dto.SuccessResponse{data=dto.MessageStatusResponse} "Situação da mensagem"
+// @Failure 400 {object} dto.ErrorResponse "ID da mensagem ausente"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada ou nenhuma confirmação recebida"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/{messageId}/status [get]
+func (h *MessageHandler) GetMessageStatus(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	messageID := chi.URLParam(r, "messageId")
+	if messageID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Message ID is required", nil)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetMessageStatusRequest{
+		SessionID: sess.ID(),
+		MessageID: messageID,
+	}
+	result, err := h.messageStatusUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToMessageStatusResponse(result.Status)
+	h.writeSuccessResponse(w, http.StatusOK, "Message status retrieved successfully", response)
+}
+
+// SendPoll handles POST /sessions/{id}/send/poll
+// @Summary Enviar enquete
+// @Description Envia uma enquete para um contato ou grupo e retorna o ID da mensagem.
+// @Description
+// @Description A enquete precisa ter de 2 a 12 opções distintas. `selectable_count` limita quantas opções cada pessoa pode escolher (0 permite qualquer quantidade).
+// @Description
+// @Description Os votos chegam pelo webhook como eventos `poll.vote`, com o ID da enquete, quem votou e as opções escolhidas.
+// @Description Os nomes das opções só são conhecidos para enquetes vistas pela sessão desde que a aplicação foi iniciada; os hashes SHA-256 das opções são sempre enviados.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param request body dto.SendPollRequest true "Enquete"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendPollResponse} "Enquete enviada"
+// @Failure 400 {object} dto.ErrorResponse "Enquete inválida ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de envio da sessão excedido (ver header Retry-After)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send/poll [post]
+func (h *MessageHandler) SendPoll(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendPollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendPollRequest{
+		SessionID:       sess.ID(),
+		To:              req.To,
+		CountryCode:     req.CountryCode,
+		Question:        req.Question,
+		Options:         req.Options,
+		SelectableCount: req.SelectableCount,
+	}
+	result, err := h.sendPollUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendPollResponse{
+		To:        result.To,
+		MessageID: result.MessageID,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Poll sent successfully", response)
+}
+
+// maxStickerUploadSize is the largest accepted sticker upload (1 MB); the sticker
+// limits enforced afterwards are smaller
+const maxStickerUploadSize = 1 << 20
+
+// SendSticker handles POST /sessions/{id}/send/sticker
+// @Summary Enviar sticker
+// @Description Envia uma figurinha para um contato ou grupo e retorna o ID da mensagem.
+// @Description
+// @Description O arquivo precisa ser uma imagem WebP de 512x512 pixels, com até 100 KB (estática) ou 500 KB (animada). Imagens em outro formato ou tamanho são rejeitadas.
+// @Tags Messages
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param to formData string true "Número de telefone ou JID do destinatário (contato ou grupo)"
+// @Param country_code formData string false "Código do país usado quando o número não possui um (sobrescreve DEFAULT_COUNTRY_CODE)"
+// @Param sticker formData file true "Imagem WebP 512x512 da figurinha"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendStickerResponse} "Sticker enviado"
+// @Failure 400 {object} dto.ErrorResponse "Sticker inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de envio da sessão excedido (ver header Retry-After)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send/sticker [post]
+func (h *MessageHandler) SendSticker(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Read the uploaded sticker
+	r.Body = http.MaxBytesReader(w, r.Body, maxStickerUploadSize+(1<<20))
+	if err := r.ParseMultipartForm(maxStickerUploadSize); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid multipart form (maximum 1 MB)", err)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	req := dto.SendStickerRequest{
+		To:          r.FormValue("to"),
+		CountryCode: r.FormValue("country_code"),
+	}
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	file, _, err := r.FormFile("sticker")
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Sticker file is required in the 'sticker' field", err)
+		return
+	}
+	defer file.Close()
+
+	stickerData, err := io.ReadAll(file)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Failed to read sticker file", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendStickerRequest{
+		SessionID:   sess.ID(),
+		To:          req.To,
+		CountryCode: req.CountryCode,
+		StickerData: stickerData,
+	}
+	result, err := h.sendStickerUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendStickerResponse{
+		To:        result.To,
+		MessageID: result.MessageID,
+		Animated:  result.Animated,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Sticker sent successfully", response)
+}
+
+// SendButtons handles POST /sessions/{id}/send/buttons
+// @Summary Enviar mensagem com botões
+// @Description Envia uma mensagem com até 3 botões de resposta rápida e retorna o ID da mensagem.
+// @Description
+// @Description Atenção: mensagens com botões não são oficialmente suportadas pelo whatsmeow. O WhatsApp pode rejeitá-las ou aceitá-las sem exibir os botões, principalmente quando a sessão não é uma conta WhatsApp Business. Um envio bem-sucedido não garante que o destinatário consiga interagir.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param request body dto.SendButtonsRequest true "Mensagem com botões"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendInteractiveResponse} "Mensagem enviada"
+// @Failure 400 {object} dto.ErrorResponse "Mensagem inválida ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de envio da sessão excedido (ver header Retry-After)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno ou mensagem rejeitada pelo WhatsApp"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send/buttons [post]
+func (h *MessageHandler) SendButtons(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendButtonsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendButtonsRequest{
+		SessionID:   sess.ID(),
+		To:          req.To,
+		CountryCode: req.CountryCode,
+		Body:        req.Body,
+		Buttons:     req.ToButtons(),
+	}
+	result, err := h.sendButtonsUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendInteractiveResponse{
+		To:        result.To,
+		MessageID: result.MessageID,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Buttons message sent successfully", response)
+}
+
+// SendList handles POST /sessions/{id}/send/list
+// @Summary Enviar mensagem com lista
+// @Description Envia uma mensagem com um botão que abre uma lista de opções agrupadas em seções e retorna o ID da mensagem.
+// @Description
+// @Description A lista aceita de 1 a 10 seções, com no máximo 10 linhas no total.
+// @Description
+// @Description Atenção: mensagens com lista não são oficialmente suportadas pelo whatsmeow. O WhatsApp pode rejeitá-las ou aceitá-las sem exibir a lista, principalmente quando a sessão não é uma conta WhatsApp Business. Um envio bem-sucedido não garante que o destinatário consiga interagir.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param request body dto.SendListRequest true "Mensagem com lista"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendInteractiveResponse} "Mensagem enviada"
+// @Failure 400 {object} dto.ErrorResponse "Mensagem inválida ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de envio da sessão excedido (ver header Retry-After)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno ou mensagem rejeitada pelo WhatsApp"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send/list [post]
+func (h *MessageHandler) SendList(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendListRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendListRequest{
+		SessionID:   sess.ID(),
+		To:          req.To,
+		CountryCode: req.CountryCode,
+		Body:        req.Body,
+		ButtonText:  req.ButtonText,
+		Sections:    req.ToSections(),
+	}
+	result, err := h.sendListUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendInteractiveResponse{
+		To:        result.To,
+		MessageID: result.MessageID,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "List message sent successfully", response)
+}
+
+// ForwardMessage handles POST /sessions/{id}/messages/{messageId}/forward
+// @Summary Encaminhar mensagem
+// @Description Reenvia uma mensagem armazenada para outro contato ou grupo, marcada como encaminhada, e retorna o ID da nova mensagem.
+// @Description
+// @Description Informe em `chat` a conversa em que a mensagem foi recebida ou enviada. Mensagens de texto e de mídia (imagem, vídeo, áudio, documento e sticker) podem ser encaminhadas; as mídias são reenviadas com os dados do upload original, sem novo download. Somente mensagens recebidas ou enviadas enquanto a sessão estava conectada ficam armazenadas.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param messageId path string true "ID da mensagem no WhatsApp"
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param request body dto.ForwardMessageRequest true "Conversa de origem e destinatário"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ForwardMessageResponse} "Mensagem encaminhada"
+// @Failure 400 {object} dto.ErrorResponse "Requisição inválida ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada, mensagem não armazenada nesta conversa ou sem mídia"
+// @Failure 422 {object} dto.ErrorResponse "Tipo de mensagem não pode ser encaminhado"
+// @Failure 429 {object} dto.ErrorResponse "Limite de envio da sessão excedido (ver header Retry-After)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno ou mensagem rejeitada pelo WhatsApp"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/{messageId}/forward [post]
+func (h *MessageHandler) ForwardMessage(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	messageID := chi.URLParam(r, "messageId")
+	if messageID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Message ID is required", nil)
+		return
+	}
+
+	var req dto.ForwardMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ForwardMessageRequest{
+		SessionID:   sess.ID(),
+		MessageID:   messageID,
+		Chat:        req.Chat,
+		To:          req.To,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.forwardUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.ForwardMessageResponse{
+		To:                result.To,
+		OriginalMessageID: result.OriginalMessageID,
+		MessageID:         result.MessageID,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Message forwarded successfully", response)
+}
+
+// SendBroadcast handles POST /sessions/{id}/send/broadcast
+// @Summary Enviar mensagem para vários destinatários
+// @Description Envia a mesma mensagem de texto para cada destinatário, um de cada vez, e retorna o resultado por destinatário com o ID da mensagem.
+// @Description
+// @Description Os envios são espaçados por WHATSAPP_BROADCAST_DELAY para reduzir o risco de a conta ser marcada como spam, então a resposta pode demorar. A quantidade de destinatários é limitada por WHATSAPP_BROADCAST_MAX_RECIPIENTS.
+// @Description
+// @Description Destinatários inválidos, repetidos ou recusados pelo limite de envio da sessão não interrompem o envio: aparecem como falha nos resultados. A mensagem não é enviada como lista de transmissão do WhatsApp; cada destinatário recebe uma mensagem individual.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param request body dto.SendBroadcastRequest true "Mensagem e destinatários"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendBroadcastResponse} "Envio concluído"
+// @Failure 400 {object} dto.ErrorResponse "Mensagem inválida, destinatários demais ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send/broadcast [post]
+func (h *MessageHandler) SendBroadcast(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendBroadcastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendBroadcastRequest{
+		SessionID:   sess.ID(),
+		To:          req.To,
+		CountryCode: req.CountryCode,
+		Message:     req.Message,
+	}
+	result, err := h.sendBroadcastUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendBroadcastResponse{
+		TotalCount:   result.TotalCount,
+		SuccessCount: result.SuccessCount,
+		FailedCount:  result.FailedCount,
+		Results:      make([]dto.BroadcastRecipientResponse, 0, len(result.Results)),
+	}
+	for _, recipient := range result.Results {
+		response.Results = append(response.Results, dto.BroadcastRecipientResponse{
+			To:        recipient.To,
+			JID:       recipient.JID,
+			MessageID: recipient.MessageID,
+			Success:   recipient.Success,
+			Error:     recipient.Error,
+		})
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Broadcast completed", response)
+}
+
+// ScheduleMessage handles POST /sessions/{id}/messages/schedule
+// @Summary Agendar mensagem
+// @Description Agenda uma mensagem de texto para ser enviada em `send_at` e retorna o ID do agendamento.
+// @Description
+// @Description A sessão não precisa estar conectada ao agendar. Mensagens vencidas de sessões desconectadas ficam pendentes e são enviadas quando a sessão se conectar. Os agendamentos são persistidos e sobrevivem a reinicializações; uma mensagem que estava sendo enviada durante uma reinicialização é marcada como falha em vez de ser reenviada.
+// @Description
+// @Description O envio é verificado a cada WHATSAPP_SCHEDULER_INTERVAL, então pode ocorrer alguns segundos após `send_at`. Falhas de envio são tentadas até 3 vezes.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param request body dto.ScheduleMessageRequest true "Mensagem e data de envio"
+// @Success 201 {object} dto.SuccessResponse{data=dto.ScheduledMessageResponse} "Mensagem agendada"
+// @Failure 400 {object} dto.ErrorResponse "Destinatário, texto ou data de envio inválidos"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/schedule [post]
+func (h *MessageHandler) ScheduleMessage(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.ScheduleMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ScheduleMessageRequest{
+		SessionID:   sess.ID(),
+		To:          req.To,
+		CountryCode: req.CountryCode,
+		Body:        req.Body,
+		SendAt:      req.SendAt,
+	}
+	result, err := h.scheduleUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToScheduledMessageResponse(result.Message)
+	h.writeSuccessResponse(w, http.StatusCreated, "Message scheduled successfully", response)
+}
+
+// ListScheduledMessages handles GET /sessions/{id}/messages/schedule
+// @Summary Listar mensagens agendadas
+// @Description Lista as mensagens agendadas da sessão por data de envio, opcionalmente filtradas pela situação.
+// @Tags Messages
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param status query string false "Situação dos agendamentos" Enums(pending, sending, sent, failed, canceled)
+// @Success 200 {object} dto.SuccessResponse{data=dto.ScheduledMessageListResponse} "Mensagens agendadas"
+// @Failure 400 {object} dto.ErrorResponse "Situação inválida"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/schedule [get]
+func (h *MessageHandler) ListScheduledMessages(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.ListScheduledMessagesRequest{
+		SessionID: sess.ID(),
+		Status:    whatsapp.ScheduledMessageStatus(strings.ToLower(r.URL.Query().Get("status"))),
+	}
+	result, err := h.listScheduledUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.ScheduledMessageListResponse{
+		Messages: make([]dto.ScheduledMessageResponse, 0, len(result.Messages)),
+		Total:    len(result.Messages),
+	}
+	for _, message := range result.Messages {
+		response.Messages = append(response.Messages, dto.ToScheduledMessageResponse(message))
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Scheduled messages retrieved successfully", response)
+}
+
+// CancelScheduledMessage handles DELETE /sessions/{id}/messages/schedule/{scheduleId}
+// @Summary Cancelar mensagem agendada
+// @Description Cancela uma mensagem agendada que ainda não foi enviada. O agendamento é mantido com a situação `canceled`.
+// @Tags Messages
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param scheduleId path string true "ID do agendamento"
+// @Success 200 {object} dto.SuccessResponse{data=dto.ScheduledMessageResponse} "Agendamento cancelado"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou agendamento não encontrado"
+// @Failure 409 {object} dto.ErrorResponse "Mensagem já enviada, em envio, com falha ou cancelada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/messages/schedule/{scheduleId} [delete]
+func (h *MessageHandler) CancelScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.CancelScheduledMessageRequest{
+		SessionID: sess.ID(),
+		ID:        chi.URLParam(r, "scheduleId"),
+	}
+	result, err := h.cancelScheduleUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToScheduledMessageResponse(result.Message)
+	h.writeSuccessResponse(w, http.StatusOK, "Scheduled message canceled successfully", response)
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// NewsletterHandler handles channel (newsletter) HTTP requests
+type NewsletterHandler struct {
+	getNewsletterUC *whatsappUC.GetNewsletterUseCase
+	sendMessageUC   *whatsappUC.SendNewsletterMessageUseCase
+
+	baseHandler
+}
+
+// NewNewsletterHandler creates a new newsletter handler
+func NewNewsletterHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	getNewsletterUC *whatsappUC.GetNewsletterUseCase,
+	sendMessageUC *whatsappUC.SendNewsletterMessageUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *NewsletterHandler {
+	return &NewsletterHandler{
+		getNewsletterUC: getNewsletterUC,
+		sendMessageUC:   sendMessageUC,
+		baseHandler:     newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// GetNewsletter handles GET /sessions/{id}/newsletters/{jid}
+// @Summary Obter metadados do canal
+// @Description Retorna os metadados de um canal (newsletter) do WhatsApp: nome, descrição, convite, seguidores, estado e o papel da sessão no canal.
+// @Description O JID precisa terminar com `@newsletter`.
+// @Tags Newsletters
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param jid path string true "JID do canal" example("120363144038483540@newsletter")
+// @Success 200 {object} dto.SuccessResponse{data=dto.NewsletterResponse} "Metadados do canal"
+// @Failure 400 {object} dto.ErrorResponse "JID do canal inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou canal não encontrado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/newsletters/{jid} [get]
+func (h *NewsletterHandler) GetNewsletter(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetNewsletterRequest{
+		SessionID:     sess.ID(),
+		NewsletterJID: chi.URLParam(r, "jid"),
+	}
+	result, err := h.getNewsletterUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToNewsletterResponse(result.Newsletter)
+	h.writeSuccessResponse(w, http.StatusOK, "Newsletter retrieved successfully", response)
+}
+
+// SendMessage handles POST /sessions/{id}/newsletters/{jid}/send
+// @Summary Publicar mensagem no canal
+// @Description Publica uma mensagem de texto em um canal (newsletter). Apenas donos e administradores do canal podem publicar.
+// @Description O JID precisa terminar com `@newsletter`.
+// @Tags Newsletters
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param jid path string true "JID do canal" example("120363144038483540@newsletter")
+// @Param Idempotency-Key header string false "Chave de idempotência: uma nova requisição com a mesma chave retorna o resultado original sem reenviar"
+// @Param request body dto.SendNewsletterMessageRequest true "Mensagem a publicar"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendNewsletterMessageResponse} "Mensagem publicada"
+// @Failure 400 {object} dto.ErrorResponse "JID do canal ou mensagem inválidos, ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de envio da sessão excedido (ver header Retry-After)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/newsletters/{jid}/send [post]
+func (h *NewsletterHandler) SendMessage(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendNewsletterMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendNewsletterMessageRequest{
+		SessionID:     sess.ID(),
+		NewsletterJID: chi.URLParam(r, "jid"),
+		Text:          req.Text,
+	}
+	result, err := h.sendMessageUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendNewsletterMessageResponse{
+		NewsletterJID: result.NewsletterJID,
+		Success:       result.Success,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Newsletter message sent successfully", response)
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// PresenceHandler handles presence-related HTTP requests
+type PresenceHandler struct {
+	subscribePresenceUC *whatsappUC.SubscribePresenceUseCase
+	sendPresenceUC      *whatsappUC.SendPresenceUseCase
+	sendChatPresenceUC  *whatsappUC.SendChatPresenceUseCase
+
+	baseHandler
+}
+
+// NewPresenceHandler creates a new presence handler
+func NewPresenceHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	subscribePresenceUC *whatsappUC.SubscribePresenceUseCase,
+	sendPresenceUC *whatsappUC.SendPresenceUseCase,
+	sendChatPresenceUC *whatsappUC.SendChatPresenceUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *PresenceHandler {
+	return &PresenceHandler{
+		subscribePresenceUC: subscribePresenceUC,
+		sendPresenceUC:      sendPresenceUC,
+		sendChatPresenceUC:  sendChatPresenceUC,
+		baseHandler:         newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// SubscribePresence handles POST /sessions/{id}/presence/subscribe
+// @Summary Acompanhar presença de um contato
+// @Description Inscreve a sessão nas atualizações de presença (online/offline) de um contato. As atualizações chegam como eventos de presença.
+// @Description
+// @Description **Pré-requisito:** o WhatsApp só entrega presença de outros usuários para sessões que se marcaram como disponíveis na conexão atual; sem isso a inscrição é ignorada silenciosamente.
+// @Description - Com `WHATSAPP_AUTO_SEND_PRESENCE=true` (padrão) a sessão é marcada como disponível automaticamente e `presence_sent` indica quando isso ocorreu
+// @Description - Com `WHATSAPP_AUTO_SEND_PRESENCE=false` a requisição falha com 409 até a presença ser enviada
+// @Tags Presence
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param request body dto.SubscribePresenceRequest true "Contato"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SubscribePresenceResponse} "Inscrição realizada"
+// @Failure 400 {object} dto.ErrorResponse "Dados inválidos ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "Presença não enviada (envio automático desativado) ou nome de perfil ainda não sincronizado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/presence/subscribe [post]
+func (h *PresenceHandler) SubscribePresence(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SubscribePresenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SubscribePresenceRequest{
+		SessionID:   sess.ID(),
+		JID:         req.JID,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.subscribePresenceUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SubscribePresenceResponse{
+		JID:          result.JID,
+		PresenceSent: result.PresenceSent,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Subscribed to presence", response)
+}
+
+// SendPresence handles POST /sessions/{id}/presence
+// @Summary Definir presença da sessão
+// @Description Marca a sessão como disponível (online) ou indisponível (offline) para os contatos.
+// @Description
+// @Description Enquanto a sessão estiver como `unavailable` o celular continua recebendo notificações e os indicadores de digitação podem não ser exibidos aos contatos.
+// @Tags Presence
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param request body dto.SendPresenceRequest true "Estado de presença"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendPresenceResponse} "Presença atualizada"
+// @Failure 400 {object} dto.ErrorResponse "Estado inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "Nome de perfil ainda não sincronizado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/presence [post]
+func (h *PresenceHandler) SendPresence(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendPresenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendPresenceRequest{
+		SessionID: sess.ID(),
+		State:     req.State,
+	}
+	result, err := h.sendPresenceUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendPresenceResponse{
+		State: result.State.String(),
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Presence updated", response)
+}
+
+// SendChatPresence handles POST /sessions/{id}/chats/{chat}/presence
+// @Summary Indicar digitação em um chat
+// @Description Exibe (`composing`) ou remove (`paused`) o indicador "digitando…" em um chat individual ou grupo.
+// @Description
+// @Description O indicador expira sozinho no aparelho do contato após alguns segundos; envie `composing` novamente para mantê-lo. Com `WHATSAPP_AUTO_SEND_PRESENCE=true` (padrão) a sessão é marcada como disponível antes, pois contatos offline não exibem o indicador.
+// @Tags Presence
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param chat path string true "Número de telefone, JID do contato ou JID do grupo"
+// @Param request body dto.SendChatPresenceRequest true "Estado de digitação"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SendChatPresenceResponse} "Estado de digitação enviado"
+// @Failure 400 {object} dto.ErrorResponse "Estado inválido ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "Nome de perfil ainda não sincronizado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/chats/{chat}/presence [post]
+func (h *PresenceHandler) SendChatPresence(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SendChatPresenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SendChatPresenceRequest{
+		SessionID:   sess.ID(),
+		Chat:        chi.URLParam(r, "chat"),
+		State:       req.State,
+		CountryCode: req.CountryCode,
+	}
+	result, err := h.sendChatPresenceUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := &dto.SendChatPresenceResponse{
+		Chat:         result.Chat,
+		State:        result.State.String(),
+		PresenceSent: result.PresenceSent,
+	}
+	h.writeSuccessResponse(w, http.StatusOK, "Chat presence sent", response)
+}
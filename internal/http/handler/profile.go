@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wazmeow/internal/http/dto"
+	sessionUC "wazmeow/internal/usecases/session"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// maxAvatarUploadSize is the largest accepted profile picture upload (10 MB)
+const maxAvatarUploadSize = 10 << 20
+
+// ProfileHandler handles HTTP requests about the profile of the session account
+type ProfileHandler struct {
+	setProfilePictureUC *whatsappUC.SetProfilePictureUseCase
+
+	baseHandler
+}
+
+// NewProfileHandler creates a new profile handler
+func NewProfileHandler(
+	resolveUC *sessionUC.ResolveUseCase,
+	setProfilePictureUC *whatsappUC.SetProfilePictureUseCase,
+	logger logger.Logger,
+	validator validator.Validator,
+) *ProfileHandler {
+	return &ProfileHandler{
+		setProfilePictureUC: setProfilePictureUC,
+		baseHandler:         newBaseHandler(resolveUC, logger, validator),
+	}
+}
+
+// SetProfilePicture handles PUT /sessions/{id}/profile/avatar
+// @Summary Definir foto de perfil da sessão
+// @Description Atualiza a foto de perfil da conta WhatsApp da sessão.
+// @Description
+// @Description A imagem (JPEG, PNG ou GIF, até 10 MB) é recortada no centro para ficar quadrada, redimensionada para no máximo 640x640 e convertida em JPEG antes do envio.
+// @Tags Profile
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param image formData file true "Imagem da foto de perfil"
+// @Success 200 {object} dto.SuccessResponse{data=dto.SetProfilePictureResponse} "Foto de perfil atualizada"
+// @Failure 400 {object} dto.ErrorResponse "Imagem ausente ou inválida, ou sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/profile/avatar [put]
+func (h *ProfileHandler) SetProfilePicture(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Read the uploaded image
+	r.Body = http.MaxBytesReader(w, r.Body, maxAvatarUploadSize+(1<<20))
+	if err := r.ParseMultipartForm(maxAvatarUploadSize); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid multipart form (maximum 10 MB)", err)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("image")
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Image file is required in the 'image' field", err)
+		return
+	}
+	defer file.Close()
+
+	imageData, err := io.ReadAll(file)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Failed to read image file", err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.SetProfilePictureRequest{
+		SessionID: sess.ID(),
+		ImageData: imageData,
+	}
+	result, err := h.setProfilePictureUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToSetProfilePictureResponse(result.SessionID.String(), result.PictureID)
+	h.writeSuccessResponse(w, http.StatusOK, "Profile picture updated successfully", response)
+}
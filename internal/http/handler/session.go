@@ -1,64 +1,125 @@
 package handler
 
 import (
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
 	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 	"wazmeow/internal/http/dto"
 	sessionUC "wazmeow/internal/usecases/session"
 	whatsappUC "wazmeow/internal/usecases/whatsapp"
-	"wazmeow/pkg/errors"
+	"wazmeow/pkg/crypto"
 	"wazmeow/pkg/logger"
 	"wazmeow/pkg/validator"
 )
 
+// backupPassphraseHeader carries the passphrase that encrypts exported backups, keeping it out of URLs
+const backupPassphraseHeader = "X-Backup-Passphrase"
+
 // SessionHandler handles session-related HTTP requests
 type SessionHandler struct {
-	createUC     *sessionUC.CreateUseCase
-	connectUC    *sessionUC.ConnectUseCase
-	disconnectUC *sessionUC.DisconnectUseCase
-	listUC       *sessionUC.ListUseCase
-	deleteUC     *sessionUC.DeleteUseCase
-	resolveUC    *sessionUC.ResolveUseCase
-	setProxyUC   *sessionUC.SetProxyUseCase
+	createUC         *sessionUC.CreateUseCase
+	connectUC        *sessionUC.ConnectUseCase
+	connectStatusUC  *sessionUC.ConnectStatusUseCase
+	disconnectUC     *sessionUC.DisconnectUseCase
+	restartUC        *sessionUC.RestartUseCase
+	liveStatusUC     *sessionUC.LiveStatusUseCase
+	connectAllUC     *sessionUC.ConnectAllUseCase
+	disconnectAllUC  *sessionUC.DisconnectAllUseCase
+	listUC           *sessionUC.ListUseCase
+	batchCreateUC    *sessionUC.BatchCreateUseCase
+	exportUC         *sessionUC.ExportUseCase
+	deleteUC         *sessionUC.DeleteUseCase
+	setProxyUC       *sessionUC.SetProxyUseCase
+	rotateProxyUC    *sessionUC.RotateProxyUseCase
+	setDisplayNameUC *sessionUC.SetDisplayNameUseCase
+	renameUC         *sessionUC.RenameUseCase
+	pairingHistoryUC *sessionUC.PairingHistoryUseCase
+	setSendersUC     *sessionUC.SetAllowedSendersUseCase
+	setSendRateUC    *sessionUC.SetSendRateLimitUseCase
+	exportBackupUC   *sessionUC.ExportBackupUseCase
+	importBackupUC   *sessionUC.ImportBackupUseCase
 
 	// WhatsApp use cases
-	generateQRUC *whatsappUC.GenerateQRUseCase
-	pairPhoneUC  *whatsappUC.PairPhoneUseCase
+	generateQRUC    *whatsappUC.GenerateQRUseCase
+	pairPhoneUC     *whatsappUC.PairPhoneUseCase
+	getSyncStatusUC *whatsappUC.GetSyncStatusUseCase
+	getHealthUC     *whatsappUC.GetHealthUseCase
+
+	converter *dto.BatchConverter
 
-	logger    logger.Logger
-	validator validator.Validator
+	baseHandler
 }
 
 // NewSessionHandler creates a new session handler
 func NewSessionHandler(
 	createUC *sessionUC.CreateUseCase,
 	connectUC *sessionUC.ConnectUseCase,
+	connectStatusUC *sessionUC.ConnectStatusUseCase,
 	disconnectUC *sessionUC.DisconnectUseCase,
+	restartUC *sessionUC.RestartUseCase,
+	liveStatusUC *sessionUC.LiveStatusUseCase,
+	connectAllUC *sessionUC.ConnectAllUseCase,
+	disconnectAllUC *sessionUC.DisconnectAllUseCase,
 	listUC *sessionUC.ListUseCase,
+	batchCreateUC *sessionUC.BatchCreateUseCase,
+	exportUC *sessionUC.ExportUseCase,
 	deleteUC *sessionUC.DeleteUseCase,
 	resolveUC *sessionUC.ResolveUseCase,
 	setProxyUC *sessionUC.SetProxyUseCase,
+	rotateProxyUC *sessionUC.RotateProxyUseCase,
+	setDisplayNameUC *sessionUC.SetDisplayNameUseCase,
+	renameUC *sessionUC.RenameUseCase,
+	pairingHistoryUC *sessionUC.PairingHistoryUseCase,
+	setSendersUC *sessionUC.SetAllowedSendersUseCase,
+	setSendRateUC *sessionUC.SetSendRateLimitUseCase,
+	exportBackupUC *sessionUC.ExportBackupUseCase,
+	importBackupUC *sessionUC.ImportBackupUseCase,
 	generateQRUC *whatsappUC.GenerateQRUseCase,
 	pairPhoneUC *whatsappUC.PairPhoneUseCase,
+	getSyncStatusUC *whatsappUC.GetSyncStatusUseCase,
+	getHealthUC *whatsappUC.GetHealthUseCase,
+	converter *dto.BatchConverter,
 	logger logger.Logger,
 	validator validator.Validator,
 ) *SessionHandler {
 	return &SessionHandler{
-		createUC:     createUC,
-		connectUC:    connectUC,
-		disconnectUC: disconnectUC,
-		listUC:       listUC,
-		deleteUC:     deleteUC,
-		resolveUC:    resolveUC,
-		setProxyUC:   setProxyUC,
-		generateQRUC: generateQRUC,
-		pairPhoneUC:  pairPhoneUC,
-		logger:       logger,
-		validator:    validator,
+		createUC:         createUC,
+		connectUC:        connectUC,
+		connectStatusUC:  connectStatusUC,
+		disconnectUC:     disconnectUC,
+		restartUC:        restartUC,
+		liveStatusUC:     liveStatusUC,
+		connectAllUC:     connectAllUC,
+		disconnectAllUC:  disconnectAllUC,
+		listUC:           listUC,
+		batchCreateUC:    batchCreateUC,
+		exportUC:         exportUC,
+		deleteUC:         deleteUC,
+		setProxyUC:       setProxyUC,
+		rotateProxyUC:    rotateProxyUC,
+		setDisplayNameUC: setDisplayNameUC,
+		renameUC:         renameUC,
+		pairingHistoryUC: pairingHistoryUC,
+		setSendersUC:     setSendersUC,
+		setSendRateUC:    setSendRateUC,
+		exportBackupUC:   exportBackupUC,
+		importBackupUC:   importBackupUC,
+		generateQRUC:     generateQRUC,
+		pairPhoneUC:      pairPhoneUC,
+		getSyncStatusUC:  getSyncStatusUC,
+		getHealthUC:      getHealthUC,
+		converter:        converter,
+		baseHandler:      newBaseHandler(resolveUC, logger, validator),
 	}
 }
 
@@ -73,8 +134,12 @@ func NewSessionHandler(
 // @Tags Sessions
 // @Accept json
 // @Produce json
+// @Description
+// @Description Com `validate_only=true`, executa todas as validações (regras do nome, proxy e unicidade do nome) sem persistir nada e retorna 200 com a sessão que seria criada em `would_create`. O ID retornado é ilustrativo e não fica reservado.
 // @Param request body dto.CreateSessionRequest true "Dados da sessão"
-// @Success 201 {object} dto.SuccessResponse{data=dto.SessionResponse} "Sessão criada com sucesso"
+// @Param validate_only query bool false "Apenas valida os dados, sem criar a sessão"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.ValidateSessionResponse] "Dados válidos (validate_only=true)"
+// @Success 201 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Sessão criada com sucesso"
 // @Failure 400 {object} dto.ErrorResponse "Dados inválidos (nome muito curto, proxy inválido, etc.)"
 // @Failure 409 {object} dto.ErrorResponse "Sessão com este nome já existe"
 // @Failure 422 {object} dto.ErrorResponse "Dados válidos mas incompatíveis (ex: username sem password)"
@@ -93,7 +158,12 @@ func (h *SessionHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
 
 	// Validate proxy parameters if provided
 	if req.HasProxy() && !req.ProxyType.IsValid() {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid proxy type. Must be 'http' or 'socks5'", nil)
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid proxy type. Must be 'http', 'socks4' or 'socks5'", nil)
+		return
+	}
+
+	if validateOnly, _ := strconv.ParseBool(r.URL.Query().Get("validate_only")); validateOnly {
+		h.validateCreateSession(w, r, &req)
 		return
 	}
 
@@ -118,7 +188,7 @@ func (h *SessionHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
 
 		_, err := h.setProxyUC.Execute(r.Context(), setProxyReq)
 		if err != nil {
-			h.logger.ErrorWithError("failed to configure proxy during session creation", err, logger.Fields{
+			h.requestLogger(r).ErrorWithError("failed to configure proxy during session creation", err, logger.Fields{
 				"session_id": result.Session.ID().String(),
 				"proxy_host": req.ProxyHost,
 			})
@@ -135,7 +205,108 @@ func (h *SessionHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
 
 	// Convert to HTTP response (this will include proxy_config if configured)
 	response := dto.ToSessionResponse(result.Session)
-	h.writeSuccessResponse(w, http.StatusCreated, "Session created successfully", response)
+	writeTypedSuccessResponse(w, http.StatusCreated, "Session created successfully", response)
+}
+
+// validateCreateSession answers POST /sessions/add?validate_only=true without persisting anything
+func (h *SessionHandler) validateCreateSession(w http.ResponseWriter, r *http.Request, req *dto.CreateSessionRequest) {
+	// Proxy fields are only checked here; a proxy that fails on creation is logged, not rejected
+	if err := h.validator.Validate(req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request data", err)
+		return
+	}
+
+	proxyURL, err := req.BuildProxyURL()
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid proxy configuration", err)
+		return
+	}
+
+	result, err := h.createUC.ValidateOnly(r.Context(), sessionUC.CreateRequest{Name: req.Name})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+	result.Session.SetProxyURL(proxyURL)
+
+	response := &dto.ValidateSessionResponse{
+		Valid:       true,
+		WouldCreate: dto.ToSessionResponse(result.Session),
+	}
+	writeTypedSuccessResponse(w, http.StatusOK, "Session data is valid", response)
+}
+
+// CreateSessionsBatch handles POST /sessions/batch
+// @Summary Criar sessões WhatsApp em lote
+// @Description Cria várias sessões em uma única requisição, no mesmo formato de /sessions/add. Cada sessão é criada de forma independente: uma falha (ex: nome duplicado) não interrompe o lote.
+// @Description
+// @Description **Limites:** no máximo 50 sessões por requisição.
+// @Description
+// @Description **Exemplo:** `{"sessions": [{"name": "sessao-1"}, {"name": "sessao-2", "proxy_host": "78.24.204.134", "proxy_port": 62122}]}`
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param request body dto.BatchCreateSessionsRequest true "Sessões a criar"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.BatchCreateSessionsResponse] "Lote processado, com o resultado de cada sessão"
+// @Failure 400 {object} dto.ErrorResponse "Lote vazio ou com mais de 50 sessões"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/batch [post]
+func (h *SessionHandler) CreateSessionsBatch(w http.ResponseWriter, r *http.Request) {
+	var req dto.BatchCreateSessionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if len(req.Sessions) == 0 || len(req.Sessions) > sessionUC.MaxBatchCreateSize {
+		h.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Batch must contain between 1 and %d sessions", sessionUC.MaxBatchCreateSize), nil)
+		return
+	}
+
+	items := make([]sessionUC.BatchCreateItem, 0, len(req.Sessions))
+	for _, item := range req.Sessions {
+		item.Normalize()
+		items = append(items, sessionUC.BatchCreateItem{
+			Name:      item.Name,
+			ProxyHost: item.ProxyHost,
+			ProxyPort: item.ProxyPort,
+			ProxyType: item.ProxyType.String(),
+			Username:  item.Username,
+			Password:  item.Password,
+		})
+	}
+
+	// Execute use case
+	result, err := h.batchCreateUC.Execute(r.Context(), sessionUC.BatchCreateRequest{Items: items})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := &dto.BatchCreateSessionsResponse{
+		Results: make([]dto.BatchCreateSessionResult, 0, len(result.Results)),
+		Created: result.Created,
+		Failed:  result.Failed,
+	}
+	for _, item := range result.Results {
+		itemResponse := dto.BatchCreateSessionResult{
+			Index:   item.Index,
+			Name:    item.Name,
+			Success: item.Error == nil,
+		}
+		if item.Error != nil {
+			itemResponse.Error = item.Error.Error()
+		} else {
+			itemResponse.Session = dto.ToSessionResponse(item.Session)
+		}
+		if item.ProxyError != nil {
+			itemResponse.ProxyError = item.ProxyError.Error()
+		}
+		response.Results = append(response.Results, itemResponse)
+	}
+
+	writeTypedSuccessResponse(w, http.StatusOK, "Batch processed successfully", response)
 }
 
 // ListSessions handles GET /sessions/list
@@ -143,59 +314,185 @@ func (h *SessionHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
 // @Description Lista todas as sessões WhatsApp registradas no sistema com informações detalhadas incluindo status, configuração de proxy e timestamps.
 // @Description
 // @Description **Filtros disponíveis:**
-// @Description - `status`: Filtra sessões por status (disconnected, connecting, connected)
+// @Description - `status`: Filtra sessões por status (disconnected, connecting, connected, error)
+// @Description - `name_contains`: Filtra sessões cujo nome contém o trecho (sem diferenciar maiúsculas)
+// @Description - `created_after` / `created_before`: Intervalo de criação (RFC 3339)
+// @Description - `is_active`: Filtra sessões ativas ou inativas
+// @Description - `sort` / `order`: Ordenação (padrão `created_at` `desc`)
+// @Description
+// @Description **Paginação:** `limit` (padrão 10, máximo 100) com `offset` ou `page`. Use `all=true` para retornar todas as sessões de uma vez.
 // @Description
 // @Description **Resposta inclui:**
 // @Description - Lista de sessões com configuração completa
 // @Description - Total de sessões encontradas
 // @Description - Informações de proxy (se configurado)
+// @Description - Filtros e ordenação aplicados
+// @Description - Metadados de paginação
+// @Description
+// @Description Sessões usam paginação por offset, adequada a listas pequenas. Listas grandes (contatos, mensagens) usam cursor (`next_cursor`), cujo custo não cresce com a profundidade da página.
 // @Tags Sessions
 // @Accept json
 // @Produce json
-// @Param status query string false "Filtrar por status da sessão" Enums(disconnected, connecting, connected)
-// @Success 200 {object} dto.SuccessResponse{data=dto.SessionListResponse} "Lista de sessões recuperada com sucesso"
+// @Param status query string false "Filtrar por status da sessão" Enums(disconnected, connecting, connected, error)
+// @Param name_contains query string false "Trecho contido no nome da sessão"
+// @Param created_after query string false "Criadas a partir desta data (RFC 3339)" example(2024-01-01T00:00:00Z)
+// @Param created_before query string false "Criadas até esta data (RFC 3339)" example(2024-12-31T23:59:59Z)
+// @Param is_active query bool false "Filtrar por sessões ativas"
+// @Param sort query string false "Campo de ordenação" Enums(created_at, name, updated_at) default(created_at)
+// @Param order query string false "Direção da ordenação" Enums(asc, desc) default(desc)
+// @Param limit query int false "Itens por página (1-100)" default(10)
+// @Param offset query int false "Itens a pular"
+// @Param page query int false "Número da página (alternativa ao offset)"
+// @Param all query bool false "Retornar todas as sessões, sem paginação"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionListResponse] "Lista de sessões recuperada com sucesso"
 // @Failure 400 {object} dto.ErrorResponse "Parâmetros de filtro inválidos"
 // @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
 // @Security ApiKeyAuth
 // @Router /sessions/list [get]
 func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
 	// Parse query parameters
-	statusStr := r.URL.Query().Get("status")
-
-	var result *sessionUC.ListResponse
-	var err error
+	query := r.URL.Query()
+	filter := session.ListFilter{
+		NameContains: strings.TrimSpace(query.Get("name_contains")),
+	}
 
-	if statusStr != "" {
-		// List by status
-		status, parseErr := session.StatusFromString(statusStr)
-		if parseErr != nil {
-			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid status parameter", parseErr)
+	if statusStr := query.Get("status"); statusStr != "" {
+		status, err := session.StatusFromString(statusStr)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid status parameter", err)
 			return
 		}
+		filter.Status = &status
+	}
 
-		ucReq := sessionUC.ListByStatusRequest{
-			Status: status,
-			Limit:  0, // 0 means no limit - return all
-			Offset: 0,
+	if isActiveStr := query.Get("is_active"); isActiveStr != "" {
+		isActive, err := strconv.ParseBool(isActiveStr)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid is_active parameter", err)
+			return
 		}
-		result, err = h.listUC.ExecuteByStatus(r.Context(), ucReq)
-	} else {
-		// List all
-		ucReq := sessionUC.ListRequest{
-			Limit:  0, // 0 means no limit - return all
-			Offset: 0,
+		filter.IsActive = &isActive
+	}
+
+	var err error
+	if filter.CreatedAfter, err = parseTimeQueryParam(query.Get("created_after")); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid created_after parameter (expected RFC 3339)", err)
+		return
+	}
+	if filter.CreatedBefore, err = parseTimeQueryParam(query.Get("created_before")); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid created_before parameter (expected RFC 3339)", err)
+		return
+	}
+
+	all := false
+	if allStr := query.Get("all"); allStr != "" {
+		if all, err = strconv.ParseBool(allStr); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid all parameter", err)
+			return
 		}
-		result, err = h.listUC.Execute(r.Context(), ucReq)
 	}
 
+	pagination := dto.PaginationRequest{
+		Limit:  queryInt(r, "limit", 0),
+		Offset: queryInt(r, "offset", 0),
+		Page:   queryInt(r, "page", 0),
+	}
+	pagination.Normalize()
+
+	ucReq := sessionUC.ListRequest{
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+		All:    all,
+		Filter: filter,
+		Sort:   query.Get("sort"),
+		Order:  query.Get("order"),
+	}
+	result, err := h.listUC.Execute(r.Context(), ucReq)
 	if err != nil {
 		h.handleUseCaseError(w, err)
 		return
 	}
 
 	// Convert to HTTP response
-	response := dto.ToSessionListResponse(result.Sessions, result.Total)
-	h.writeSuccessResponse(w, http.StatusOK, "Sessions retrieved successfully", response)
+	response := h.converter.ToSessionListResponse(result.Sessions, result.Total)
+	response.Filters = dto.ToSessionListFilters(result.Filter, result.Sort, result.Order)
+	if !all {
+		response.Pagination = dto.NewPaginationResponse(result.Total, result.Limit, result.Offset)
+	}
+	writeTypedSuccessResponse(w, http.StatusOK, "Sessions retrieved successfully", response)
+}
+
+// parseTimeQueryParam parses an optional RFC 3339 query parameter, returning nil when empty
+func parseTimeQueryParam(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// ExportSessions handles GET /sessions/export.csv
+// @Summary Exportar sessões em CSV
+// @Description Exporta todas as sessões WhatsApp em formato CSV (RFC 4180) para relatórios e planilhas.
+// @Description
+// @Description **Colunas:** id, name, status, jid, proxy_host, created_at, updated_at
+// @Description
+// @Description O arquivo é transmitido em lotes, sem carregar todas as sessões em memória. Datas em RFC 3339 (UTC).
+// @Tags Sessions
+// @Produce text/csv
+// @Success 200 {string} string "Arquivo CSV com as sessões"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/export.csv [get]
+func (h *SessionHandler) ExportSessions(w http.ResponseWriter, r *http.Request) {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	flusher, _ := w.(http.Flusher)
+
+	// Headers are only sent with the first batch, so failures before it still get a JSON error
+	started := false
+	start := func() {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="sessions.csv"`)
+		w.WriteHeader(http.StatusOK)
+		writer.Write(dto.SessionCSVHeader)
+		started = true
+	}
+
+	_, err := h.exportUC.Execute(r.Context(), func(sessions []*session.Session) error {
+		if !started {
+			start()
+		}
+
+		for _, sess := range sessions {
+			writer.Write(dto.ToSessionCSVRecord(sess))
+		}
+
+		writer.Flush()
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return writer.Error()
+	})
+
+	if err != nil {
+		if !started {
+			h.handleUseCaseError(w, err)
+			return
+		}
+		// The response is already streaming; the client receives a truncated file
+		h.requestLogger(r).ErrorWithError("session export interrupted", err, nil)
+		return
+	}
+
+	// No sessions: return just the header row
+	if !started {
+		start()
+		writer.Flush()
+	}
 }
 
 // GetSession handles GET /sessions/{id}/info
@@ -205,7 +502,7 @@ func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path string true "ID da sessão (UUID) ou nome da sessão"
-// @Success 200 {object} dto.SuccessResponse{data=dto.SessionResponse} "Detalhes da sessão"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Detalhes da sessão"
 // @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
 // @Failure 500 {object} dto.ErrorResponse "Erro interno"
@@ -223,7 +520,7 @@ func (h *SessionHandler) GetSession(w http.ResponseWriter, r *http.Request) {
 
 	// Convert to HTTP response
 	response := dto.ToSessionResponse(sess)
-	h.writeSuccessResponse(w, http.StatusOK, "Session retrieved successfully", response)
+	writeTypedSuccessResponse(w, http.StatusOK, "Session retrieved successfully", response)
 }
 
 // ConnectSession handles POST /sessions/{id}/connect
@@ -235,6 +532,11 @@ func (h *SessionHandler) GetSession(w http.ResponseWriter, r *http.Request) {
 // @Description 2. Sessão autenticada: Conecta diretamente ao WhatsApp
 // @Description 3. Sessão já conectada: Retorna erro 409
 // @Description
+// @Description **Modo assíncrono (padrão):** a resposta é imediata com `state: pending`; acompanhe o progresso em `GET /sessions/{id}/connect/status` até `qr-ready`, `code-ready`, `authenticated` ou `failed`.
+// @Description **Modo síncrono (`wait=true`):** a resposta aguarda até 60s pelo QR Code, código de pareamento ou login.
+// @Description
+// @Description **Falhas de conexão** trazem em `code` o motivo: `SESSION_BANNED` (403, conta banida), `SESSION_NOT_PAIRED` (409, dispositivo desvinculado, parear novamente), `WHATSAPP_UNREACHABLE`, `PROXY_CONNECTION_FAILED`, `WHATSAPP_CLIENT_OUTDATED` ou `CONNECTION_FAILED` (502).
+// @Description
 // @Description **Identificadores aceitos:**
 // @Description - UUID da sessão: `4ee6195b-6a0f-4c85-a4ee-673ee15f14c8`
 // @Description - Nome da sessão: `minha-sessao`
@@ -242,11 +544,15 @@ func (h *SessionHandler) GetSession(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
-// @Success 200 {object} dto.SuccessResponse{data=dto.ConnectSessionResponse} "Processo de conexão iniciado (QR Code gerado ou sessão conectada)"
+// @Param wait query bool false "Aguardar o QR Code ou o login antes de responder (padrão false)"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.ConnectSessionResponse] "Processo de conexão iniciado (QR Code gerado ou sessão conectada)"
 // @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido ou malformado"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada com o identificador fornecido"
-// @Failure 409 {object} dto.ErrorResponse "Sessão já está conectada"
-// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor ou falha na conexão WhatsApp"
+// @Failure 403 {object} dto.ErrorResponse "Conta banida pelo WhatsApp (SESSION_BANNED)"
+// @Failure 409 {object} dto.ErrorResponse "Sessão já está conectada ou dispositivo desvinculado (SESSION_NOT_PAIRED)"
+// @Failure 429 {object} dto.ErrorResponse "Limite de sessões simultâneas atingido (MAX_SESSIONS)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Failure 502 {object} dto.ErrorResponse "WhatsApp ou proxy inacessível, ou conexão recusada"
 // @Security ApiKeyAuth
 // @Router /sessions/{id}/connect [post]
 func (h *SessionHandler) ConnectSession(w http.ResponseWriter, r *http.Request) {
@@ -275,16 +581,71 @@ func (h *SessionHandler) ConnectSession(w http.ResponseWriter, r *http.Request)
 		Message:   result.Message,
 	}
 
-	h.writeSuccessResponse(w, http.StatusOK, "Session connection processed", response)
+	// Report the pairing state, waiting for progress in synchronous mode
+	statusReq := sessionUC.ConnectStatusRequest{SessionID: sess.ID()}
+	if wait, _ := strconv.ParseBool(r.URL.Query().Get("wait")); wait {
+		statusReq.WaitTimeout = sessionUC.DefaultConnectWait
+	}
+	if status, err := h.connectStatusUC.Execute(r.Context(), statusReq); err == nil {
+		response.Session = dto.ToSessionResponse(status.Session)
+		response.State = status.Status.State.String()
+		if status.Status.QRCode != "" {
+			response.QRCode = status.Status.QRCode
+		}
+	}
+
+	writeTypedSuccessResponse(w, http.StatusOK, "Session connection processed", response)
+}
+
+// GetConnectStatus handles GET /sessions/{id}/connect/status
+// @Summary Consultar progresso da conexão
+// @Description Retorna o progresso da conexão iniciada por `POST /sessions/{id}/connect` ou do pareamento por telefone, para consulta periódica (polling).
+// @Description
+// @Description **Estados:**
+// @Description - `pending`: conexão iniciada, aguardando QR Code
+// @Description - `qr-ready`: QR Code disponível em `qr_code` (renovado automaticamente pelo WhatsApp)
+// @Description - `code-ready`: código de pareamento disponível em `pairing_code`
+// @Description - `authenticated`: dispositivo pareado, `jid` preenchido
+// @Description - `failed`: pareamento falhou ou expirou, motivo em `error`
+// @Tags Sessions
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Success 200 {object} dto.TypedSuccessResponse[dto.ConnectStatusResponse] "Progresso da conexão"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "Nenhuma conexão iniciada para a sessão"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/connect/status [get]
+func (h *SessionHandler) GetConnectStatus(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := sessionUC.ConnectStatusRequest{SessionID: sess.ID()}
+	result, err := h.connectStatusUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToConnectStatusResponse(result.Session, result.Status)
+	writeTypedSuccessResponse(w, http.StatusOK, "Connect status retrieved", response)
 }
 
 // DeleteSession handles DELETE /sessions/{id}
 // @Summary Deletar sessão WhatsApp
-// @Description Deleta uma sessão WhatsApp específica por ID ou nome. Sempre força a deleção mesmo se conectada
+// @Description Deleta uma sessão WhatsApp específica por ID ou nome. Sempre força a deleção mesmo se conectada. As credenciais do dispositivo pareado, as mensagens, confirmações de leitura, conversas e mensagens agendadas da sessão também são removidas; se não puderem ser removidas, a sessão é mantida para nova tentativa
 // @Tags Sessions
 // @Produce json
 // @Param id path string true "ID da sessão (UUID) ou nome da sessão"
-// @Success 200 {object} dto.SuccessResponse{data=dto.DeleteSessionResponse} "Sessão deletada"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.DeleteSessionResponse] "Sessão deletada"
 // @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
 // @Failure 500 {object} dto.ErrorResponse "Erro interno"
@@ -318,120 +679,219 @@ func (h *SessionHandler) DeleteSession(w http.ResponseWriter, r *http.Request) {
 		Message:   result.Message,
 	}
 
-	h.writeSuccessResponse(w, http.StatusOK, "Session deleted", response)
+	writeTypedSuccessResponse(w, http.StatusOK, "Session deleted", response)
 }
 
-// Helper methods
+// RestartSession handles POST /sessions/{id}/restart
+// @Summary Reiniciar sessão
+// @Description Desconecta e reconecta o cliente WhatsApp da sessão. Útil quando a sessão parece conectada mas não responde.
+// @Description
+// @Description Se o cliente não estiver carregado (ex: após reiniciar o servidor), ele é recriado quando a sessão já está pareada.
+// @Tags Sessions
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Success 200 {object} dto.TypedSuccessResponse[dto.RestartSessionResponse] "Sessão reiniciada"
+// @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 403 {object} dto.ErrorResponse "Conta banida pelo WhatsApp (SESSION_BANNED)"
+// @Failure 409 {object} dto.ErrorResponse "Sessão sem dispositivo WhatsApp pareado ou dispositivo desvinculado (SESSION_NOT_PAIRED)"
+// @Failure 500 {object} dto.ErrorResponse "Falha ao reconectar o cliente WhatsApp"
+// @Failure 502 {object} dto.ErrorResponse "WhatsApp ou proxy inacessível, ou conexão recusada"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/restart [post]
+func (h *SessionHandler) RestartSession(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
 
-// resolveSessionByIdentifier resolves a session using the flexible identifier
-func (h *SessionHandler) resolveSessionByIdentifier(r *http.Request, identifierStr string) (*session.Session, error) {
-	// Validate input
-	if identifierStr == "" {
-		h.logger.WarnWithFields("empty session identifier provided", logger.Fields{
-			"request_path": r.URL.Path,
-		})
-		return nil, session.ErrInvalidSessionIdentifier
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
 	}
 
-	// Create SessionIdentifier with automatic type detection
-	identifier, err := session.NewSessionIdentifier(identifierStr)
+	result, err := h.restartUC.Execute(r.Context(), sessionUC.RestartRequest{SessionID: sess.ID()})
 	if err != nil {
-		h.logger.ErrorWithError("invalid session identifier format", err, logger.Fields{
-			"identifier":     identifierStr,
-			"request_path":   r.URL.Path,
-			"request_method": r.Method,
-		})
-		return nil, err
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := &dto.RestartSessionResponse{
+		Session: dto.ToSessionResponse(result.Session),
+		Status:  result.Status.String(),
+	}
+
+	writeTypedSuccessResponse(w, http.StatusOK, "Session restarted", response)
+}
+
+// GetLiveStatus handles GET /sessions/{id}/status
+// @Summary Obter estado de conexão em tempo real
+// @Description Consulta o cliente WhatsApp em memória para saber se a sessão está realmente conectada e autenticada.
+// @Description
+// @Description O status retornado por `/sessions/{id}/info` vem do banco de dados e pode estar desatualizado (ex: banco indica "connected" mas o socket caiu).
+// @Description Se o cliente não estiver carregado, o estado vem do banco de dados (`source: database`) e `is_connected` é sempre `false`.
+// @Tags Sessions
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Success 200 {object} dto.TypedSuccessResponse[dto.LiveStatusResponse] "Estado de conexão"
+// @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/status [get]
+func (h *SessionHandler) GetLiveStatus(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
 	}
 
-	// Use resolve use case to get the session
-	ucReq := sessionUC.ResolveRequest{Identifier: identifier}
-	result, err := h.resolveUC.Execute(r.Context(), ucReq)
+	result, err := h.liveStatusUC.Execute(r.Context(), sessionUC.LiveStatusRequest{SessionID: sess.ID()})
 	if err != nil {
-		h.logger.ErrorWithError("failed to resolve session", err, logger.Fields{
-			"identifier":      identifierStr,
-			"identifier_type": identifier.Type().String(),
-			"request_path":    r.URL.Path,
-			"request_method":  r.Method,
-		})
-		return nil, err
+		h.handleUseCaseError(w, err)
+		return
 	}
 
-	h.logger.InfoWithFields("session resolved successfully", logger.Fields{
-		"session_id":      result.Session.ID().String(),
-		"session_name":    result.Session.Name(),
-		"identifier":      identifierStr,
-		"identifier_type": result.IdentifierType,
-		"request_path":    r.URL.Path,
-	})
+	response := &dto.LiveStatusResponse{
+		SessionID:       result.Session.ID().String(),
+		Status:          result.Status,
+		StoredStatus:    result.Session.Status().String(),
+		IsConnected:     result.IsConnected,
+		IsAuthenticated: result.IsAuthenticated,
+		JID:             result.JID,
+		Source:          result.Source,
+	}
 
-	return result.Session, nil
+	writeTypedSuccessResponse(w, http.StatusOK, "Session status retrieved", response)
 }
 
-func (h *SessionHandler) writeSuccessResponse(w http.ResponseWriter, statusCode int, message string, data any) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
+// ConnectAllSessions handles POST /sessions/connect-all
+// @Summary Conectar todas as sessões
+// @Description Conecta todos os clientes WhatsApp carregados que ainda não estão conectados. Útil após janelas de manutenção.
+// @Description
+// @Description A resposta traz o resultado de cada sessão, incluindo erros de conexão individuais, e o total de clientes que mudaram de estado.
+// @Tags Sessions
+// @Produce json
+// @Success 200 {object} dto.TypedSuccessResponse[dto.BulkConnectionResponse] "Resultado da conexão de cada sessão"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/connect-all [post]
+func (h *SessionHandler) ConnectAllSessions(w http.ResponseWriter, r *http.Request) {
+	result, err := h.connectAllUC.Execute(r.Context())
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
 
-	response := dto.NewSuccessResponse(message, data)
-	json.NewEncoder(w).Encode(response)
+	writeTypedSuccessResponse(w, http.StatusOK, "Sessions connected", toBulkConnectionResponse(result))
 }
 
-func (h *SessionHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, message string, err error) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-
-	var details string
+// DisconnectAllSessions handles POST /sessions/disconnect-all
+// @Summary Desconectar todas as sessões
+// @Description Desconecta todos os clientes WhatsApp conectados, mantendo o pareamento. Útil durante janelas de manutenção.
+// @Tags Sessions
+// @Produce json
+// @Success 200 {object} dto.TypedSuccessResponse[dto.BulkConnectionResponse] "Resultado da desconexão de cada sessão"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/disconnect-all [post]
+func (h *SessionHandler) DisconnectAllSessions(w http.ResponseWriter, r *http.Request) {
+	result, err := h.disconnectAllUC.Execute(r.Context())
 	if err != nil {
-		details = err.Error()
+		h.handleUseCaseError(w, err)
+		return
 	}
 
-	response := dto.NewErrorResponse(message, "", details)
-	json.NewEncoder(w).Encode(response)
-
-	h.logger.ErrorWithError("HTTP error response", err, logger.Fields{
-		"status_code": statusCode,
-		"message":     message,
-	})
+	writeTypedSuccessResponse(w, http.StatusOK, "Sessions disconnected", toBulkConnectionResponse(result))
 }
 
-func (h *SessionHandler) handleUseCaseError(w http.ResponseWriter, err error) {
-	if appErr, ok := err.(*errors.AppError); ok {
-		h.writeErrorResponse(w, appErr.GetHTTPStatus(), appErr.Message, err)
-		return
+// toBulkConnectionResponse converts the bulk connection results to HTTP response
+func toBulkConnectionResponse(result *sessionUC.BulkConnectionResponse) *dto.BulkConnectionResponse {
+	response := &dto.BulkConnectionResponse{
+		Results: make([]dto.BulkConnectionResult, 0, len(result.Results)),
+		Total:   result.Total,
+		Changed: result.Changed,
+		Failed:  result.Failed,
 	}
 
-	// Handle domain errors
-	switch err {
-	case session.ErrSessionNotFound:
-		h.writeErrorResponse(w, http.StatusNotFound, "Session not found", err)
-	case session.ErrSessionAlreadyExists:
-		h.writeErrorResponse(w, http.StatusConflict, "Session already exists", err)
-	case session.ErrSessionAlreadyConnected:
-		h.writeErrorResponse(w, http.StatusConflict, "Session already connected", err)
-	case session.ErrSessionNotConnected:
-		h.writeErrorResponse(w, http.StatusBadRequest, "Session not connected", err)
-	case session.ErrSessionInvalidState:
-		h.writeErrorResponse(w, http.StatusBadRequest, "Session in invalid state", err)
-	default:
-		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", err)
+	for _, item := range result.Results {
+		itemResponse := dto.BulkConnectionResult{
+			SessionID: item.SessionID.String(),
+			Name:      item.Name,
+			Changed:   item.Changed,
+			Status:    item.Status.String(),
+		}
+		if item.Error != nil {
+			itemResponse.Error = item.Error.Error()
+		}
+		response.Results = append(response.Results, itemResponse)
 	}
+
+	return response
+}
+
+// DisconnectSession handles POST /sessions/{id}/disconnect
+// @Summary Desconectar sessão
+// @Description Fecha a conexão com o WhatsApp mas preserva o dispositivo pareado e suas credenciais,
+// @Description permitindo reconectar rapidamente com `/connect` sem novo QR Code.
+// @Description
+// @Description Para remover o dispositivo da conta do WhatsApp use `POST /sessions/{id}/logout?full=true`.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.DisconnectSessionResponse] "Sessão desconectada"
+// @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/disconnect [post]
+func (h *SessionHandler) DisconnectSession(w http.ResponseWriter, r *http.Request) {
+	h.disconnect(w, r, false)
 }
 
 // LogoutSession handles POST /sessions/{id}/logout
-// @Summary Desconectar sessão (logout)
-// @Description Desconecta a sessão do WhatsApp, encerrando a comunicação
+// @Summary Logout da sessão
+// @Description Com `full=true` o dispositivo é removido da conta do WhatsApp (deixa de aparecer no celular),
+// @Description as credenciais são apagadas e o JID da sessão é limpo; um novo pareamento será necessário.
+// @Description O logout completo exige que a sessão esteja conectada.
+// @Description
+// @Description **Obsoleto:** sem `full=true` esta rota apenas fecha a conexão, como `POST /sessions/{id}/disconnect`.
+// @Description Esse comportamento será removido; a resposta traz os cabeçalhos `Deprecation` e `Link` apontando para a nova rota.
 // @Tags Sessions
 // @Accept json
 // @Produce json
-// @Param id path string true "ID da sessão (UUID)"
-// @Success 200 {object} dto.SuccessResponse{data=dto.DisconnectSessionResponse} "Sessão desconectada"
-// @Failure 400 {object} dto.ErrorResponse "ID da sessão inválido"
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param full query bool false "Remover o dispositivo da conta do WhatsApp" default(false)
+// @Success 200 {object} dto.TypedSuccessResponse[dto.DisconnectSessionResponse] "Logout realizado ou sessão desconectada"
+// @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido, parâmetro full inválido ou sessão não conectada (logout completo)"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
-// @Failure 409 {object} dto.ErrorResponse "Sessão já desconectada"
 // @Failure 500 {object} dto.ErrorResponse "Erro interno"
 // @Security ApiKeyAuth
 // @Router /sessions/{id}/logout [post]
 func (h *SessionHandler) LogoutSession(w http.ResponseWriter, r *http.Request) {
+	full := false
+	if fullStr := r.URL.Query().Get("full"); fullStr != "" {
+		var err error
+		if full, err = strconv.ParseBool(fullStr); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid full parameter", err)
+			return
+		}
+	}
+
+	if !full {
+		// Plain disconnects moved to /disconnect; this fallback is kept during the deprecation period
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", fmt.Sprintf("</sessions/%s/disconnect>; rel=\"successor-version\"", chi.URLParam(r, "id")))
+	}
+
+	h.disconnect(w, r, full)
+}
+
+// disconnect closes the connection of the session, also unregistering its device when logout is set
+func (h *SessionHandler) disconnect(w http.ResponseWriter, r *http.Request, logout bool) {
 	identifierStr := chi.URLParam(r, "id")
 
 	// Resolve session using flexible identifier
@@ -442,7 +902,7 @@ func (h *SessionHandler) LogoutSession(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Execute use case with resolved session ID
-	ucReq := sessionUC.DisconnectRequest{SessionID: sess.ID()}
+	ucReq := sessionUC.DisconnectRequest{SessionID: sess.ID(), Logout: logout}
 	result, err := h.disconnectUC.Execute(r.Context(), ucReq)
 	if err != nil {
 		h.handleUseCaseError(w, err)
@@ -452,23 +912,40 @@ func (h *SessionHandler) LogoutSession(w http.ResponseWriter, r *http.Request) {
 	// Convert to HTTP response
 	response := &dto.DisconnectSessionResponse{
 		Session: dto.ToSessionResponse(result.Session),
+		Status:  result.Session.Status().String(),
 		Message: result.Message,
 	}
 
-	h.writeSuccessResponse(w, http.StatusOK, "Session disconnected", response)
+	message := "Session disconnected"
+	if logout {
+		message = "Session logged out"
+	}
+	writeTypedSuccessResponse(w, http.StatusOK, message, response)
 }
 
 // GenerateQR handles GET /sessions/{id}/qr
 // @Summary Gerar QR Code para autenticação
 // @Description Gera um QR Code para autenticação de uma sessão WhatsApp específica por ID ou nome
+// @Description
+// @Description Logo após `/connect` o primeiro QR Code pode ainda não existir: a requisição aguarda até `WHATSAPP_QR_WAIT_TIMEOUT` (padrão 5s).
+// @Description Se ele não chegar a tempo, a resposta é 202 com `qr_code` vazio; tente novamente em instantes.
+// @Description
+// @Description **Formatos:**
+// @Description - `png` (padrão): imagem PNG em base64 (data URI) no campo `qr_code`, pronta para exibir
+// @Description - `raw`: texto de pareamento no campo `raw_code`, para clientes que renderizam o QR Code
+// @Description
+// @Description `expires_at` indica quando o QR Code deixa de valer (cerca de 60 segundos após ser gerado); solicite-o novamente a partir desse momento para obter o código renovado.
 // @Tags Sessions
 // @Accept json
 // @Produce json
 // @Param id path string true "ID da sessão (UUID) ou nome da sessão"
-// @Success 200 {object} dto.SuccessResponse{data=dto.QRCodeResponse} "QR Code gerado"
-// @Failure 400 {object} dto.ErrorResponse "Identificador da sessão inválido"
+// @Param format query string false "Formato do QR Code" Enums(png, raw) default(png)
+// @Success 200 {object} dto.TypedSuccessResponse[dto.QRCodeResponse] "QR Code gerado"
+// @Success 202 {object} dto.TypedSuccessResponse[dto.QRCodeResponse] "QR Code ainda não disponível"
+// @Failure 400 {object} dto.ErrorResponse "Identificador da sessão ou formato inválido"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
 // @Failure 409 {object} dto.ErrorResponse "Sessão já autenticada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de sessões simultâneas atingido (MAX_SESSIONS)"
 // @Failure 500 {object} dto.ErrorResponse "Erro interno"
 // @Security ApiKeyAuth
 // @Router /sessions/{id}/qr [get]
@@ -482,9 +959,27 @@ func (h *SessionHandler) GenerateQR(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = whatsappUC.QRFormatPNG
+	}
+	if format != whatsappUC.QRFormatPNG && format != whatsappUC.QRFormatRaw {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid format parameter (expected png or raw)", fmt.Errorf("invalid QR format: %q", format))
+		return
+	}
+
 	// Execute use case with resolved session ID
-	ucReq := whatsappUC.GenerateQRRequest{SessionID: sess.ID()}
+	ucReq := whatsappUC.GenerateQRRequest{SessionID: sess.ID(), Format: format}
 	result, err := h.generateQRUC.Execute(r.Context(), ucReq)
+	if err == whatsapp.ErrQRNotReady {
+		response := &dto.QRCodeResponse{
+			SessionID: sess.ID().String(),
+			Format:    format,
+			Message:   "QR code not ready yet, retry shortly",
+		}
+		writeTypedSuccessResponse(w, http.StatusAccepted, "QR Code not ready", response)
+		return
+	}
 	if err != nil {
 		h.handleUseCaseError(w, err)
 		return
@@ -494,23 +989,36 @@ func (h *SessionHandler) GenerateQR(w http.ResponseWriter, r *http.Request) {
 	response := &dto.QRCodeResponse{
 		SessionID: result.SessionID.String(),
 		QRCode:    result.QRCode,
+		RawCode:   result.RawCode,
+		Format:    result.Format,
 		Message:   result.Message,
 	}
+	if !result.ExpiresAt.IsZero() {
+		expiresAt := result.ExpiresAt
+		response.ExpiresAt = &expiresAt
+	}
 
-	h.writeSuccessResponse(w, http.StatusOK, "QR Code generated", response)
+	writeTypedSuccessResponse(w, http.StatusOK, "QR Code generated", response)
 }
 
 // PairPhone handles POST /sessions/{id}/pairphone
 // @Summary Emparelhar telefone com sessão
 // @Description Emparelha um telefone com a sessão WhatsApp por ID ou nome
+// @Description
+// @Description Números em formato nacional (sem código do país) são normalizados com `country_code` ou, na ausência dele, com `DEFAULT_COUNTRY_CODE`. O código só é aplicado quando nenhum é detectado no número (prefixo `+`/`00` ou número já iniciado pelo código).
+// @Description
+// @Description A resposta traz o `pairing_code` que deve ser digitado no celular em Aparelhos conectados > Conectar com número de telefone. O código expira em cerca de 160 segundos; depois disso, solicite um novo.
+// @Description
+// @Description `display_name` e `client_type` personalizam como o aparelho aparece no celular durante o pareamento (padrão: WHATSAPP_PAIR_CLIENT_NAME e o tipo da plataforma configurada). O WhatsApp só aceita navegadores e sistemas comuns no nome.
 // @Tags Sessions
 // @Accept json
 // @Produce json
 // @Param id path string true "ID da sessão (UUID) ou nome da sessão"
 // @Param request body dto.PairPhoneRequest true "Dados do telefone"
-// @Success 200 {object} dto.SuccessResponse{data=dto.PairPhoneResponse} "Telefone emparelhado"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.PairPhoneResponse] "Telefone emparelhado"
 // @Failure 400 {object} dto.ErrorResponse "Dados inválidos"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 429 {object} dto.ErrorResponse "Limite de sessões simultâneas atingido (MAX_SESSIONS)"
 // @Failure 500 {object} dto.ErrorResponse "Erro interno"
 // @Security ApiKeyAuth
 // @Router /sessions/{id}/pairphone [post]
@@ -534,6 +1042,9 @@ func (h *SessionHandler) PairPhone(w http.ResponseWriter, r *http.Request) {
 	ucReq := whatsappUC.PairPhoneRequest{
 		SessionID:   sess.ID(),
 		PhoneNumber: req.PhoneNumber,
+		CountryCode: req.CountryCode,
+		ClientType:  whatsapp.PairClientType(strings.ToLower(req.ClientType)),
+		DisplayName: req.DisplayName,
 	}
 	result, err := h.pairPhoneUC.Execute(r.Context(), ucReq)
 	if err != nil {
@@ -545,31 +1056,150 @@ func (h *SessionHandler) PairPhone(w http.ResponseWriter, r *http.Request) {
 	response := &dto.PairPhoneResponse{
 		SessionID:   result.SessionID.String(),
 		PhoneNumber: result.PhoneNumber,
+		PairingCode: result.PairingCode,
 		Success:     result.Success,
 		Message:     result.Message,
 	}
 
-	h.writeSuccessResponse(w, http.StatusOK, "Phone pairing processed", response)
+	writeTypedSuccessResponse(w, http.StatusOK, "Phone pairing processed", response)
 }
 
-// SetProxy handles POST /sessions/{id}/proxy/set
-// @Summary Configurar proxy para sessão
-// @Description Configura ou atualiza a configuração de proxy para uma sessão existente. O proxy será usado para todas as conexões WhatsApp desta sessão.
-// @Description
-// @Description **Tipos de proxy suportados:**
-// @Description - HTTP: Proxy HTTP/HTTPS padrão
-// @Description - SOCKS5: Proxy SOCKS5 com suporte a autenticação
+// GetSyncStatus handles GET /sessions/{id}/sync-status
+// @Summary Obter estado da sincronização inicial
+// @Description Retorna se a sincronização inicial de contatos, app state e histórico já foi concluída após o pareamento, com o progresso de cada tipo.
 // @Description
-// @Description **Exemplos de configuração:**
-// @Description - Proxy HTTP: `{"proxy_host": "78.24.204.134", "proxy_port": 62122, "proxy_type": "http"}`
-// @Description - Proxy com autenticação: `{"proxy_host": "78.24.204.134", "proxy_port": 62122, "proxy_type": "http", "username": "user", "password": "pass"}`
-// @Description - Proxy SOCKS5: `{"proxy_host": "78.24.204.134", "proxy_port": 62123, "proxy_type": "socks5", "username": "user", "password": "pass"}`
+// @Description Logo após o pareamento os contatos ainda não estão disponíveis. Use este endpoint para exibir "sincronizando, aguarde" em vez de uma lista de contatos vazia.
 // @Tags Sessions
 // @Accept json
 // @Produce json
-// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
-// @Param request body dto.ProxySetRequest true "Configuração do proxy"
-// @Success 200 {object} dto.SuccessResponse{data=dto.ProxySetResponse} "Proxy configurado com sucesso"
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SyncStatusResponse] "Estado da sincronização"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/sync-status [get]
+func (h *SessionHandler) GetSyncStatus(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetSyncStatusRequest{SessionID: sess.ID()}
+	result, err := h.getSyncStatusUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToSyncStatusResponse(result.SessionID.String(), result.Status, result.Message)
+	writeTypedSuccessResponse(w, http.StatusOK, "Sync status retrieved", response)
+}
+
+// GetHealth handles GET /sessions/{id}/health
+// @Summary Obter saúde da sessão
+// @Description Retorna problemas que degradam a sessão mesmo quando ela aparece como conectada, com uma dica de correção para cada um.
+// @Description
+// @Description Problemas detectados: falha ao renovar credenciais (`cat_refresh_failed`), falha ao enviar prekeys de criptografia (`prekey_upload_failed`), keepalive sem resposta (`keepalive_timeout`) e versão do cliente rejeitada (`client_outdated`).
+// @Description Os problemas são removidos automaticamente quando a condição é resolvida (por exemplo, após reconectar).
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionHealthResponse] "Saúde da sessão"
+// @Failure 404 {object} dto.ErrorResponse "Sessão ou cliente WhatsApp não encontrado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/health [get]
+func (h *SessionHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := whatsappUC.GetHealthRequest{SessionID: sess.ID()}
+	result, err := h.getHealthUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToSessionHealthResponse(result.SessionID.String(), result.Health)
+	writeTypedSuccessResponse(w, http.StatusOK, "Session health retrieved", response)
+}
+
+// GetPairingHistory handles GET /sessions/{id}/pairing-history
+// @Summary Histórico de pareamento da sessão
+// @Description Retorna o log de auditoria das tentativas de pareamento (QR code ou código por telefone) da sessão, incluindo sucessos, falhas e timeouts.
+// @Description Cada registro contém o JID resultante, a data e o `X-Request-ID` da requisição que iniciou a conexão.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param limit query int false "Quantidade máxima de registros (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.PairingHistoryResponse] "Histórico de pareamento"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/pairing-history [get]
+func (h *SessionHandler) GetPairingHistory(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Execute use case with resolved session ID
+	ucReq := sessionUC.PairingHistoryRequest{
+		SessionID: sess.ID(),
+		Limit:     queryInt(r, "limit", 0),
+		Offset:    queryInt(r, "offset", 0),
+	}
+	result, err := h.pairingHistoryUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Convert to HTTP response
+	response := dto.ToPairingHistoryResponse(result.SessionID.String(), result.Attempts, result.Total, result.Limit, result.Offset)
+	writeTypedSuccessResponse(w, http.StatusOK, "Pairing history retrieved", response)
+}
+
+// SetProxy handles POST /sessions/{id}/proxy/set
+// @Summary Configurar proxy para sessão
+// @Description Configura ou atualiza a configuração de proxy para uma sessão existente. O proxy será usado para todas as conexões WhatsApp desta sessão.
+// @Description
+// @Description **Tipos de proxy suportados:**
+// @Description - HTTP: Proxy HTTP/HTTPS padrão
+// @Description - SOCKS5: Proxy SOCKS5 com suporte a autenticação
+// @Description
+// @Description **Exemplos de configuração:**
+// @Description - Proxy HTTP: `{"proxy_host": "78.24.204.134", "proxy_port": 62122, "proxy_type": "http"}`
+// @Description - Proxy com autenticação: `{"proxy_host": "78.24.204.134", "proxy_port": 62122, "proxy_type": "http", "username": "user", "password": "pass"}`
+// @Description - Proxy SOCKS5: `{"proxy_host": "78.24.204.134", "proxy_port": 62123, "proxy_type": "socks5", "username": "user", "password": "pass"}`
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Param request body dto.ProxySetRequest true "Configuração do proxy"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.ProxySetResponse] "Proxy configurado com sucesso"
 // @Failure 400 {object} dto.ErrorResponse "Dados de proxy inválidos (host, porta, tipo, etc.)"
 // @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
 // @Failure 422 {object} dto.ErrorResponse "Configuração de proxy inválida (ex: username sem password)"
@@ -614,7 +1244,7 @@ func (h *SessionHandler) SetProxy(w http.ResponseWriter, r *http.Request) {
 			Success:   true,
 			Message:   "Proxy removed successfully",
 		}
-		h.writeSuccessResponse(w, http.StatusOK, "Proxy removed", response)
+		writeTypedSuccessResponse(w, http.StatusOK, "Proxy removed", response)
 		return
 	}
 
@@ -644,5 +1274,417 @@ func (h *SessionHandler) SetProxy(w http.ResponseWriter, r *http.Request) {
 		Message:   result.Message,
 	}
 
-	h.writeSuccessResponse(w, http.StatusOK, "Proxy configured", response)
+	writeTypedSuccessResponse(w, http.StatusOK, "Proxy configured", response)
+}
+
+// RotateProxy handles POST /sessions/{id}/proxy/rotate
+// @Summary Rotacionar proxy da sessão
+// @Description Troca o proxy de uma sessão conectada sem deslogar, reconectando pelo novo proxy com o mínimo de indisponibilidade.
+// @Description
+// @Description **Origem do novo proxy:**
+// @Description - Sem corpo (ou sem `proxy_host`): usa o próximo proxy configurado em `PROXY_POOL`, em ordem circular
+// @Description - Com `proxy_host`: usa o proxy informado na requisição
+// @Description
+// @Description Envios de mensagens feitos durante a reconexão aguardam a conclusão da troca e não são perdidos.
+// @Description Se o novo proxy não conectar, o proxy anterior é restaurado.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Param request body dto.ProxyRotateRequest false "Proxy a ser usado (opcional)"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.ProxyRotateResponse] "Proxy rotacionado com sucesso"
+// @Failure 400 {object} dto.ErrorResponse "Sessão não conectada ou proxy inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 422 {object} dto.ErrorResponse "Nenhum proxy disponível para rotação"
+// @Failure 502 {object} dto.ErrorResponse "Falha ao conectar pelo novo proxy (proxy anterior restaurado)"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/proxy/rotate [post]
+func (h *SessionHandler) RotateProxy(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// Body is optional - an empty body rotates through the configured pool
+	var req dto.ProxyRotateRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+			return
+		}
+	}
+
+	req.Normalize()
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid proxy configuration", err)
+		return
+	}
+
+	ucReq := sessionUC.RotateProxyRequest{
+		SessionID: sess.ID(),
+		ProxyHost: req.ProxyHost,
+		ProxyPort: req.ProxyPort,
+		ProxyType: req.ProxyType.String(),
+		Username:  req.Username,
+		Password:  req.Password,
+	}
+
+	result, err := h.rotateProxyUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToProxyRotateResponse(
+		result.Session.ID().String(),
+		result.ProxyURL,
+		result.PreviousProxyURL,
+		string(result.Source),
+		result.Downtime,
+	)
+	writeTypedSuccessResponse(w, http.StatusOK, "Proxy rotated", response)
+}
+
+// SetDisplayName handles PUT /sessions/{id}/display-name
+// @Summary Definir nome de exibição da sessão
+// @Description Define um nome de exibição livre para a sessão, usado em logs e painéis.
+// @Description
+// @Description Diferente do `name` (único e restrito a letras, números, hífens e underscores), o `display_name` aceita espaços, acentos e emojis.
+// @Description Ele não altera o nome de perfil (push name) do WhatsApp. Enviar `display_name` vazio remove o nome de exibição.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Param request body dto.SetDisplayNameRequest true "Nome de exibição"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Nome de exibição atualizado"
+// @Failure 400 {object} dto.ErrorResponse "Nome de exibição inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/display-name [put]
+func (h *SessionHandler) SetDisplayName(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SetDisplayNameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	req.Normalize()
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid display name", err)
+		return
+	}
+
+	ucReq := sessionUC.SetDisplayNameRequest{
+		SessionID:   sess.ID(),
+		DisplayName: req.DisplayName,
+	}
+
+	result, err := h.setDisplayNameUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToSessionResponse(result.Session)
+	writeTypedSuccessResponse(w, http.StatusOK, "Display name updated", response)
+}
+
+// RenameSession handles PUT /sessions/{id}/name
+// @Summary Renomear sessão
+// @Description Altera o nome único da sessão. O novo nome deve ter de 3 a 50 caracteres (letras, números, espaços, hífens e underscores) e não pode estar em uso por outra sessão.
+// @Description
+// @Description Para um rótulo livre (acentos, emojis), use `PUT /sessions/{id}/display-name`.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Param request body dto.RenameSessionRequest true "Novo nome da sessão"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Sessão renomeada"
+// @Failure 400 {object} dto.ErrorResponse "Nome de sessão inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "Já existe uma sessão com este nome"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/name [put]
+func (h *SessionHandler) RenameSession(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.RenameSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	req.Normalize()
+
+	ucReq := sessionUC.RenameRequest{
+		SessionID: sess.ID(),
+		Name:      req.Name,
+	}
+
+	result, err := h.renameUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToSessionResponse(result.Session)
+	writeTypedSuccessResponse(w, http.StatusOK, "Session renamed", response)
+}
+
+// SetAllowedSenders handles PUT /sessions/{id}/allowed-senders
+// @Summary Definir remetentes permitidos da sessão
+// @Description Restringe as mensagens recebidas aos remetentes informados: mensagens de outros remetentes não são armazenadas nem enviadas ao webhook.
+// @Description
+// @Description Aceita números de telefone (o código do país padrão é aplicado quando ausente) ou JIDs. Em grupos, o filtro considera o participante que enviou a mensagem.
+// @Description Mensagens enviadas pela própria sessão não são filtradas. Enviar `senders` vazio volta a aceitar todos os remetentes.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Param request body dto.SetAllowedSendersRequest true "Remetentes permitidos"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Remetentes permitidos atualizados"
+// @Failure 400 {object} dto.ErrorResponse "Remetente inválido ou lista muito grande"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/allowed-senders [put]
+func (h *SessionHandler) SetAllowedSenders(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SetAllowedSendersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid allowed senders", err)
+		return
+	}
+
+	ucReq := sessionUC.SetAllowedSendersRequest{
+		SessionID:   sess.ID(),
+		Senders:     req.Senders,
+		CountryCode: req.CountryCode,
+	}
+
+	result, err := h.setSendersUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToSessionResponse(result.Session)
+	writeTypedSuccessResponse(w, http.StatusOK, "Allowed senders updated", response)
+}
+
+// SetSendRateLimit handles PUT /sessions/{id}/send-rate-limit
+// @Summary Definir limite de envio da sessão
+// @Description Limita as mensagens enviadas pela sessão com um token bucket: até `burst` mensagens saem de uma vez e depois `per_second` por segundo, sobrescrevendo o limite global (WHATSAPP_SEND_RATE_PER_SECOND).
+// @Description
+// @Description Envios acima do limite aguardam a vez por até WHATSAPP_SEND_RATE_MAX_WAIT; além disso são recusados com 429 e o header `Retry-After`. `per_second` igual a 0 remove o limite da sessão.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Param request body dto.SetSendRateLimitRequest true "Limite de envio"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Limite de envio atualizado"
+// @Failure 400 {object} dto.ErrorResponse "Limite inválido"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send-rate-limit [put]
+func (h *SessionHandler) SetSendRateLimit(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	var req dto.SetSendRateLimitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid send rate limit", err)
+		return
+	}
+
+	ucReq := sessionUC.SetSendRateLimitRequest{
+		SessionID: sess.ID(),
+		Limit: &session.SendRateLimit{
+			PerSecond: req.PerSecond,
+			Burst:     req.Burst,
+		},
+	}
+
+	result, err := h.setSendRateUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToSessionResponse(result.Session)
+	writeTypedSuccessResponse(w, http.StatusOK, "Send rate limit updated", response)
+}
+
+// ResetSendRateLimit handles DELETE /sessions/{id}/send-rate-limit
+// @Summary Remover limite de envio da sessão
+// @Description Remove o limite de envio próprio da sessão, que volta a usar o limite global.
+// @Tags Sessions
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão" example("minha-sessao")
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Sessão usando o limite global"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno do servidor"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/send-rate-limit [delete]
+func (h *SessionHandler) ResetSendRateLimit(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	result, err := h.setSendRateUC.Execute(r.Context(), sessionUC.SetSendRateLimitRequest{SessionID: sess.ID()})
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToSessionResponse(result.Session)
+	writeTypedSuccessResponse(w, http.StatusOK, "Send rate limit reset", response)
+}
+
+// ExportBackup handles GET /sessions/{id}/export
+// @Summary Exportar backup da sessão
+// @Description Exporta a sessão e as credenciais do dispositivo WhatsApp (chaves e estado do Signal) para restaurá-la em outra instância sem novo pareamento.
+// @Description
+// @Description **Segurança:** as chaves do dispositivo dão acesso total à conta. O backup é criptografado com AES-256-GCM usando uma chave derivada (scrypt) da senha enviada no header `X-Backup-Passphrase` (mínimo 8 caracteres).
+// @Description
+// @Description Recomenda-se desconectar a sessão antes de exportar e não usá-la mais na instância de origem após a importação.
+// @Tags Sessions
+// @Produce json
+// @Param id path string true "ID da sessão (UUID) ou nome da sessão"
+// @Param X-Backup-Passphrase header string true "Senha usada para criptografar o backup"
+// @Success 200 {object} dto.TypedSuccessResponse[dto.SessionBackupResponse] "Backup criptografado da sessão"
+// @Failure 400 {object} dto.ErrorResponse "Senha ausente ou muito curta"
+// @Failure 404 {object} dto.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} dto.ErrorResponse "Sessão sem dispositivo pareado"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/{id}/export [get]
+func (h *SessionHandler) ExportBackup(w http.ResponseWriter, r *http.Request) {
+	identifierStr := chi.URLParam(r, "id")
+
+	// Resolve session using flexible identifier
+	sess, err := h.resolveSessionByIdentifier(r, identifierStr)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	passphrase := r.Header.Get(backupPassphraseHeader)
+	if len(passphrase) < crypto.MinPassphraseLength {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid backup passphrase", crypto.ErrPassphraseTooShort)
+		return
+	}
+
+	ucReq := sessionUC.ExportBackupRequest{
+		SessionID:  sess.ID(),
+		Passphrase: passphrase,
+	}
+
+	result, err := h.exportBackupUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	// The backup holds device keys; keep it out of caches
+	w.Header().Set("Cache-Control", "no-store")
+
+	response := dto.ToSessionBackupResponse(result.Session, result.Backup, result.ExportedAt)
+	writeTypedSuccessResponse(w, http.StatusOK, "Session backup exported", response)
+}
+
+// ImportBackup handles POST /sessions/import
+// @Summary Importar backup de sessão
+// @Description Recria uma sessão a partir de um backup gerado por `GET /sessions/{id}/export`, restaurando as credenciais do dispositivo WhatsApp.
+// @Description
+// @Description A sessão é criada no estado 'disconnected'; ao conectá-la, a conta é retomada sem escanear QR Code.
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Param request body dto.ImportSessionRequest true "Backup e senha"
+// @Success 201 {object} dto.TypedSuccessResponse[dto.SessionResponse] "Sessão importada"
+// @Failure 400 {object} dto.ErrorResponse "Backup inválido ou senha incorreta"
+// @Failure 409 {object} dto.ErrorResponse "Sessão ou dispositivo já existe nesta instância"
+// @Failure 500 {object} dto.ErrorResponse "Erro interno"
+// @Security ApiKeyAuth
+// @Router /sessions/import [post]
+func (h *SessionHandler) ImportBackup(w http.ResponseWriter, r *http.Request) {
+	var req dto.ImportSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid session backup", err)
+		return
+	}
+
+	ucReq := sessionUC.ImportBackupRequest{
+		Backup:     req.Backup,
+		Passphrase: req.Passphrase,
+		Name:       req.Name,
+	}
+
+	result, err := h.importBackupUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		h.handleUseCaseError(w, err)
+		return
+	}
+
+	response := dto.ToSessionResponse(result.Session)
+	writeTypedSuccessResponse(w, http.StatusCreated, "Session imported successfully", response)
 }
@@ -204,3 +204,49 @@ func maskAPIKey(apiKey string) string {
 	}
 	return apiKey[:4] + "****" + apiKey[len(apiKey)-4:]
 }
+
+// Supported authentication types
+const (
+	AuthTypeAPIKey = "api_key"
+	AuthTypeBasic  = "basic"
+	AuthTypeJWT    = "jwt"
+)
+
+// AuthSettings selects and configures the authentication mode of the API
+type AuthSettings struct {
+	Type          string
+	APIKey        *AuthConfig
+	BasicUsername string
+	BasicPassword string
+	JWT           *JWTConfig
+}
+
+// NewAuthMiddleware returns the authentication middleware for the configured type
+func NewAuthMiddleware(settings *AuthSettings, log logger.Logger) func(http.Handler) http.Handler {
+	switch settings.Type {
+	case AuthTypeAPIKey:
+		return AuthMiddleware(settings.APIKey, log)
+	case AuthTypeBasic:
+		return BasicAuthMiddleware(settings.BasicUsername, settings.BasicPassword, log)
+	case AuthTypeJWT:
+		return JWTMiddleware(settings.JWT, log)
+	}
+
+	// Unknown types reject every request instead of leaving the API open
+	log.ErrorWithFields("Unsupported authentication type", logger.Fields{
+		"auth_type": settings.Type,
+	})
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+
+			response := dto.NewErrorResponse(
+				"Authentication misconfigured",
+				"INTERNAL_ERROR",
+				"Unsupported authentication type",
+			)
+			json.NewEncoder(w).Encode(response)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -74,12 +75,12 @@ func CORSMiddleware(config *CORSConfig) func(http.Handler) http.Handler {
 				w.Header().Set("Access-Control-Allow-Credentials", "true")
 			}
 
-			if config.MaxAge > 0 {
-				w.Header().Set("Access-Control-Max-Age", string(rune(config.MaxAge)))
-			}
-
 			// Handle preflight requests
 			if r.Method == http.MethodOptions {
+				// Let browsers cache the preflight result for MaxAge seconds
+				if config.MaxAge > 0 {
+					w.Header().Set("Access-Control-Max-Age", strconv.Itoa(config.MaxAge))
+				}
 				w.WriteHeader(http.StatusNoContent)
 				return
 			}
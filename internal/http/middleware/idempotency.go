@@ -0,0 +1,326 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"wazmeow/internal/http/dto"
+	"wazmeow/pkg/logger"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the client's idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from a previous request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotencyKeyLength bounds the keys kept in memory
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyConfig holds idempotency configuration
+type IdempotencyConfig struct {
+	// TTL is how long the result of a request is replayed for its key
+	TTL time.Duration
+	// MaxBodySize bounds the request body buffered to fingerprint a request (0 = unlimited)
+	MaxBodySize int64
+	// HeaderName is the API key header, used to tell callers apart
+	HeaderName string
+}
+
+// idempotencyEntry is the state of one idempotency key
+type idempotencyEntry struct {
+	fingerprint [sha256.Size]byte // Hash of the request body
+	completed   bool
+	expiresAt   time.Time
+
+	statusCode  int
+	contentType string
+	body        []byte
+}
+
+// idempotencyStore holds the entries of recently seen keys
+type idempotencyStore struct {
+	ttl     time.Duration
+	entries map[string]*idempotencyEntry
+	mutex   sync.Mutex
+}
+
+// begin returns the entry stored for a key, or reserves the key for a new request
+// and returns nil
+func (s *idempotencyStore) begin(key string, fingerprint [sha256.Size]byte, now time.Time) *idempotencyEntry {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if entry, exists := s.entries[key]; exists && now.Before(entry.expiresAt) {
+		copied := *entry
+		return &copied
+	}
+
+	// In-flight reservations expire too, so a crashed handler cannot block a key forever
+	s.entries[key] = &idempotencyEntry{
+		fingerprint: fingerprint,
+		expiresAt:   now.Add(s.ttl),
+	}
+	return nil
+}
+
+// complete stores the result of a successful request for replay
+func (s *idempotencyStore) complete(key string, statusCode int, contentType string, body []byte, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, exists := s.entries[key]
+	if !exists {
+		return
+	}
+	entry.completed = true
+	entry.statusCode = statusCode
+	entry.contentType = contentType
+	entry.body = body
+	entry.expiresAt = now.Add(s.ttl)
+}
+
+// release forgets a key so the request can be retried
+func (s *idempotencyStore) release(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.entries, key)
+}
+
+// collect removes expired entries
+func (s *idempotencyStore) collect(now time.Time) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	removed := 0
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// recordingResponseWriter keeps a copy of the response written through it
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rw *recordingResponseWriter) WriteHeader(code int) {
+	if rw.statusCode == 0 {
+		rw.statusCode = code
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingResponseWriter) Write(b []byte) (int, error) {
+	if rw.statusCode == 0 {
+		rw.statusCode = http.StatusOK
+	}
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped ResponseWriter so http.ResponseController can reach it
+func (rw *recordingResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// Idempotency prevents duplicate sends when clients retry a request.
+//
+// Requests carrying an Idempotency-Key header are keyed by caller, method, path and key.
+// A successful (2xx) response is kept for the TTL and replayed for later requests
+// with the same key instead of running the handler again. Failed requests are not
+// kept, so they can be retried. Reusing a key with a different body, or while the
+// first request is still running, is rejected. Requests without the header are not
+// affected. A non-positive TTL disables it.
+type Idempotency struct {
+	config *IdempotencyConfig
+	store  *idempotencyStore
+	log    logger.Logger
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewIdempotency creates the idempotency middleware state. Expired keys are collected in
+// the background until Stop is called.
+func NewIdempotency(config *IdempotencyConfig, log logger.Logger) *Idempotency {
+	if config == nil {
+		config = &IdempotencyConfig{}
+	}
+
+	i := &Idempotency{
+		config: config,
+		log:    log,
+		stop:   make(chan struct{}),
+	}
+	if config.TTL <= 0 {
+		return i
+	}
+
+	i.store = &idempotencyStore{
+		ttl:     config.TTL,
+		entries: make(map[string]*idempotencyEntry),
+	}
+
+	// Collect expired keys so memory does not grow with every request seen
+	go func() {
+		ticker := time.NewTicker(config.TTL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-i.stop:
+				return
+			case now := <-ticker.C:
+				if removed := i.store.collect(now); removed > 0 {
+					log.DebugWithFields("Expired idempotency keys collected", logger.Fields{
+						"removed": removed,
+					})
+				}
+			}
+		}
+	}()
+
+	return i
+}
+
+// Stop ends the collection of expired keys. It is safe to call more than once.
+func (i *Idempotency) Stop() {
+	i.stopOnce.Do(func() {
+		close(i.stop)
+	})
+}
+
+// Middleware returns the middleware replaying the results of repeated requests
+func (i *Idempotency) Middleware() func(http.Handler) http.Handler {
+	if i.store == nil {
+		return func(next http.Handler) http.Handler {
+			return next
+		}
+	}
+
+	store := i.store
+	log := i.log
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idempotencyKey := r.Header.Get(IdempotencyKeyHeader)
+			if idempotencyKey == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(idempotencyKey) > maxIdempotencyKeyLength {
+				writeIdempotencyError(w, http.StatusBadRequest, "Invalid idempotency key", "INVALID_IDEMPOTENCY_KEY",
+					"Idempotency-Key must have up to 255 characters")
+				return
+			}
+
+			reader := r.Body
+			if i.config.MaxBodySize > 0 {
+				reader = http.MaxBytesReader(w, r.Body, i.config.MaxBodySize)
+			}
+			body, err := io.ReadAll(reader)
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					writeIdempotencyError(w, http.StatusRequestEntityTooLarge, "Request body too large", "REQUEST_BODY_TOO_LARGE",
+						"Request body must have up to "+strconv.FormatInt(maxBytesErr.Limit, 10)+" bytes")
+					return
+				}
+				writeIdempotencyError(w, http.StatusBadRequest, "Invalid request body", "INVALID_REQUEST_BODY", err.Error())
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			key := i.principal(r) + " " + r.Method + " " + r.URL.Path + " " + idempotencyKey
+			fingerprint := sha256.Sum256(body)
+			entry := store.begin(key, fingerprint, time.Now())
+			switch {
+			case entry == nil:
+				// First request with this key
+			case entry.fingerprint != fingerprint:
+				writeIdempotencyError(w, http.StatusUnprocessableEntity, "Idempotency key reused", "IDEMPOTENCY_KEY_MISMATCH",
+					"Idempotency-Key was already used with a different request body")
+				return
+			case !entry.completed:
+				writeIdempotencyError(w, http.StatusConflict, "Request in progress", "IDEMPOTENCY_KEY_IN_USE",
+					"A request with this Idempotency-Key is still being processed, retry later")
+				return
+			default:
+				log.InfoWithFields("Idempotent request replayed", logger.Fields{
+					"method": r.Method,
+					"path":   r.URL.Path,
+				})
+
+				if entry.contentType != "" {
+					w.Header().Set("Content-Type", entry.contentType)
+				}
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(entry.statusCode)
+				w.Write(entry.body)
+				return
+			}
+
+			recorder := &recordingResponseWriter{ResponseWriter: w}
+			completed := false
+			defer func() {
+				// Release the key if the handler failed or panicked so the client can retry
+				if !completed {
+					store.release(key)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= 200 && recorder.statusCode < 300 {
+				store.complete(key, recorder.statusCode, w.Header().Get("Content-Type"), recorder.body.Bytes(), time.Now())
+				completed = true
+			}
+		})
+	}
+}
+
+// principal identifies the authenticated caller, so callers cannot replay each other's
+// results by reusing a key. Credentials are hashed rather than kept in memory.
+func (i *Idempotency) principal(r *http.Request) string {
+	if subject, ok := SubjectFromContext(r.Context()); ok {
+		return "sub:" + subject
+	}
+	if i.config.HeaderName != "" {
+		if apiKey := r.Header.Get(i.config.HeaderName); apiKey != "" {
+			return "key:" + hashCredential(apiKey)
+		}
+	}
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		return "auth:" + hashCredential(authorization)
+	}
+	return "anonymous"
+}
+
+// hashCredential returns the hex encoded SHA-256 of a credential
+func hashCredential(credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeIdempotencyError writes an error response for a rejected idempotent request
+func writeIdempotencyError(w http.ResponseWriter, status int, message, code, details string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(dto.NewErrorResponse(message, code, details))
+}
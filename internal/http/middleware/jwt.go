@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"wazmeow/internal/http/dto"
+	"wazmeow/pkg/logger"
+)
+
+// JWT errors
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// jwtHeader is the only header accepted and issued: HMAC-SHA256 signed JWTs
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// JWTConfig holds JWT authentication configuration
+type JWTConfig struct {
+	Secret    string
+	SkipPaths []string
+}
+
+// JWTClaims holds the registered claims carried by issued tokens
+type JWTClaims struct {
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// IssueToken creates a token for the subject signed with the secret, valid for ttl
+func IssueToken(secret, subject string, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	payload, err := json.Marshal(JWTClaims{
+		Subject:   subject,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + signToken(secret, unsigned), expiresAt, nil
+}
+
+// ParseToken verifies the signature and expiry of a token and returns its claims
+func ParseToken(secret, token string) (*JWTClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != jwtHeader {
+		return nil, ErrInvalidToken
+	}
+
+	expected := signToken(secret, parts[0]+"."+parts[1])
+	if !hmac.Equal([]byte(parts[2]), []byte(expected)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var claims JWTClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+
+	if claims.ExpiresAt == 0 || time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	return &claims, nil
+}
+
+// SubjectFromContext returns the subject of the token that authenticated the request
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(logger.ContextKeyUserID).(string)
+	return subject, ok && subject != ""
+}
+
+// JWTMiddleware implements bearer token authentication
+func JWTMiddleware(config *JWTConfig, log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Skip authentication for certain paths
+			if shouldSkipAuth(r.URL.Path, config.SkipPaths) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			authHeader := r.Header.Get("Authorization")
+			if !strings.HasPrefix(authHeader, "Bearer ") {
+				log.WarnWithFields("Missing bearer token", logger.Fields{
+					"method":      r.Method,
+					"path":        r.URL.Path,
+					"remote_addr": r.RemoteAddr,
+				})
+
+				writeUnauthorized(w, "Bearer token required", "Missing bearer token in Authorization header")
+				return
+			}
+
+			claims, err := ParseToken(config.Secret, strings.TrimPrefix(authHeader, "Bearer "))
+			if err != nil {
+				log.WarnWithFields("Invalid bearer token", logger.Fields{
+					"method":      r.Method,
+					"path":        r.URL.Path,
+					"remote_addr": r.RemoteAddr,
+					"error":       err.Error(),
+				})
+
+				if errors.Is(err, ErrTokenExpired) {
+					writeUnauthorized(w, "Token expired", "The provided token has expired")
+				} else {
+					writeUnauthorized(w, "Invalid token", "The provided token is not valid")
+				}
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), logger.ContextKeyUserID, claims.Subject)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// signToken returns the base64url HMAC-SHA256 signature of the unsigned token
+func signToken(secret, unsigned string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// writeUnauthorized writes a 401 JSON error response with a bearer challenge
+func writeUnauthorized(w http.ResponseWriter, message, details string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="WazMeow API"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+
+	response := dto.NewErrorResponse(message, "UNAUTHORIZED", details)
+	json.NewEncoder(w).Encode(response)
+}
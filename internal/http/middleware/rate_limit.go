@@ -1,19 +1,30 @@
 package middleware
 
 import (
-	"fmt"
+	"encoding/json"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"wazmeow/internal/http/dto"
 	"wazmeow/pkg/logger"
 )
 
+// defaultRateLimitIdleTimeout is how long an unused bucket is kept before being collected
+const defaultRateLimitIdleTimeout = 10 * time.Minute
+
 // RateLimitConfig holds rate limiting configuration
 type RateLimitConfig struct {
 	RequestsPerMinute int
 	BurstSize         int
 	KeyFunc           func(*http.Request) string
+
+	// IdleTimeout is how long a client's bucket is kept after its last request
+	IdleTimeout time.Duration
 }
 
 // DefaultRateLimitConfig returns a default rate limit configuration
@@ -21,123 +32,290 @@ func DefaultRateLimitConfig() *RateLimitConfig {
 	return &RateLimitConfig{
 		RequestsPerMinute: 60,
 		BurstSize:         10,
-		KeyFunc: func(r *http.Request) string {
-			// Use IP address as default key
-			return r.RemoteAddr
-		},
+		KeyFunc:           ClientIPKey,
+		IdleTimeout:       defaultRateLimitIdleTimeout,
+	}
+}
+
+// ClientIPKey keys requests by client IP, ignoring the source port
+func ClientIPKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return "ip:" + r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// APIKeyOrIPKey keys requests by API key when it is one of validKeys, falling back to the
+// client IP. Unknown keys are not trusted so clients cannot get fresh buckets by inventing keys.
+func APIKeyOrIPKey(headerName string, validKeys []string) func(*http.Request) string {
+	return func(r *http.Request) string {
+		apiKey := r.Header.Get(headerName)
+		if apiKey == "" {
+			apiKey = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		}
+
+		if apiKey != "" && isValidAPIKey(apiKey, validKeys) {
+			return "key:" + apiKey
+		}
+		return ClientIPKey(r)
 	}
 }
 
 // rateLimiter implements a token bucket rate limiter
 type rateLimiter struct {
-	tokens     int
-	maxTokens  int
-	refillRate time.Duration
+	tokens     float64
+	maxTokens  float64
+	perSecond  float64
 	lastRefill time.Time
 	mutex      sync.Mutex
 }
 
-// newRateLimiter creates a new rate limiter
-func newRateLimiter(requestsPerMinute, burstSize int) *rateLimiter {
+// newRateLimiter creates a full bucket refilled at requestsPerMinute
+func newRateLimiter(requestsPerMinute, burstSize int, now time.Time) *rateLimiter {
 	return &rateLimiter{
-		tokens:     burstSize,
-		maxTokens:  burstSize,
-		refillRate: time.Minute / time.Duration(requestsPerMinute),
-		lastRefill: time.Now(),
+		tokens:     float64(burstSize),
+		maxTokens:  float64(burstSize),
+		perSecond:  float64(requestsPerMinute) / 60,
+		lastRefill: now,
 	}
 }
 
-// allow checks if a request is allowed
-func (rl *rateLimiter) allow() bool {
+// take consumes a token if available, returning the tokens left and, when denied,
+// how long until the next token is available
+func (rl *rateLimiter) take(now time.Time) (bool, int, time.Duration) {
 	rl.mutex.Lock()
 	defer rl.mutex.Unlock()
 
-	now := time.Now()
+	// Refill tokens based on time elapsed, keeping fractions for the next request
+	rl.tokens = math.Min(rl.maxTokens, rl.tokens+now.Sub(rl.lastRefill).Seconds()*rl.perSecond)
+	rl.lastRefill = now
 
-	// Refill tokens based on time elapsed
-	elapsed := now.Sub(rl.lastRefill)
-	tokensToAdd := int(elapsed / rl.refillRate)
+	if rl.tokens >= 1 {
+		rl.tokens--
+		return true, int(rl.tokens), 0
+	}
 
-	if tokensToAdd > 0 {
-		rl.tokens += tokensToAdd
-		if rl.tokens > rl.maxTokens {
-			rl.tokens = rl.maxTokens
-		}
-		rl.lastRefill = now
+	wait := time.Duration((1 - rl.tokens) / rl.perSecond * float64(time.Second))
+	return false, 0, wait
+}
+
+// idleSince reports whether the bucket has not been used since the cutoff
+func (rl *rateLimiter) idleSince(cutoff time.Time) bool {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	return rl.lastRefill.Before(cutoff)
+}
+
+// rateLimiterStore holds one bucket per client and collects idle ones
+type rateLimiterStore struct {
+	config   *RateLimitConfig
+	limiters map[string]*rateLimiter
+	mutex    sync.Mutex
+}
+
+// newRateLimiterStore creates the buckets store of the given limits
+func newRateLimiterStore(requestsPerMinute, burstSize int, idleTimeout time.Duration) *rateLimiterStore {
+	if burstSize <= 0 {
+		burstSize = 1
+	}
+	// Buckets must outlive a full refill, otherwise collecting them would reset a drained bucket
+	refill := time.Duration(float64(burstSize) / float64(requestsPerMinute) * float64(time.Minute))
+	if idleTimeout < refill {
+		idleTimeout = refill
 	}
 
-	// Check if request is allowed
-	if rl.tokens > 0 {
-		rl.tokens--
-		return true
+	return &rateLimiterStore{
+		config: &RateLimitConfig{
+			RequestsPerMinute: requestsPerMinute,
+			BurstSize:         burstSize,
+			IdleTimeout:       idleTimeout,
+		},
+		limiters: make(map[string]*rateLimiter),
+	}
+}
+
+// get returns the bucket of a client, creating it on first use
+func (s *rateLimiterStore) get(key string, now time.Time) *rateLimiter {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	limiter, exists := s.limiters[key]
+	if !exists {
+		limiter = newRateLimiter(s.config.RequestsPerMinute, s.config.BurstSize, now)
+		s.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// collect removes buckets idle for longer than the idle timeout. An idle bucket is full
+// again by then, so dropping it does not change the outcome of the next request.
+func (s *rateLimiterStore) collect(now time.Time) int {
+	cutoff := now.Add(-s.config.IdleTimeout)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	removed := 0
+	for key, limiter := range s.limiters {
+		if limiter.idleSince(cutoff) {
+			delete(s.limiters, key)
+			removed++
+		}
 	}
+	return removed
+}
+
+// RateLimiter limits requests with a token bucket per client. Its limits can be changed
+// while serving, which the rate limit middleware alone does not allow.
+type RateLimiter struct {
+	keyFunc     func(*http.Request) string
+	idleTimeout time.Duration
+	log         logger.Logger
 
-	return false
+	mu         sync.RWMutex
+	store      *rateLimiterStore // nil while rate limiting is disabled
+	collecting bool              // Whether idle buckets are being collected
+	stopped    bool
+
+	stop chan struct{}
 }
 
-// RateLimitMiddleware implements rate limiting
-func RateLimitMiddleware(config *RateLimitConfig, log logger.Logger) func(http.Handler) http.Handler {
+// NewRateLimiter creates a rate limiter. A non-positive RequestsPerMinute disables rate
+// limiting until SetLimits enables it. Idle buckets are collected in the background while
+// rate limiting is enabled, until Stop is called.
+func NewRateLimiter(config *RateLimitConfig, log logger.Logger) *RateLimiter {
 	if config == nil {
 		config = DefaultRateLimitConfig()
 	}
 
-	limiters := make(map[string]*rateLimiter)
-	var mutex sync.RWMutex
+	rl := &RateLimiter{
+		keyFunc:     config.KeyFunc,
+		idleTimeout: config.IdleTimeout,
+		log:         log,
+		stop:        make(chan struct{}),
+	}
+	if rl.keyFunc == nil {
+		rl.keyFunc = ClientIPKey
+	}
+	if rl.idleTimeout <= 0 {
+		rl.idleTimeout = defaultRateLimitIdleTimeout
+	}
+	rl.SetLimits(config.RequestsPerMinute, config.BurstSize)
+
+	return rl
+}
+
+// SetLimits replaces the limits of every client. Buckets start over full, so each client
+// may send a whole burst right after a change. A non-positive requestsPerMinute disables
+// rate limiting.
+func (rl *RateLimiter) SetLimits(requestsPerMinute, burstSize int) {
+	var store *rateLimiterStore
+	if requestsPerMinute > 0 {
+		store = newRateLimiterStore(requestsPerMinute, burstSize, rl.idleTimeout)
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.store = store
 
-	// Cleanup goroutine to remove old limiters
-	go func() {
-		ticker := time.NewTicker(time.Minute)
-		defer ticker.Stop()
+	if store != nil && !rl.collecting && !rl.stopped {
+		rl.collecting = true
+		go rl.collectIdle()
+	}
+}
+
+// Stop ends the collection of idle buckets. It is safe to call more than once.
+func (rl *RateLimiter) Stop() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.stopped {
+		return
+	}
+	rl.stopped = true
+	close(rl.stop)
+}
 
-		for range ticker.C {
-			// Cleanup old limiters (simplified implementation)
-			// In production, you'd implement proper cleanup logic
-			// For now, we skip cleanup to avoid empty critical section
+// collectIdle collects buckets of idle clients so memory does not grow with every client seen
+func (rl *RateLimiter) collectIdle() {
+	ticker := time.NewTicker(rl.idleTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-rl.stop:
+			return
+		case now := <-ticker.C:
+			store := rl.currentStore()
+			if store == nil {
+				continue
+			}
+			if removed := store.collect(now); removed > 0 {
+				rl.log.DebugWithFields("Idle rate limit buckets collected", logger.Fields{
+					"removed": removed,
+				})
+			}
 		}
-	}()
+	}
+}
+
+// currentStore returns the buckets of the current limits, nil when disabled
+func (rl *RateLimiter) currentStore() *rateLimiterStore {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.store
+}
 
+// Middleware returns the middleware enforcing the current limits
+func (rl *RateLimiter) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			key := config.KeyFunc(r)
-
-			// Get or create limiter for this key
-			mutex.RLock()
-			limiter, exists := limiters[key]
-			mutex.RUnlock()
-
-			if !exists {
-				mutex.Lock()
-				// Double-check after acquiring write lock
-				if limiter, exists = limiters[key]; !exists {
-					limiter = newRateLimiter(config.RequestsPerMinute, config.BurstSize)
-					limiters[key] = limiter
-				}
-				mutex.Unlock()
+			store := rl.currentStore()
+			if store == nil {
+				next.ServeHTTP(w, r)
+				return
 			}
 
-			// Check if request is allowed
-			if !limiter.allow() {
-				log.WarnWithFields("Rate limit exceeded", logger.Fields{
-					"key":    key,
-					"method": r.Method,
-					"path":   r.URL.Path,
+			key := rl.keyFunc(r)
+
+			allowed, remaining, retryAfter := store.get(key, time.Now()).take(time.Now())
+
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(store.config.RequestsPerMinute))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+
+			if !allowed {
+				retrySeconds := int(math.Ceil(retryAfter.Seconds()))
+
+				rl.log.WarnWithFields("Rate limit exceeded", logger.Fields{
+					"key":         maskRateLimitKey(key),
+					"method":      r.Method,
+					"path":        r.URL.Path,
+					"retry_after": retrySeconds,
 				})
 
+				w.Header().Set("Retry-After", strconv.Itoa(retrySeconds))
 				w.Header().Set("Content-Type", "application/json")
-				w.Header().Set("X-RateLimit-Limit", fmt.Sprintf("%d", config.RequestsPerMinute))
-				w.Header().Set("X-RateLimit-Remaining", "0")
-				w.Header().Set("Retry-After", "60")
 				w.WriteHeader(http.StatusTooManyRequests)
 
-				response := `{"success": false, "error": "Rate limit exceeded", "code": "RATE_LIMIT_EXCEEDED"}`
-				w.Write([]byte(response))
+				response := dto.NewErrorResponse(
+					"Rate limit exceeded",
+					"RATE_LIMIT_EXCEEDED",
+					"Too many requests, retry after "+strconv.Itoa(retrySeconds)+"s",
+				)
+				json.NewEncoder(w).Encode(response)
 				return
 			}
 
-			// Add rate limit headers
-			w.Header().Set("X-RateLimit-Limit", fmt.Sprintf("%d", config.RequestsPerMinute))
-
 			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+// maskRateLimitKey hides API keys used as rate limit keys in logs
+func maskRateLimitKey(key string) string {
+	if apiKey, ok := strings.CutPrefix(key, "key:"); ok {
+		return "key:" + maskAPIKey(apiKey)
+	}
+	return key
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"wazmeow/pkg/logger"
+)
+
+// RequestIDHeader is the header carrying the request ID in requests and responses
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds client-supplied request IDs so they stay safe to log
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware correlates the logs of a request. It reuses the client's
+// X-Request-ID when it is valid, or generates a UUID, stores it in the request
+// context for logger.WithContext and echoes it in the response.
+func RequestIDMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				requestID = uuid.NewString()
+			}
+			// Keep the header in sync for middleware that reads it before the context
+			r.Header.Set(RequestIDHeader, requestID)
+
+			// Add request ID to response headers
+			w.Header().Set(RequestIDHeader, requestID)
+
+			// Make request ID available to downstream layers
+			ctx := context.WithValue(r.Context(), logger.ContextKeyRequestID, requestID)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by RequestIDMiddleware, or "" if none
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(logger.ContextKeyRequestID).(string)
+	return requestID
+}
+
+// validRequestID reports whether a client-supplied request ID is non-empty,
+// bounded and made of printable ASCII
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
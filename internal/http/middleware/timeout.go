@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"wazmeow/pkg/logger"
+)
+
+// RequestTimeoutMiddleware bounds the context of every request, so use cases that honor
+// it give up with context.DeadlineExceeded instead of hanging. Handlers map that error
+// to 504. A zero timeout disables the deadline.
+func RequestTimeoutMiddleware(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// WriteTimeoutMiddleware replaces the server-wide write timeout for the wrapped routes.
+// It is used by endpoints that legitimately hold the connection longer than the global
+// timeout, such as pairing. A zero timeout keeps the server default.
+//
+// The request context deadline set by RequestTimeoutMiddleware is replaced by the same
+// timeout; the request is still canceled when the client goes away.
+func WriteTimeoutMiddleware(timeout time.Duration, log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if timeout > 0 {
+				rc := http.NewResponseController(w)
+				if err := rc.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+					log.WarnWithFields("failed to extend write deadline", logger.Fields{
+						"path":    r.URL.Path,
+						"timeout": timeout.String(),
+						"error":   err.Error(),
+					})
+				}
+
+				ctx, cancel := extendDeadline(r.Context(), timeout)
+				defer cancel()
+				r = r.WithContext(ctx)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// extendDeadline returns a context that keeps the values of parent but expires after
+// timeout instead of at the parent's deadline. It is still canceled with parent for
+// any other reason, such as the client closing the connection.
+func extendDeadline(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(parent), timeout)
+	stop := context.AfterFunc(parent, func() {
+		if !errors.Is(context.Cause(parent), context.DeadlineExceeded) {
+			cancel()
+		}
+	})
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
@@ -47,25 +47,6 @@ func ValidationMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 	}
 }
 
-// RequestIDMiddleware adds a request ID to each request
-func RequestIDMiddleware() func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			requestID := r.Header.Get("X-Request-ID")
-			if requestID == "" {
-				// Generate a simple request ID (in production, use UUID)
-				requestID = generateRequestID()
-				r.Header.Set("X-Request-ID", requestID)
-			}
-
-			// Add request ID to response headers
-			w.Header().Set("X-Request-ID", requestID)
-
-			next.ServeHTTP(w, r)
-		})
-	}
-}
-
 // RecoveryMiddleware recovers from panics and returns a proper error response
 func RecoveryMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -152,14 +133,3 @@ func ValidateJSONMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 		})
 	}
 }
-
-// generateRequestID generates a simple request ID
-func generateRequestID() string {
-	// In production, use a proper UUID library
-	return "req-" + strings.ReplaceAll(strings.ReplaceAll(
-		strings.ReplaceAll(
-			"xxxxxxxx-xxxx-4xxx-yxxx-xxxxxxxxxxxx",
-			"x", "a"), // Simple replacement for demo
-		"y", "b"), // Simple replacement for demo
-		"-", "")[:16]
-}
@@ -1,11 +1,12 @@
 package routes
 
 import (
-	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	httpSwagger "github.com/swaggo/http-swagger"
 
+	"wazmeow/internal/domain/whatsapp"
 	"wazmeow/internal/http/handler"
 	"wazmeow/internal/http/middleware"
 	"wazmeow/internal/infra/config"
@@ -17,25 +18,88 @@ import (
 
 // Router holds all route handlers and dependencies
 type Router struct {
-	sessionHandler *handler.SessionHandler
-	healthHandler  *handler.HealthHandler
-	config         *config.Config
-	logger         logger.Logger
+	sessionHandler    *handler.SessionHandler
+	groupHandler      *handler.GroupHandler
+	newsletterHandler *handler.NewsletterHandler
+	contactHandler    *handler.ContactHandler
+	presenceHandler   *handler.PresenceHandler
+	profileHandler    *handler.ProfileHandler
+	chatHandler       *handler.ChatHandler
+	labelHandler      *handler.LabelHandler
+	messageHandler    *handler.MessageHandler
+	healthHandler     *handler.HealthHandler
+	authHandler       *handler.AuthHandler
+	adminHandler      *handler.AdminHandler
+	rateLimiter       *middleware.RateLimiter
+	idempotency       *middleware.Idempotency
+	config            *config.Config
+	logger            logger.Logger
 }
 
 // NewRouter creates a new router with all handlers
 func NewRouter(
 	sessionHandler *handler.SessionHandler,
+	groupHandler *handler.GroupHandler,
+	newsletterHandler *handler.NewsletterHandler,
+	contactHandler *handler.ContactHandler,
+	presenceHandler *handler.PresenceHandler,
+	profileHandler *handler.ProfileHandler,
+	chatHandler *handler.ChatHandler,
+	labelHandler *handler.LabelHandler,
+	messageHandler *handler.MessageHandler,
 	healthHandler *handler.HealthHandler,
+	authHandler *handler.AuthHandler,
+	adminHandler *handler.AdminHandler,
 	config *config.Config,
 	logger logger.Logger,
 ) *Router {
-	return &Router{
-		sessionHandler: sessionHandler,
-		healthHandler:  healthHandler,
-		config:         config,
-		logger:         logger,
+	rt := &Router{
+		sessionHandler:    sessionHandler,
+		groupHandler:      groupHandler,
+		newsletterHandler: newsletterHandler,
+		contactHandler:    contactHandler,
+		presenceHandler:   presenceHandler,
+		profileHandler:    profileHandler,
+		chatHandler:       chatHandler,
+		labelHandler:      labelHandler,
+		messageHandler:    messageHandler,
+		healthHandler:     healthHandler,
+		authHandler:       authHandler,
+		adminHandler:      adminHandler,
+		config:            config,
+		logger:            logger,
 	}
+
+	// Created up front so its limits can be changed by a configuration reload
+	rateLimitConfig := &middleware.RateLimitConfig{
+		RequestsPerMinute: config.Server.RateLimit.RequestsPerMinute,
+		BurstSize:         config.Server.RateLimit.BurstSize,
+		KeyFunc:           middleware.ClientIPKey,
+	}
+	// Clients with a valid API key get their own bucket regardless of their address
+	if config.Auth.Enabled && config.Auth.Type == middleware.AuthTypeAPIKey {
+		rateLimitConfig.KeyFunc = middleware.APIKeyOrIPKey(config.Auth.HeaderName, config.Auth.APIKeys)
+	}
+	rt.rateLimiter = middleware.NewRateLimiter(rateLimitConfig, logger)
+
+	rt.idempotency = middleware.NewIdempotency(&middleware.IdempotencyConfig{
+		TTL:         config.Server.IdempotencyTTL,
+		MaxBodySize: config.Server.MaxBodySize,
+		HeaderName:  config.Auth.HeaderName,
+	}, logger)
+
+	return rt
+}
+
+// RateLimiter returns the rate limiter applied to every request
+func (rt *Router) RateLimiter() *middleware.RateLimiter {
+	return rt.rateLimiter
+}
+
+// Stop releases the background work of the router middleware
+func (rt *Router) Stop() {
+	rt.rateLimiter.Stop()
+	rt.idempotency.Stop()
 }
 
 // SetupRoutes configures all routes and middleware
@@ -51,6 +115,12 @@ func (rt *Router) SetupRoutes() *chi.Mux {
 	// Swagger documentation route (no auth required)
 	rt.setupSwaggerRoute(r)
 
+	// Token issuing route (authenticated by its own credentials)
+	rt.setupAuthRoutes(r)
+
+	// Admin routes (authenticated by the admin keys)
+	rt.setupAdminRoutes(r)
+
 	// API routes with authentication
 	rt.setupAPIRoutes(r)
 
@@ -65,6 +135,9 @@ func (rt *Router) setupGlobalMiddleware(r *chi.Mux) {
 	// Request ID middleware
 	r.Use(middleware.RequestIDMiddleware())
 
+	// Request deadline; routes with a longer write timeout extend it
+	r.Use(middleware.RequestTimeoutMiddleware(rt.config.Server.ReadTimeout))
+
 	// Security headers
 	r.Use(middleware.SecurityHeadersMiddleware())
 
@@ -82,14 +155,7 @@ func (rt *Router) setupGlobalMiddleware(r *chi.Mux) {
 	r.Use(middleware.LoggingMiddleware(rt.logger))
 
 	// Rate limiting middleware
-	rateLimitConfig := &middleware.RateLimitConfig{
-		RequestsPerMinute: rt.config.Server.RateLimit.RequestsPerMinute,
-		BurstSize:         rt.config.Server.RateLimit.BurstSize,
-		KeyFunc: func(r *http.Request) string {
-			return r.RemoteAddr
-		},
-	}
-	r.Use(middleware.RateLimitMiddleware(rateLimitConfig, rt.logger))
+	r.Use(rt.rateLimiter.Middleware())
 
 	// Content validation middleware
 	r.Use(middleware.ValidationMiddleware(rt.logger))
@@ -98,33 +164,79 @@ func (rt *Router) setupGlobalMiddleware(r *chi.Mux) {
 // setupHealthRoutes configures health and metrics routes
 func (rt *Router) setupHealthRoutes(r *chi.Mux) {
 	r.Get("/health", rt.healthHandler.Health)
+	r.Get("/health/live", rt.healthHandler.Live)
+	r.Get("/health/ready", rt.healthHandler.Ready)
 	r.Get("/metrics", rt.healthHandler.Metrics)
+	if rt.config.Features.EnableMetrics {
+		r.Get("/metrics/app", rt.healthHandler.AppMetrics)
+		rt.setupPrometheusRoute(r)
+	}
+}
+
+// setupPrometheusRoute serves the Prometheus metrics to holders of an API or admin key,
+// as they carry per-session counters. It is not served when no key is configured.
+func (rt *Router) setupPrometheusRoute(r *chi.Mux) {
+	keys := make([]string, 0, len(rt.config.Auth.APIKeys)+len(rt.config.Auth.AdminAPIKeys))
+	keys = append(keys, rt.config.Auth.APIKeys...)
+	keys = append(keys, rt.config.Auth.AdminAPIKeys...)
+	if len(keys) == 0 {
+		rt.logger.Warn("Prometheus metrics not served: no API or admin key configured")
+		return
+	}
+
+	r.With(middleware.AuthMiddleware(&middleware.AuthConfig{
+		APIKeys:    keys,
+		HeaderName: rt.config.Auth.HeaderName,
+	}, rt.logger)).Get("/metrics/prometheus", rt.healthHandler.PrometheusMetrics)
+}
+
+// setupAuthRoutes configures the token endpoint used by the jwt auth mode
+func (rt *Router) setupAuthRoutes(r *chi.Mux) {
+	if rt.config.Auth.Enabled && rt.config.Auth.Type == middleware.AuthTypeJWT {
+		r.Post("/auth/token", rt.authHandler.IssueToken)
+	}
+}
+
+// setupAdminRoutes configures the operational routes, served only when admin keys are set
+func (rt *Router) setupAdminRoutes(r *chi.Mux) {
+	if len(rt.config.Auth.AdminAPIKeys) == 0 {
+		return
+	}
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(middleware.AuthMiddleware(&middleware.AuthConfig{
+			APIKeys:    rt.config.Auth.AdminAPIKeys,
+			HeaderName: rt.config.Auth.HeaderName,
+		}, rt.logger))
+
+		r.Post("/config/reload", rt.adminHandler.ReloadConfig)
+	})
 }
 
 // setupAPIRoutes configures API routes with authentication
 func (rt *Router) setupAPIRoutes(r *chi.Mux) {
-	// Authentication middleware for API routes
-	if rt.config.Auth.Enabled {
-		switch rt.config.Auth.Type {
-		case "api_key":
-			authConfig := &middleware.AuthConfig{
-				APIKeys:    rt.config.Auth.APIKeys,
-				SkipPaths:  []string{"/health", "/metrics"},
-				HeaderName: rt.config.Auth.HeaderName,
-			}
-			r.Use(middleware.AuthMiddleware(authConfig, rt.logger))
-		case "basic":
-			r.Use(middleware.BasicAuthMiddleware(
-				rt.config.Auth.BasicAuth.Username,
-				rt.config.Auth.BasicAuth.Password,
-				rt.logger,
-			))
+	// Group the API routes so the auth middleware does not apply to the public routes above
+	r.Group(func(r chi.Router) {
+		if rt.config.Auth.Enabled {
+			r.Use(middleware.NewAuthMiddleware(&middleware.AuthSettings{
+				Type: rt.config.Auth.Type,
+				APIKey: &middleware.AuthConfig{
+					APIKeys:    rt.config.Auth.APIKeys,
+					SkipPaths:  []string{"/health", "/metrics"},
+					HeaderName: rt.config.Auth.HeaderName,
+				},
+				BasicUsername: rt.config.Auth.BasicAuth.Username,
+				BasicPassword: rt.config.Auth.BasicAuth.Password,
+				JWT: &middleware.JWTConfig{
+					Secret:    rt.config.Security.JWTSecret,
+					SkipPaths: []string{"/health", "/metrics"},
+				},
+			}, rt.logger))
 		}
-	}
-
-	// Session routes
-	rt.setupSessionRoutes(r)
 
+		// Session routes
+		rt.setupSessionRoutes(r)
+	})
 }
 
 // setupSessionRoutes configures session-related routes
@@ -132,25 +244,120 @@ func (rt *Router) setupSessionRoutes(r chi.Router) {
 	r.Route("/sessions", func(r chi.Router) {
 		// Session CRUD operations
 		r.Post("/add", rt.sessionHandler.CreateSession)
+		r.Post("/batch", rt.sessionHandler.CreateSessionsBatch)
 		r.Get("/list", rt.sessionHandler.ListSessions)
+		r.Get("/export.csv", rt.sessionHandler.ExportSessions)
+		r.Post("/import", rt.sessionHandler.ImportBackup)
+
+		// Bulk connection operations, handy during maintenance windows. Connecting every
+		// client may outlive the global write timeout.
+		r.With(middleware.WriteTimeoutMiddleware(rt.config.Server.PairingWriteTimeout, rt.logger)).
+			Post("/connect-all", rt.sessionHandler.ConnectAllSessions)
+		r.Post("/disconnect-all", rt.sessionHandler.DisconnectAllSessions)
 
 		// Individual session operations
 		r.Route("/{id}", func(r chi.Router) {
+			// Pairing operations may outlive the global write timeout
+			pairing := r.With(middleware.WriteTimeoutMiddleware(rt.config.Server.PairingWriteTimeout, rt.logger))
+			// Sends replay their first result when retried with the same Idempotency-Key
+			idempotent := r.With(rt.idempotency.Middleware())
+
 			r.Get("/info", rt.sessionHandler.GetSession)
+			r.Get("/status", rt.sessionHandler.GetLiveStatus)
 			r.Delete("/", rt.sessionHandler.DeleteSession)
 
 			// Session state operations
-			r.Post("/connect", rt.sessionHandler.ConnectSession)
+			pairing.Post("/connect", rt.sessionHandler.ConnectSession)
+			r.Get("/connect/status", rt.sessionHandler.GetConnectStatus)
+			r.Post("/disconnect", rt.sessionHandler.DisconnectSession)
 			r.Post("/logout", rt.sessionHandler.LogoutSession)
+			pairing.Post("/restart", rt.sessionHandler.RestartSession)
 
 			// WhatsApp operations for specific session
-			r.Get("/qr", rt.sessionHandler.GenerateQR)
-			r.Post("/pairphone", rt.sessionHandler.PairPhone)
+			pairing.Get("/qr", rt.sessionHandler.GenerateQR)
+			pairing.Post("/pairphone", rt.sessionHandler.PairPhone)
 			r.Post("/proxy/set", rt.sessionHandler.SetProxy)
+			pairing.Post("/proxy/rotate", rt.sessionHandler.RotateProxy)
+			r.Put("/name", rt.sessionHandler.RenameSession)
+			r.Put("/display-name", rt.sessionHandler.SetDisplayName)
+			r.Put("/allowed-senders", rt.sessionHandler.SetAllowedSenders)
+			r.Put("/send-rate-limit", rt.sessionHandler.SetSendRateLimit)
+			r.Delete("/send-rate-limit", rt.sessionHandler.ResetSendRateLimit)
+			r.Get("/sync-status", rt.sessionHandler.GetSyncStatus)
+			r.Get("/health", rt.sessionHandler.GetHealth)
+			r.Get("/pairing-history", rt.sessionHandler.GetPairingHistory)
+			r.Get("/export", rt.sessionHandler.ExportBackup)
+
+			// Group operations
+			r.Get("/groups", rt.groupHandler.ListGroups)
+			r.Get("/groups/{groupId}", rt.groupHandler.GetGroup)
+			r.Post("/groups/{groupId}/participants", rt.groupHandler.UpdateParticipants)
+
+			// Newsletter (channel) operations
+			r.Get("/newsletters/{jid}", rt.newsletterHandler.GetNewsletter)
+			idempotent.Post("/newsletters/{jid}/send", rt.newsletterHandler.SendMessage)
+
+			// Contact operations
+			r.Get("/contacts", rt.contactHandler.ListContacts)
+			r.Post("/contacts/check", rt.contactHandler.CheckNumbers)
+			r.Get("/contacts/{jid}/avatar", rt.contactHandler.GetProfilePicture)
+			r.Get("/contacts/{jid}/business", rt.contactHandler.GetBusinessProfile)
+
+			// Presence operations
+			r.Post("/presence", rt.presenceHandler.SendPresence)
+			r.Post("/presence/subscribe", rt.presenceHandler.SubscribePresence)
+			r.Post("/chats/{chat}/presence", rt.presenceHandler.SendChatPresence)
+
+			// Chat operations
+			r.Get("/chats", rt.chatHandler.ListChats)
+			r.Post("/chats/{chat}/read", rt.chatHandler.MarkRead)
+			r.Post("/chats/{chat}/unread", rt.chatHandler.MarkUnread)
+			r.Post("/chats/{chat}/archive", rt.chatHandler.ArchiveChat)
+			r.Post("/chats/{chat}/mute", rt.chatHandler.MuteChat)
+			r.Post("/chats/{chat}/clear", rt.chatHandler.ClearChat)
+			r.Delete("/chats/{chat}", rt.chatHandler.DeleteChat)
+			r.Get("/chats/{chat}/context", rt.chatHandler.GetMessageContext)
+			r.Get("/chats/{chat}/messages", rt.chatHandler.ListMessages)
+
+			// Label operations (WhatsApp Business)
+			r.Get("/labels", rt.labelHandler.ListLabels)
+			r.Post("/labels", rt.labelHandler.CreateLabel)
+			r.Post("/chats/{chat}/labels", rt.labelHandler.LabelChat)
+
+			// Message operations
+			r.Get("/messages/recent", rt.messageHandler.GetRecentMessages)
+			r.Get("/messages/{messageId}/media", rt.messageHandler.DownloadMedia)
+			r.Get("/messages/{messageId}/status", rt.messageHandler.GetMessageStatus)
+			r.Post("/messages/schedule", rt.messageHandler.ScheduleMessage)
+			r.Get("/messages/schedule", rt.messageHandler.ListScheduledMessages)
+			r.Delete("/messages/schedule/{scheduleId}", rt.messageHandler.CancelScheduledMessage)
+			idempotent.Post("/send/poll", rt.messageHandler.SendPoll)
+			idempotent.Post("/send/sticker", rt.messageHandler.SendSticker)
+			idempotent.Post("/send/buttons", rt.messageHandler.SendButtons)
+			idempotent.Post("/send/list", rt.messageHandler.SendList)
+			idempotent.Post("/messages/{messageId}/forward", rt.messageHandler.ForwardMessage)
+			// Broadcasts pause between recipients and may outlive the global write timeout
+			idempotent.With(middleware.WriteTimeoutMiddleware(rt.broadcastWriteTimeout(), rt.logger)).
+				Post("/send/broadcast", rt.messageHandler.SendBroadcast)
+
+			// Profile operations
+			r.Put("/profile/avatar", rt.profileHandler.SetProfilePicture)
 		})
 	})
 }
 
+// broadcastSendAllowance is the time budgeted for each send of a broadcast, on top of the delay
+const broadcastSendAllowance = 5 * time.Second
+
+// broadcastWriteTimeout is the write timeout of a broadcast to the maximum number of recipients
+func (rt *Router) broadcastWriteTimeout() time.Duration {
+	maxRecipients := rt.config.WhatsApp.BroadcastMaxRecipients
+	if maxRecipients <= 0 {
+		maxRecipients = whatsapp.DefaultBroadcastMaxRecipients
+	}
+	return rt.config.Server.WriteTimeout + time.Duration(maxRecipients)*(rt.config.WhatsApp.BroadcastDelay+broadcastSendAllowance)
+}
+
 // setupSwaggerRoute configures the Swagger documentation route
 func (rt *Router) setupSwaggerRoute(r *chi.Mux) {
 	// Swagger documentation route - accessible without authentication
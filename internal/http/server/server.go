@@ -43,11 +43,12 @@ func (s *Server) Start() error {
 	}
 
 	s.logger.InfoWithFields("Starting HTTP server", logger.Fields{
-		"host":          s.config.Host,
-		"port":          s.config.Port,
-		"read_timeout":  s.config.ReadTimeout,
-		"write_timeout": s.config.WriteTimeout,
-		"idle_timeout":  s.config.IdleTimeout,
+		"host":                  s.config.Host,
+		"port":                  s.config.Port,
+		"read_timeout":          s.config.ReadTimeout,
+		"write_timeout":         s.config.WriteTimeout,
+		"pairing_write_timeout": s.config.PairingWriteTimeout,
+		"idle_timeout":          s.config.IdleTimeout,
 	})
 
 	// Start server
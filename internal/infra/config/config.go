@@ -2,12 +2,12 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/joho/godotenv"
 )
 
 // Config represents the application configuration
@@ -20,17 +20,26 @@ type Config struct {
 	Features FeaturesConfig `json:"features"`
 	Auth     AuthConfig     `json:"auth"`
 	Proxy    ProxyConfig    `json:"proxy"`
+	Webhook  WebhookConfig  `json:"webhook"`
 }
 
 // ServerConfig represents server configuration
 type ServerConfig struct {
-	Host         string          `json:"host"`
-	Port         int             `json:"port"`
-	ReadTimeout  time.Duration   `json:"read_timeout"`
-	WriteTimeout time.Duration   `json:"write_timeout"`
-	IdleTimeout  time.Duration   `json:"idle_timeout"`
-	CORS         CORSConfig      `json:"cors"`
-	RateLimit    RateLimitConfig `json:"rate_limit"`
+	Host                string          `json:"host"`
+	Port                int             `json:"port"`
+	ReadTimeout         time.Duration   `json:"read_timeout"`
+	WriteTimeout        time.Duration   `json:"write_timeout"`
+	PairingWriteTimeout time.Duration   `json:"pairing_write_timeout"` // Write timeout for connect, QR, pair phone and proxy rotation (0 = WriteTimeout)
+	IdleTimeout         time.Duration   `json:"idle_timeout"`
+	IdempotencyTTL      time.Duration   `json:"idempotency_ttl"` // How long send results are replayed for an Idempotency-Key (0 = disabled)
+	MaxBodySize         int64           `json:"max_body_size"`   // Largest body in bytes of a request sent with an Idempotency-Key (0 = unlimited)
+	CORS                CORSConfig      `json:"cors"`
+	RateLimit           RateLimitConfig `json:"rate_limit"`
+
+	// Session lists of up to ConversionParallelThreshold sessions are converted to responses
+	// sequentially, longer ones by ConversionWorkers goroutines (0 uses the defaults)
+	ConversionParallelThreshold int `json:"conversion_parallel_threshold"`
+	ConversionWorkers           int `json:"conversion_workers"`
 }
 
 // DatabaseConfig represents database configuration
@@ -42,6 +51,11 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `json:"max_idle_conns"`    // Max idle connections
 	ConnMaxLifetime time.Duration `json:"conn_max_lifetime"` // Connection max lifetime
 
+	// ConnectRetries is how many more times connecting is tried when the database is not
+	// ready at startup; the wait starts at ConnectRetryInterval and doubles each retry
+	ConnectRetries       int           `json:"connect_retries"`
+	ConnectRetryInterval time.Duration `json:"connect_retry_interval"`
+
 	// PostgreSQL specific settings
 	PostgreSQL PostgreSQLConfig `json:"postgresql"`
 
@@ -71,6 +85,10 @@ type SQLiteConfig struct {
 	CacheSize   int    `json:"cache_size"`   // Page cache size
 	TempStore   string `json:"temp_store"`   // DEFAULT, FILE, MEMORY
 	MmapSize    int64  `json:"mmap_size"`    // Memory-mapped I/O size
+
+	// BusyTimeout is how long a connection waits for a lock held by another one
+	// before failing with "database is locked"
+	BusyTimeout time.Duration `json:"busy_timeout"`
 }
 
 // WhatsAppConfig represents WhatsApp configuration
@@ -79,6 +97,81 @@ type WhatsAppConfig struct {
 	QRTimeout      time.Duration `json:"qr_timeout"`
 	ReconnectDelay time.Duration `json:"reconnect_delay"`
 	MaxReconnects  int           `json:"max_reconnects"`
+
+	// DefaultCountryCode is prepended to phone numbers without a detectable country code
+	DefaultCountryCode string `json:"default_country_code"`
+
+	// AutoSendPresence marks the session as available when a feature requires it
+	// (e.g. presence subscriptions). Disable to never appear online implicitly.
+	AutoSendPresence bool `json:"auto_send_presence"`
+
+	// StatusSyncInterval is how often persisted session statuses are reconciled with
+	// the live connection state. Zero disables reconciliation.
+	StatusSyncInterval time.Duration `json:"status_sync_interval"`
+
+	// DisconnectGracePeriod is how long a lost connection may last before the session is
+	// reported as disconnected, absorbing blips that reconnect on their own. Zero reports immediately.
+	DisconnectGracePeriod time.Duration `json:"disconnect_grace_period"`
+
+	// QRWaitTimeout is how long a QR request waits for the first code of a pairing
+	// attempt before answering that it is not ready yet. Zero answers immediately.
+	QRWaitTimeout time.Duration `json:"qr_wait_timeout"`
+
+	// QRSize is the width in pixels of generated QR code images, clamped to
+	// MinQRSize-MaxQRSize. QRLevel is the error-correction level: low, medium (default),
+	// high or highest.
+	QRSize  int    `json:"qr_size"`
+	QRLevel string `json:"qr_level"`
+
+	// AutoReconnect restores previously connected, paired sessions when the server starts
+	AutoReconnect bool `json:"auto_reconnect"`
+
+	// Device identity announced when pairing, shown on the linked devices screen of the
+	// phone. DevicePlatform is one of DevicePlatforms and DeviceOSVersion an x.y.z version.
+	DeviceName         string `json:"device_name"`
+	DevicePlatform     string `json:"device_platform"`
+	DeviceOSVersion    string `json:"device_os_version"`
+	DeviceModel        string `json:"device_model"`
+	DeviceManufacturer string `json:"device_manufacturer"`
+
+	// AppVersion overrides the x.y.z WhatsApp Web version announced to the server.
+	// Empty keeps the version bundled with whatsmeow; outdated versions are rejected.
+	AppVersion string `json:"app_version"`
+
+	// PairClientName is the "Browser (OS)" name shown when pairing with a phone code.
+	// WhatsApp only accepts common browsers and operating systems.
+	PairClientName string `json:"pair_client_name"`
+
+	// BroadcastDelay is the pause between recipients of a broadcast, spacing the sends out
+	// so the account is less likely to be flagged as spam. BroadcastMaxRecipients caps
+	// the recipients of a single broadcast; zero keeps the default of 50.
+	BroadcastDelay         time.Duration `json:"broadcast_delay"`
+	BroadcastMaxRecipients int           `json:"broadcast_max_recipients"`
+
+	// SendRatePerSecond and SendRateBurst are the default outbound message limit of each
+	// session: up to SendRateBurst messages at once, then SendRatePerSecond more each second.
+	// A zero rate disables the limit. Sends wait up to SendRateMaxWait for their turn and
+	// are refused with 429 beyond that. Sessions can override the rate and burst.
+	SendRatePerSecond float64       `json:"send_rate_per_second"`
+	SendRateBurst     int           `json:"send_rate_burst"`
+	SendRateMaxWait   time.Duration `json:"send_rate_max_wait"`
+
+	// SchedulerInterval is how often due scheduled messages are sent. Zero disables
+	// sending; schedules are still accepted and sent once it is enabled again.
+	SchedulerInterval time.Duration `json:"scheduler_interval"`
+
+	// RecentMessagesSize is how many incoming messages of each session are kept in memory
+	// for polling; zero disables the buffer. RecentMessagesRetention is how long they stay
+	// available; zero keeps them until newer messages overwrite them.
+	RecentMessagesSize      int           `json:"recent_messages_size"`
+	RecentMessagesRetention time.Duration `json:"recent_messages_retention"`
+
+	// MessageRetention is how long stored messages, receipts and chats are kept; older
+	// ones are deleted periodically. Zero keeps them until their session is deleted.
+	MessageRetention time.Duration `json:"message_retention"`
+
+	// MaxSessions caps how many sessions can have a live client at once; zero means no limit
+	MaxSessions int `json:"max_sessions"`
 }
 
 // LogConfig represents logging configuration
@@ -95,6 +188,10 @@ type LogConfig struct {
 	MaxBackups    int    `json:"max_backups"` // Maximum number of backup files
 	MaxAge        int    `json:"max_age"`     // Maximum age in days
 	PrettyJSON    bool   `json:"pretty_json"` // Enable pretty printing for JSON file output
+
+	// WebhookURL receives error-level log entries as JSON for alerting; empty disables it
+	WebhookURL     string        `json:"webhook_url"`
+	WebhookTimeout time.Duration `json:"webhook_timeout"`
 }
 
 // CORSConfig represents CORS configuration
@@ -115,10 +212,15 @@ type RateLimitConfig struct {
 // AuthConfig represents authentication configuration
 type AuthConfig struct {
 	Enabled    bool            `json:"enabled"`
-	Type       string          `json:"type"` // "api_key" or "basic"
+	Type       string          `json:"type"` // "api_key", "basic" or "jwt"
 	APIKeys    []string        `json:"api_keys"`
 	HeaderName string          `json:"header_name"`
 	BasicAuth  BasicAuthConfig `json:"basic_auth"`
+	TokenTTL   time.Duration   `json:"token_ttl"` // Lifetime of tokens issued in jwt mode
+
+	// AdminAPIKeys authenticate the admin routes, sent in HeaderName. The admin routes are
+	// not served when empty, whether or not AUTH_ENABLED is set.
+	AdminAPIKeys []string `json:"admin_api_keys"`
 }
 
 // BasicAuthConfig represents basic authentication configuration
@@ -151,24 +253,38 @@ type ProxyConfig struct {
 	MaxRetries      int           `json:"max_retries"`
 	TestURL         string        `json:"test_url"`          // URL used to test proxy connectivity
 	AllowPerSession bool          `json:"allow_per_session"` // Allow per-session proxy override
+	Pool            []string      `json:"pool"`              // Proxy URLs used when rotating a session proxy
+}
+
+// WebhookConfig represents webhook delivery configuration (enabled by ENABLE_WEBHOOKS)
+type WebhookConfig struct {
+	URL                 string        `json:"url"`                     // Receiver for session events
+	Timeout             time.Duration `json:"timeout"`                 // Per-delivery timeout, including reading the response
+	MaxIdleConnsPerHost int           `json:"max_idle_conns_per_host"` // Keep-alive connections kept open to the receiver
+	QueueSize           int           `json:"queue_size"`              // Pending deliveries before new events are dropped
+	Workers             int           `json:"workers"`                 // Concurrent deliveries
+	Format              string        `json:"format"`                  // Payload format: rich (full event data) or simple (flat messages)
 }
 
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Try to load .env file (ignore error if file doesn't exist)
-	_ = godotenv.Load()
+	loadDotEnv()
 
 	config := &Config{
 		Server: ServerConfig{
-			Host:         getEnvString("SERVER_HOST", "localhost"),
-			Port:         getEnvInt("SERVER_PORT", 8080),
-			ReadTimeout:  getEnvDuration("SERVER_READ_TIMEOUT", 30*time.Second),
-			WriteTimeout: getEnvDuration("SERVER_WRITE_TIMEOUT", 30*time.Second),
-			IdleTimeout:  getEnvDuration("SERVER_IDLE_TIMEOUT", 60*time.Second),
+			Host:                getEnvString("SERVER_HOST", "localhost"),
+			Port:                getEnvInt("SERVER_PORT", 8080),
+			ReadTimeout:         getEnvDuration("SERVER_READ_TIMEOUT", 30*time.Second),
+			WriteTimeout:        getEnvDuration("SERVER_WRITE_TIMEOUT", 30*time.Second),
+			PairingWriteTimeout: getEnvDuration("SERVER_PAIRING_WRITE_TIMEOUT", 6*time.Minute),
+			IdleTimeout:         getEnvDuration("SERVER_IDLE_TIMEOUT", 60*time.Second),
+			IdempotencyTTL:      getEnvDuration("SERVER_IDEMPOTENCY_TTL", 24*time.Hour),
+			MaxBodySize:         getEnvInt64("SERVER_MAX_BODY_SIZE", 16<<20),
 			CORS: CORSConfig{
 				AllowedOrigins:   getEnvStringSlice("CORS_ALLOWED_ORIGINS", []string{"*"}),
 				AllowedMethods:   getEnvStringSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
-				AllowedHeaders:   getEnvStringSlice("CORS_ALLOWED_HEADERS", []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"}),
+				AllowedHeaders:   getEnvStringSlice("CORS_ALLOWED_HEADERS", []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Idempotency-Key"}),
 				AllowCredentials: getEnvBool("CORS_ALLOW_CREDENTIALS", false),
 				MaxAge:           getEnvInt("CORS_MAX_AGE", 86400),
 			},
@@ -176,6 +292,8 @@ func Load() (*Config, error) {
 				RequestsPerMinute: getEnvInt("RATE_LIMIT_REQUESTS", 100),
 				BurstSize:         getEnvInt("RATE_LIMIT_BURST_SIZE", 10),
 			},
+			ConversionParallelThreshold: getEnvInt("SERVER_CONVERSION_PARALLEL_THRESHOLD", 0),
+			ConversionWorkers:           getEnvInt("SERVER_CONVERSION_WORKERS", 0),
 		},
 		Database: DatabaseConfig{
 			Driver:          getEnvString("DB_DRIVER", "sqlite3"),
@@ -184,6 +302,9 @@ func Load() (*Config, error) {
 			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 25),
 			MaxIdleConns:    getEnvInt("DB_MAX_IDLE_CONNS", 5),
 			ConnMaxLifetime: getEnvDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+
+			ConnectRetries:       getEnvInt("DB_CONNECT_RETRIES", 5),
+			ConnectRetryInterval: getEnvDuration("DB_CONNECT_RETRY_INTERVAL", time.Second),
 			PostgreSQL: PostgreSQLConfig{
 				Host:       getEnvString("POSTGRES_HOST", "localhost"),
 				Port:       getEnvInt("POSTGRES_PORT", 5432),
@@ -203,6 +324,7 @@ func Load() (*Config, error) {
 				CacheSize:   getEnvInt("SQLITE_CACHE_SIZE", 1000),
 				TempStore:   getEnvString("SQLITE_TEMP_STORE", "memory"),
 				MmapSize:    getEnvInt64("SQLITE_MMAP_SIZE", 268435456),
+				BusyTimeout: getEnvDuration("SQLITE_BUSY_TIMEOUT", 5*time.Second),
 			},
 		},
 		WhatsApp: WhatsAppConfig{
@@ -210,6 +332,40 @@ func Load() (*Config, error) {
 			QRTimeout:      getEnvDuration("WHATSAPP_QR_TIMEOUT", 5*time.Minute),
 			ReconnectDelay: getEnvDuration("WHATSAPP_RECONNECT_DELAY", 5*time.Second),
 			MaxReconnects:  getEnvInt("WHATSAPP_MAX_RECONNECTS", 3),
+
+			DefaultCountryCode: getEnvString("DEFAULT_COUNTRY_CODE", ""),
+			AutoSendPresence:   getEnvBool("WHATSAPP_AUTO_SEND_PRESENCE", true),
+			StatusSyncInterval: getEnvDuration("WHATSAPP_STATUS_SYNC_INTERVAL", time.Minute),
+			AutoReconnect:      getEnvBool("WHATSAPP_AUTO_RECONNECT", true),
+
+			DisconnectGracePeriod: getEnvDuration("WHATSAPP_DISCONNECT_GRACE_PERIOD", 5*time.Second),
+			QRWaitTimeout:         getEnvDuration("WHATSAPP_QR_WAIT_TIMEOUT", 5*time.Second),
+			QRSize:                clampQRSize(getEnvInt("WHATSAPP_QR_SIZE", 256)),
+			QRLevel:               strings.ToLower(getEnvString("WHATSAPP_QR_LEVEL", "medium")),
+
+			DeviceName:         getEnvString("WHATSAPP_DEVICE_NAME", "WazMeow"),
+			DevicePlatform:     strings.ToLower(getEnvString("WHATSAPP_DEVICE_PLATFORM", "desktop")),
+			DeviceOSVersion:    getEnvString("WHATSAPP_DEVICE_OS_VERSION", "0.1.0"),
+			DeviceModel:        getEnvString("WHATSAPP_DEVICE_MODEL", "Desktop"),
+			DeviceManufacturer: getEnvString("WHATSAPP_DEVICE_MANUFACTURER", "WazMeow"),
+			AppVersion:         getEnvString("WHATSAPP_APP_VERSION", ""),
+			PairClientName:     getEnvString("WHATSAPP_PAIR_CLIENT_NAME", "Chrome (Linux)"),
+
+			BroadcastDelay:         getEnvDuration("WHATSAPP_BROADCAST_DELAY", time.Second),
+			BroadcastMaxRecipients: getEnvInt("WHATSAPP_BROADCAST_MAX_RECIPIENTS", 50),
+
+			SendRatePerSecond: getEnvFloat("WHATSAPP_SEND_RATE_PER_SECOND", 0),
+			SendRateBurst:     getEnvInt("WHATSAPP_SEND_RATE_BURST", 5),
+			SendRateMaxWait:   getEnvDuration("WHATSAPP_SEND_RATE_MAX_WAIT", 10*time.Second),
+
+			SchedulerInterval: getEnvDuration("WHATSAPP_SCHEDULER_INTERVAL", 10*time.Second),
+
+			RecentMessagesSize:      getEnvInt("WHATSAPP_RECENT_MESSAGES_SIZE", 100),
+			RecentMessagesRetention: getEnvDuration("WHATSAPP_RECENT_MESSAGES_RETENTION", time.Hour),
+
+			MessageRetention: getEnvDuration("WHATSAPP_MESSAGE_RETENTION", 0),
+
+			MaxSessions: getEnvInt("MAX_SESSIONS", 0),
 		},
 		Log: LogConfig{
 			Level:         getEnvString("LOG_LEVEL", "info"),
@@ -223,6 +379,9 @@ func Load() (*Config, error) {
 			MaxSize:       getEnvInt("LOG_MAX_SIZE", 100),
 			MaxBackups:    getEnvInt("LOG_MAX_BACKUPS", 3),
 			MaxAge:        getEnvInt("LOG_MAX_AGE", 28),
+
+			WebhookURL:     getEnvString("LOG_WEBHOOK_URL", ""),
+			WebhookTimeout: getEnvDuration("LOG_WEBHOOK_TIMEOUT", 5*time.Second),
 		},
 		Security: SecurityConfig{
 			JWTSecret: getEnvString("JWT_SECRET", ""),
@@ -241,6 +400,8 @@ func Load() (*Config, error) {
 				Username: getEnvString("AUTH_BASIC_USERNAME", ""),
 				Password: getEnvString("AUTH_BASIC_PASSWORD", ""),
 			},
+			TokenTTL:     getEnvDuration("AUTH_TOKEN_TTL", time.Hour),
+			AdminAPIKeys: getEnvStringSlice("ADMIN_API_KEYS", []string{}),
 		},
 		Proxy: ProxyConfig{
 			Enabled:         getEnvBool("PROXY_ENABLED", false),
@@ -253,6 +414,15 @@ func Load() (*Config, error) {
 			MaxRetries:      getEnvInt("PROXY_MAX_RETRIES", 3),
 			TestURL:         getEnvString("PROXY_TEST_URL", "https://httpbin.org/ip"),
 			AllowPerSession: getEnvBool("PROXY_ALLOW_PER_SESSION", true),
+			Pool:            getEnvProxyPool("PROXY_POOL"),
+		},
+		Webhook: WebhookConfig{
+			URL:                 getEnvString("WEBHOOK_URL", ""),
+			Timeout:             getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxIdleConnsPerHost: getEnvInt("WEBHOOK_MAX_IDLE_CONNS_PER_HOST", 16),
+			QueueSize:           getEnvInt("WEBHOOK_QUEUE_SIZE", 1000),
+			Workers:             getEnvInt("WEBHOOK_WORKERS", 4),
+			Format:              getEnvString("WEBHOOK_FORMAT", "rich"),
 		},
 	}
 
@@ -269,6 +439,22 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid server port: %d", c.Server.Port)
 	}
 
+	if c.Server.PairingWriteTimeout < 0 {
+		return fmt.Errorf("invalid pairing write timeout: %v", c.Server.PairingWriteTimeout)
+	}
+
+	if c.Server.IdempotencyTTL < 0 {
+		return fmt.Errorf("invalid idempotency TTL: %v", c.Server.IdempotencyTTL)
+	}
+
+	if c.Server.MaxBodySize < 0 {
+		return fmt.Errorf("invalid max body size: %d", c.Server.MaxBodySize)
+	}
+
+	if c.Server.ConversionParallelThreshold < 0 || c.Server.ConversionWorkers < 0 {
+		return fmt.Errorf("invalid session conversion settings: threshold %d, workers %d", c.Server.ConversionParallelThreshold, c.Server.ConversionWorkers)
+	}
+
 	if c.Database.Driver == "" {
 		return fmt.Errorf("database driver is required")
 	}
@@ -277,6 +463,10 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("database URL is required")
 	}
 
+	if err := c.validateDatabasePool(); err != nil {
+		return fmt.Errorf("invalid database configuration: %w", err)
+	}
+
 	if c.Log.Level == "" {
 		return fmt.Errorf("log level is required")
 	}
@@ -300,16 +490,135 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid file log format: %s", c.Log.FileFormat)
 	}
 
+	if c.Log.WebhookURL != "" {
+		parsed, err := url.Parse(c.Log.WebhookURL)
+		if err != nil || parsed.Host == "" || !contains([]string{"http", "https"}, parsed.Scheme) {
+			return fmt.Errorf("invalid log webhook URL: %q", c.Log.WebhookURL)
+		}
+		if c.Log.WebhookTimeout <= 0 {
+			return fmt.Errorf("invalid log webhook timeout: %v", c.Log.WebhookTimeout)
+		}
+	}
+
+	if !isValidCountryCode(c.WhatsApp.DefaultCountryCode) {
+		return fmt.Errorf("invalid default country code: %s", c.WhatsApp.DefaultCountryCode)
+	}
+
+	if c.WhatsApp.StatusSyncInterval < 0 {
+		return fmt.Errorf("status sync interval cannot be negative")
+	}
+
+	if c.WhatsApp.DisconnectGracePeriod < 0 {
+		return fmt.Errorf("disconnect grace period cannot be negative")
+	}
+
+	if c.WhatsApp.QRWaitTimeout < 0 {
+		return fmt.Errorf("QR wait timeout cannot be negative")
+	}
+
+	// An empty level keeps the default (medium)
+	if c.WhatsApp.QRLevel != "" && !contains(QRLevels, c.WhatsApp.QRLevel) {
+		return fmt.Errorf("invalid QR level: %q (expected one of %s)", c.WhatsApp.QRLevel, strings.Join(QRLevels, ", "))
+	}
+
+	if c.WhatsApp.BroadcastDelay < 0 {
+		return fmt.Errorf("broadcast delay cannot be negative")
+	}
+
+	if c.WhatsApp.BroadcastMaxRecipients < 0 {
+		return fmt.Errorf("invalid broadcast max recipients: %d", c.WhatsApp.BroadcastMaxRecipients)
+	}
+
+	if c.WhatsApp.SendRatePerSecond < 0 || c.WhatsApp.SendRatePerSecond > 100 {
+		return fmt.Errorf("invalid send rate per second: %v (must be between 0 and 100)", c.WhatsApp.SendRatePerSecond)
+	}
+
+	if c.WhatsApp.SendRateBurst < 0 {
+		return fmt.Errorf("invalid send rate burst: %d", c.WhatsApp.SendRateBurst)
+	}
+
+	if c.WhatsApp.SendRateMaxWait < 0 {
+		return fmt.Errorf("send rate max wait cannot be negative")
+	}
+
+	if c.WhatsApp.SchedulerInterval < 0 {
+		return fmt.Errorf("scheduler interval cannot be negative")
+	}
+
+	if c.WhatsApp.RecentMessagesSize < 0 {
+		return fmt.Errorf("invalid recent messages size: %d", c.WhatsApp.RecentMessagesSize)
+	}
+
+	if c.WhatsApp.RecentMessagesRetention < 0 {
+		return fmt.Errorf("recent messages retention cannot be negative")
+	}
+
+	if c.WhatsApp.MessageRetention < 0 {
+		return fmt.Errorf("message retention cannot be negative")
+	}
+
+	if c.WhatsApp.MaxSessions < 0 {
+		return fmt.Errorf("invalid max sessions: %d", c.WhatsApp.MaxSessions)
+	}
+
+	if err := c.validateDeviceIdentity(); err != nil {
+		return fmt.Errorf("invalid device identity: %w", err)
+	}
+
+	// Validate authentication configuration
+	if err := c.validateAuth(); err != nil {
+		return fmt.Errorf("invalid auth configuration: %w", err)
+	}
+
 	// Validate proxy configuration
 	if err := c.validateProxy(); err != nil {
 		return fmt.Errorf("invalid proxy configuration: %w", err)
 	}
 
+	// Validate webhook configuration
+	if err := c.validateWebhook(); err != nil {
+		return fmt.Errorf("invalid webhook configuration: %w", err)
+	}
+
 	return nil
 }
 
+// validateAuth validates the authentication configuration
+func (c *Config) validateAuth() error {
+	if !c.Auth.Enabled {
+		return nil
+	}
+
+	switch c.Auth.Type {
+	case "api_key", "basic":
+		return nil
+	case "jwt":
+		if c.Security.JWTSecret == "" {
+			return fmt.Errorf("JWT_SECRET is required for jwt auth")
+		}
+		// Tokens are issued in exchange for the basic credentials
+		if c.Auth.BasicAuth.Username == "" || c.Auth.BasicAuth.Password == "" {
+			return fmt.Errorf("basic credentials are required to issue jwt tokens")
+		}
+		if c.Auth.TokenTTL <= 0 {
+			return fmt.Errorf("token TTL must be positive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported auth type: %s", c.Auth.Type)
+	}
+}
+
 // validateProxy validates the proxy configuration
 func (c *Config) validateProxy() error {
+	// Validate proxy pool entries (used for rotation even when the default proxy is disabled)
+	for _, proxyURL := range c.Proxy.Pool {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil || parsed.Host == "" || !contains([]string{"http", "https", "socks4", "socks5"}, parsed.Scheme) {
+			return fmt.Errorf("invalid proxy pool entry: %s", proxyURL)
+		}
+	}
+
 	if !c.Proxy.Enabled {
 		return nil // Skip validation if proxy is disabled
 	}
@@ -345,6 +654,130 @@ func (c *Config) validateProxy() error {
 	return nil
 }
 
+// QR code image limits
+const (
+	MinQRSize = 128
+	MaxQRSize = 1024
+)
+
+// QRLevels are the supported QR code error-correction levels, from least to most redundant
+var QRLevels = []string{"low", "medium", "high", "highest"}
+
+// clampQRSize keeps the QR code image size within MinQRSize and MaxQRSize
+func clampQRSize(size int) int {
+	if size < MinQRSize {
+		return MinQRSize
+	}
+	if size > MaxQRSize {
+		return MaxQRSize
+	}
+	return size
+}
+
+// DevicePlatforms are the supported device platforms shown on the linked devices screen
+var DevicePlatforms = []string{"desktop", "chrome", "firefox", "safari", "edge", "opera", "ie", "uwp", "ipad", "android_tablet", "unknown"}
+
+var (
+	// versionPattern matches x.y.z versions
+	versionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	// pairClientNamePattern matches "Browser (OS)" names
+	pairClientNamePattern = regexp.MustCompile(`^[^()]+ \([^()]+\)$`)
+)
+
+// validateDatabasePool validates the connection pool and startup retry settings.
+// Zero pool values keep the database/sql defaults (unlimited open connections, no lifetime).
+func (c *Config) validateDatabasePool() error {
+	db := c.Database
+
+	if db.MaxOpenConns < 0 {
+		return fmt.Errorf("max open connections cannot be negative")
+	}
+
+	if db.MaxIdleConns < 0 {
+		return fmt.Errorf("max idle connections cannot be negative")
+	}
+
+	if db.MaxOpenConns > 0 && db.MaxIdleConns > db.MaxOpenConns {
+		return fmt.Errorf("max idle connections (%d) cannot exceed max open connections (%d)", db.MaxIdleConns, db.MaxOpenConns)
+	}
+
+	if db.ConnMaxLifetime < 0 {
+		return fmt.Errorf("connection max lifetime cannot be negative")
+	}
+
+	if db.SQLite.BusyTimeout < 0 {
+		return fmt.Errorf("SQLite busy timeout cannot be negative")
+	}
+
+	if db.ConnectRetries < 0 {
+		return fmt.Errorf("connect retries cannot be negative")
+	}
+
+	if db.ConnectRetries > 0 && db.ConnectRetryInterval <= 0 {
+		return fmt.Errorf("connect retry interval must be positive when retries are enabled")
+	}
+
+	return nil
+}
+
+// validateDeviceIdentity validates the device identity announced when pairing.
+// Empty values keep the defaults.
+func (c *Config) validateDeviceIdentity() error {
+	wa := c.WhatsApp
+
+	if wa.DevicePlatform != "" && !contains(DevicePlatforms, wa.DevicePlatform) {
+		return fmt.Errorf("invalid device platform: %q (expected one of %s)", wa.DevicePlatform, strings.Join(DevicePlatforms, ", "))
+	}
+
+	if wa.DeviceOSVersion != "" && !versionPattern.MatchString(wa.DeviceOSVersion) {
+		return fmt.Errorf("invalid device OS version: %q (expected x.y.z)", wa.DeviceOSVersion)
+	}
+
+	if wa.AppVersion != "" && !versionPattern.MatchString(wa.AppVersion) {
+		return fmt.Errorf("invalid app version: %q (expected x.y.z, e.g. 2.3000.1025357550)", wa.AppVersion)
+	}
+
+	if wa.PairClientName != "" && !pairClientNamePattern.MatchString(wa.PairClientName) {
+		return fmt.Errorf("invalid pair client name: %q (expected \"Browser (OS)\", e.g. \"Chrome (Linux)\")", wa.PairClientName)
+	}
+
+	return nil
+}
+
+// validateWebhook validates the webhook configuration
+func (c *Config) validateWebhook() error {
+	if !c.Features.EnableWebhooks {
+		return nil // Skip validation if webhooks are disabled
+	}
+
+	parsed, err := url.Parse(c.Webhook.URL)
+	if err != nil || parsed.Host == "" || !contains([]string{"http", "https"}, parsed.Scheme) {
+		return fmt.Errorf("invalid webhook URL: %q", c.Webhook.URL)
+	}
+
+	if c.Webhook.Timeout <= 0 {
+		return fmt.Errorf("invalid webhook timeout: %v", c.Webhook.Timeout)
+	}
+
+	if c.Webhook.MaxIdleConnsPerHost <= 0 {
+		return fmt.Errorf("invalid webhook max idle connections per host: %d", c.Webhook.MaxIdleConnsPerHost)
+	}
+
+	if c.Webhook.QueueSize <= 0 {
+		return fmt.Errorf("invalid webhook queue size: %d", c.Webhook.QueueSize)
+	}
+
+	if c.Webhook.Workers <= 0 {
+		return fmt.Errorf("invalid webhook workers: %d", c.Webhook.Workers)
+	}
+
+	if !contains([]string{"rich", "simple"}, c.Webhook.Format) {
+		return fmt.Errorf("invalid webhook format: %q", c.Webhook.Format)
+	}
+
+	return nil
+}
+
 // GetServerAddress returns the server address
 func (c *Config) GetServerAddress() string {
 	return fmt.Sprintf("%s:%d", c.Server.Host, c.Server.Port)
@@ -450,6 +883,15 @@ func getEnvInt64(key string, defaultValue int64) int64 {
 	return defaultValue
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if boolValue, err := strconv.ParseBool(value); err == nil {
@@ -475,6 +917,17 @@ func getEnvStringSlice(key string, defaultValue []string) []string {
 	return defaultValue
 }
 
+// getEnvProxyPool reads a comma-separated list of proxy URLs, ignoring blank entries
+func getEnvProxyPool(key string) []string {
+	var pool []string
+	for _, entry := range getEnvStringSlice(key, nil) {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			pool = append(pool, entry)
+		}
+	}
+	return pool
+}
+
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
 		if s == item {
@@ -483,3 +936,20 @@ func contains(slice []string, item string) bool {
 	}
 	return false
 }
+
+// isValidCountryCode checks if a country code is empty or 1-3 digits with an optional leading +
+func isValidCountryCode(code string) bool {
+	code = strings.TrimPrefix(code, "+")
+	if code == "" {
+		return true
+	}
+	if len(code) > 3 || code[0] == '0' {
+		return false
+	}
+	for _, char := range code {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
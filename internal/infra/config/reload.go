@@ -0,0 +1,117 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/joho/godotenv"
+)
+
+// dotEnvKeys holds the variables set from the .env file, so a later Load can pick up
+// changes to the file. Variables of the process environment always take precedence.
+var (
+	dotEnvMu   sync.Mutex
+	dotEnvKeys = make(map[string]bool)
+)
+
+// loadDotEnv sets the variables of the .env file in the process environment, replacing the
+// ones set by a previous load and unsetting the ones since removed from the file
+func loadDotEnv() {
+	values, err := godotenv.Read()
+	if err != nil {
+		values = nil
+	}
+
+	dotEnvMu.Lock()
+	defer dotEnvMu.Unlock()
+
+	for key := range dotEnvKeys {
+		if _, ok := values[key]; !ok {
+			os.Unsetenv(key)
+			delete(dotEnvKeys, key)
+		}
+	}
+	for key, value := range values {
+		if _, set := os.LookupEnv(key); set && !dotEnvKeys[key] {
+			continue
+		}
+		os.Setenv(key, value)
+		dotEnvKeys[key] = true
+	}
+}
+
+// SettingChange is a setting whose value differs between the running and the loaded configuration
+type SettingChange struct {
+	Name     string // environment variable
+	Previous string
+	Current  string
+}
+
+// ReloadPlan splits the settings changed in a reloaded configuration into the ones applied
+// without restarting the process and the ones that only take effect after a restart
+type ReloadPlan struct {
+	Reloadable      []SettingChange
+	RestartRequired []SettingChange
+}
+
+// HasChanges reports whether any setting changed
+func (p *ReloadPlan) HasChanges() bool {
+	return len(p.Reloadable) > 0 || len(p.RestartRequired) > 0
+}
+
+// PlanReload compares the running configuration with a reloaded one. Only the log level,
+// the HTTP and send rate limits and the disconnect grace period are reloadable.
+func PlanReload(current, next *Config) *ReloadPlan {
+	plan := &ReloadPlan{}
+
+	reloadable := func(name string, previous, current any) {
+		if change, ok := settingChange(name, previous, current); ok {
+			plan.Reloadable = append(plan.Reloadable, change)
+		}
+	}
+	reloadable("LOG_LEVEL", current.Log.Level, next.Log.Level)
+	reloadable("RATE_LIMIT_REQUESTS", current.Server.RateLimit.RequestsPerMinute, next.Server.RateLimit.RequestsPerMinute)
+	reloadable("RATE_LIMIT_BURST_SIZE", current.Server.RateLimit.BurstSize, next.Server.RateLimit.BurstSize)
+	reloadable("WHATSAPP_SEND_RATE_PER_SECOND", current.WhatsApp.SendRatePerSecond, next.WhatsApp.SendRatePerSecond)
+	reloadable("WHATSAPP_SEND_RATE_BURST", current.WhatsApp.SendRateBurst, next.WhatsApp.SendRateBurst)
+	reloadable("WHATSAPP_SEND_RATE_MAX_WAIT", current.WhatsApp.SendRateMaxWait, next.WhatsApp.SendRateMaxWait)
+	reloadable("WHATSAPP_DISCONNECT_GRACE_PERIOD", current.WhatsApp.DisconnectGracePeriod, next.WhatsApp.DisconnectGracePeriod)
+
+	restartRequired := func(name string, previous, current any) {
+		if change, ok := settingChange(name, previous, current); ok {
+			plan.RestartRequired = append(plan.RestartRequired, change)
+		}
+	}
+	restartRequired("DB_DRIVER", current.Database.Driver, next.Database.Driver)
+	restartRequired("SERVER_HOST", current.Server.Host, next.Server.Host)
+	restartRequired("SERVER_PORT", current.Server.Port, next.Server.Port)
+	// The database URL may hold credentials, so only the change itself is reported
+	if current.Database.URL != next.Database.URL {
+		plan.RestartRequired = append(plan.RestartRequired, SettingChange{Name: "DB_URL"})
+	}
+
+	return plan
+}
+
+// ApplyReloadable copies the reloadable settings of next into c
+func (c *Config) ApplyReloadable(next *Config) {
+	c.Log.Level = next.Log.Level
+	c.Server.RateLimit = next.Server.RateLimit
+	c.WhatsApp.SendRatePerSecond = next.WhatsApp.SendRatePerSecond
+	c.WhatsApp.SendRateBurst = next.WhatsApp.SendRateBurst
+	c.WhatsApp.SendRateMaxWait = next.WhatsApp.SendRateMaxWait
+	c.WhatsApp.DisconnectGracePeriod = next.WhatsApp.DisconnectGracePeriod
+}
+
+// settingChange formats the values of a setting, returning false when they are equal
+func settingChange(name string, previous, current any) (SettingChange, bool) {
+	if previous == current {
+		return SettingChange{}, false
+	}
+	return SettingChange{
+		Name:     name,
+		Previous: fmt.Sprint(previous),
+		Current:  fmt.Sprint(current),
+	}, true
+}
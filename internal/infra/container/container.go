@@ -4,9 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
+	"time"
 
-	_ "github.com/mattn/go-sqlite3" // Import SQLite driver for whatsmeow
 	"github.com/uptrace/bun"
 	"go.mau.fi/whatsmeow/store/sqlstore"
 
@@ -17,6 +16,7 @@ import (
 	"wazmeow/internal/infra/database/migrations"
 	infraLogger "wazmeow/internal/infra/logger"
 	"wazmeow/internal/infra/repository"
+	"wazmeow/internal/infra/webhook"
 	"wazmeow/internal/infra/whats"
 	"wazmeow/pkg/logger"
 	"wazmeow/pkg/validator"
@@ -37,14 +37,25 @@ type Container struct {
 	Migrator     *migrations.Migrator
 
 	// Repositories
-	SessionRepo session.Repository
+	SessionRepo      session.Repository
+	PairingAuditRepo session.PairingAuditRepository
+	MessageRepo      whatsapp.MessageRepository
+	ReceiptRepo      whatsapp.ReceiptRepository
+	ChatRepo         whatsapp.ChatRepository
+	ScheduledRepo    whatsapp.ScheduledMessageRepository
+
+	// Webhook delivery (nil when webhooks are disabled)
+	WebhookSender *webhook.Sender
 
 	// WhatsApp components
 	WhatsAppStore   *sqlstore.Container
 	WhatsAppManager whatsapp.Manager
+	DeviceStore     whatsapp.DeviceStore
 
 	// Internal state
-	isInitialized bool
+	isInitialized    bool
+	whatsAppStoreDB  *sql.DB
+	whatsAppStoreErr error
 }
 
 // New creates a new infrastructure container
@@ -84,6 +95,11 @@ func (c *Container) initialize() error {
 		return fmt.Errorf("failed to initialize repositories: %w", err)
 	}
 
+	// Initialize webhook delivery
+	if err := c.initializeWebhook(); err != nil {
+		return fmt.Errorf("failed to initialize webhook: %w", err)
+	}
+
 	// Initialize WhatsApp manager
 	if err := c.initializeWhatsApp(); err != nil {
 		return fmt.Errorf("failed to initialize WhatsApp: %w", err)
@@ -137,62 +153,118 @@ func (c *Container) initializeRepositories() error {
 	// Session repository
 	c.SessionRepo = repository.NewSessionRepository(c.DB, c.Logger)
 
+	// Pairing audit repository
+	c.PairingAuditRepo = repository.NewPairingAuditRepository(c.DB, c.Logger)
+
+	// Message repository
+	c.MessageRepo = repository.NewMessageRepository(c.DB, c.Logger)
+
+	// Message receipt repository
+	c.ReceiptRepo = repository.NewReceiptRepository(c.DB, c.Logger)
+
+	// Chat list repository
+	c.ChatRepo = repository.NewChatRepository(c.DB, c.Logger)
+
+	// Scheduled message repository
+	c.ScheduledRepo = repository.NewScheduledMessageRepository(c.DB, c.Logger)
+
 	c.Logger.Info("repositories initialized")
 	return nil
 }
 
-// initializeWhatsApp sets up WhatsApp components
-func (c *Container) initializeWhatsApp() error {
-	// Create WhatsApp sqlstore container using the same database
-	dbURL := c.Config.Database.URL
-	dbDriver := c.Config.Database.Driver
-
-	// Adjust driver name for whatsmeow compatibility
-	switch dbDriver {
-	case "sqlite", "sqlite3":
-		dbDriver = "sqlite3"
-		// Add foreign keys parameter for SQLite (only for file-based databases)
-		if dbURL == "./data/wazmeow.db" {
-			dbURL = "./data/wazmeow.db?_foreign_keys=on"
-		} else if !strings.Contains(dbURL, ":memory:") && !strings.Contains(dbURL, "mode=memory") && !strings.Contains(dbURL, "_foreign_keys") {
-			// Add foreign keys parameter if not already present and not in-memory
-			if strings.Contains(dbURL, "?") {
-				dbURL += "&_foreign_keys=on"
-			} else {
-				dbURL += "?_foreign_keys=on"
-			}
-		}
-	case "postgres", "postgresql":
-		dbDriver = "postgres"
-	default:
-		return fmt.Errorf("unsupported database driver for WhatsApp store: %s", dbDriver)
+// initializeWebhook sets up webhook delivery when webhooks are enabled
+func (c *Container) initializeWebhook() error {
+	if !c.Config.Features.EnableWebhooks {
+		return nil
 	}
 
-	// Create logger adapter for whatsmeow
-	waLogger := whats.NewLoggerAdapter(c.Logger, "WhatsApp")
+	c.WebhookSender = webhook.NewSender(&c.Config.Webhook, c.Logger)
+
+	c.Logger.InfoWithFields("webhook delivery initialized", logger.Fields{
+		"url":     c.Config.Webhook.URL,
+		"timeout": c.Config.Webhook.Timeout.String(),
+		"workers": c.Config.Webhook.Workers,
+	})
+	return nil
+}
 
-	whatsappStore, err := sqlstore.New(context.Background(), dbDriver, dbURL, waLogger)
+// initializeWhatsApp sets up WhatsApp components
+func (c *Container) initializeWhatsApp() error {
+	// Open WhatsApp sqlstore container using the same database
+	whatsappStore, storeDB, err := whats.OpenStore(c.Config.Database.Driver, c.Config.Database.URL, c.Logger)
 	if err != nil {
 		return fmt.Errorf("failed to create WhatsApp store: %w", err)
 	}
 
-	// Upgrade WhatsApp store schema
-	err = whatsappStore.Upgrade(context.Background())
-	if err != nil {
+	c.WhatsAppStore = whatsappStore
+	c.whatsAppStoreDB = storeDB
+	c.DeviceStore = whats.NewDeviceStore(whatsappStore, storeDB)
+
+	// Upgrade WhatsApp store schema. Sessions cannot be restored nor clients created on an
+	// outdated store, so a failure aborts startup.
+	if _, err := c.UpgradeWhatsAppStore(context.Background()); err != nil {
 		return fmt.Errorf("failed to upgrade WhatsApp store: %w", err)
 	}
 
-	c.WhatsAppStore = whatsappStore
-
 	// Create WhatsApp manager
-	c.WhatsAppManager = whats.NewManager(&c.Config.WhatsApp, whatsappStore, c.SessionRepo, c.Logger)
+	var webhookHandler whatsapp.WebhookHandler
+	if c.WebhookSender != nil {
+		webhookHandler = c.WebhookSender
+	}
+	c.WhatsAppManager = whats.NewManager(&c.Config.WhatsApp, whatsappStore, c.SessionRepo, c.PairingAuditRepo, c.MessageRepo, c.ReceiptRepo, c.ChatRepo, c.ScheduledRepo, webhookHandler, c.Logger)
 
 	c.Logger.Info("WhatsApp components initialized")
 	return nil
 }
 
-// Close gracefully shuts down all infrastructure components
+// UpgradeWhatsAppStore creates or upgrades the WhatsApp store schema and returns its version
+func (c *Container) UpgradeWhatsAppStore(ctx context.Context) (*whats.StoreSchema, error) {
+	if c.WhatsAppStore == nil || c.whatsAppStoreDB == nil {
+		return nil, fmt.Errorf("WhatsApp store not initialized")
+	}
+
+	schema, err := whats.UpgradeStore(ctx, c.WhatsAppStore, c.whatsAppStoreDB)
+	c.whatsAppStoreErr = err
+	if err != nil {
+		return schema, err
+	}
+
+	c.Logger.InfoWithFields("WhatsApp store schema ready", logger.Fields{
+		"version":        schema.Version,
+		"latest_version": schema.LatestVersion,
+	})
+	return schema, nil
+}
+
+// WhatsAppStoreSchema checks that the WhatsApp store schema is ready and returns its version
+func (c *Container) WhatsAppStoreSchema(ctx context.Context) (*whats.StoreSchema, error) {
+	if c.whatsAppStoreDB == nil {
+		return nil, fmt.Errorf("WhatsApp store not initialized")
+	}
+	if c.whatsAppStoreErr != nil {
+		return nil, c.whatsAppStoreErr
+	}
+
+	schema, err := whats.GetStoreSchema(ctx, c.whatsAppStoreDB)
+	if err != nil {
+		return nil, err
+	}
+	if !schema.IsReady() {
+		return schema, fmt.Errorf("WhatsApp store schema is at version %d, expected %d", schema.Version, schema.LatestVersion)
+	}
+
+	return schema, nil
+}
+
+// Close gracefully shuts down all infrastructure components, waiting for in-flight
+// message sends without a deadline
 func (c *Container) Close() error {
+	return c.Shutdown(context.Background())
+}
+
+// Shutdown gracefully shuts down all infrastructure components. In-flight message
+// sends are given until ctx is done to finish.
+func (c *Container) Shutdown(ctx context.Context) error {
 	if !c.isInitialized {
 		return nil
 	}
@@ -203,11 +275,16 @@ func (c *Container) Close() error {
 
 	// Stop WhatsApp manager
 	if c.WhatsAppManager != nil {
-		if err := c.WhatsAppManager.Stop(); err != nil {
+		if err := c.WhatsAppManager.Stop(ctx); err != nil {
 			errors = append(errors, fmt.Errorf("failed to stop WhatsApp manager: %w", err))
 		}
 	}
 
+	// Deliver events queued while the clients were stopping
+	if c.WebhookSender != nil {
+		c.WebhookSender.Close()
+	}
+
 	// Close WhatsApp store
 	if c.WhatsAppStore != nil {
 		if err := c.WhatsAppStore.Close(); err != nil {
@@ -245,6 +322,11 @@ func (c *Container) Health() error {
 		return fmt.Errorf("database health check failed: %w", err)
 	}
 
+	// Check WhatsApp store schema
+	if _, err := c.WhatsAppStoreSchema(context.Background()); err != nil {
+		return fmt.Errorf("WhatsApp store health check failed: %w", err)
+	}
+
 	// Check WhatsApp manager health
 	if err := c.WhatsAppManager.HealthCheck(); err != nil {
 		return fmt.Errorf("WhatsApp manager health check failed: %w", err)
@@ -278,6 +360,29 @@ func (c *Container) GetWhatsAppStats() *whatsapp.ManagerStats {
 	return nil
 }
 
+// GetMessageSendStats returns the sent message counters of the WhatsApp manager
+func (c *Container) GetMessageSendStats() *whatsapp.MessageSendStats {
+	if manager, ok := c.WhatsAppManager.(*whats.Manager); ok {
+		return manager.GetMessageSendStats()
+	}
+	return nil
+}
+
+// GetWebhookStats returns the webhook delivery statistics, nil when webhooks are disabled
+func (c *Container) GetWebhookStats() *whatsapp.WebhookStats {
+	if c.WebhookSender == nil {
+		return nil
+	}
+	return c.WebhookSender.GetStats()
+}
+
+// SetDisconnectGracePeriod changes the disconnect grace period of the WhatsApp clients
+func (c *Container) SetDisconnectGracePeriod(grace time.Duration) {
+	if manager, ok := c.WhatsAppManager.(*whats.Manager); ok {
+		manager.SetDisconnectGracePeriod(grace)
+	}
+}
+
 // StartWhatsAppManager starts the WhatsApp manager
 func (c *Container) StartWhatsAppManager() error {
 	if c.WhatsAppManager == nil {
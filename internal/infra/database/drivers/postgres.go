@@ -44,10 +44,14 @@ func (c *PostgreSQLConnection) GetDB() *bun.DB {
 func (c *PostgreSQLConnection) Close() error {
 	if c.DB != nil {
 		if err := c.DB.Close(); err != nil {
-			c.Logger.ErrorWithError("failed to close database connection", err, nil)
+			if c.Logger != nil {
+				c.Logger.ErrorWithError("failed to close database connection", err, nil)
+			}
 			return err
 		}
-		c.Logger.Info("database connection closed")
+		if c.Logger != nil {
+			c.Logger.Info("database connection closed")
+		}
 	}
 	return nil
 }
@@ -81,13 +85,15 @@ func (c *PostgreSQLConnection) connect() error {
 		return fmt.Errorf("failed to build connection string: %w", err)
 	}
 
-	c.Logger.InfoWithFields("connecting to PostgreSQL", logger.Fields{
-		"host":     c.Config.PostgreSQL.Host,
-		"port":     c.Config.PostgreSQL.Port,
-		"database": c.Config.PostgreSQL.Database,
-		"username": c.Config.PostgreSQL.Username,
-		"ssl_mode": c.Config.PostgreSQL.SSLMode,
-	})
+	if c.Logger != nil {
+		c.Logger.InfoWithFields("connecting to PostgreSQL", logger.Fields{
+			"host":     c.Config.PostgreSQL.Host,
+			"port":     c.Config.PostgreSQL.Port,
+			"database": c.Config.PostgreSQL.Database,
+			"username": c.Config.PostgreSQL.Username,
+			"ssl_mode": c.Config.PostgreSQL.SSLMode,
+		})
+	}
 
 	// Open PostgreSQL connection
 	sqlDB, err := sql.Open("postgres", connStr)
@@ -103,8 +109,8 @@ func (c *PostgreSQLConnection) connect() error {
 	// Create Bun DB instance with PostgreSQL dialect
 	c.DB = bun.NewDB(sqlDB, pgdialect.New())
 
-	// Test the connection
-	if err := c.DB.Ping(); err != nil {
+	// Test the connection, waiting for a server that is still starting
+	if err := PingWithRetry(context.Background(), c.DB.PingContext, c.Config.ConnectRetries, c.Config.ConnectRetryInterval, c.Logger); err != nil {
 		sqlDB.Close()
 		return fmt.Errorf("failed to ping PostgreSQL database: %w", err)
 	}
@@ -115,15 +121,17 @@ func (c *PostgreSQLConnection) connect() error {
 		return fmt.Errorf("failed to configure PostgreSQL: %w", err)
 	}
 
-	c.Logger.InfoWithFields("PostgreSQL connection established", logger.Fields{
-		"driver":            "postgres",
-		"host":              c.Config.PostgreSQL.Host,
-		"port":              c.Config.PostgreSQL.Port,
-		"database":          c.Config.PostgreSQL.Database,
-		"max_open_conns":    c.Config.MaxOpenConns,
-		"max_idle_conns":    c.Config.MaxIdleConns,
-		"conn_max_lifetime": c.Config.ConnMaxLifetime,
-	})
+	if c.Logger != nil {
+		c.Logger.InfoWithFields("PostgreSQL connection established", logger.Fields{
+			"driver":            "postgres",
+			"host":              c.Config.PostgreSQL.Host,
+			"port":              c.Config.PostgreSQL.Port,
+			"database":          c.Config.PostgreSQL.Database,
+			"max_open_conns":    c.Config.MaxOpenConns,
+			"max_idle_conns":    c.Config.MaxIdleConns,
+			"conn_max_lifetime": c.Config.ConnMaxLifetime,
+		})
+	}
 
 	return nil
 }
@@ -183,7 +191,7 @@ func (c *PostgreSQLConnection) configurePostgreSQL() error {
 	// Set timezone if specified
 	if c.Config.PostgreSQL.TimeZone != "" {
 		_, err := c.DB.ExecContext(ctx, "SET timezone = ?", c.Config.PostgreSQL.TimeZone)
-		if err != nil {
+		if err != nil && c.Logger != nil {
 			c.Logger.WarnWithError("failed to set timezone", err, logger.Fields{
 				"timezone": c.Config.PostgreSQL.TimeZone,
 			})
@@ -193,13 +201,15 @@ func (c *PostgreSQLConnection) configurePostgreSQL() error {
 	// Set search path if specified
 	if c.Config.PostgreSQL.SearchPath != "" {
 		_, err := c.DB.ExecContext(ctx, "SET search_path = ?", c.Config.PostgreSQL.SearchPath)
-		if err != nil {
+		if err != nil && c.Logger != nil {
 			c.Logger.WarnWithError("failed to set search path", err, logger.Fields{
 				"search_path": c.Config.PostgreSQL.SearchPath,
 			})
 		}
 	}
 
-	c.Logger.Info("PostgreSQL configuration applied successfully")
+	if c.Logger != nil {
+		c.Logger.Info("PostgreSQL configuration applied successfully")
+	}
 	return nil
 }
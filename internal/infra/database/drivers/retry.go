@@ -0,0 +1,39 @@
+package drivers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"wazmeow/pkg/logger"
+)
+
+// maxConnectRetryInterval caps the backoff between connection attempts
+const maxConnectRetryInterval = 30 * time.Second
+
+// PingWithRetry calls ping until it succeeds, retrying up to retries times. The wait
+// before each retry starts at interval and doubles, up to maxConnectRetryInterval.
+// It gives up early when ctx is done.
+func PingWithRetry(ctx context.Context, ping func(ctx context.Context) error, retries int, interval time.Duration, log logger.Logger) error {
+	err := ping(ctx)
+	for attempt := 1; err != nil && attempt <= retries; attempt++ {
+		if log != nil {
+			log.WarnWithFields("database not ready, retrying", logger.Fields{
+				"attempt":     attempt,
+				"max_retries": retries,
+				"retry_in":    interval.String(),
+				"error":       err.Error(),
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (gave up waiting: %v)", err, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		err = ping(ctx)
+		interval = min(interval*2, maxConnectRetryInterval)
+	}
+	return err
+}
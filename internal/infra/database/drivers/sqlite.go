@@ -1,11 +1,14 @@
 package drivers
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/sqlitedialect"
@@ -53,10 +56,14 @@ func (c *SQLiteConnection) GetDB() *bun.DB {
 func (c *SQLiteConnection) Close() error {
 	if c.DB != nil {
 		if err := c.DB.Close(); err != nil {
-			c.Logger.ErrorWithError("failed to close database connection", err, nil)
+			if c.Logger != nil {
+				c.Logger.ErrorWithError("failed to close database connection", err, nil)
+			}
 			return err
 		}
-		c.Logger.Info("database connection closed")
+		if c.Logger != nil {
+			c.Logger.Info("database connection closed")
+		}
 	}
 	return nil
 }
@@ -99,11 +106,14 @@ func (c *SQLiteConnection) connect() error {
 		}
 	}
 
-	// Open SQLite connection
-	sqlDB, err := sql.Open(sqliteshim.ShimName, dbPath)
-	if err != nil {
-		return fmt.Errorf("failed to open SQLite database: %w", err)
-	}
+	// Open SQLite connection. PRAGMAs other than journal_mode only last for the
+	// connection they run on, so they are applied to every connection of the pool.
+	pragmas := c.sqlitePragmas()
+	sqlDB := sql.OpenDB(&pragmaConnector{
+		driver:  sqliteshim.Driver(),
+		dsn:     dbPath,
+		pragmas: pragmas,
+	})
 
 	// Configure connection pool
 	sqlDB.SetMaxOpenConns(c.Config.MaxOpenConns)
@@ -113,35 +123,37 @@ func (c *SQLiteConnection) connect() error {
 	// Create Bun DB instance with SQLite dialect
 	c.DB = bun.NewDB(sqlDB, sqlitedialect.New())
 
-	// Test the connection
+	// Test the connection, which also applies the PRAGMAs
 	if err := c.DB.Ping(); err != nil {
 		sqlDB.Close()
 		return fmt.Errorf("failed to ping SQLite database: %w", err)
 	}
 
-	// Apply SQLite specific configurations
-	if err := c.configureSQLite(sqlDB); err != nil {
-		sqlDB.Close()
-		return fmt.Errorf("failed to configure SQLite: %w", err)
+	if c.Logger != nil {
+		c.Logger.InfoWithFields("SQLite connection established", logger.Fields{
+			"driver":            c.Config.Driver,
+			"path":              dbPath,
+			"max_open_conns":    c.Config.MaxOpenConns,
+			"max_idle_conns":    c.Config.MaxIdleConns,
+			"conn_max_lifetime": c.Config.ConnMaxLifetime,
+			"pragmas":           pragmas,
+		})
 	}
 
-	c.Logger.InfoWithFields("SQLite connection established", logger.Fields{
-		"driver":            c.Config.Driver,
-		"path":              dbPath,
-		"max_open_conns":    c.Config.MaxOpenConns,
-		"max_idle_conns":    c.Config.MaxIdleConns,
-		"conn_max_lifetime": c.Config.ConnMaxLifetime,
-	})
-
 	return nil
 }
 
-// configureSQLite applies SQLite specific configurations
-func (c *SQLiteConnection) configureSQLite(sqlDB *sql.DB) error {
+// sqlitePragmas builds the PRAGMA statements for the configured SQLite settings.
+// busy_timeout comes first so the other statements wait for locks too.
+func (c *SQLiteConnection) sqlitePragmas() []string {
 	sqliteConfig := c.Config.SQLite
 
-	// Build PRAGMA statements based on configuration
-	pragmas := []string{}
+	// Busy timeout
+	busyTimeout := sqliteConfig.BusyTimeout
+	if busyTimeout == 0 {
+		busyTimeout = 5 * time.Second
+	}
+	pragmas := []string{fmt.Sprintf("PRAGMA busy_timeout = %d", busyTimeout.Milliseconds())}
 
 	// Foreign keys
 	if sqliteConfig.ForeignKeys {
@@ -185,21 +197,51 @@ func (c *SQLiteConnection) configureSQLite(sqlDB *sql.DB) error {
 	}
 	pragmas = append(pragmas, fmt.Sprintf("PRAGMA mmap_size = %d", mmapSize))
 
-	// Execute all PRAGMA statements
-	for _, pragma := range pragmas {
-		if _, err := sqlDB.Exec(pragma); err != nil {
-			return fmt.Errorf("failed to execute %s: %w", pragma, err)
+	return pragmas
+}
+
+// pragmaConnector opens SQLite connections and runs the PRAGMA statements on each one
+type pragmaConnector struct {
+	driver  driver.Driver
+	dsn     string
+	pragmas []string
+}
+
+// Connect opens a connection and applies the PRAGMAs to it
+func (c *pragmaConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pragma := range c.pragmas {
+		if err := execOnConn(ctx, conn, pragma); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to execute %s: %w", pragma, err)
 		}
 	}
 
-	c.Logger.InfoWithFields("SQLite configuration applied", logger.Fields{
-		"foreign_keys": sqliteConfig.ForeignKeys,
-		"journal_mode": journalMode,
-		"synchronous":  synchronous,
-		"cache_size":   cacheSize,
-		"temp_store":   tempStore,
-		"mmap_size":    mmapSize,
-	})
+	return conn, nil
+}
 
-	return nil
+// Driver returns the underlying SQLite driver
+func (c *pragmaConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// execOnConn runs a statement without arguments on a raw driver connection
+func execOnConn(ctx context.Context, conn driver.Conn, query string) error {
+	if execer, ok := conn.(driver.ExecerContext); ok {
+		_, err := execer.ExecContext(ctx, query, nil)
+		return err
+	}
+
+	stmt, err := conn.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(nil)
+	return err
 }
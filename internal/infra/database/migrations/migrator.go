@@ -29,9 +29,14 @@ func NewMigrator(db *bun.DB, log logger.Logger) *Migrator {
 func (m *Migrator) Migrate(ctx context.Context) error {
 	m.logger.Info("starting database migrations")
 
-	// Create only our application table - whatsmeow will create its own tables
+	// Create only our application tables - whatsmeow will create its own tables
 	models := []interface{}{
 		(*database.WazMeowSessionModel)(nil),
+		(*database.PairingAuditModel)(nil),
+		(*database.MessageModel)(nil),
+		(*database.ScheduledMessageModel)(nil),
+		(*database.MessageReceiptModel)(nil),
+		(*database.ChatModel)(nil),
 	}
 
 	for _, model := range models {
@@ -66,6 +71,16 @@ func (m *Migrator) createTable(ctx context.Context, model interface{}) error {
 	switch model.(type) {
 	case *database.WazMeowSessionModel:
 		tableName = "wazmeow_sessions"
+	case *database.PairingAuditModel:
+		tableName = "pairing_audit"
+	case *database.MessageModel:
+		tableName = "messages"
+	case *database.ScheduledMessageModel:
+		tableName = "scheduled_messages"
+	case *database.MessageReceiptModel:
+		tableName = "message_receipts"
+	case *database.ChatModel:
+		tableName = "chats"
 	default:
 		tableName = "unknown"
 	}
@@ -118,6 +133,20 @@ func (m *Migrator) createIndexes(ctx context.Context) error {
 		"CREATE INDEX IF NOT EXISTS idx_wazmeow_sessions_is_active ON wazmeow_sessions(is_active)",
 		"CREATE INDEX IF NOT EXISTS idx_wazmeow_sessions_created_at ON wazmeow_sessions(created_at)",
 		"CREATE INDEX IF NOT EXISTS idx_wazmeow_sessions_wa_jid ON wazmeow_sessions(wa_jid)",
+
+		// Pairing audit table indexes
+		"CREATE INDEX IF NOT EXISTS idx_pairing_audit_session_id ON pairing_audit(session_id)",
+		"CREATE INDEX IF NOT EXISTS idx_pairing_audit_created_at ON pairing_audit(created_at)",
+
+		// Messages table indexes
+		"CREATE INDEX IF NOT EXISTS idx_messages_chat_timestamp ON messages(session_id, chat_jid, timestamp)",
+
+		// Scheduled messages table indexes
+		"CREATE INDEX IF NOT EXISTS idx_scheduled_messages_status_send_at ON scheduled_messages(status, send_at)",
+		"CREATE INDEX IF NOT EXISTS idx_scheduled_messages_session_id ON scheduled_messages(session_id, send_at)",
+
+		// Chat list indexes
+		"CREATE INDEX IF NOT EXISTS idx_chats_session_last_message ON chats(session_id, last_message_at)",
 	}
 
 	for _, indexSQL := range indexes {
@@ -203,11 +232,39 @@ func (m *Migrator) runSchemaMigrations(ctx context.Context) error {
 		migrations = []string{
 			// Add proxy_config column to wazmeow_sessions table
 			`ALTER TABLE wazmeow_sessions ADD COLUMN proxy_config TEXT DEFAULT NULL`,
+			// Add display_name column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN display_name VARCHAR(255) DEFAULT NULL`,
+			// Add allowed_senders column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN allowed_senders TEXT DEFAULT NULL`,
+			// Add error_reason column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN error_reason TEXT DEFAULT NULL`,
+			// Add disconnect_reason column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN disconnect_reason VARCHAR(50) DEFAULT NULL`,
+			// Add qr_code_expires_at column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN qr_code_expires_at DATETIME DEFAULT NULL`,
+			// Add send_rate_limit column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN send_rate_limit TEXT DEFAULT NULL`,
+			// Add next_attempt_at column to scheduled_messages table
+			`ALTER TABLE scheduled_messages ADD COLUMN next_attempt_at DATETIME DEFAULT NULL`,
 		}
 	case "*pgdialect.Dialect":
 		migrations = []string{
 			// Add proxy_config column to wazmeow_sessions table
 			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS proxy_config JSONB DEFAULT NULL`,
+			// Add display_name column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS display_name VARCHAR(255) DEFAULT NULL`,
+			// Add allowed_senders column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS allowed_senders TEXT DEFAULT NULL`,
+			// Add error_reason column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS error_reason TEXT DEFAULT NULL`,
+			// Add disconnect_reason column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS disconnect_reason VARCHAR(50) DEFAULT NULL`,
+			// Add qr_code_expires_at column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS qr_code_expires_at TIMESTAMP DEFAULT NULL`,
+			// Add send_rate_limit column to wazmeow_sessions table
+			`ALTER TABLE wazmeow_sessions ADD COLUMN IF NOT EXISTS send_rate_limit TEXT DEFAULT NULL`,
+			// Add next_attempt_at column to scheduled_messages table
+			`ALTER TABLE scheduled_messages ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP DEFAULT NULL`,
 		}
 	default:
 		m.logger.WarnWithFields("unknown database type, skipping schema migrations", logger.Fields{
@@ -245,6 +302,7 @@ func (m *Migrator) Drop(ctx context.Context) error {
 
 	models := []interface{}{
 		(*database.WazMeowSessionModel)(nil),
+		(*database.PairingAuditModel)(nil),
 	}
 
 	for _, model := range models {
@@ -273,6 +331,8 @@ func (m *Migrator) dropTable(ctx context.Context, model interface{}) error {
 	switch model.(type) {
 	case *database.WazMeowSessionModel:
 		tableName = "wazmeow_sessions"
+	case *database.PairingAuditModel:
+		tableName = "pairing_audit"
 	default:
 		tableName = "unknown"
 	}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 
 	"github.com/uptrace/bun"
 )
@@ -20,12 +21,19 @@ type ProxyConfig struct {
 	Password string `json:"password,omitempty"`
 }
 
+// SendRateLimitConfig represents the per-session send rate limit stored as JSON
+type SendRateLimitConfig struct {
+	PerSecond float64 `json:"per_second"`
+	Burst     int     `json:"burst"`
+}
+
 // WazMeowSessionModel represents the database model for sessions
 type WazMeowSessionModel struct {
 	bun.BaseModel `bun:"table:wazmeow_sessions"`
 
 	ID          string       `bun:"id,pk,type:varchar(36)" json:"id"`
 	Name        string       `bun:"name,unique,notnull,type:varchar(50)" json:"name"`
+	DisplayName string       `bun:"display_name,type:varchar(255)" json:"display_name,omitempty"`
 	Status      string       `bun:"status,notnull,type:varchar(20),default:'disconnected'" json:"status"`
 	WaJID       string       `bun:"wa_jid,type:varchar(100)" json:"wa_jid,omitempty"`
 	QRCode      string       `bun:"qr_code,type:text" json:"qr_code,omitempty"`
@@ -33,6 +41,21 @@ type WazMeowSessionModel struct {
 	IsActive    bool         `bun:"is_active,notnull,default:false" json:"is_active"`
 	CreatedAt   time.Time    `bun:"created_at,notnull,default:current_timestamp,type:datetime" json:"created_at"`
 	UpdatedAt   time.Time    `bun:"updated_at,notnull,default:current_timestamp,type:datetime" json:"updated_at"`
+
+	// Sender allow-list stored as a JSON array
+	AllowedSenders []string `bun:"allowed_senders,type:text" json:"allowed_senders,omitempty"`
+
+	// Reason of the last connection failure while the session is in error state
+	ErrorReason string `bun:"error_reason,type:text" json:"error_reason,omitempty"`
+
+	// Why the session was last disconnected
+	DisconnectReason string `bun:"disconnect_reason,type:varchar(50)" json:"disconnect_reason,omitempty"`
+
+	// When the stored QR code expires; NULL when there is none
+	QRCodeExpiresAt time.Time `bun:"qr_code_expires_at,nullzero,type:datetime" json:"qr_code_expires_at,omitempty"`
+
+	// Send rate limit overriding the global one; NULL uses the global limit
+	SendRateLimit *SendRateLimitConfig `bun:"send_rate_limit,type:text" json:"send_rate_limit,omitempty"`
 }
 
 // ToWazMeowSessionModel converts a domain session to database model
@@ -53,9 +76,18 @@ func ToWazMeowSessionModel(sess *session.Session) *WazMeowSessionModel {
 		}
 	}
 
+	var sendRateLimit *SendRateLimitConfig
+	if limit := sess.SendRateLimit(); limit != nil {
+		sendRateLimit = &SendRateLimitConfig{
+			PerSecond: limit.PerSecond,
+			Burst:     limit.Burst,
+		}
+	}
+
 	return &WazMeowSessionModel{
 		ID:          sess.ID().String(),
 		Name:        sess.Name(),
+		DisplayName: sess.DisplayName(),
 		Status:      sess.Status().String(),
 		WaJID:       sess.WaJID(),
 		QRCode:      sess.QRCode(),
@@ -63,6 +95,13 @@ func ToWazMeowSessionModel(sess *session.Session) *WazMeowSessionModel {
 		IsActive:    sess.IsActive(),
 		CreatedAt:   sess.CreatedAt(),
 		UpdatedAt:   sess.UpdatedAt(),
+
+		AllowedSenders: sess.AllowedSenders(),
+		ErrorReason:    sess.ErrorReason(),
+
+		DisconnectReason: sess.DisconnectReason().String(),
+		QRCodeExpiresAt:  sess.QRCodeExpiresAt(),
+		SendRateLimit:    sendRateLimit,
 	}
 }
 
@@ -84,9 +123,10 @@ func FromWazMeowSessionModel(model *WazMeowSessionModel) (*session.Session, erro
 		proxyURL = buildProxyURL(model.ProxyConfig)
 	}
 
-	return session.RestoreSession(
+	sess := session.RestoreSession(
 		sessionID,
 		model.Name,
+		model.DisplayName,
 		status,
 		model.WaJID,
 		model.QRCode,
@@ -94,7 +134,19 @@ func FromWazMeowSessionModel(model *WazMeowSessionModel) (*session.Session, erro
 		model.IsActive,
 		model.CreatedAt,
 		model.UpdatedAt,
-	), nil
+	)
+	sess.RestoreAllowedSenders(model.AllowedSenders)
+	sess.RestoreErrorReason(model.ErrorReason)
+	sess.RestoreDisconnectReason(session.DisconnectReason(model.DisconnectReason))
+	sess.RestoreQRCodeExpiresAt(model.QRCodeExpiresAt)
+	if model.SendRateLimit != nil {
+		sess.RestoreSendRateLimit(&session.SendRateLimit{
+			PerSecond: model.SendRateLimit.PerSecond,
+			Burst:     model.SendRateLimit.Burst,
+		})
+	}
+
+	return sess, nil
 }
 
 // parseProxyPort converts string port to int
@@ -142,3 +194,276 @@ func buildProxyURL(config *ProxyConfig) string {
 
 	return proxyURL
 }
+
+// PairingAuditModel represents the database model for pairing audit entries
+type PairingAuditModel struct {
+	bun.BaseModel `bun:"table:pairing_audit"`
+
+	ID        string    `bun:"id,pk,type:varchar(36)" json:"id"`
+	SessionID string    `bun:"session_id,notnull,type:varchar(36)" json:"session_id"`
+	Outcome   string    `bun:"outcome,notnull,type:varchar(20)" json:"outcome"`
+	Method    string    `bun:"method,type:varchar(20)" json:"method,omitempty"`
+	WaJID     string    `bun:"wa_jid,type:varchar(100)" json:"wa_jid,omitempty"`
+	RequestID string    `bun:"request_id,type:varchar(100)" json:"request_id,omitempty"`
+	Reason    string    `bun:"reason,type:text" json:"reason,omitempty"`
+	CreatedAt time.Time `bun:"created_at,notnull,default:current_timestamp,type:datetime" json:"created_at"`
+}
+
+// ToPairingAuditModel converts a domain pairing attempt to database model
+func ToPairingAuditModel(attempt *session.PairingAttempt) *PairingAuditModel {
+	return &PairingAuditModel{
+		ID:        attempt.ID,
+		SessionID: attempt.SessionID.String(),
+		Outcome:   attempt.Outcome.String(),
+		Method:    attempt.Method.String(),
+		WaJID:     attempt.JID,
+		RequestID: attempt.RequestID,
+		Reason:    attempt.Reason,
+		CreatedAt: attempt.CreatedAt,
+	}
+}
+
+// FromPairingAuditModel converts a database model to domain pairing attempt
+func FromPairingAuditModel(model *PairingAuditModel) (*session.PairingAttempt, error) {
+	sessionID, err := session.SessionIDFromString(model.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session.PairingAttempt{
+		ID:        model.ID,
+		SessionID: sessionID,
+		Outcome:   session.PairingOutcome(model.Outcome),
+		Method:    session.PairingMethod(model.Method),
+		JID:       model.WaJID,
+		RequestID: model.RequestID,
+		Reason:    model.Reason,
+		CreatedAt: model.CreatedAt,
+	}, nil
+}
+
+// MessageModel represents the database model for stored messages
+type MessageModel struct {
+	bun.BaseModel `bun:"table:messages"`
+
+	SessionID     string    `bun:"session_id,pk,type:varchar(36)" json:"session_id"`
+	MessageID     string    `bun:"message_id,pk,type:varchar(128)" json:"message_id"`
+	ChatJID       string    `bun:"chat_jid,notnull,type:varchar(100)" json:"chat_jid"`
+	SenderJID     string    `bun:"sender_jid,type:varchar(100)" json:"sender_jid,omitempty"`
+	IsFromMe      bool      `bun:"is_from_me,notnull,default:false" json:"is_from_me"`
+	Type          string    `bun:"type,notnull,type:varchar(20)" json:"type"`
+	Body          string    `bun:"body,type:text" json:"body,omitempty"`
+	MimeType      string    `bun:"mime_type,type:varchar(255)" json:"mime_type,omitempty"`
+	FileName      string    `bun:"file_name,type:varchar(255)" json:"file_name,omitempty"`
+	FileLength    int64     `bun:"file_length" json:"file_length,omitempty"`
+	URL           string    `bun:"url,type:text" json:"url,omitempty"`
+	DirectPath    string    `bun:"direct_path,type:text" json:"direct_path,omitempty"`
+	MediaKey      []byte    `bun:"media_key" json:"-"`
+	FileSHA256    []byte    `bun:"file_sha256" json:"-"`
+	FileEncSHA256 []byte    `bun:"file_enc_sha256" json:"-"`
+	Timestamp     time.Time `bun:"timestamp,notnull,type:datetime" json:"timestamp"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp,type:datetime" json:"created_at"`
+}
+
+// ToMessageModel converts a domain stored message to database model
+func ToMessageModel(message *whatsapp.StoredMessage) *MessageModel {
+	model := &MessageModel{
+		SessionID: message.SessionID.String(),
+		MessageID: message.ID,
+		ChatJID:   message.ChatJID,
+		SenderJID: message.SenderJID,
+		IsFromMe:  message.IsFromMe,
+		Type:      message.Type.String(),
+		Body:      message.Body,
+		Timestamp: message.Timestamp,
+		CreatedAt: message.CreatedAt,
+	}
+
+	if media := message.Media; media != nil {
+		model.MimeType = media.MimeType
+		model.FileName = media.FileName
+		model.FileLength = int64(media.FileLength)
+		model.URL = media.URL
+		model.DirectPath = media.DirectPath
+		model.MediaKey = media.MediaKey
+		model.FileSHA256 = media.FileSHA256
+		model.FileEncSHA256 = media.FileEncSHA256
+	}
+
+	return model
+}
+
+// FromMessageModel converts a database model to domain stored message
+func FromMessageModel(model *MessageModel) (*whatsapp.StoredMessage, error) {
+	sessionID, err := session.SessionIDFromString(model.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &whatsapp.StoredMessage{
+		SessionID: sessionID,
+		ID:        model.MessageID,
+		ChatJID:   model.ChatJID,
+		SenderJID: model.SenderJID,
+		IsFromMe:  model.IsFromMe,
+		Type:      whatsapp.ParseMessageType(model.Type),
+		Body:      model.Body,
+		Timestamp: model.Timestamp,
+		CreatedAt: model.CreatedAt,
+	}
+
+	if model.DirectPath != "" || len(model.MediaKey) > 0 {
+		message.Media = &whatsapp.MediaInfo{
+			MimeType:      model.MimeType,
+			FileName:      model.FileName,
+			FileLength:    uint64(model.FileLength),
+			URL:           model.URL,
+			DirectPath:    model.DirectPath,
+			MediaKey:      model.MediaKey,
+			FileSHA256:    model.FileSHA256,
+			FileEncSHA256: model.FileEncSHA256,
+		}
+	}
+
+	return message, nil
+}
+
+// ScheduledMessageModel represents the database model for scheduled messages
+type ScheduledMessageModel struct {
+	bun.BaseModel `bun:"table:scheduled_messages"`
+
+	ID            string    `bun:"id,pk,type:varchar(36)" json:"id"`
+	SessionID     string    `bun:"session_id,notnull,type:varchar(36)" json:"session_id"`
+	ToJID         string    `bun:"to_jid,notnull,type:varchar(100)" json:"to_jid"`
+	Body          string    `bun:"body,notnull,type:text" json:"body"`
+	SendAt        time.Time `bun:"send_at,notnull,type:datetime" json:"send_at"`
+	Status        string    `bun:"status,notnull,type:varchar(20),default:'pending'" json:"status"`
+	Attempts      int       `bun:"attempts,notnull,default:0" json:"attempts"`
+	MessageID     string    `bun:"message_id,type:varchar(128)" json:"message_id,omitempty"`
+	Error         string    `bun:"error,type:text" json:"error,omitempty"`
+	NextAttemptAt time.Time `bun:"next_attempt_at,nullzero,type:datetime" json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp,type:datetime" json:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at,notnull,default:current_timestamp,type:datetime" json:"updated_at"`
+}
+
+// ToScheduledMessageModel converts a domain scheduled message to database model
+func ToScheduledMessageModel(message *whatsapp.ScheduledMessage) *ScheduledMessageModel {
+	return &ScheduledMessageModel{
+		ID:            message.ID,
+		SessionID:     message.SessionID.String(),
+		ToJID:         message.To,
+		Body:          message.Body,
+		SendAt:        message.SendAt,
+		Status:        message.Status.String(),
+		Attempts:      message.Attempts,
+		MessageID:     message.MessageID,
+		Error:         message.Error,
+		NextAttemptAt: message.NextAttemptAt,
+		CreatedAt:     message.CreatedAt,
+		UpdatedAt:     message.UpdatedAt,
+	}
+}
+
+// FromScheduledMessageModel converts a database model to domain scheduled message
+func FromScheduledMessageModel(model *ScheduledMessageModel) (*whatsapp.ScheduledMessage, error) {
+	sessionID, err := session.SessionIDFromString(model.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &whatsapp.ScheduledMessage{
+		ID:            model.ID,
+		SessionID:     sessionID,
+		To:            model.ToJID,
+		Body:          model.Body,
+		SendAt:        model.SendAt,
+		Status:        whatsapp.ScheduledMessageStatus(model.Status),
+		Attempts:      model.Attempts,
+		MessageID:     model.MessageID,
+		Error:         model.Error,
+		NextAttemptAt: model.NextAttemptAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
+
+// MessageReceiptModel represents the database model for message receipts
+type MessageReceiptModel struct {
+	bun.BaseModel `bun:"table:message_receipts"`
+
+	SessionID    string    `bun:"session_id,pk,type:varchar(36)" json:"session_id"`
+	MessageID    string    `bun:"message_id,pk,type:varchar(128)" json:"message_id"`
+	RecipientJID string    `bun:"recipient_jid,pk,type:varchar(100)" json:"recipient_jid"`
+	ChatJID      string    `bun:"chat_jid,notnull,type:varchar(100)" json:"chat_jid"`
+	Status       string    `bun:"status,notnull,type:varchar(20)" json:"status"`
+	StatusRank   int       `bun:"status_rank,notnull,default:0" json:"-"` // Lets upserts keep the furthest status
+	Timestamp    time.Time `bun:"timestamp,notnull,type:datetime" json:"timestamp"`
+}
+
+// ToMessageReceiptModel converts a domain message receipt to database model
+func ToMessageReceiptModel(receipt *whatsapp.MessageReceipt) *MessageReceiptModel {
+	return &MessageReceiptModel{
+		SessionID:    receipt.SessionID.String(),
+		MessageID:    receipt.MessageID,
+		RecipientJID: receipt.RecipientJID,
+		ChatJID:      receipt.ChatJID,
+		Status:       receipt.Status.String(),
+		StatusRank:   receipt.Status.Rank(),
+		Timestamp:    receipt.Timestamp,
+	}
+}
+
+// FromMessageReceiptModel converts a database model to domain message receipt
+func FromMessageReceiptModel(model *MessageReceiptModel) (*whatsapp.MessageReceipt, error) {
+	sessionID, err := session.SessionIDFromString(model.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &whatsapp.MessageReceipt{
+		SessionID:    sessionID,
+		MessageID:    model.MessageID,
+		ChatJID:      model.ChatJID,
+		RecipientJID: model.RecipientJID,
+		Status:       whatsapp.ReceiptStatus(model.Status),
+		Timestamp:    model.Timestamp,
+	}, nil
+}
+
+// ChatModel represents the database model for the chat list
+type ChatModel struct {
+	bun.BaseModel `bun:"table:chats"`
+
+	SessionID     string    `bun:"session_id,pk,type:varchar(36)" json:"session_id"`
+	JID           string    `bun:"jid,pk,type:varchar(100)" json:"jid"`
+	Name          string    `bun:"name,notnull,type:varchar(255)" json:"name,omitempty"`
+	LastMessageAt time.Time `bun:"last_message_at,notnull,type:datetime" json:"last_message_at"`
+	UnreadCount   int       `bun:"unread_count,notnull" json:"unread_count"`
+}
+
+// ToChatModel converts a domain chat summary to database model
+func ToChatModel(chat *whatsapp.ChatSummary) *ChatModel {
+	return &ChatModel{
+		SessionID:     chat.SessionID.String(),
+		JID:           chat.JID,
+		Name:          chat.Name,
+		LastMessageAt: chat.LastMessageAt,
+		UnreadCount:   chat.UnreadCount,
+	}
+}
+
+// FromChatModel converts a database model to domain chat summary
+func FromChatModel(model *ChatModel) (*whatsapp.ChatSummary, error) {
+	sessionID, err := session.SessionIDFromString(model.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &whatsapp.ChatSummary{
+		SessionID:     sessionID,
+		JID:           model.JID,
+		Name:          model.Name,
+		LastMessageAt: model.LastMessageAt,
+		UnreadCount:   model.UnreadCount,
+	}, nil
+}
@@ -21,7 +21,16 @@ func New(cfg *config.LogConfig) logger.Logger {
 		MaxAge:        cfg.MaxAge,
 	}
 
-	return logger.New(loggerConfig)
+	log := logger.New(loggerConfig)
+
+	// Forward error-level entries to the alerting endpoint
+	if cfg.WebhookURL != "" {
+		if hookLogger, ok := log.(logger.HookLogger); ok {
+			hookLogger.AddHook(logger.NewWebhookHook(cfg.WebhookURL, cfg.WebhookTimeout))
+		}
+	}
+
+	return log
 }
 
 // NewDefault creates a logger with default configuration
@@ -0,0 +1,51 @@
+// Package proxy builds the dialers used to route WhatsApp connections through session proxies
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	netproxy "golang.org/x/net/proxy"
+)
+
+// dialTimeout bounds the TCP connection to the proxy server
+const dialTimeout = 30 * time.Second
+
+// ErrUnsupportedScheme is returned for proxy URLs with a scheme that cannot be dialed
+var ErrUnsupportedScheme = errors.New("unsupported proxy scheme")
+
+// IsHTTP reports whether the proxy is an HTTP(S) proxy, used through CONNECT rather than a dialer
+func IsHTTP(proxyURL *url.URL) bool {
+	return proxyURL.Scheme == "http" || proxyURL.Scheme == "https"
+}
+
+// NewSOCKSDialer creates a dialer that connects through a socks4, socks4a, socks5 or socks5h proxy.
+// Credentials in the URL are used as the SOCKS5 username/password or the SOCKS4 user ID.
+func NewSOCKSDialer(proxyURL *url.URL) (netproxy.Dialer, error) {
+	if proxyURL.Hostname() == "" || proxyURL.Port() == "" {
+		return nil, fmt.Errorf("proxy URL must include host and port: %s", proxyURL.Redacted())
+	}
+
+	forward := &net.Dialer{Timeout: dialTimeout}
+
+	switch proxyURL.Scheme {
+	case "socks5", "socks5h":
+		var auth *netproxy.Auth
+		if proxyURL.User != nil {
+			password, _ := proxyURL.User.Password()
+			auth = &netproxy.Auth{User: proxyURL.User.Username(), Password: password}
+		}
+		return netproxy.SOCKS5("tcp", proxyURL.Host, auth, forward)
+	case "socks4", "socks4a":
+		dialer := &SOCKS4Dialer{Addr: proxyURL.Host, Forward: forward}
+		if proxyURL.User != nil {
+			dialer.UserID = proxyURL.User.Username()
+		}
+		return dialer, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, proxyURL.Scheme)
+	}
+}
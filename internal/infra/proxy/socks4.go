@@ -0,0 +1,118 @@
+package proxy
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// SOCKS4 protocol constants
+const (
+	socks4Version        = 0x04
+	socks4CommandConnect = 0x01
+	socks4Granted        = 0x5a
+)
+
+// ErrSOCKS4Rejected is returned when the SOCKS4 server refuses the connection
+var ErrSOCKS4Rejected = errors.New("socks4 connection rejected")
+
+// SOCKS4Dialer connects through a SOCKS4 proxy. Hostnames are sent to the proxy to resolve
+// (SOCKS4a), so DNS lookups do not leak outside the proxy.
+type SOCKS4Dialer struct {
+	Addr    string
+	UserID  string
+	Forward *net.Dialer
+}
+
+// Dial connects to addr through the proxy
+func (d *SOCKS4Dialer) Dial(network, addr string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, addr)
+}
+
+// DialContext connects to addr through the proxy, bounded by ctx
+func (d *SOCKS4Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" {
+		return nil, fmt.Errorf("socks4: unsupported network %s", network)
+	}
+
+	request, err := d.connectRequest(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := d.Forward.DialContext(ctx, "tcp", d.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("socks4: failed to reach proxy: %w", err)
+	}
+
+	// Bound the handshake by the context deadline
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	if err := handshakeSOCKS4(conn, request); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// connectRequest builds a CONNECT request for addr
+func (d *SOCKS4Dialer) connectRequest(addr string) ([]byte, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("socks4: invalid address %s: %w", addr, err)
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("socks4: invalid port %s", portStr)
+	}
+
+	request := []byte{socks4Version, socks4CommandConnect, 0, 0}
+	binary.BigEndian.PutUint16(request[2:], uint16(port))
+
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil:
+		// SOCKS4a: an invalid IP 0.0.0.x tells the proxy a hostname follows the user ID
+		request = append(request, 0, 0, 0, 1)
+		request = append(request, d.UserID...)
+		request = append(request, 0)
+		request = append(request, host...)
+		request = append(request, 0)
+	case ip.To4() != nil:
+		request = append(request, ip.To4()...)
+		request = append(request, d.UserID...)
+		request = append(request, 0)
+	default:
+		return nil, fmt.Errorf("socks4: IPv6 address %s is not supported", host)
+	}
+
+	return request, nil
+}
+
+// handshakeSOCKS4 sends the request and checks the proxy reply
+func handshakeSOCKS4(conn net.Conn, request []byte) error {
+	if _, err := conn.Write(request); err != nil {
+		return fmt.Errorf("socks4: failed to send request: %w", err)
+	}
+
+	reply := make([]byte, 8)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return fmt.Errorf("socks4: failed to read reply: %w", err)
+	}
+
+	if reply[1] != socks4Granted {
+		return fmt.Errorf("%w: code 0x%02x", ErrSOCKS4Rejected, reply[1])
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/database"
+	"wazmeow/pkg/logger"
+)
+
+// latestLastMessageAt keeps the most recent last message time on upserts.
+// CASE is used instead of MAX/GREATEST, which differ between SQLite and PostgreSQL.
+const latestLastMessageAt = "last_message_at = CASE WHEN EXCLUDED.last_message_at > ?TableAlias.last_message_at THEN EXCLUDED.last_message_at ELSE ?TableAlias.last_message_at END"
+
+// ChatRepository implements whatsapp.ChatRepository using Bun ORM
+type ChatRepository struct {
+	db     *bun.DB
+	logger logger.Logger
+}
+
+// NewChatRepository creates a new chat repository using Bun ORM
+func NewChatRepository(db *bun.DB, logger logger.Logger) whatsapp.ChatRepository {
+	return &ChatRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// SaveAll stores chats received from a history sync
+func (r *ChatRepository) SaveAll(ctx context.Context, chats []*whatsapp.ChatSummary) error {
+	if len(chats) == 0 {
+		return nil
+	}
+
+	models := make([]*database.ChatModel, 0, len(chats))
+	for _, chat := range chats {
+		models = append(models, database.ToChatModel(chat))
+	}
+
+	_, err := r.db.NewInsert().
+		Model(&models).
+		On("CONFLICT (session_id, jid) DO UPDATE").
+		Set("name = CASE WHEN EXCLUDED.name <> '' THEN EXCLUDED.name ELSE ?TableAlias.name END").
+		Set(latestLastMessageAt).
+		Set("unread_count = EXCLUDED.unread_count").
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to save chats", err, logger.Fields{
+			"session_id": chats[0].SessionID.String(),
+			"count":      len(chats),
+		})
+		return fmt.Errorf("failed to save chats: %w", err)
+	}
+
+	return nil
+}
+
+// RecordMessage moves a chat's last message time forward and counts incoming messages as unread
+func (r *ChatRepository) RecordMessage(ctx context.Context, sessionID session.SessionID, chatJID string, at time.Time, incoming bool) error {
+	model := &database.ChatModel{
+		SessionID:     sessionID.String(),
+		JID:           chatJID,
+		LastMessageAt: at,
+	}
+	if incoming {
+		model.UnreadCount = 1
+	}
+
+	_, err := r.db.NewInsert().
+		Model(model).
+		On("CONFLICT (session_id, jid) DO UPDATE").
+		Set(latestLastMessageAt).
+		Set("unread_count = ?TableAlias.unread_count + EXCLUDED.unread_count").
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to record chat message", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"chat_jid":   chatJID,
+		})
+		return fmt.Errorf("failed to record chat message: %w", err)
+	}
+
+	return nil
+}
+
+// MarkRead resets the unread count of a chat
+func (r *ChatRepository) MarkRead(ctx context.Context, sessionID session.SessionID, chatJID string) error {
+	_, err := r.db.NewUpdate().
+		Model((*database.ChatModel)(nil)).
+		Set("unread_count = 0").
+		Where("session_id = ?", sessionID.String()).
+		Where("jid = ?", chatJID).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to mark chat as read", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"chat_jid":   chatJID,
+		})
+		return fmt.Errorf("failed to mark chat as read: %w", err)
+	}
+
+	return nil
+}
+
+// ListBySession retrieves the chats of a session, most recent first
+func (r *ChatRepository) ListBySession(ctx context.Context, sessionID session.SessionID) ([]*whatsapp.ChatSummary, error) {
+	var models []database.ChatModel
+
+	err := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", sessionID.String()).
+		Order("last_message_at DESC", "jid ASC").
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list chats", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return nil, fmt.Errorf("failed to list chats: %w", err)
+	}
+
+	chats := make([]*whatsapp.ChatSummary, 0, len(models))
+	for _, model := range models {
+		chat, err := database.FromChatModel(&model)
+		if err != nil {
+			r.logger.ErrorWithError("failed to convert chat model", err, logger.Fields{
+				"jid": model.JID,
+			})
+			continue // Skip invalid entries
+		}
+		chats = append(chats, chat)
+	}
+
+	return chats, nil
+}
+
+// DeleteBySession deletes all the chats of a session
+func (r *ChatRepository) DeleteBySession(ctx context.Context, sessionID session.SessionID) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.ChatModel)(nil)).
+		Where("session_id = ?", sessionID.String()).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete session chats", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return 0, fmt.Errorf("failed to delete session chats: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// DeleteBefore deletes the chats of every session whose last message is older than the given time
+func (r *ChatRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.ChatModel)(nil)).
+		Where("last_message_at < ?", before).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete old chats", err, logger.Fields{
+			"before": before,
+		})
+		return 0, fmt.Errorf("failed to delete old chats: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
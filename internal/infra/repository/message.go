@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/database"
+	"wazmeow/pkg/logger"
+)
+
+// MessageRepository implements whatsapp.MessageRepository using Bun ORM
+type MessageRepository struct {
+	db     *bun.DB
+	logger logger.Logger
+}
+
+// NewMessageRepository creates a new message repository using Bun ORM
+func NewMessageRepository(db *bun.DB, logger logger.Logger) whatsapp.MessageRepository {
+	return &MessageRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Save stores a message. Messages delivered more than once are stored only once.
+func (r *MessageRepository) Save(ctx context.Context, message *whatsapp.StoredMessage) error {
+	model := database.ToMessageModel(message)
+
+	_, err := r.db.NewInsert().
+		Model(model).
+		On("CONFLICT (session_id, message_id) DO NOTHING").
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to save message", err, logger.Fields{
+			"session_id": message.SessionID.String(),
+			"message_id": message.ID,
+		})
+		return fmt.Errorf("failed to save message: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a message of a session by its WhatsApp message ID
+func (r *MessageRepository) GetByID(ctx context.Context, sessionID session.SessionID, messageID string) (*whatsapp.StoredMessage, error) {
+	model := &database.MessageModel{}
+
+	err := r.db.NewSelect().
+		Model(model).
+		Where("session_id = ?", sessionID.String()).
+		Where("message_id = ?", messageID).
+		Scan(ctx)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, whatsapp.ErrMessageNotFound
+		}
+		r.logger.ErrorWithError("failed to get message", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"message_id": messageID,
+		})
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return database.FromMessageModel(model)
+}
+
+// GetLatestByChat retrieves the most recent message of a chat
+func (r *MessageRepository) GetLatestByChat(ctx context.Context, sessionID session.SessionID, chatJID string) (*whatsapp.StoredMessage, error) {
+	model := &database.MessageModel{}
+
+	err := r.db.NewSelect().
+		Model(model).
+		Where("session_id = ?", sessionID.String()).
+		Where("chat_jid = ?", chatJID).
+		Order("timestamp DESC", "message_id DESC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, whatsapp.ErrMessageNotFound
+		}
+		r.logger.ErrorWithError("failed to get latest chat message", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"chat_jid":   chatJID,
+		})
+		return nil, fmt.Errorf("failed to get latest chat message: %w", err)
+	}
+
+	return database.FromMessageModel(model)
+}
+
+// ListBefore retrieves up to limit messages of the anchor's chat sent before it, oldest first
+func (r *MessageRepository) ListBefore(ctx context.Context, anchor *whatsapp.StoredMessage, limit int) ([]*whatsapp.StoredMessage, error) {
+	if limit <= 0 {
+		return []*whatsapp.StoredMessage{}, nil
+	}
+
+	var models []database.MessageModel
+
+	// Ties on timestamp are broken by message ID so the order is stable
+	err := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", anchor.SessionID.String()).
+		Where("chat_jid = ?", anchor.ChatJID).
+		Where("(timestamp < ? OR (timestamp = ? AND message_id < ?))", anchor.Timestamp, anchor.Timestamp, anchor.ID).
+		Order("timestamp DESC", "message_id DESC").
+		Limit(limit).
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list messages before anchor", err, logger.Fields{
+			"session_id": anchor.SessionID.String(),
+			"message_id": anchor.ID,
+		})
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	// Reverse into chronological order
+	for i, j := 0, len(models)-1; i < j; i, j = i+1, j-1 {
+		models[i], models[j] = models[j], models[i]
+	}
+
+	return r.toStoredMessages(models), nil
+}
+
+// ListAfter retrieves up to limit messages of the anchor's chat sent after it, oldest first
+func (r *MessageRepository) ListAfter(ctx context.Context, anchor *whatsapp.StoredMessage, limit int) ([]*whatsapp.StoredMessage, error) {
+	if limit <= 0 {
+		return []*whatsapp.StoredMessage{}, nil
+	}
+
+	var models []database.MessageModel
+
+	err := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", anchor.SessionID.String()).
+		Where("chat_jid = ?", anchor.ChatJID).
+		Where("(timestamp > ? OR (timestamp = ? AND message_id > ?))", anchor.Timestamp, anchor.Timestamp, anchor.ID).
+		Order("timestamp ASC", "message_id ASC").
+		Limit(limit).
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list messages after anchor", err, logger.Fields{
+			"session_id": anchor.SessionID.String(),
+			"message_id": anchor.ID,
+		})
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	return r.toStoredMessages(models), nil
+}
+
+// ListByChat retrieves up to limit messages of a chat, newest first, starting right before the cursor.
+// The (timestamp, message_id) keyset keeps deep pages as cheap as the first one.
+func (r *MessageRepository) ListByChat(ctx context.Context, sessionID session.SessionID, chatJID string, before *whatsapp.MessageCursor, limit int) ([]*whatsapp.StoredMessage, error) {
+	if limit <= 0 {
+		return []*whatsapp.StoredMessage{}, nil
+	}
+
+	var models []database.MessageModel
+
+	query := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", sessionID.String()).
+		Where("chat_jid = ?", chatJID)
+	if before != nil {
+		query = query.Where("(timestamp < ? OR (timestamp = ? AND message_id < ?))", before.Timestamp, before.Timestamp, before.ID)
+	}
+
+	err := query.
+		Order("timestamp DESC", "message_id DESC").
+		Limit(limit).
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list chat messages", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"chat_jid":   chatJID,
+		})
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	return r.toStoredMessages(models), nil
+}
+
+// DeleteBySession deletes all the messages of a session
+func (r *MessageRepository) DeleteBySession(ctx context.Context, sessionID session.SessionID) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.MessageModel)(nil)).
+		Where("session_id = ?", sessionID.String()).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete session messages", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return 0, fmt.Errorf("failed to delete session messages: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// DeleteBefore deletes the messages of every session sent before the given time
+func (r *MessageRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.MessageModel)(nil)).
+		Where("timestamp < ?", before).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete old messages", err, logger.Fields{
+			"before": before,
+		})
+		return 0, fmt.Errorf("failed to delete old messages: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// toStoredMessages converts database models to domain stored messages
+func (r *MessageRepository) toStoredMessages(models []database.MessageModel) []*whatsapp.StoredMessage {
+	messages := make([]*whatsapp.StoredMessage, 0, len(models))
+	for _, model := range models {
+		message, err := database.FromMessageModel(&model)
+		if err != nil {
+			r.logger.ErrorWithError("failed to convert message model", err, logger.Fields{
+				"message_id": model.MessageID,
+			})
+			continue // Skip invalid entries
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/infra/database"
+	"wazmeow/pkg/logger"
+)
+
+// PairingAuditRepository implements session.PairingAuditRepository using Bun ORM
+type PairingAuditRepository struct {
+	db     *bun.DB
+	logger logger.Logger
+}
+
+// NewPairingAuditRepository creates a new pairing audit repository using Bun ORM
+func NewPairingAuditRepository(db *bun.DB, logger logger.Logger) session.PairingAuditRepository {
+	return &PairingAuditRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new pairing attempt
+func (r *PairingAuditRepository) Create(ctx context.Context, attempt *session.PairingAttempt) error {
+	model := database.ToPairingAuditModel(attempt)
+
+	_, err := r.db.NewInsert().
+		Model(model).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to create pairing audit entry", err, logger.Fields{
+			"session_id": attempt.SessionID.String(),
+			"outcome":    attempt.Outcome.String(),
+		})
+		return fmt.Errorf("failed to create pairing audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListBySessionID retrieves pairing attempts of a session, newest first, with pagination
+func (r *PairingAuditRepository) ListBySessionID(ctx context.Context, sessionID session.SessionID, limit, offset int) ([]*session.PairingAttempt, int, error) {
+	var models []database.PairingAuditModel
+
+	err := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", sessionID.String()).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list pairing audit entries", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"limit":      limit,
+			"offset":     offset,
+		})
+		return nil, 0, fmt.Errorf("failed to list pairing audit entries: %w", err)
+	}
+
+	// Get total count
+	total, err := r.db.NewSelect().
+		Model((*database.PairingAuditModel)(nil)).
+		Where("session_id = ?", sessionID.String()).
+		Count(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to count pairing audit entries", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return nil, 0, fmt.Errorf("failed to count pairing audit entries: %w", err)
+	}
+
+	// Convert models to domain entities
+	attempts := make([]*session.PairingAttempt, 0, len(models))
+	for _, model := range models {
+		attempt, err := database.FromPairingAuditModel(&model)
+		if err != nil {
+			r.logger.ErrorWithError("failed to convert pairing audit model", err, logger.Fields{
+				"id": model.ID,
+			})
+			continue // Skip invalid entries
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, total, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/database"
+	"wazmeow/pkg/logger"
+)
+
+// ReceiptRepository implements whatsapp.ReceiptRepository using Bun ORM
+type ReceiptRepository struct {
+	db     *bun.DB
+	logger logger.Logger
+}
+
+// NewReceiptRepository creates a new receipt repository using Bun ORM
+func NewReceiptRepository(db *bun.DB, logger logger.Logger) whatsapp.ReceiptRepository {
+	return &ReceiptRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Save stores the receipt of a recipient. Receipts can arrive out of order, so a
+// stored status is only replaced by a further one (delivered, then read, then played).
+func (r *ReceiptRepository) Save(ctx context.Context, receipt *whatsapp.MessageReceipt) error {
+	model := database.ToMessageReceiptModel(receipt)
+
+	_, err := r.db.NewInsert().
+		Model(model).
+		On("CONFLICT (session_id, message_id, recipient_jid) DO UPDATE").
+		Set("status = EXCLUDED.status").
+		Set("status_rank = EXCLUDED.status_rank").
+		Set("timestamp = EXCLUDED.timestamp").
+		Where("?TableAlias.status_rank < EXCLUDED.status_rank").
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to save message receipt", err, logger.Fields{
+			"session_id": receipt.SessionID.String(),
+			"message_id": receipt.MessageID,
+			"status":     receipt.Status.String(),
+		})
+		return fmt.Errorf("failed to save message receipt: %w", err)
+	}
+
+	return nil
+}
+
+// ListByMessage retrieves the receipts of a message, one per recipient, oldest first
+func (r *ReceiptRepository) ListByMessage(ctx context.Context, sessionID session.SessionID, messageID string) ([]*whatsapp.MessageReceipt, error) {
+	var models []database.MessageReceiptModel
+
+	err := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", sessionID.String()).
+		Where("message_id = ?", messageID).
+		Order("timestamp ASC", "recipient_jid ASC").
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list message receipts", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"message_id": messageID,
+		})
+		return nil, fmt.Errorf("failed to list message receipts: %w", err)
+	}
+
+	receipts := make([]*whatsapp.MessageReceipt, 0, len(models))
+	for _, model := range models {
+		receipt, err := database.FromMessageReceiptModel(&model)
+		if err != nil {
+			r.logger.ErrorWithError("failed to convert message receipt model", err, logger.Fields{
+				"message_id": model.MessageID,
+			})
+			continue // Skip invalid entries
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// DeleteBySession deletes all the receipts of a session
+func (r *ReceiptRepository) DeleteBySession(ctx context.Context, sessionID session.SessionID) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.MessageReceiptModel)(nil)).
+		Where("session_id = ?", sessionID.String()).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete session receipts", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return 0, fmt.Errorf("failed to delete session receipts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// DeleteBefore deletes the receipts of every session received before the given time
+func (r *ReceiptRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.MessageReceiptModel)(nil)).
+		Where("timestamp < ?", before).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete old receipts", err, logger.Fields{
+			"before": before,
+		})
+		return 0, fmt.Errorf("failed to delete old receipts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/database"
+	"wazmeow/pkg/logger"
+)
+
+// ScheduledMessageRepository implements whatsapp.ScheduledMessageRepository using Bun ORM
+type ScheduledMessageRepository struct {
+	db     *bun.DB
+	logger logger.Logger
+}
+
+// NewScheduledMessageRepository creates a new scheduled message repository using Bun ORM
+func NewScheduledMessageRepository(db *bun.DB, logger logger.Logger) whatsapp.ScheduledMessageRepository {
+	return &ScheduledMessageRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new scheduled message
+func (r *ScheduledMessageRepository) Create(ctx context.Context, message *whatsapp.ScheduledMessage) error {
+	model := database.ToScheduledMessageModel(message)
+
+	_, err := r.db.NewInsert().
+		Model(model).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to create scheduled message", err, logger.Fields{
+			"session_id": message.SessionID.String(),
+			"id":         message.ID,
+		})
+		return fmt.Errorf("failed to create scheduled message: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a scheduled message of a session
+func (r *ScheduledMessageRepository) GetByID(ctx context.Context, sessionID session.SessionID, id string) (*whatsapp.ScheduledMessage, error) {
+	model := &database.ScheduledMessageModel{}
+
+	err := r.db.NewSelect().
+		Model(model).
+		Where("session_id = ?", sessionID.String()).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, whatsapp.ErrScheduledMessageNotFound
+		}
+		r.logger.ErrorWithError("failed to get scheduled message", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"id":         id,
+		})
+		return nil, fmt.Errorf("failed to get scheduled message: %w", err)
+	}
+
+	return database.FromScheduledMessageModel(model)
+}
+
+// ListBySession retrieves the scheduled messages of a session by send time.
+// An empty status returns messages in every status.
+func (r *ScheduledMessageRepository) ListBySession(ctx context.Context, sessionID session.SessionID, status whatsapp.ScheduledMessageStatus) ([]*whatsapp.ScheduledMessage, error) {
+	var models []database.ScheduledMessageModel
+
+	query := r.db.NewSelect().
+		Model(&models).
+		Where("session_id = ?", sessionID.String())
+	if status != "" {
+		query = query.Where("status = ?", status.String())
+	}
+
+	err := query.
+		Order("send_at ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list scheduled messages", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"status":     status.String(),
+		})
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+
+	return r.toScheduledMessages(models), nil
+}
+
+// ListDue retrieves up to limit pending messages of the given sessions whose send time
+// and retry delay have passed, oldest first
+func (r *ScheduledMessageRepository) ListDue(ctx context.Context, sessionIDs []session.SessionID, now time.Time, limit int) ([]*whatsapp.ScheduledMessage, error) {
+	if len(sessionIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		ids[i] = sessionID.String()
+	}
+
+	var models []database.ScheduledMessageModel
+
+	err := r.db.NewSelect().
+		Model(&models).
+		Where("status = ?", whatsapp.ScheduledMessagePending.String()).
+		Where("session_id IN (?)", bun.In(ids)).
+		Where("send_at <= ?", now.UTC()).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("next_attempt_at IS NULL").WhereOr("next_attempt_at <= ?", now.UTC())
+		}).
+		Order("send_at ASC", "id ASC").
+		Limit(limit).
+		Scan(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to list due scheduled messages", err, logger.Fields{
+			"limit": limit,
+		})
+		return nil, fmt.Errorf("failed to list due scheduled messages: %w", err)
+	}
+
+	return r.toScheduledMessages(models), nil
+}
+
+// Transition persists the delivery state of a message if its stored status is still from
+func (r *ScheduledMessageRepository) Transition(ctx context.Context, message *whatsapp.ScheduledMessage, from whatsapp.ScheduledMessageStatus) error {
+	model := database.ToScheduledMessageModel(message)
+
+	result, err := r.db.NewUpdate().
+		Model(model).
+		Column("status", "attempts", "message_id", "error", "next_attempt_at", "updated_at").
+		WherePK().
+		Where("status = ?", from.String()).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to update scheduled message", err, logger.Fields{
+			"session_id": message.SessionID.String(),
+			"id":         message.ID,
+			"status":     message.Status.String(),
+		})
+		return fmt.Errorf("failed to update scheduled message: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return whatsapp.ErrScheduledMessageNotPending
+	}
+
+	return nil
+}
+
+// FailInterrupted marks messages left in sending state by a previous run as failed.
+// Whether they reached WhatsApp is unknown, so they are not sent again.
+func (r *ScheduledMessageRepository) FailInterrupted(ctx context.Context, reason string) (int, error) {
+	result, err := r.db.NewUpdate().
+		Model((*database.ScheduledMessageModel)(nil)).
+		Set("status = ?", whatsapp.ScheduledMessageFailed.String()).
+		Set("error = ?", reason).
+		Set("updated_at = ?", time.Now()).
+		Where("status = ?", whatsapp.ScheduledMessageSending.String()).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to fail interrupted scheduled messages", err, nil)
+		return 0, fmt.Errorf("failed to fail interrupted scheduled messages: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// DeleteBySession deletes all the scheduled messages of a session
+func (r *ScheduledMessageRepository) DeleteBySession(ctx context.Context, sessionID session.SessionID) (int, error) {
+	result, err := r.db.NewDelete().
+		Model((*database.ScheduledMessageModel)(nil)).
+		Where("session_id = ?", sessionID.String()).
+		Exec(ctx)
+
+	if err != nil {
+		r.logger.ErrorWithError("failed to delete session scheduled messages", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return 0, fmt.Errorf("failed to delete session scheduled messages: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// toScheduledMessages converts database models to domain scheduled messages
+func (r *ScheduledMessageRepository) toScheduledMessages(models []database.ScheduledMessageModel) []*whatsapp.ScheduledMessage {
+	messages := make([]*whatsapp.ScheduledMessage, 0, len(models))
+	for _, model := range models {
+		message, err := database.FromScheduledMessageModel(&model)
+		if err != nil {
+			r.logger.ErrorWithError("failed to convert scheduled message model", err, logger.Fields{
+				"id": model.ID,
+			})
+			continue // Skip invalid entries
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/uptrace/bun"
 
@@ -156,6 +157,87 @@ func (r *SessionRepository) List(ctx context.Context, limit, offset int) ([]*ses
 	return sessions, total, nil
 }
 
+// ListWithFilter retrieves the sessions matching the filter with sorting and pagination
+func (r *SessionRepository) ListWithFilter(ctx context.Context, filter session.ListFilter, options session.ListOptions) ([]*session.Session, int, error) {
+	var models []database.WazMeowSessionModel
+
+	sortField := options.Sort
+	if !session.IsValidSortField(sortField) {
+		sortField = session.SortByCreatedAt
+	}
+	sortOrder := strings.ToLower(options.Order)
+	if !session.IsValidSortOrder(sortOrder) {
+		sortOrder = session.SortOrderDesc
+	}
+
+	query := r.db.NewSelect().Model(&models)
+	applySessionFilter(query, filter)
+	query = query.OrderExpr("? "+strings.ToUpper(sortOrder), bun.Ident(sortField))
+	if options.Limit > 0 {
+		query = query.Limit(options.Limit)
+	}
+	if options.Offset > 0 {
+		query = query.Offset(options.Offset)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		r.logger.ErrorWithError("failed to list sessions with filter", err, logger.Fields{
+			"limit":  options.Limit,
+			"offset": options.Offset,
+			"sort":   sortField,
+			"order":  sortOrder,
+		})
+		return nil, 0, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	// Count every session matching the filter, regardless of pagination
+	countQuery := r.db.NewSelect().Model((*database.WazMeowSessionModel)(nil))
+	applySessionFilter(countQuery, filter)
+	total, err := countQuery.Count(ctx)
+	if err != nil {
+		r.logger.ErrorWithError("failed to count sessions with filter", err, nil)
+		return nil, 0, fmt.Errorf("failed to count sessions: %w", err)
+	}
+
+	sessions := make([]*session.Session, 0, len(models))
+	for _, model := range models {
+		sess, err := database.FromWazMeowSessionModel(&model)
+		if err != nil {
+			r.logger.ErrorWithError("failed to convert session model", err, logger.Fields{
+				"session_id": model.ID,
+			})
+			continue // Skip invalid sessions
+		}
+		sessions = append(sessions, sess)
+	}
+
+	return sessions, total, nil
+}
+
+// applySessionFilter adds the WHERE clauses of a list filter to a session query
+func applySessionFilter(query *bun.SelectQuery, filter session.ListFilter) {
+	if filter.Status != nil {
+		query.Where("status = ?", filter.Status.String())
+	}
+	if filter.IsActive != nil {
+		query.Where("is_active = ?", *filter.IsActive)
+	}
+	if filter.NameContains != "" {
+		query.Where("LOWER(name) LIKE ? ESCAPE '\\'", "%"+escapeLike(strings.ToLower(filter.NameContains))+"%")
+	}
+	if filter.CreatedAfter != nil {
+		query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query.Where("created_at <= ?", *filter.CreatedBefore)
+	}
+}
+
+// escapeLike escapes the LIKE wildcards so the value is matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(value)
+}
+
 // Update updates an existing session
 func (r *SessionRepository) Update(ctx context.Context, sess *session.Session) error {
 	model := database.ToWazMeowSessionModel(sess)
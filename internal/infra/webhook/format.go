@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+const (
+	// FormatRich delivers every event with its full data (the default)
+	FormatRich = "rich"
+	// FormatSimple delivers inbound messages as a flat payload for no-code platforms
+	FormatSimple = "simple"
+)
+
+// SimpleMessagePayload is the flat JSON body delivered for messages in the simple format
+type SimpleMessagePayload struct {
+	From      string    `json:"from"`
+	Text      string    `json:"text"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// encodePayload serializes an event according to the delivery format. Only message
+// events have a simple shape; every other event keeps the rich payload.
+func encodePayload(event *whatsapp.Event, format string) ([]byte, error) {
+	if format == FormatSimple && event.Type == whatsapp.EventTypeMessage {
+		if data, ok := event.Data.(map[string]interface{}); ok {
+			return json.Marshal(simpleMessagePayload(event, data))
+		}
+	}
+
+	return json.Marshal(&Payload{
+		ID:        event.ID,
+		Type:      event.Type.String(),
+		SessionID: event.SessionID.String(),
+		Timestamp: event.Timestamp,
+		Data:      event.Data,
+	})
+}
+
+// simpleMessagePayload flattens the data of a message event
+func simpleMessagePayload(event *whatsapp.Event, data map[string]interface{}) *SimpleMessagePayload {
+	payload := &SimpleMessagePayload{Timestamp: event.Timestamp}
+	payload.From, _ = data["from"].(string)
+	payload.Text, _ = data["body"].(string)
+	payload.Type, _ = data["type"].(string)
+	if timestamp, ok := data["timestamp"].(time.Time); ok && !timestamp.IsZero() {
+		payload.Timestamp = timestamp
+	}
+	return payload
+}
@@ -0,0 +1,329 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/config"
+	"wazmeow/pkg/logger"
+)
+
+// maxDrainBytes is how much of a response body is read so the connection can be reused
+const maxDrainBytes = 64 << 10
+
+// Payload is the JSON body delivered to the webhook receiver
+type Payload struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	SessionID string      `json:"session_id"`
+	Timestamp time.Time   `json:"timestamp"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// Sender implements whatsapp.WebhookHandler. All deliveries share one HTTP client
+// so keep-alive connections to the receiver are reused, and asynchronous deliveries
+// go through a bounded queue so a slow receiver never blocks event handling.
+type Sender struct {
+	client *http.Client
+	logger logger.Logger
+
+	mu         sync.RWMutex
+	url        string
+	headers    map[string]string
+	timeout    time.Duration
+	maxRetries int
+	backoff    time.Duration
+	format     string
+
+	queue   chan *whatsapp.Event
+	wg      sync.WaitGroup
+	queueMu sync.RWMutex // Guards sends on queue against Close
+	closed  bool
+
+	statsMu            sync.Mutex
+	stats              whatsapp.WebhookStats
+	totalLatency       time.Duration
+	successfulAttempts int64
+}
+
+// NewSender creates a webhook sender and starts its delivery workers
+func NewSender(cfg *config.WebhookConfig, log logger.Logger) *Sender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.DialContext = (&net.Dialer{
+		Timeout:   cfg.Timeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+
+	s := &Sender{
+		// Timeouts are applied per request so SetTimeout is safe while deliveries run
+		client:  &http.Client{Transport: transport},
+		logger:  log,
+		url:     cfg.URL,
+		headers: make(map[string]string),
+		timeout: cfg.Timeout,
+		format:  FormatRich,
+		queue:   make(chan *whatsapp.Event, cfg.QueueSize),
+	}
+
+	if cfg.Format != "" {
+		s.format = cfg.Format
+	}
+
+	for i := 0; i < cfg.Workers; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+
+	return s
+}
+
+// worker delivers queued events until the queue is closed
+func (s *Sender) worker() {
+	defer s.wg.Done()
+
+	for event := range s.queue {
+		if err := s.SendWebhook(event); err != nil {
+			s.logger.ErrorWithError("webhook delivery failed", err, logger.Fields{
+				"session_id": event.SessionID.String(),
+				"event_id":   event.ID,
+				"event_type": event.Type.String(),
+			})
+		}
+	}
+}
+
+// SendWebhook delivers an event synchronously, retrying according to the retry policy
+func (s *Sender) SendWebhook(event *whatsapp.Event) error {
+	s.mu.RLock()
+	targetURL := s.url
+	headers := make(map[string]string, len(s.headers))
+	for k, v := range s.headers {
+		headers[k] = v
+	}
+	timeout, maxRetries, backoff, format := s.timeout, s.maxRetries, s.backoff, s.format
+	s.mu.RUnlock()
+
+	body, err := encodePayload(event, format)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		err = s.deliver(targetURL, headers, timeout, body)
+		s.recordAttempt(time.Since(start), err)
+
+		if err == nil || attempt >= maxRetries {
+			s.recordOutcome(event.SessionID, err)
+			return err
+		}
+		s.recordRetry(event.SessionID)
+
+		s.logger.WarnWithFields("webhook delivery failed, retrying", logger.Fields{
+			"session_id": event.SessionID.String(),
+			"event_id":   event.ID,
+			"attempt":    attempt + 1,
+			"error":      err.Error(),
+		})
+		time.Sleep(backoff * time.Duration(attempt+1))
+	}
+}
+
+// deliver performs a single POST of the payload
+func (s *Sender) deliver(targetURL string, headers map[string]string, timeout time.Duration, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "wazmeow-webhook")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the keep-alive connection goes back to the pool
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook receiver returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SendWebhookAsync queues an event for delivery, dropping it when the queue is full
+func (s *Sender) SendWebhookAsync(event *whatsapp.Event) {
+	s.queueMu.RLock()
+	defer s.queueMu.RUnlock()
+
+	if s.closed {
+		s.logger.WarnWithFields("webhook sender closed, event dropped", logger.Fields{
+			"session_id": event.SessionID.String(),
+			"event_type": event.Type.String(),
+		})
+		return
+	}
+
+	select {
+	case s.queue <- event:
+	default:
+		s.recordOutcome(event.SessionID, fmt.Errorf("webhook queue full"))
+		s.logger.WarnWithFields("webhook queue full, event dropped", logger.Fields{
+			"session_id": event.SessionID.String(),
+			"event_type": event.Type.String(),
+			"queue_size": cap(s.queue),
+		})
+	}
+}
+
+// SetURL sets the webhook receiver URL
+func (s *Sender) SetURL(url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.url = url
+}
+
+// SetHeaders sets extra headers sent with every delivery
+func (s *Sender) SetHeaders(headers map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.headers = make(map[string]string, len(headers))
+	for k, v := range headers {
+		s.headers[k] = v
+	}
+}
+
+// SetTimeout sets the per-delivery timeout
+func (s *Sender) SetTimeout(timeout time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timeout = timeout
+}
+
+// SetFormat sets the payload format, FormatRich or FormatSimple
+func (s *Sender) SetFormat(format string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.format = format
+}
+
+// SetRetryPolicy sets how many times a failed delivery is retried, waiting
+// backoff multiplied by the attempt number between tries
+func (s *Sender) SetRetryPolicy(maxRetries int, backoff time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxRetries = maxRetries
+	s.backoff = backoff
+}
+
+// IsHealthy returns true if the last delivery succeeded
+func (s *Sender) IsHealthy() bool {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	return s.stats.LastError == nil
+}
+
+// GetStats returns delivery statistics
+func (s *Sender) GetStats() *whatsapp.WebhookStats {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	stats := s.stats
+	stats.BySession = make(map[session.SessionID]*whatsapp.WebhookSessionStats, len(s.stats.BySession))
+	for sessionID, sessionStats := range s.stats.BySession {
+		copied := *sessionStats
+		stats.BySession[sessionID] = &copied
+	}
+	stats.Latency.Counts = append([]int64(nil), s.stats.Latency.Counts...)
+	stats.QueueLength = len(s.queue)
+	stats.QueueCapacity = cap(s.queue)
+	return &stats
+}
+
+// recordAttempt updates the statistics with the latency and result of a delivery attempt
+func (s *Sender) recordAttempt(latency time.Duration, err error) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	s.stats.LastError = err
+	s.stats.Latency.Observe(latency)
+	if err != nil {
+		return
+	}
+
+	s.stats.LastSentAt = time.Now()
+	s.totalLatency += latency
+	s.successfulAttempts++
+	s.stats.AverageLatency = s.totalLatency / time.Duration(s.successfulAttempts)
+}
+
+// recordRetry counts a failed attempt that is about to be retried
+func (s *Sender) recordRetry(sessionID session.SessionID) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	s.stats.TotalRetried++
+	s.sessionStats(sessionID).Retried++
+}
+
+// recordOutcome counts an event delivered or given up on
+func (s *Sender) recordOutcome(sessionID session.SessionID, err error) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	if err != nil {
+		s.stats.LastError = err
+		s.stats.TotalFailed++
+		s.sessionStats(sessionID).Failed++
+		return
+	}
+	s.stats.TotalSent++
+	s.sessionStats(sessionID).Delivered++
+}
+
+// sessionStats returns the counters of a session, creating them on first use.
+// The caller must hold statsMu.
+func (s *Sender) sessionStats(sessionID session.SessionID) *whatsapp.WebhookSessionStats {
+	if s.stats.BySession == nil {
+		s.stats.BySession = make(map[session.SessionID]*whatsapp.WebhookSessionStats)
+	}
+	stats, exists := s.stats.BySession[sessionID]
+	if !exists {
+		stats = &whatsapp.WebhookSessionStats{}
+		s.stats.BySession[sessionID] = stats
+	}
+	return stats
+}
+
+// Close stops accepting events and waits for queued deliveries to finish
+func (s *Sender) Close() {
+	s.queueMu.Lock()
+	if s.closed {
+		s.queueMu.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.queue)
+	s.queueMu.Unlock()
+
+	s.wg.Wait()
+	s.client.CloseIdleConnections()
+}
@@ -0,0 +1,393 @@
+package whats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	"go.mau.fi/whatsmeow/proto/waSyncAction"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// MarkRead sends a read receipt for messages of a chat.
+// All messages must have been sent by senderJID, which is only used in group chats.
+func (c *Client) MarkRead(ctx context.Context, chatJID, senderJID string, messageIDs []string) error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	chat, err := types.ParseJID(chatJID)
+	if err != nil {
+		return fmt.Errorf("invalid chat JID: %w", err)
+	}
+
+	var sender types.JID
+	if senderJID != "" {
+		sender, err = types.ParseJID(senderJID)
+		if err != nil {
+			return fmt.Errorf("invalid sender JID: %w", err)
+		}
+	}
+
+	ids := make([]types.MessageID, len(messageIDs))
+	for i, id := range messageIDs {
+		ids[i] = types.MessageID(id)
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	if err := c.client.MarkRead(ids, time.Now(), chat, sender); err != nil {
+		return fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+
+	c.logger.InfoWithFields("✔️ Mensagens marcadas como lidas", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chatJID,
+		"sender":     senderJID,
+		"count":      len(ids),
+	})
+
+	return nil
+}
+
+// MarkChatUnread flags a chat as unread on all devices through an app state patch.
+// The chat must have a stored message or known app state settings.
+func (c *Client) MarkChatUnread(ctx context.Context, chatJID string) (*whatsapp.ChatState, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	chat, err := types.ParseJID(chatJID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat JID: %w", err)
+	}
+
+	settings, err := c.client.Store.ChatSettings.GetChatSettings(ctx, chat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat settings: %w", err)
+	}
+
+	lastTimestamp, lastKey, err := c.lastMessageRange(ctx, chat, settings.Found)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	if err := c.client.SendAppState(ctx, buildMarkChatAsRead(chat, false, lastTimestamp, lastKey)); err != nil {
+		return nil, fmt.Errorf("failed to mark chat as unread: %w", err)
+	}
+
+	c.logger.InfoWithFields("📌 Chat marcado como não lido", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chat.String(),
+	})
+
+	return &whatsapp.ChatState{
+		JID:        chat.String(),
+		Unread:     true,
+		Archived:   settings.Archived,
+		Pinned:     settings.Pinned,
+		MutedUntil: settings.MutedUntil,
+	}, nil
+}
+
+// ArchiveChat archives or unarchives a chat on all devices through an app state patch.
+// Archiving also unpins the chat. The chat must have a stored message or known app state settings.
+func (c *Client) ArchiveChat(ctx context.Context, chatJID string, archive bool) (*whatsapp.ChatState, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	chat, err := types.ParseJID(chatJID)
+	if err != nil {
+		return nil, whatsapp.ErrInvalidChatJID
+	}
+
+	settings, err := c.client.Store.ChatSettings.GetChatSettings(ctx, chat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat settings: %w", err)
+	}
+
+	lastTimestamp, lastKey, err := c.lastMessageRange(ctx, chat, settings.Found)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	if err := c.client.SendAppState(ctx, appstate.BuildArchive(chat, archive, lastTimestamp, lastKey)); err != nil {
+		return nil, fmt.Errorf("failed to update chat archive: %w", err)
+	}
+
+	c.logger.InfoWithFields("🗄️ Arquivamento do chat atualizado", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chat.String(),
+		"archived":   archive,
+	})
+
+	return &whatsapp.ChatState{
+		JID:        chat.String(),
+		Archived:   archive,
+		Pinned:     settings.Pinned && !archive,
+		MutedUntil: settings.MutedUntil,
+	}, nil
+}
+
+// MuteChat mutes a chat until the given time on all devices through an app state patch.
+// A zero time unmutes the chat.
+func (c *Client) MuteChat(ctx context.Context, chatJID string, until time.Time) (*whatsapp.ChatState, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	chat, err := types.ParseJID(chatJID)
+	if err != nil {
+		return nil, whatsapp.ErrInvalidChatJID
+	}
+
+	if err := whatsapp.ValidateMuteUntil(until, time.Now()); err != nil {
+		return nil, err
+	}
+
+	settings, err := c.client.Store.ChatSettings.GetChatSettings(ctx, chat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat settings: %w", err)
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	mute := !until.IsZero()
+	var duration time.Duration
+	if mute {
+		duration = time.Until(until)
+	}
+	if err := c.client.SendAppState(ctx, appstate.BuildMute(chat, mute, duration)); err != nil {
+		return nil, fmt.Errorf("failed to update chat mute: %w", err)
+	}
+
+	c.logger.InfoWithFields("🔕 Silenciamento do chat atualizado", logger.Fields{
+		"session_id":  c.sessionID.String(),
+		"chat":        chat.String(),
+		"muted":       mute,
+		"muted_until": until,
+	})
+
+	return &whatsapp.ChatState{
+		JID:        chat.String(),
+		Archived:   settings.Archived,
+		Pinned:     settings.Pinned,
+		MutedUntil: until,
+	}, nil
+}
+
+// DeleteChat deletes a chat from the chat list of the linked account on all devices through an
+// app state patch. Messages stored by this service are kept.
+func (c *Client) DeleteChat(ctx context.Context, chatJID string) error {
+	return c.sendChatRangePatch(ctx, chatJID, appstate.IndexDeleteChat, "🗑️ Chat apagado da conta")
+}
+
+// ClearChat clears the message history of a chat on all devices of the linked account through
+// an app state patch, keeping starred messages. Messages stored by this service are kept.
+func (c *Client) ClearChat(ctx context.Context, chatJID string) error {
+	return c.sendChatRangePatch(ctx, chatJID, appstate.IndexClearChat, "🧹 Histórico do chat limpo na conta")
+}
+
+// sendChatRangePatch sends a delete or clear chat patch covering every message up to the last one
+func (c *Client) sendChatRangePatch(ctx context.Context, chatJID, index, logMessage string) error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	chat, err := types.ParseJID(chatJID)
+	if err != nil {
+		return whatsapp.ErrInvalidChatJID
+	}
+
+	settings, err := c.client.Store.ChatSettings.GetChatSettings(ctx, chat)
+	if err != nil {
+		return fmt.Errorf("failed to get chat settings: %w", err)
+	}
+
+	lastTimestamp, lastKey, err := c.lastMessageRange(ctx, chat, settings.Found)
+	if err != nil {
+		return err
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	if err := c.client.SendAppState(ctx, buildChatRangePatch(index, chat, lastTimestamp, lastKey)); err != nil {
+		return c.appStateError(chat, err)
+	}
+
+	c.logger.InfoWithFields(logMessage, logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chat.String(),
+	})
+
+	return nil
+}
+
+// appStateError maps app state failures that callers can act on to domain errors,
+// logging the whatsmeow error they replace
+func (c *Client) appStateError(chat types.JID, err error) error {
+	var mapped error
+	switch {
+	case errors.Is(err, appstate.ErrKeyNotFound), strings.Contains(err.Error(), "no app state keys found"):
+		mapped = whatsapp.ErrAppStateNotReady
+	case errors.Is(err, whatsmeow.ErrAppStateUpdate):
+		mapped = whatsapp.ErrAppStateRejected
+	default:
+		return fmt.Errorf("failed to send app state patch: %w", err)
+	}
+
+	c.logger.WarnWithFields("⚠️ Falha ao enviar alteração de app state", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chat.String(),
+		"error":      err.Error(),
+	})
+	return mapped
+}
+
+// lastMessageRange returns the timestamp and key of the last stored message of a chat, which
+// WhatsApp expects at the end of the message range of some app state patches. Without a stored
+// message the range ends now, but only for chats with known app state settings.
+func (c *Client) lastMessageRange(ctx context.Context, chat types.JID, settingsFound bool) (time.Time, *waCommon.MessageKey, error) {
+	if c.messageRepo == nil {
+		if !settingsFound {
+			return time.Time{}, nil, whatsapp.ErrChatNotFound
+		}
+		return time.Now(), nil, nil
+	}
+
+	last, err := c.messageRepo.GetLatestByChat(ctx, c.sessionID, chat.String())
+	switch {
+	case errors.Is(err, whatsapp.ErrMessageNotFound):
+		if !settingsFound {
+			return time.Time{}, nil, whatsapp.ErrChatNotFound
+		}
+		return time.Now(), nil, nil
+	case err != nil:
+		return time.Time{}, nil, err
+	}
+
+	key := &waCommon.MessageKey{
+		RemoteJID: proto.String(last.ChatJID),
+		FromMe:    proto.Bool(last.IsFromMe),
+		ID:        proto.String(last.ID),
+	}
+	if chat.Server == types.GroupServer && !last.IsFromMe {
+		key.Participant = proto.String(last.SenderJID)
+	}
+	return last.Timestamp, key, nil
+}
+
+// notifyChatStateChanged reports a chat setting changed on another device. Full syncs are
+// not reported: they replay the settings of every chat rather than a user action.
+func (c *Client) notifyChatStateChanged(kind whatsapp.ChatStateChangeKind, chat types.JID, timestamp time.Time) {
+	handler := c.handler()
+	if handler == nil {
+		return
+	}
+
+	// whatsmeow stores the new setting before dispatching the event
+	settings, err := c.client.Store.ChatSettings.GetChatSettings(context.Background(), chat)
+	if err != nil {
+		c.logger.WarnWithFields("⚠️ Falha ao ler configurações do chat", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"chat":       chat.String(),
+			"error":      err.Error(),
+		})
+		return
+	}
+
+	handler.OnChatStateChanged(c.sessionID, &whatsapp.ChatStateChange{
+		Kind: kind,
+		State: whatsapp.ChatState{
+			JID:        chat.String(),
+			Archived:   settings.Archived,
+			Pinned:     settings.Pinned,
+			MutedUntil: settings.MutedUntil,
+		},
+		Timestamp: timestamp,
+	})
+}
+
+// buildMarkChatAsRead builds the app state patch that marks a chat as read or unread
+func buildMarkChatAsRead(target types.JID, read bool, lastMessageTimestamp time.Time, lastMessageKey *waCommon.MessageKey) appstate.PatchInfo {
+	messageRange := &waSyncAction.SyncActionMessageRange{
+		LastMessageTimestamp: proto.Int64(lastMessageTimestamp.Unix()),
+	}
+	if lastMessageKey != nil {
+		messageRange.Messages = []*waSyncAction.SyncActionMessage{{
+			Key:       lastMessageKey,
+			Timestamp: proto.Int64(lastMessageTimestamp.Unix()),
+		}}
+	}
+
+	return appstate.PatchInfo{
+		Type: appstate.WAPatchRegularLow,
+		Mutations: []appstate.MutationInfo{{
+			Index:   []string{appstate.IndexMarkChatAsRead, target.String()},
+			Version: 3,
+			Value: &waSyncAction.SyncActionValue{
+				MarkChatAsReadAction: &waSyncAction.MarkChatAsReadAction{
+					Read:         proto.Bool(read),
+					MessageRange: messageRange,
+				},
+			},
+		}},
+	}
+}
+
+// buildChatRangePatch builds the app state patch that deletes or clears a chat up to its last message.
+// Clearing keeps starred messages and media files, like the default of the phone.
+func buildChatRangePatch(index string, target types.JID, lastMessageTimestamp time.Time, lastMessageKey *waCommon.MessageKey) appstate.PatchInfo {
+	messageRange := &waSyncAction.SyncActionMessageRange{
+		LastMessageTimestamp: proto.Int64(lastMessageTimestamp.Unix()),
+	}
+	if lastMessageKey != nil {
+		messageRange.Messages = []*waSyncAction.SyncActionMessage{{
+			Key:       lastMessageKey,
+			Timestamp: proto.Int64(lastMessageTimestamp.Unix()),
+		}}
+	}
+
+	mutation := appstate.MutationInfo{
+		Version: 6,
+		Value:   &waSyncAction.SyncActionValue{},
+	}
+	if index == appstate.IndexClearChat {
+		// The flags keep starred messages and downloaded media
+		mutation.Index = []string{appstate.IndexClearChat, target.String(), "0", "0"}
+		mutation.Value.ClearChatAction = &waSyncAction.ClearChatAction{MessageRange: messageRange}
+	} else {
+		// The flag keeps downloaded media
+		mutation.Index = []string{appstate.IndexDeleteChat, target.String(), "0"}
+		mutation.Value.DeleteChatAction = &waSyncAction.DeleteChatAction{MessageRange: messageRange}
+	}
+
+	return appstate.PatchInfo{
+		Type:      appstate.WAPatchRegularHigh,
+		Mutations: []appstate.MutationInfo{mutation},
+	}
+}
@@ -0,0 +1,147 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waHistorySync"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetChats returns the known chats of the session, most recent first.
+// While the history sync is still running the list holds only what arrived so far.
+func (c *Client) GetChats(ctx context.Context) (*whatsapp.ChatList, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+	if c.chatRepo == nil {
+		return nil, fmt.Errorf("chat list is not available")
+	}
+
+	chats, err := c.chatRepo.ListBySession(ctx, c.sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chats: %w", err)
+	}
+
+	// History syncs name groups but not always contacts; fall back to the contact store
+	for _, chat := range chats {
+		if chat.Name == "" {
+			chat.Name = c.contactName(ctx, chat.JID)
+		}
+	}
+
+	return &whatsapp.ChatList{
+		Chats:        chats,
+		SyncComplete: c.syncTracker.snapshot().HistorySync.Completed,
+	}, nil
+}
+
+// contactName returns the best known name of a contact, or an empty string
+func (c *Client) contactName(ctx context.Context, jid string) string {
+	parsed, err := types.ParseJID(jid)
+	if err != nil {
+		return ""
+	}
+
+	contact, err := c.client.Store.Contacts.GetContact(ctx, parsed)
+	if err != nil || !contact.Found {
+		return ""
+	}
+
+	switch {
+	case contact.FullName != "":
+		return contact.FullName
+	case contact.BusinessName != "":
+		return contact.BusinessName
+	default:
+		return contact.PushName
+	}
+}
+
+// storeHistoryChats persists the conversations delivered by a history sync
+func (c *Client) storeHistoryChats(evt *events.HistorySync) {
+	if c.chatRepo == nil {
+		return
+	}
+
+	chats := ToChatSummaries(c.sessionID, evt.Data.GetConversations())
+	if err := c.chatRepo.SaveAll(context.Background(), chats); err != nil {
+		c.logger.ErrorWithFields("💥 Falha ao salvar conversas do histórico", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"count":      len(chats),
+			"error":      err.Error(),
+		})
+	}
+}
+
+// recordChatMessage moves the chat of a live message to the top of the chat list
+func (c *Client) recordChatMessage(evt *events.Message) {
+	if c.chatRepo == nil {
+		return
+	}
+
+	chat := evt.Info.Chat.String()
+	if err := c.chatRepo.RecordMessage(context.Background(), c.sessionID, chat, evt.Info.Timestamp, !evt.Info.IsFromMe); err != nil {
+		c.logger.ErrorWithFields("💥 Falha ao atualizar lista de conversas", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"chat":       chat,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// recordChatRead clears the unread count of a chat read on another device
+func (c *Client) recordChatRead(evt *events.MarkChatAsRead) {
+	if c.chatRepo == nil || !evt.Action.GetRead() {
+		return
+	}
+
+	chat := evt.JID.String()
+	if err := c.chatRepo.MarkRead(context.Background(), c.sessionID, chat); err != nil {
+		c.logger.ErrorWithFields("💥 Falha ao marcar conversa como lida", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"chat":       chat,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// ToChatSummaries converts history sync conversations to chat list entries.
+// Conversations without an ID are skipped.
+func ToChatSummaries(sessionID session.SessionID, conversations []*waHistorySync.Conversation) []*whatsapp.ChatSummary {
+	chats := make([]*whatsapp.ChatSummary, 0, len(conversations))
+	for _, conv := range conversations {
+		if conv.GetID() == "" {
+			continue
+		}
+
+		name := conv.GetName()
+		if name == "" {
+			name = conv.GetDisplayName()
+		}
+
+		lastMessage := conv.GetLastMsgTimestamp()
+		if lastMessage == 0 {
+			lastMessage = conv.GetConversationTimestamp()
+		}
+		var lastMessageAt time.Time
+		if lastMessage > 0 {
+			lastMessageAt = time.Unix(int64(lastMessage), 0)
+		}
+
+		chats = append(chats, &whatsapp.ChatSummary{
+			SessionID:     sessionID,
+			JID:           conv.GetID(),
+			Name:          name,
+			LastMessageAt: lastMessageAt,
+			UnreadCount:   int(conv.GetUnreadCount()),
+		})
+	}
+	return chats
+}
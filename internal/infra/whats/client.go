@@ -6,14 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
-	"net/url"
 	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mdp/qrterminal/v3"
-	"github.com/skip2/go-qrcode"
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/proto/waE2E"
 	"go.mau.fi/whatsmeow/store"
@@ -28,21 +27,93 @@ import (
 
 // Client implements whatsapp.Client using the real whatsmeow library
 type Client struct {
-	sessionID    session.SessionID
-	eventHandler whatsapp.EventHandler
-	logger       logger.Logger
+	sessionID session.SessionID
+	logger    logger.Logger
+
+	// eventHandlers fans the client's events out to every handler, in the order they were added
+	eventHandlers *EventHandlerChain
 
 	// Whatsmeow components
 	container *sqlstore.Container
 	device    *store.Device
 	client    *whatsmeow.Client
 
-	// QR code management
+	// QR code management - qrMu guards the QR code, its image and the monitoring flag,
+	// which are written from the event and QR channel goroutines and read by HTTP requests
+	qrMu             sync.RWMutex
 	currentQRCode    string
 	currentQRBase64  string
+	currentQRExpires time.Time
 	qrChannel        <-chan whatsmeow.QRChannelItem
 	qrMonitoringDone chan bool
 	isMonitoring     bool
+
+	// QR requests wait up to qrWaitTimeout for the first code; qrReady is closed when
+	// a code is stored or monitoring stops
+	qrWaitTimeout time.Duration
+	qrReady       chan struct{}
+
+	// Rendering of QR code images
+	qrImage QRImageOptions
+
+	// Device identity announced when pairing
+	identity DeviceIdentity
+
+	// Initial sync tracking
+	syncTracker *syncTracker
+
+	// Connection attempt progress, polled by async connect clients
+	pairingTracker *pairingTracker
+
+	// Unresolved conditions that degrade sending (credential refresh, prekeys, keepalive)
+	healthTracker *healthTracker
+
+	// Options of recent polls, used to name the options chosen in votes
+	polls *pollTracker
+
+	// Business labels synced through app state
+	labels *LabelTracker
+
+	// Message store, used to download media of received messages
+	messageRepo whatsapp.MessageRepository
+
+	// Receipt store, tracking whether sent messages were delivered, read or played
+	receiptRepo whatsapp.ReceiptRepository
+
+	// Chat list store, kept up to date from history syncs and incoming messages
+	chatRepo whatsapp.ChatRepository
+
+	// Latest incoming messages kept in memory for polling (nil when disabled)
+	recent *RecentMessageBuffer
+
+	// In-flight message sends, drained before the manager closes the client
+	sends OperationTracker
+
+	// Sent and received message counters shared by all clients of the manager
+	sendStats    *SendStats
+	receiveStats *ReceiveStats
+
+	// Pairing audit - request that initiated the current pairing attempt.
+	// Written from request goroutines and consumed from the event goroutine.
+	pairingMu        sync.Mutex
+	pairingRequestID string
+	pairingMethod    session.PairingMethod
+
+	// Proxy management - sends wait on the gate while the proxy is being switched
+	proxyURL string
+	sendGate sync.RWMutex
+
+	// Presence - whether "available" was sent on the current connection
+	presenceAvailable atomic.Bool
+
+	// Sender allow-list - incoming messages from other senders are dropped
+	allowedSenders atomic.Pointer[[]string]
+
+	// Connection losses are only reported once they outlast the grace period
+	disconnects *disconnectDebouncer
+
+	// Result of the login that follows a connect, awaited by Connect for paired devices
+	connectOutcome *ConnectOutcome
 }
 
 // getDeviceForSession gets or creates a device for the given session
@@ -134,8 +205,32 @@ func parseJID(jidStr string) (types.JID, bool) {
 	return jid, true
 }
 
+// ClientOptions holds the optional dependencies and settings of a client. The zero value
+// is usable: nothing is persisted or counted and the defaults below apply.
+type ClientOptions struct {
+	// Repositories the received messages, receipts and chats are stored in
+	MessageRepo whatsapp.MessageRepository
+	ReceiptRepo whatsapp.ReceiptRepository
+	ChatRepo    whatsapp.ChatRepository
+
+	// Counters shared by the clients of a manager
+	SendStats    *SendStats
+	ReceiveStats *ReceiveStats
+
+	// DisconnectGrace is how long a disconnect waits for a reconnect before it is reported
+	DisconnectGrace time.Duration
+	// QRWaitTimeout is how long QR requests wait for the first code; zero does not wait
+	QRWaitTimeout time.Duration
+	// QRImage sets the rendering of QR code images; zero values use DefaultQRImageOptions
+	QRImage QRImageOptions
+	// Recent sets the buffer of recent messages; the zero value disables it
+	Recent RecentMessageOptions
+	// Identity is how the device presents itself; zero values use DefaultDeviceIdentity
+	Identity DeviceIdentity
+}
+
 // NewClient creates a new WhatsApp client using whatsmeow with proper multi-session support
-func NewClient(sessionID session.SessionID, container *sqlstore.Container, savedJID string, proxyURL string, log logger.Logger) (whatsapp.Client, error) {
+func NewClient(sessionID session.SessionID, container *sqlstore.Container, savedJID string, proxyURL string, opts ClientOptions, log logger.Logger) (whatsapp.Client, error) {
 	log.InfoWithFields("🏗️ CRIANDO novo cliente WhatsApp", logger.Fields{
 		"session_id":    sessionID.String(),
 		"saved_jid":     savedJID,
@@ -167,8 +262,12 @@ func NewClient(sessionID session.SessionID, container *sqlstore.Container, saved
 		"device_id":  deviceIDStr,
 	})
 
-	// Create whatsmeow client
-	client := whatsmeow.NewClient(device, nil)
+	// Create whatsmeow client; its log is only watched for prekey upload results
+	logWatcher := &preKeyLogWatcher{}
+	client := whatsmeow.NewClient(device, logWatcher)
+	// Full syncs replay label edits, which are the only source of business labels;
+	// HandleEvent drops every other replayed mutation
+	client.EmitAppStateEventsOnFullSync = true
 
 	// Configure proxy if provided
 	if proxyURL != "" {
@@ -177,19 +276,16 @@ func NewClient(sessionID session.SessionID, container *sqlstore.Container, saved
 			"proxy_url":  proxyURL,
 		})
 
-		parsedURL, err := url.Parse(proxyURL)
-		if err != nil {
-			log.ErrorWithFields("❌ Erro ao fazer parse da URL do proxy", logger.Fields{
+		// Configure proxy for WhatsApp WebSocket connections and media transfers
+		if err := applyProxy(client, proxyURL); err != nil {
+			log.ErrorWithFields("❌ Erro ao configurar o proxy", logger.Fields{
 				"session_id": sessionID.String(),
 				"proxy_url":  proxyURL,
 				"error":      err.Error(),
 			})
-			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+			return nil, err
 		}
 
-		// Configure proxy for WhatsApp WebSocket connections
-		client.SetProxy(http.ProxyURL(parsedURL))
-
 		log.InfoWithFields("✅ Proxy configurado com sucesso no cliente WhatsApp", logger.Fields{
 			"session_id": sessionID.String(),
 			"proxy_url":  proxyURL,
@@ -204,10 +300,30 @@ func NewClient(sessionID session.SessionID, container *sqlstore.Container, saved
 		client:           client,
 		qrMonitoringDone: make(chan bool, 1),
 		isMonitoring:     false,
+		syncTracker:      newSyncTracker(),
+		pairingTracker:   newPairingTracker(),
+		healthTracker:    newHealthTracker(),
+		polls:            newPollTracker(),
+		labels:           NewLabelTracker(),
+		messageRepo:      opts.MessageRepo,
+		receiptRepo:      opts.ReceiptRepo,
+		chatRepo:         opts.ChatRepo,
+		sendStats:        opts.SendStats,
+		receiveStats:     opts.ReceiveStats,
+		proxyURL:         proxyURL,
+		disconnects:      newDisconnectDebouncer(opts.DisconnectGrace),
+		connectOutcome:   NewConnectOutcome(),
+		qrWaitTimeout:    opts.QRWaitTimeout,
+		qrImage:          opts.QRImage,
+		recent:           NewRecentMessageBuffer(opts.Recent),
+		identity:         opts.Identity.withDefaults(),
+		eventHandlers:    NewEventHandlerChain(nil),
 	}
+	logWatcher.onFailure = whatsmeowClient.handlePreKeyUploadFailure
+	logWatcher.onSuccess = whatsmeowClient.handlePreKeyUploadSuccess
 
 	// Set up event handler
-	client.AddEventHandler(whatsmeowClient.handleEvent)
+	client.AddEventHandler(whatsmeowClient.HandleEvent)
 
 	log.InfoWithFields("🎉 CLIENTE WhatsApp criado com sucesso", logger.Fields{
 		"session_id":   sessionID.String(),
@@ -218,8 +334,15 @@ func NewClient(sessionID session.SessionID, container *sqlstore.Container, saved
 	return whatsmeowClient, nil
 }
 
-// handleEvent handles events from whatsmeow
-func (c *Client) handleEvent(evt interface{}) {
+// HandleEvent handles events from whatsmeow; it is registered as the handler of the whatsmeow client
+func (c *Client) HandleEvent(evt interface{}) {
+	// Track initial sync progress
+	c.syncTracker.handleEvent(evt)
+
+	if IsDroppedAppStateEvent(evt) {
+		return
+	}
+
 	// Get event description and additional fields
 	eventDesc, additionalFields := c.getEventDescription(evt)
 
@@ -248,13 +371,32 @@ func (c *Client) handleEvent(evt interface{}) {
 			"is_authenticated": c.client.Store.ID != nil,
 		})
 
+		// Presence must be announced again on every new connection
+		c.presenceAvailable.Store(false)
+
+		// A successful login proves the credentials and connection are fine again
+		c.resolveHealthIssues(
+			whatsapp.HealthIssueCATRefreshFailed,
+			whatsapp.HealthIssueKeepAliveTimeout,
+			whatsapp.HealthIssueClientOutdated,
+		)
+		c.connectOutcome.Resolve(nil)
+
+		// A reconnection within the grace period was never reported as a disconnection
+		if c.disconnects.cancel() {
+			c.logger.InfoWithFields("🔁 Reconectado dentro do período de tolerância - desconexão ignorada", logger.Fields{
+				"session_id": c.sessionID.String(),
+			})
+			return
+		}
+
 		// Trigger connected event if handler is set
-		if c.eventHandler != nil {
+		if handler := c.handler(); handler != nil {
 			jid := ""
 			if c.client.Store.ID != nil {
 				jid = c.client.Store.ID.String()
 			}
-			c.eventHandler.OnConnected(c.sessionID, jid)
+			handler.OnConnected(c.sessionID, jid)
 		}
 
 	case *events.Disconnected:
@@ -263,10 +405,15 @@ func (c *Client) handleEvent(evt interface{}) {
 			"is_authenticated": c.client.Store.ID != nil,
 		})
 
-		// Trigger disconnected event if handler is set
-		if c.eventHandler != nil {
-			c.eventHandler.OnDisconnected(c.sessionID, "connection lost")
-		}
+		// Report the disconnection only if whatsmeow does not reconnect within the grace period
+		c.disconnects.schedule(func() {
+			if c.client.IsConnected() {
+				return
+			}
+			if handler := c.handler(); handler != nil {
+				handler.OnDisconnected(c.sessionID, session.DisconnectReasonConnectionLost)
+			}
+		})
 
 	case *events.LoggedOut:
 		c.logger.ErrorWithFields("🚪 WhatsApp LOGOUT - sessão invalidada", logger.Fields{
@@ -275,14 +422,18 @@ func (c *Client) handleEvent(evt interface{}) {
 		})
 
 		// Clear authentication state
-		c.currentQRCode = ""
-		c.currentQRBase64 = ""
+		c.clearQR()
+
+		// A logout is final, it replaces any pending connection loss report
+		c.disconnects.cancel()
 
 		// Trigger disconnected event if handler is set
-		if c.eventHandler != nil {
-			c.eventHandler.OnDisconnected(c.sessionID, fmt.Sprintf("logged out: %s", v.Reason.String()))
+		if handler := c.handler(); handler != nil {
+			handler.OnDisconnected(c.sessionID, session.DisconnectReasonLoggedOut)
 		}
 
+		c.connectOutcome.Resolve(ConnectFailureError(v.Reason))
+
 	case *events.QR:
 		c.logger.InfoWithFields("📱 QR codes recebidos via EVENTOS - exibindo automaticamente", logger.Fields{
 			"session_id":  c.sessionID.String(),
@@ -299,8 +450,8 @@ func (c *Client) handleEvent(evt interface{}) {
 			c.handleQRCodeEvent(v.Codes[0])
 
 			// Trigger QR event if handler is set
-			if c.eventHandler != nil {
-				c.eventHandler.OnQRCode(c.sessionID, v.Codes[0])
+			if handler := c.handler(); handler != nil {
+				handler.OnQRCode(c.sessionID, v.Codes[0])
 			}
 		}
 
@@ -311,41 +462,219 @@ func (c *Client) handleEvent(evt interface{}) {
 		})
 
 		// Clear QR code state since we're now authenticated
-		c.currentQRCode = ""
-		c.currentQRBase64 = ""
+		c.clearQR()
 
 		// Trigger authentication event if handler is set
-		if c.eventHandler != nil {
-			c.eventHandler.OnAuthenticated(c.sessionID, v.ID.String())
+		if handler := c.handler(); handler != nil {
+			handler.OnAuthenticated(c.sessionID, v.ID.String())
 		}
 
+		c.recordPairingAttempt(session.PairingOutcomeSuccess, v.ID.String(), "")
+
+	case *events.PairError:
+		c.logger.ErrorWithFields("❌ FALHA no PAREAMENTO", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"jid":        v.ID.String(),
+			"error":      v.Error.Error(),
+		})
+
+		c.recordPairingAttempt(session.PairingOutcomeFailed, v.ID.String(), v.Error.Error())
+
 	case *events.StreamError:
 		c.logger.ErrorWithFields("💥 ERRO de STREAM", logger.Fields{
 			"session_id": c.sessionID.String(),
 			"code":       v.Code,
 		})
 
-		// Trigger error event if handler is set
-		if c.eventHandler != nil {
-			c.eventHandler.OnError(c.sessionID, fmt.Errorf("stream error: code=%s", v.Code))
+		connErr := whatsapp.NewConnectionError(whatsapp.ConnectionFailureUnknown, fmt.Errorf("stream error: code=%s", v.Code))
+
+		// Trigger connection failure event if handler is set
+		if handler := c.handler(); handler != nil {
+			handler.OnConnectionFailed(c.sessionID, connErr)
 		}
 
+		c.connectOutcome.Resolve(connErr)
+
 	case *events.ConnectFailure:
 		c.logger.ErrorWithFields("💥 FALHA na CONEXÃO", logger.Fields{
 			"session_id": c.sessionID.String(),
 			"reason":     v.Reason.String(),
 		})
 
-		// Trigger error event if handler is set
-		if c.eventHandler != nil {
-			c.eventHandler.OnError(c.sessionID, fmt.Errorf("connection failure: %s", v.Reason.String()))
+		connErr := ConnectFailureError(v.Reason)
+
+		// Trigger connection failure event if handler is set
+		if handler := c.handler(); handler != nil {
+			handler.OnConnectionFailed(c.sessionID, connErr)
+		}
+
+		c.connectOutcome.Resolve(connErr)
+
+	case *events.TemporaryBan:
+		c.logger.ErrorWithFields("⛔ CONTA BANIDA TEMPORARIAMENTE", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"code":       v.Code.String(),
+			"expire":     v.Expire.String(),
+		})
+
+		connErr := whatsapp.NewConnectionError(whatsapp.ConnectionFailureBanned, errors.New(v.String()))
+
+		// Trigger connection failure event if handler is set
+		if handler := c.handler(); handler != nil {
+			handler.OnConnectionFailed(c.sessionID, connErr)
+		}
+
+		c.connectOutcome.Resolve(connErr)
+
+	case *events.StreamReplaced:
+		c.logger.ErrorWithFields("🔀 SESSÃO SUBSTITUÍDA - outro cliente conectou com as mesmas credenciais", logger.Fields{
+			"session_id": c.sessionID.String(),
+		})
+
+		// Reconnecting would take the session back and start a reconnect fight with the other client
+		c.client.EnableAutoReconnect = false
+
+		if handler := c.handler(); handler != nil {
+			handler.OnSessionReplaced(c.sessionID)
+		}
+
+	case *events.CATRefreshError:
+		c.reportHealthIssue(whatsapp.HealthIssueCATRefreshFailed, v.Error.Error())
+
+	case *events.ClientOutdated:
+		c.reportHealthIssue(whatsapp.HealthIssueClientOutdated, "client version rejected by WhatsApp")
+		c.connectOutcome.Resolve(whatsapp.NewConnectionError(whatsapp.ConnectionFailureClientOutdated, errors.New("client version rejected by WhatsApp")))
+
+	case *events.KeepAliveTimeout:
+		c.reportHealthIssue(whatsapp.HealthIssueKeepAliveTimeout, fmt.Sprintf(
+			"%d keepalive pings failed, last success at %s", v.ErrorCount, v.LastSuccess.Format(time.RFC3339),
+		))
+
+	case *events.KeepAliveRestored:
+		c.resolveHealthIssues(whatsapp.HealthIssueKeepAliveTimeout)
+
+	case *events.Message:
+		if !c.acceptsMessage(v) {
+			return
+		}
+		// Poll votes are not messages of their own, they update the poll
+		if v.Message.GetPollUpdateMessage() != nil {
+			c.handlePollVote(v)
+			return
 		}
+		c.rememberPoll(v)
+		if !v.Info.IsFromMe {
+			c.receiveStats.Record(c.sessionID)
+		}
+		c.storeMessage(v)
+		c.recordChatMessage(v)
+		c.notifyMessage(v)
+		c.bufferRecentMessage(v)
+
+	case *events.HistorySync:
+		c.storeHistoryChats(v)
+
+	case *events.MarkChatAsRead:
+		c.recordChatRead(v)
+
+	case *events.Receipt:
+		c.storeReceipts(v)
+
+	case *events.Mute:
+		c.notifyChatStateChanged(whatsapp.ChatStateChangeMute, v.JID, v.Timestamp)
+
+	case *events.Pin:
+		c.notifyChatStateChanged(whatsapp.ChatStateChangePin, v.JID, v.Timestamp)
+
+	case *events.Archive:
+		c.notifyChatStateChanged(whatsapp.ChatStateChangeArchive, v.JID, v.Timestamp)
+
+	case *events.LabelEdit:
+		c.labels.Apply(v.LabelID, v.Action)
 
 	default:
 		// Handle other events as needed - payload already logged above
 	}
 }
 
+// IsDroppedAppStateEvent reports whether a client drops evt without logging or handling it:
+// an app state mutation replayed by a full sync other than a label edit, or the raw AppState
+// event that duplicates the typed event of every mutation
+func IsDroppedAppStateEvent(evt interface{}) bool {
+	switch v := evt.(type) {
+	case *events.AppState:
+		return true
+	case *events.LabelEdit:
+		return false
+	case *events.Contact:
+		return v.FromFullSync
+	case *events.Pin:
+		return v.FromFullSync
+	case *events.Star:
+		return v.FromFullSync
+	case *events.DeleteForMe:
+		return v.FromFullSync
+	case *events.Mute:
+		return v.FromFullSync
+	case *events.Archive:
+		return v.FromFullSync
+	case *events.MarkChatAsRead:
+		return v.FromFullSync
+	case *events.ClearChat:
+		return v.FromFullSync
+	case *events.DeleteChat:
+		return v.FromFullSync
+	case *events.PushNameSetting:
+		return v.FromFullSync
+	case *events.UnarchiveChatsSetting:
+		return v.FromFullSync
+	case *events.UserStatusMute:
+		return v.FromFullSync
+	case *events.LabelAssociationChat:
+		return v.FromFullSync
+	case *events.LabelAssociationMessage:
+		return v.FromFullSync
+	}
+	return false
+}
+
+// reportHealthIssue records a health issue, logs it with a remediation hint and notifies the event handler
+func (c *Client) reportHealthIssue(kind whatsapp.HealthIssueKind, errMsg string) {
+	issue := c.healthTracker.report(kind, errMsg)
+
+	c.logger.ErrorWithFields("🩺 SESSÃO DEGRADADA - envios podem falhar", logger.Fields{
+		"session_id":  c.sessionID.String(),
+		"issue":       kind.String(),
+		"error":       errMsg,
+		"occurrences": issue.Count,
+		"remediation": issue.Remediation,
+	})
+
+	if handler := c.handler(); handler != nil {
+		handler.OnHealthIssue(c.sessionID, issue)
+	}
+}
+
+// resolveHealthIssues clears health issues that no longer apply
+func (c *Client) resolveHealthIssues(kinds ...whatsapp.HealthIssueKind) {
+	if c.healthTracker.resolve(kinds...) {
+		c.logger.InfoWithFields("🩺 Saúde da sessão restabelecida", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"healthy":    c.healthTracker.snapshot().Healthy,
+		})
+	}
+}
+
+// handlePreKeyUploadFailure is called when whatsmeow fails to upload encryption prekeys
+func (c *Client) handlePreKeyUploadFailure(msg string) {
+	c.reportHealthIssue(whatsapp.HealthIssuePreKeyUploadFailed, msg)
+}
+
+// handlePreKeyUploadSuccess is called when whatsmeow uploads encryption prekeys
+func (c *Client) handlePreKeyUploadSuccess() {
+	c.resolveHealthIssues(whatsapp.HealthIssuePreKeyUploadFailed)
+}
+
 // getEventDescription returns a descriptive message and additional fields for each event type
 func (c *Client) getEventDescription(evt interface{}) (string, logger.Fields) {
 	switch e := evt.(type) {
@@ -440,7 +769,14 @@ func (c *Client) getEventDescription(evt interface{}) (string, logger.Fields) {
 		}
 
 	case *events.OfflineSyncPreview:
-		return "👀 PRÉVIA DE SINCRONIZAÇÃO OFFLINE", logger.Fields{}
+		return "👀 PRÉVIA DE SINCRONIZAÇÃO OFFLINE", logger.Fields{
+			"total": e.Total,
+		}
+
+	case *events.AppStateSyncComplete:
+		return "🗂️ SINCRONIZAÇÃO DE APP STATE CONCLUÍDA", logger.Fields{
+			"name": string(e.Name),
+		}
 
 	case *events.PushName:
 		return "👤 NOME ATUALIZADO", logger.Fields{
@@ -504,6 +840,9 @@ func (c *Client) Connect(ctx context.Context) (*whatsapp.ConnectionResult, error
 		Timestamp: time.Now(),
 	}
 
+	// An explicit connect takes the session back even if it was replaced elsewhere
+	c.client.EnableAutoReconnect = true
+
 	// Check if already logged in
 	if c.client.Store.ID == nil {
 		c.logger.InfoWithFields("📱 Nenhum ID armazenado - novo login necessário", logger.Fields{
@@ -533,6 +872,7 @@ func (c *Client) Connect(ctx context.Context) (*whatsapp.ConnectionResult, error
 			})
 			result.Status = whatsapp.StatusAuthenticated
 			result.JID = c.client.Store.ID.String()
+			c.pairingTracker.authenticated(result.JID)
 		} else {
 			// Conectar DEPOIS de obter o canal QR
 			c.logger.InfoWithFields("🌐 Conectando ao WhatsApp...", logger.Fields{
@@ -541,11 +881,13 @@ func (c *Client) Connect(ctx context.Context) (*whatsapp.ConnectionResult, error
 
 			err = c.client.Connect()
 			if err != nil {
+				connErr := ClassifyConnectError(fmt.Errorf("failed to connect: %w", err), c.hasProxy())
 				c.logger.ErrorWithFields("💥 FALHA: Erro na conexão com WhatsApp", logger.Fields{
 					"session_id": c.sessionID.String(),
+					"reason":     connErr.Reason.String(),
 					"error":      err.Error(),
 				})
-				return nil, fmt.Errorf("failed to connect: %w", err)
+				return nil, connErr
 			}
 
 			c.logger.InfoWithFields("✅ Conexão estabelecida - processando QR codes", logger.Fields{
@@ -553,8 +895,12 @@ func (c *Client) Connect(ctx context.Context) (*whatsapp.ConnectionResult, error
 				"is_connected": c.client.IsConnected(),
 			})
 
+			// Registrar a requisição que iniciou o pareamento para auditoria
+			c.startPairingAttempt(ctx, session.PairingMethodQR)
+			c.pairingTracker.pending()
+
 			// Processar QR codes de forma assíncrona para não travar o endpoint
-			go c.processQRChannel(qrChan)
+			c.MonitorQRChannel(qrChan)
 
 			result.Status = whatsapp.StatusAuthenticating
 		}
@@ -567,20 +913,31 @@ func (c *Client) Connect(ctx context.Context) (*whatsapp.ConnectionResult, error
 		// Already logged in, just connect
 		result.Status = whatsapp.StatusAuthenticated
 		result.JID = c.client.Store.ID.String()
+		c.pairingTracker.authenticated(result.JID)
 
 		c.logger.InfoWithFields("🌐 Reconectando cliente autenticado...", logger.Fields{
 			"session_id": c.sessionID.String(),
 			"jid":        result.JID,
 		})
 
+		outcome := c.connectOutcome.Expect()
 		err := c.client.Connect()
 		if err != nil {
+			c.connectOutcome.Cancel()
+			connErr := ClassifyConnectError(fmt.Errorf("failed to connect: %w", err), c.hasProxy())
 			c.logger.ErrorWithFields("💥 FALHA: Erro na reconexão de cliente autenticado", logger.Fields{
 				"session_id": c.sessionID.String(),
 				"jid":        result.JID,
+				"reason":     connErr.Reason.String(),
 				"error":      err.Error(),
 			})
-			return nil, fmt.Errorf("failed to connect: %w", err)
+			return nil, connErr
+		}
+
+		// WhatsApp accepts or refuses the login right after the handshake; a refusal
+		// (ban, unlinked device) is returned instead of reporting a connection
+		if err := c.waitForLogin(ctx, outcome); err != nil {
+			return nil, err
 		}
 
 		c.logger.InfoWithFields("✅ Cliente autenticado reconectado com sucesso", logger.Fields{
@@ -601,6 +958,43 @@ func (c *Client) Connect(ctx context.Context) (*whatsapp.ConnectionResult, error
 	return result, nil
 }
 
+// waitForLogin waits up to connectOutcomeTimeout for WhatsApp to accept the login of a
+// paired device. Only a refusal fails; when the answer is late the connection is left
+// to complete in the background and its outcome is reported through the event handlers.
+func (c *Client) waitForLogin(ctx context.Context, outcome <-chan error) error {
+	defer c.connectOutcome.Cancel()
+
+	timer := time.NewTimer(connectOutcomeTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-outcome:
+		if err != nil {
+			c.logger.ErrorWithFields("💥 FALHA: WhatsApp recusou o login", logger.Fields{
+				"session_id": c.sessionID.String(),
+				"error":      err.Error(),
+			})
+		}
+		return err
+	case <-timer.C:
+		c.logger.WarnWithFields("⏳ Login ainda não confirmado - seguindo em segundo plano", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"timeout":    connectOutcomeTimeout.String(),
+		})
+		return nil
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// hasProxy reports whether the connection goes through a proxy
+func (c *Client) hasProxy() bool {
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	return c.proxyURL != ""
+}
+
 // Disconnect closes the WhatsApp connection
 func (c *Client) Disconnect(ctx context.Context) error {
 	c.logger.InfoWithFields("disconnecting from WhatsApp", logger.Fields{
@@ -611,6 +1005,29 @@ func (c *Client) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// Logout unregisters the device from the WhatsApp account, so the phone no longer lists it,
+// and deletes its credentials from the store. The client must be connected and authenticated.
+func (c *Client) Logout(ctx context.Context) error {
+	if c.client.Store.ID == nil || !c.client.IsConnected() {
+		return session.ErrSessionNotConnected
+	}
+
+	c.logger.InfoWithFields("🚪 Fazendo logout do WhatsApp - removendo dispositivo", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"jid":        c.client.Store.ID.String(),
+	})
+
+	// The logout is intentional, no connection loss should be reported for it
+	c.disconnects.cancel()
+
+	if err := c.client.Logout(ctx); err != nil {
+		return fmt.Errorf("failed to log out from WhatsApp: %w", err)
+	}
+	c.clearQR()
+
+	return nil
+}
+
 // IsConnected returns true if connected to WhatsApp
 func (c *Client) IsConnected() bool {
 	return c.client.IsConnected()
@@ -631,11 +1048,13 @@ func (c *Client) GetConnectionStatus() whatsapp.ConnectionStatus {
 
 // GenerateQR generates a QR code for authentication
 func (c *Client) GenerateQR(ctx context.Context) (string, error) {
+	currentQRCode, currentQRBase64, isMonitoring := c.qrSnapshot()
+
 	c.logger.InfoWithFields("🔍 SOLICITAÇÃO de geração de QR code", logger.Fields{
 		"session_id":      c.sessionID.String(),
 		"store_id_exists": c.client.Store.ID != nil,
-		"is_monitoring":   c.isMonitoring,
-		"has_current_qr":  c.currentQRCode != "",
+		"is_monitoring":   isMonitoring,
+		"has_current_qr":  currentQRCode != "",
 		"is_connected":    c.client.IsConnected(),
 	})
 
@@ -649,28 +1068,40 @@ func (c *Client) GenerateQR(ctx context.Context) (string, error) {
 
 	c.logger.InfoWithFields("📱 Gerando QR code para autenticação", logger.Fields{
 		"session_id":        c.sessionID.String(),
-		"is_monitoring":     c.isMonitoring,
-		"has_qr":            c.currentQRCode != "",
+		"is_monitoring":     isMonitoring,
+		"has_qr":            currentQRCode != "",
 		"qr_channel_active": c.qrChannel != nil,
 	})
 
 	// Return the current QR code in base64 if available from continuous monitoring
-	if c.currentQRBase64 != "" {
+	if currentQRBase64 != "" {
 		c.logger.InfoWithFields("✅ Retornando QR code base64 atual do monitoramento contínuo", logger.Fields{
 			"session_id":    c.sessionID.String(),
-			"qr_length":     len(c.currentQRBase64),
-			"is_monitoring": c.isMonitoring,
+			"qr_length":     len(currentQRBase64),
+			"is_monitoring": isMonitoring,
 		})
-		return c.currentQRBase64, nil
+		return currentQRBase64, nil
 	}
 
-	// If monitoring is active but no QR code yet, return placeholder
-	if c.isMonitoring {
-		c.logger.InfoWithFields("⏳ Monitoramento ativo mas QR ainda não disponível - retornando placeholder", logger.Fields{
-			"session_id":        c.sessionID.String(),
-			"qr_channel_active": c.qrChannel != nil,
+	// If monitoring is active but no QR code yet, wait briefly for the first one
+	if isMonitoring {
+		c.logger.InfoWithFields("⏳ Monitoramento ativo mas QR ainda não disponível - aguardando", logger.Fields{
+			"session_id":   c.sessionID.String(),
+			"wait_timeout": c.qrWaitTimeout.String(),
 		})
-		return "qr-code-will-be-provided-via-continuous-monitoring", nil
+
+		if qrBase64 := c.waitForQR(ctx); qrBase64 != "" {
+			c.logger.InfoWithFields("✅ QR code disponível após aguardar", logger.Fields{
+				"session_id": c.sessionID.String(),
+				"qr_length":  len(qrBase64),
+			})
+			return qrBase64, nil
+		}
+
+		c.logger.InfoWithFields("⌛ QR code ainda não disponível", logger.Fields{
+			"session_id": c.sessionID.String(),
+		})
+		return "", whatsapp.ErrQRNotReady
 	}
 
 	// If no monitoring is active, return error
@@ -682,20 +1113,43 @@ func (c *Client) GenerateQR(ctx context.Context) (string, error) {
 	return "", fmt.Errorf("QR monitoring not active - please connect the session first")
 }
 
-// PairPhone pairs with a phone number
-func (c *Client) PairPhone(ctx context.Context, phoneNumber string) error {
+// GetQRCode returns the raw pairing string of the current QR code
+func (c *Client) GetQRCode() string {
+	qrCode, _, _ := c.qrSnapshot()
+	return qrCode
+}
+
+// QRCodeExpiresAt returns when the current QR code expires, zero when there is none
+func (c *Client) QRCodeExpiresAt() time.Time {
+	c.qrMu.RLock()
+	defer c.qrMu.RUnlock()
+	return c.currentQRExpires
+}
+
+// PairPhone pairs with a phone number and returns the code to enter on the phone.
+// The options override the configured client type and display name shown on the phone.
+func (c *Client) PairPhone(ctx context.Context, phoneNumber string, opts whatsapp.PairPhoneOptions) (string, error) {
 	if c.client.Store.ID != nil {
-		return fmt.Errorf("already authenticated")
+		return "", fmt.Errorf("already authenticated")
 	}
 
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+	clientType, displayName := c.identity.pairPhoneClient(opts)
+
 	c.logger.InfoWithFields("pairing with phone", logger.Fields{
 		"session_id":   c.sessionID.String(),
 		"phone_number": phoneNumber,
+		"display_name": displayName,
 	})
 
-	code, err := c.client.PairPhone(ctx, phoneNumber, true, whatsmeow.PairClientChrome, "Chrome (Linux)")
+	c.startPairingAttempt(ctx, session.PairingMethodPhone)
+
+	code, err := c.client.PairPhone(ctx, phoneNumber, true, clientType, displayName)
 	if err != nil {
-		return fmt.Errorf("failed to pair phone: %w", err)
+		c.recordPairingAttempt(session.PairingOutcomeFailed, "", err.Error())
+		return "", fmt.Errorf("failed to pair phone: %w", err)
 	}
 
 	c.logger.InfoWithFields("pairing code generated", logger.Fields{
@@ -703,7 +1157,9 @@ func (c *Client) PairPhone(ctx context.Context, phoneNumber string) error {
 		"code":       code,
 	})
 
-	return nil
+	c.pairingTracker.codeReady(code)
+
+	return code, nil
 }
 
 // IsAuthenticated returns true if authenticated with WhatsApp
@@ -726,43 +1182,86 @@ func (c *Client) GetJID() string {
 
 // GetDeviceInfo returns device information
 func (c *Client) GetDeviceInfo() *whatsapp.DeviceInfo {
-	return &whatsapp.DeviceInfo{
-		Platform:     "linux",
-		AppVersion:   "2.2412.54",
-		DeviceModel:  "Desktop",
-		OSVersion:    "0.1",
-		Manufacturer: "WazMeow",
+	return c.identity.deviceInfo()
+}
+
+// GetSyncStatus returns the initial sync status derived from whatsmeow events
+func (c *Client) GetSyncStatus() *whatsapp.SyncStatus {
+	return c.syncTracker.snapshot()
+}
+
+// GetHealth returns the unresolved health issues of the client
+func (c *Client) GetHealth() *whatsapp.ClientHealth {
+	return c.healthTracker.snapshot()
+}
+
+// GetPairingStatus returns the progress of the current connection attempt
+func (c *Client) GetPairingStatus() *whatsapp.PairingStatus {
+	status := c.pairingTracker.snapshot()
+
+	// Clients restored from the store never go through pairing
+	if status.State == "" && c.IsAuthenticated() {
+		status.State = whatsapp.PairingStateAuthenticated
+		status.JID = c.GetJID()
 	}
+
+	return status
 }
 
-// SendMessage sends a text message
-func (c *Client) SendMessage(ctx context.Context, to, message string) error {
+// SendMessage sends a text message and returns its message ID
+func (c *Client) SendMessage(ctx context.Context, to, message string) (string, error) {
+	done := c.BeginSend()
+	defer done()
+
 	if !c.IsAuthenticated() {
-		return fmt.Errorf("not authenticated")
+		return "", fmt.Errorf("not authenticated")
 	}
 
 	// Parse recipient JID
 	recipient, err := types.ParseJID(to)
 	if err != nil {
-		return fmt.Errorf("invalid recipient JID: %w", err)
+		return "", fmt.Errorf("invalid recipient JID: %w", err)
 	}
 
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
 	// Send message
-	_, err = c.client.SendMessage(ctx, recipient, &waE2E.Message{
+	resp, err := c.client.SendMessage(ctx, recipient, &waE2E.Message{
 		Conversation: &message,
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to send message: %w", err)
+		return "", fmt.Errorf("failed to send message: %w", err)
 	}
+	c.sendStats.Record(c.sessionID, whatsapp.MessageTypeText)
 
 	c.logger.InfoWithFields("message sent", logger.Fields{
 		"session_id": c.sessionID.String(),
 		"to":         to,
 		"message":    message,
+		"message_id": resp.ID,
 	})
 
-	return nil
+	return resp.ID, nil
+}
+
+// BeginSend marks a message send in flight until done is called; Manager.Stop waits for
+// the sends in flight before closing the client
+func (c *Client) BeginSend() (done func()) {
+	return c.sends.Begin()
+}
+
+// pendingSends returns how many message sends are in flight
+func (c *Client) pendingSends() int {
+	return c.sends.Count()
+}
+
+// waitForSends waits until no message send is in flight or ctx is done, and returns
+// how many sends were still in flight
+func (c *Client) waitForSends(ctx context.Context) int {
+	return c.sends.Wait(ctx)
 }
 
 // SendImage sends an image message
@@ -775,14 +1274,33 @@ func (c *Client) SendDocument(ctx context.Context, to, documentPath, filename st
 	return fmt.Errorf("document sending not implemented yet")
 }
 
-// SetEventHandler sets the event handler
+// SetEventHandler replaces every event handler of the client with handler
 func (c *Client) SetEventHandler(handler whatsapp.EventHandler) {
-	c.eventHandler = handler
+	c.eventHandlers.Replace(handler)
 }
 
-// RemoveEventHandler removes the event handler
+// AddEventHandler adds a handler notified after the existing ones and returns a function that removes it
+func (c *Client) AddEventHandler(handler whatsapp.EventHandler) (remove func()) {
+	return c.eventHandlers.Add(handler)
+}
+
+// RemoveEventHandler removes every event handler of the client
 func (c *Client) RemoveEventHandler() {
-	c.eventHandler = nil
+	c.eventHandlers.Replace(nil)
+}
+
+// handler returns the handler notifying every event handler, or nil when none is set
+func (c *Client) handler() whatsapp.EventHandler {
+	if c.eventHandlers.empty() {
+		return nil
+	}
+	return c.eventHandlers
+}
+
+// SetAllowedSenders limits stored and delivered incoming messages to the given sender JIDs
+func (c *Client) SetAllowedSenders(senders []string) {
+	allowed := append([]string(nil), senders...)
+	c.allowedSenders.Store(&allowed)
 }
 
 // Close closes the client
@@ -793,6 +1311,12 @@ func (c *Client) Close() error {
 
 	// Stop QR monitoring if active
 	c.stopQRMonitoring()
+	c.disconnects.cancel()
+
+	// Buffered messages are not served once the client is removed
+	if c.recent != nil {
+		c.recent.Clear()
+	}
 
 	// Disconnect from WhatsApp
 	c.client.Disconnect()
@@ -804,6 +1328,13 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// MonitorQRChannel processes the QR channel in the background. Monitoring is marked
+// active before the goroutine starts so GenerateQR right after Connect sees it.
+func (c *Client) MonitorQRChannel(qrChan <-chan whatsmeow.QRChannelItem) {
+	c.setMonitoring(true)
+	go c.processQRChannel(qrChan)
+}
+
 // processQRChannel processes QR channel synchronously (baseado no código de referência)
 // Processa QR codes de forma síncrona seguindo o padrão exato do código que funciona
 func (c *Client) processQRChannel(qrChan <-chan whatsmeow.QRChannelItem) {
@@ -811,6 +1342,9 @@ func (c *Client) processQRChannel(qrChan <-chan whatsmeow.QRChannelItem) {
 		"session_id": c.sessionID.String(),
 	})
 
+	// Monitoring ends with the channel, whether it timed out, succeeded or closed
+	defer c.setMonitoring(false)
+
 	// Track if connection was established successfully
 	connectionEstablished := false
 
@@ -849,6 +1383,16 @@ func (c *Client) processQRChannel(qrChan <-chan whatsmeow.QRChannelItem) {
 			c.handleQRSuccessEvent()
 			return // Encerrar após sucesso
 
+		case whatsmeow.QRChannelClientOutdated.Event,
+			whatsmeow.QRChannelScannedWithoutMultidevice.Event,
+			whatsmeow.QRChannelErrUnexpectedEvent.Event:
+			// Pairing errors ("error" event) are recorded from the PairError event
+			c.logger.ErrorWithFields("❌ QR login error", logger.Fields{
+				"session_id": c.sessionID.String(),
+				"event":      evt.Event,
+			})
+			c.recordPairingAttempt(session.PairingOutcomeFailed, "", evt.Event)
+
 		default:
 			c.logger.InfoWithFields("📋 Other login event", logger.Fields{
 				"session_id": c.sessionID.String(),
@@ -874,7 +1418,8 @@ func (c *Client) processQRChannel(qrChan <-chan whatsmeow.QRChannelItem) {
 // handleQRCodeEvent handles new QR code events - inicial ou renovação automática
 // Baseado na implementação do zmeow QRCodeManager.handleQRCode
 func (c *Client) handleQRCodeEvent(qrCode string) {
-	isRenewal := c.currentQRCode != ""
+	previousQRCode, _, _ := c.qrSnapshot()
+	isRenewal := previousQRCode != ""
 	eventType := "initial"
 	if isRenewal {
 		eventType = "auto-renewal"
@@ -887,11 +1432,8 @@ func (c *Client) handleQRCodeEvent(qrCode string) {
 		"is_renewal":  isRenewal,
 	})
 
-	// Store the current QR code
-	c.currentQRCode = qrCode
-
 	// Generate base64 encoded QR code
-	image, err := qrcode.Encode(qrCode, qrcode.Medium, 256)
+	image, err := c.qrImage.Encode(qrCode)
 	if err != nil {
 		c.logger.ErrorWithFields("❌ Failed to encode QR code", logger.Fields{
 			"session_id": c.sessionID.String(),
@@ -901,20 +1443,19 @@ func (c *Client) handleQRCodeEvent(qrCode string) {
 		return
 	}
 
+	// Store the current QR code along with its image
 	base64QR := "data:image/png;base64," + base64.StdEncoding.EncodeToString(image)
-	c.currentQRBase64 = base64QR
+	c.storeQR(qrCode, base64QR)
+	c.pairingTracker.qrReady(base64QR)
 
 	// Display QR code in terminal (sempre exibir, mesmo renovações)
 	c.displayQRCodeInTerminal(qrCode, eventType)
 
-	// Trigger QR event if handler is set
-	if c.eventHandler != nil {
-		c.eventHandler.OnQRCode(c.sessionID, qrCode)
+	// Trigger QR event if handler is set; the session handler persists the code and its expiry
+	if handler := c.handler(); handler != nil {
+		handler.OnQRCode(c.sessionID, qrCode)
 	}
 
-	// TODO: Persistir no banco de dados
-	// TODO: Enviar webhook se configurado
-
 	c.logger.InfoWithFields("✅ QR code event processed successfully", logger.Fields{
 		"session_id": c.sessionID.String(),
 		"qr_length":  len(base64QR),
@@ -924,25 +1465,25 @@ func (c *Client) handleQRCodeEvent(qrCode string) {
 
 // handleQRTimeoutEvent handles QR code timeout events
 func (c *Client) handleQRTimeoutEvent() {
+	// Clear QR code state
+	previousQRCode, previousQRBase64 := c.clearQR()
+
 	c.logger.WarnWithFields("⏰ QR code timeout - limpando estado", logger.Fields{
 		"session_id":    c.sessionID.String(),
-		"had_qr_code":   c.currentQRCode != "",
-		"had_qr_base64": c.currentQRBase64 != "",
+		"had_qr_code":   previousQRCode != "",
+		"had_qr_base64": previousQRBase64 != "",
 	})
 
-	// Clear QR code state
-	previousQRCode := c.currentQRCode
-	c.currentQRCode = ""
-	c.currentQRBase64 = ""
-
 	// Trigger timeout event if handler is set
-	if c.eventHandler != nil {
+	if handler := c.handler(); handler != nil {
 		c.logger.InfoWithFields("📢 Disparando evento de timeout para handler", logger.Fields{
 			"session_id": c.sessionID.String(),
 		})
-		c.eventHandler.OnError(c.sessionID, fmt.Errorf("QR code timeout"))
+		handler.OnError(c.sessionID, fmt.Errorf("QR code timeout"))
 	}
 
+	c.recordPairingAttempt(session.PairingOutcomeTimeout, "", "QR code expired without being scanned")
+
 	c.logger.InfoWithFields("🧹 QR code state cleared after timeout", logger.Fields{
 		"session_id":         c.sessionID.String(),
 		"previous_qr_length": len(previousQRCode),
@@ -956,8 +1497,7 @@ func (c *Client) handleQRSuccessEvent() {
 	})
 
 	// Clear QR code state
-	c.currentQRCode = ""
-	c.currentQRBase64 = ""
+	c.clearQR()
 
 	// Get JID from authenticated client
 	jid := ""
@@ -967,8 +1507,8 @@ func (c *Client) handleQRSuccessEvent() {
 
 	// Trigger authentication event if handler is set
 	// The event handler should save the JID to the database
-	if c.eventHandler != nil && jid != "" {
-		c.eventHandler.OnAuthenticated(c.sessionID, jid)
+	if handler := c.handler(); handler != nil && jid != "" {
+		handler.OnAuthenticated(c.sessionID, jid)
 	}
 
 	c.logger.InfoWithFields("QR success handled - session authenticated", logger.Fields{
@@ -977,28 +1517,61 @@ func (c *Client) handleQRSuccessEvent() {
 	})
 }
 
+// startPairingAttempt remembers the request that initiated a pairing attempt
+func (c *Client) startPairingAttempt(ctx context.Context, method session.PairingMethod) {
+	requestID, _ := ctx.Value(logger.ContextKeyRequestID).(string)
+
+	c.pairingMu.Lock()
+	c.pairingRequestID = requestID
+	c.pairingMethod = method
+	c.pairingMu.Unlock()
+}
+
+// recordPairingAttempt notifies the event handler about the outcome of a pairing attempt
+func (c *Client) recordPairingAttempt(outcome session.PairingOutcome, jid, reason string) {
+	c.pairingMu.Lock()
+	requestID, method := c.pairingRequestID, c.pairingMethod
+	c.pairingRequestID = ""
+	c.pairingMethod = ""
+	c.pairingMu.Unlock()
+
+	if method == "" {
+		method = session.PairingMethodQR
+	}
+
+	if handler := c.handler(); handler != nil {
+		attempt := session.NewPairingAttempt(c.sessionID, outcome, method, jid, requestID, reason)
+		handler.OnPairingAttempt(c.sessionID, attempt)
+	}
+
+	if outcome == session.PairingOutcomeSuccess {
+		c.pairingTracker.authenticated(jid)
+	} else {
+		c.pairingTracker.failed(reason)
+	}
+}
+
 // handleQRChannelClosedWithoutConnection handles when QR channel is closed without establishing connection
 func (c *Client) handleQRChannelClosedWithoutConnection() {
+	// Clear QR code state and mark monitoring as inactive
+	previousQRCode, previousQRBase64 := c.clearQR()
+	c.setMonitoring(false)
+
 	c.logger.WarnWithFields("🔌 QR channel fechado sem conexão estabelecida - limpando estado e notificando", logger.Fields{
 		"session_id":    c.sessionID.String(),
-		"had_qr_code":   c.currentQRCode != "",
-		"had_qr_base64": c.currentQRBase64 != "",
+		"had_qr_code":   previousQRCode != "",
+		"had_qr_base64": previousQRBase64 != "",
 	})
 
-	// Clear QR code state
-	c.currentQRCode = ""
-	c.currentQRBase64 = ""
-
-	// Mark monitoring as inactive
-	c.isMonitoring = false
+	c.pairingTracker.failed("QR channel closed without connection")
 
 	// Trigger disconnection event if handler is set
 	// This will change the session status from connecting to disconnected
-	if c.eventHandler != nil {
+	if handler := c.handler(); handler != nil {
 		c.logger.InfoWithFields("📢 Disparando evento de desconexão para handler", logger.Fields{
 			"session_id": c.sessionID.String(),
 		})
-		c.eventHandler.OnDisconnected(c.sessionID, "QR channel closed without connection")
+		handler.OnDisconnected(c.sessionID, session.DisconnectReasonQRTimeout)
 	}
 
 	c.logger.InfoWithFields("🔚 QR channel closure handled - session marked as disconnected", logger.Fields{
@@ -1006,9 +1579,88 @@ func (c *Client) handleQRChannelClosedWithoutConnection() {
 	})
 }
 
+// qrSnapshot returns the current QR code, its base64 image and whether QR monitoring is active
+func (c *Client) qrSnapshot() (qrCode, qrBase64 string, isMonitoring bool) {
+	c.qrMu.RLock()
+	defer c.qrMu.RUnlock()
+	return c.currentQRCode, c.currentQRBase64, c.isMonitoring
+}
+
+// storeQR replaces the current QR code and its base64 image, waking up waiting requests
+func (c *Client) storeQR(qrCode, qrBase64 string) {
+	c.qrMu.Lock()
+	defer c.qrMu.Unlock()
+	c.currentQRCode = qrCode
+	c.currentQRBase64 = qrBase64
+	c.currentQRExpires = time.Now().Add(session.QRCodeLifetime)
+	c.wakeQRWaiters()
+}
+
+// clearQR clears the current QR code and returns the values it held
+func (c *Client) clearQR() (qrCode, qrBase64 string) {
+	c.qrMu.Lock()
+	defer c.qrMu.Unlock()
+	qrCode, qrBase64 = c.currentQRCode, c.currentQRBase64
+	c.currentQRCode = ""
+	c.currentQRBase64 = ""
+	c.currentQRExpires = time.Time{}
+	return qrCode, qrBase64
+}
+
+// setMonitoring marks QR monitoring as active or inactive. Requests waiting for a
+// code stop waiting once monitoring ends.
+func (c *Client) setMonitoring(active bool) {
+	c.qrMu.Lock()
+	defer c.qrMu.Unlock()
+	c.isMonitoring = active
+	if !active {
+		c.wakeQRWaiters()
+	}
+}
+
+// wakeQRWaiters releases the requests waiting in waitForQR; qrMu must be held
+func (c *Client) wakeQRWaiters() {
+	if c.qrReady != nil {
+		close(c.qrReady)
+		c.qrReady = nil
+	}
+}
+
+// waitForQR waits up to the QR wait timeout for a QR code and returns its base64
+// image, or an empty string if none arrived in time
+func (c *Client) waitForQR(ctx context.Context) string {
+	if c.qrWaitTimeout <= 0 {
+		return ""
+	}
+
+	c.qrMu.Lock()
+	if c.currentQRBase64 != "" || !c.isMonitoring {
+		qrBase64 := c.currentQRBase64
+		c.qrMu.Unlock()
+		return qrBase64
+	}
+	if c.qrReady == nil {
+		c.qrReady = make(chan struct{})
+	}
+	ready := c.qrReady
+	c.qrMu.Unlock()
+
+	timer := time.NewTimer(c.qrWaitTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ready:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	_, qrBase64, _ := c.qrSnapshot()
+	return qrBase64
+}
+
 // stopQRMonitoring stops the QR monitoring gracefully
 func (c *Client) stopQRMonitoring() {
-	if c.isMonitoring {
+	if _, _, isMonitoring := c.qrSnapshot(); isMonitoring {
 		c.logger.InfoWithFields("Stopping QR monitoring", logger.Fields{
 			"session_id": c.sessionID.String(),
 		})
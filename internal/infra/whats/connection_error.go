@@ -0,0 +1,106 @@
+package whats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// ClassifyConnectError turns an error returned by whatsmeow's Connect into a
+// *whatsapp.ConnectionError. Whatsmeow only fails there while dialing the WebSocket
+// or during the noise handshake, so when a proxy is configured the proxy is blamed
+// for dial failures.
+func ClassifyConnectError(err error, proxied bool) *whatsapp.ConnectionError {
+	var connErr *whatsapp.ConnectionError
+	if errors.As(err, &connErr) {
+		return connErr
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && (opErr.Op == "proxyconnect" || strings.HasPrefix(opErr.Op, "socks")) {
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureProxy, err)
+	}
+
+	dialFailed := strings.Contains(err.Error(), "couldn't dial")
+	if proxied && dialFailed {
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureProxy, err)
+	}
+
+	var netErr net.Error
+	if dialFailed || errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		strings.Contains(err.Error(), "noise handshake failed") {
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureNetwork, err)
+	}
+
+	return whatsapp.NewConnectionError(whatsapp.ConnectionFailureUnknown, err)
+}
+
+// ConnectFailureError maps the reason of a connect failure sent by WhatsApp
+func ConnectFailureError(reason events.ConnectFailureReason) *whatsapp.ConnectionError {
+	err := fmt.Errorf("connection failure: %s", reason.String())
+
+	switch reason {
+	case events.ConnectFailureTempBanned, events.ConnectFailureUnknownLogout:
+		// 406 is called BANNED by WhatsApp Web
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureBanned, err)
+	case events.ConnectFailureLoggedOut, events.ConnectFailureMainDeviceGone:
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureNotPaired, err)
+	case events.ConnectFailureClientOutdated, events.ConnectFailureBadUserAgent:
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureClientOutdated, err)
+	default:
+		return whatsapp.NewConnectionError(whatsapp.ConnectionFailureUnknown, err)
+	}
+}
+
+// connectOutcomeTimeout bounds how long Connect waits for WhatsApp to accept the login
+// of a paired device
+const connectOutcomeTimeout = 10 * time.Second
+
+// ConnectOutcome hands the result of the login that follows a connect to the Connect
+// call waiting for it. Results arriving while nobody waits are dropped.
+type ConnectOutcome struct {
+	mu     sync.Mutex
+	result chan error
+}
+
+// NewConnectOutcome creates an outcome with no waiter
+func NewConnectOutcome() *ConnectOutcome {
+	return &ConnectOutcome{}
+}
+
+// Expect starts waiting for the next result, replacing any previous waiter
+func (o *ConnectOutcome) Expect() <-chan error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.result = make(chan error, 1)
+	return o.result
+}
+
+// Resolve delivers the result to the waiter, if any; nil means the login succeeded
+func (o *ConnectOutcome) Resolve(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.result == nil {
+		return
+	}
+	o.result <- err
+	o.result = nil
+}
+
+// Cancel stops waiting without a result
+func (o *ConnectOutcome) Cancel() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.result = nil
+}
@@ -0,0 +1,93 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetContacts returns the contacts stored for the session, sorted by JID.
+// The store is populated by the initial sync, so it may be empty right after pairing.
+func (c *Client) GetContacts(ctx context.Context) ([]*whatsapp.ContactInfo, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	contacts, err := c.client.Store.Contacts.GetAllContacts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contacts: %w", err)
+	}
+
+	result := make([]*whatsapp.ContactInfo, 0, len(contacts))
+	for jid, contact := range contacts {
+		result = append(result, &whatsapp.ContactInfo{
+			JID:          jid.String(),
+			PushName:     contact.PushName,
+			BusinessName: contact.BusinessName,
+			FirstName:    contact.FirstName,
+			FullName:     contact.FullName,
+		})
+	}
+
+	// Map iteration order is random - keep pagination stable
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].JID < result[j].JID
+	})
+
+	c.logger.InfoWithFields("📇 Contatos recuperados", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"count":      len(result),
+	})
+
+	return result, nil
+}
+
+// IsOnWhatsApp checks which phone numbers are registered on WhatsApp.
+// Numbers must be in international format (digits only); results keep the input order.
+func (c *Client) IsOnWhatsApp(ctx context.Context, phones []string) ([]*whatsapp.NumberCheckResult, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	queries := make([]string, 0, len(phones))
+	for _, phone := range phones {
+		queries = append(queries, "+"+phone)
+	}
+
+	responses, err := c.client.IsOnWhatsApp(queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check numbers: %w", err)
+	}
+
+	// WhatsApp echoes the query without the "+" prefix
+	byQuery := make(map[string]types.IsOnWhatsAppResponse, len(responses))
+	for _, response := range responses {
+		byQuery[strings.TrimPrefix(response.Query, "+")] = response
+	}
+
+	results := make([]*whatsapp.NumberCheckResult, 0, len(phones))
+	registered := 0
+	for _, phone := range phones {
+		result := &whatsapp.NumberCheckResult{Query: phone}
+		if response, ok := byQuery[phone]; ok && response.IsIn {
+			result.Exists = true
+			result.JID = response.JID.String()
+			registered++
+		}
+		results = append(results, result)
+	}
+
+	c.logger.InfoWithFields("🔎 Números verificados no WhatsApp", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"checked":    len(phones),
+		"registered": registered,
+	})
+
+	return results, nil
+}
@@ -0,0 +1,157 @@
+package whats
+
+import (
+	"strings"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waCompanionReg"
+	"go.mau.fi/whatsmeow/store"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/config"
+)
+
+// DeviceIdentity is the device announced to WhatsApp when pairing, shown on the linked
+// devices screen of the phone. Empty fields keep the defaults.
+type DeviceIdentity struct {
+	Name           string
+	Platform       string // one of config.DevicePlatforms
+	OSVersion      string // x.y.z
+	Model          string
+	Manufacturer   string
+	AppVersion     string // x.y.z WhatsApp Web version; empty keeps the whatsmeow default
+	PairClientName string // "Browser (OS)" name used for phone code pairing
+}
+
+// DefaultDeviceIdentity is the identity used when none is configured
+var DefaultDeviceIdentity = DeviceIdentity{
+	Name:           "WazMeow",
+	Platform:       "desktop",
+	OSVersion:      "0.1.0",
+	Model:          "Desktop",
+	Manufacturer:   "WazMeow",
+	PairClientName: "Chrome (Linux)",
+}
+
+// pairClientTypes maps device platforms to the client types accepted by phone code pairing
+var pairClientTypes = map[string]whatsmeow.PairClientType{
+	"chrome":  whatsmeow.PairClientChrome,
+	"edge":    whatsmeow.PairClientEdge,
+	"firefox": whatsmeow.PairClientFirefox,
+	"ie":      whatsmeow.PairClientIE,
+	"opera":   whatsmeow.PairClientOpera,
+	"safari":  whatsmeow.PairClientSafari,
+	"desktop": whatsmeow.PairClientElectron,
+	"uwp":     whatsmeow.PairClientUWP,
+}
+
+// whatsmeowPairClientTypes maps domain pairing client types to the whatsmeow constants
+var whatsmeowPairClientTypes = map[whatsapp.PairClientType]whatsmeow.PairClientType{
+	whatsapp.PairClientChrome:         whatsmeow.PairClientChrome,
+	whatsapp.PairClientEdge:           whatsmeow.PairClientEdge,
+	whatsapp.PairClientFirefox:        whatsmeow.PairClientFirefox,
+	whatsapp.PairClientIE:             whatsmeow.PairClientIE,
+	whatsapp.PairClientOpera:          whatsmeow.PairClientOpera,
+	whatsapp.PairClientSafari:         whatsmeow.PairClientSafari,
+	whatsapp.PairClientElectron:       whatsmeow.PairClientElectron,
+	whatsapp.PairClientUWP:            whatsmeow.PairClientUWP,
+	whatsapp.PairClientOtherWebClient: whatsmeow.PairClientOtherWebClient,
+}
+
+// deviceIdentityFromConfig builds the device identity from the WhatsApp configuration
+func deviceIdentityFromConfig(cfg *config.WhatsAppConfig) DeviceIdentity {
+	identity := DeviceIdentity{
+		Name:           cfg.DeviceName,
+		Platform:       cfg.DevicePlatform,
+		OSVersion:      cfg.DeviceOSVersion,
+		Model:          cfg.DeviceModel,
+		Manufacturer:   cfg.DeviceManufacturer,
+		AppVersion:     cfg.AppVersion,
+		PairClientName: cfg.PairClientName,
+	}
+	return identity.withDefaults()
+}
+
+// withDefaults fills the empty fields with DefaultDeviceIdentity
+func (d DeviceIdentity) withDefaults() DeviceIdentity {
+	defaults := DefaultDeviceIdentity
+	if d.Name == "" {
+		d.Name = defaults.Name
+	}
+	if d.Platform == "" {
+		d.Platform = defaults.Platform
+	}
+	if d.OSVersion == "" {
+		d.OSVersion = defaults.OSVersion
+	}
+	if d.Model == "" {
+		d.Model = defaults.Model
+	}
+	if d.Manufacturer == "" {
+		d.Manufacturer = defaults.Manufacturer
+	}
+	if d.PairClientName == "" {
+		d.PairClientName = defaults.PairClientName
+	}
+	return d
+}
+
+// apply writes the identity to the whatsmeow device properties and client payload. These
+// are process-wide, so they must be set before any client connects. Invalid versions are
+// ignored here; the configuration validation rejects them at startup.
+func (d DeviceIdentity) apply() {
+	osVersion, err := store.ParseVersion(d.OSVersion)
+	if err != nil {
+		osVersion, _ = store.ParseVersion(DefaultDeviceIdentity.OSVersion)
+	}
+	store.SetOSInfo(d.Name, osVersion)
+
+	if platform, ok := waCompanionReg.DeviceProps_PlatformType_value[strings.ToUpper(d.Platform)]; ok {
+		store.DeviceProps.PlatformType = waCompanionReg.DeviceProps_PlatformType(platform).Enum()
+	}
+
+	store.BaseClientPayload.UserAgent.Device = proto.String(d.Model)
+	store.BaseClientPayload.UserAgent.Manufacturer = proto.String(d.Manufacturer)
+
+	if d.AppVersion != "" {
+		if version, err := store.ParseVersion(d.AppVersion); err == nil {
+			store.SetWAVersion(version)
+		}
+	}
+}
+
+// pairClientType returns the phone code pairing client type matching the platform
+func (d DeviceIdentity) pairClientType() whatsmeow.PairClientType {
+	if clientType, ok := pairClientTypes[d.Platform]; ok {
+		return clientType
+	}
+	return whatsmeow.PairClientChrome
+}
+
+// pairPhoneClient returns the client type and display name used for phone code pairing,
+// preferring the per-request options over the identity
+func (d DeviceIdentity) pairPhoneClient(opts whatsapp.PairPhoneOptions) (whatsmeow.PairClientType, string) {
+	clientType := d.pairClientType()
+	if mapped, ok := whatsmeowPairClientTypes[opts.ClientType]; ok {
+		clientType = mapped
+	}
+
+	displayName := d.PairClientName
+	if opts.DisplayName != "" {
+		displayName = opts.DisplayName
+	}
+
+	return clientType, displayName
+}
+
+// deviceInfo returns the identity as reported by the API
+func (d DeviceIdentity) deviceInfo() *whatsapp.DeviceInfo {
+	return &whatsapp.DeviceInfo{
+		Platform:     d.Platform,
+		AppVersion:   store.GetWAVersion().String(),
+		DeviceModel:  d.Model,
+		OSVersion:    d.OSVersion,
+		Manufacturer: d.Manufacturer,
+	}
+}
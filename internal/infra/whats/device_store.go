@@ -0,0 +1,459 @@
+package whats
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.mau.fi/libsignal/ecc"
+	"go.mau.fi/whatsmeow/proto/waAdv"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// deviceBackupVersion is the format version of serialized devices
+const deviceBackupVersion = 1
+
+// columnKind is the type of a store column, used to keep values typed through JSON
+type columnKind int
+
+const (
+	kindText columnKind = iota
+	kindBytes
+	kindInt
+	kindBool
+)
+
+// storeColumn is a column of a device state table
+type storeColumn struct {
+	name string
+	kind columnKind
+}
+
+// deviceTable is a whatsmeow table holding per-device state, keyed by the device JID in owner
+type deviceTable struct {
+	name    string
+	owner   string
+	columns []storeColumn
+}
+
+// deviceTables lists the device state copied along with the credentials, parents before
+// children. The event buffer and the global LID map are rebuilt by whatsmeow and not copied.
+var deviceTables = []deviceTable{
+	{"whatsmeow_identity_keys", "our_jid", []storeColumn{{"their_id", kindText}, {"identity", kindBytes}}},
+	{"whatsmeow_pre_keys", "jid", []storeColumn{{"key_id", kindInt}, {"key", kindBytes}, {"uploaded", kindBool}}},
+	{"whatsmeow_sessions", "our_jid", []storeColumn{{"their_id", kindText}, {"session", kindBytes}}},
+	{"whatsmeow_sender_keys", "our_jid", []storeColumn{{"chat_id", kindText}, {"sender_id", kindText}, {"sender_key", kindBytes}}},
+	{"whatsmeow_app_state_sync_keys", "jid", []storeColumn{{"key_id", kindBytes}, {"key_data", kindBytes}, {"timestamp", kindInt}, {"fingerprint", kindBytes}}},
+	{"whatsmeow_app_state_version", "jid", []storeColumn{{"name", kindText}, {"version", kindInt}, {"hash", kindBytes}}},
+	{"whatsmeow_app_state_mutation_macs", "jid", []storeColumn{{"name", kindText}, {"version", kindInt}, {"index_mac", kindBytes}, {"value_mac", kindBytes}}},
+	{"whatsmeow_contacts", "our_jid", []storeColumn{{"their_jid", kindText}, {"first_name", kindText}, {"full_name", kindText}, {"push_name", kindText}, {"business_name", kindText}}},
+	{"whatsmeow_chat_settings", "our_jid", []storeColumn{{"chat_jid", kindText}, {"muted_until", kindInt}, {"pinned", kindBool}, {"archived", kindBool}}},
+	{"whatsmeow_message_secrets", "our_jid", []storeColumn{{"chat_jid", kindText}, {"sender_jid", kindText}, {"message_id", kindText}, {"key", kindBytes}}},
+	{"whatsmeow_privacy_tokens", "our_jid", []storeColumn{{"their_jid", kindText}, {"token", kindBytes}, {"timestamp", kindInt}}},
+}
+
+// deviceCredentials holds the identity of a device as stored by sqlstore.Container
+type deviceCredentials struct {
+	JID                   string `json:"jid"`
+	LID                   string `json:"lid,omitempty"`
+	RegistrationID        uint32 `json:"registration_id"`
+	NoiseKey              []byte `json:"noise_key"`
+	IdentityKey           []byte `json:"identity_key"`
+	SignedPreKey          []byte `json:"signed_pre_key"`
+	SignedPreKeyID        uint32 `json:"signed_pre_key_id"`
+	SignedPreKeySig       []byte `json:"signed_pre_key_sig"`
+	AdvSecretKey          []byte `json:"adv_key"`
+	AdvDetails            []byte `json:"adv_details"`
+	AdvAccountSig         []byte `json:"adv_account_sig"`
+	AdvAccountSigKey      []byte `json:"adv_account_sig_key"`
+	AdvDeviceSig          []byte `json:"adv_device_sig"`
+	Platform              string `json:"platform"`
+	BusinessName          string `json:"business_name"`
+	PushName              string `json:"push_name"`
+	FacebookUUID          string `json:"facebook_uuid,omitempty"`
+	LIDMigrationTimestamp int64  `json:"lid_migration_ts"`
+}
+
+// deviceBackup is the serialized form of a device and its state
+type deviceBackup struct {
+	Version int                            `json:"version"`
+	Device  deviceCredentials              `json:"device"`
+	Tables  map[string][][]json.RawMessage `json:"tables"`
+}
+
+// DeviceStore exports and imports devices of the whatsmeow sqlstore
+type DeviceStore struct {
+	container *sqlstore.Container
+	db        *sql.DB
+}
+
+// NewDeviceStore creates a device store over the WhatsApp store and its database handle
+func NewDeviceStore(container *sqlstore.Container, db *sql.DB) whatsapp.DeviceStore {
+	return &DeviceStore{
+		container: container,
+		db:        db,
+	}
+}
+
+// ExportDevice serializes the credentials and signal state of the device JID
+func (s *DeviceStore) ExportDevice(ctx context.Context, jid string) ([]byte, error) {
+	deviceJID, ok := parseJID(jid)
+	if !ok {
+		return nil, whatsapp.ErrDeviceNotFound
+	}
+
+	device, err := s.container.GetDevice(ctx, deviceJID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load device: %w", err)
+	}
+	if device == nil {
+		return nil, whatsapp.ErrDeviceNotFound
+	}
+
+	backup := deviceBackup{
+		Version: deviceBackupVersion,
+		Device:  credentialsFromDevice(device),
+		Tables:  make(map[string][][]json.RawMessage, len(deviceTables)),
+	}
+
+	// Read all tables in one transaction so the signal state is consistent
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start export transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range deviceTables {
+		rows, err := exportTable(ctx, tx, table, device.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		backup.Tables[table.name] = rows
+	}
+
+	return json.Marshal(backup)
+}
+
+// ImportDevice restores a device serialized by ExportDevice and returns its JID
+func (s *DeviceStore) ImportDevice(ctx context.Context, data []byte) (string, error) {
+	var backup deviceBackup
+	if err := json.Unmarshal(data, &backup); err != nil || backup.Version != deviceBackupVersion {
+		return "", whatsapp.ErrInvalidDeviceBackup
+	}
+
+	device, err := deviceFromCredentials(backup.Device)
+	if err != nil {
+		return "", err
+	}
+
+	existing, err := s.container.GetDevice(ctx, *device.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check existing device: %w", err)
+	}
+	if existing != nil {
+		return "", whatsapp.ErrDeviceAlreadyExists
+	}
+
+	// Validate all rows before writing anything
+	for _, table := range deviceTables {
+		for _, row := range backup.Tables[table.name] {
+			if len(row) != len(table.columns) {
+				return "", whatsapp.ErrInvalidDeviceBackup
+			}
+		}
+	}
+
+	if err := s.container.PutDevice(ctx, device); err != nil {
+		return "", fmt.Errorf("failed to store device: %w", err)
+	}
+
+	if err := s.importTables(ctx, device.ID.String(), backup.Tables); err != nil {
+		// The state rows were rolled back; remove the device so the import can be retried
+		s.container.DeleteDevice(ctx, device)
+		return "", err
+	}
+
+	return device.ID.String(), nil
+}
+
+// DeleteDevice removes a device and all of its state
+func (s *DeviceStore) DeleteDevice(ctx context.Context, jid string) error {
+	deviceJID, ok := parseJID(jid)
+	if !ok {
+		return whatsapp.ErrDeviceNotFound
+	}
+
+	device, err := s.container.GetDevice(ctx, deviceJID)
+	if err != nil {
+		return fmt.Errorf("failed to load device: %w", err)
+	}
+	if device == nil {
+		return whatsapp.ErrDeviceNotFound
+	}
+
+	// Privacy tokens have no foreign key to the device, so they are not removed by the cascade
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM whatsmeow_privacy_tokens WHERE our_jid=$1", jid); err != nil {
+		return fmt.Errorf("failed to delete privacy tokens: %w", err)
+	}
+
+	return s.container.DeleteDevice(ctx, device)
+}
+
+// importTables inserts the device state rows in a single transaction
+func (s *DeviceStore) importTables(ctx context.Context, jid string, tables map[string][][]json.RawMessage) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start import transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range deviceTables {
+		query := insertQuery(table)
+		for _, row := range tables[table.name] {
+			args := make([]any, 0, len(row)+1)
+			args = append(args, jid)
+			for i, column := range table.columns {
+				value, err := decodeColumn(column.kind, row[i])
+				if err != nil {
+					return fmt.Errorf("%w: %s.%s", whatsapp.ErrInvalidDeviceBackup, table.name, column.name)
+				}
+				args = append(args, value)
+			}
+
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("failed to import %s: %w", table.name, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit device import: %w", err)
+	}
+	return nil
+}
+
+// exportTable reads the rows of a device state table as JSON values
+func exportTable(ctx context.Context, tx *sql.Tx, table deviceTable, jid string) ([][]json.RawMessage, error) {
+	names := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		names[i] = column.name
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s=$1", strings.Join(names, ", "), table.name, table.owner)
+	rows, err := tx.QueryContext(ctx, query, jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", table.name, err)
+	}
+	defer rows.Close()
+
+	result := make([][]json.RawMessage, 0)
+	for rows.Next() {
+		dest := make([]any, len(table.columns))
+		for i, column := range table.columns {
+			dest[i] = scanDestination(column.kind)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", table.name, err)
+		}
+
+		row := make([]json.RawMessage, len(dest))
+		for i, value := range dest {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %w", table.name, err)
+			}
+			row[i] = encoded
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
+// insertQuery builds the insert statement of a device state table
+func insertQuery(table deviceTable) string {
+	names := []string{table.owner}
+	placeholders := []string{"$1"}
+	for i, column := range table.columns {
+		names = append(names, column.name)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+2))
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table.name, strings.Join(names, ", "), strings.Join(placeholders, ", "))
+}
+
+// scanDestination returns a nullable scan target for a column kind; all of them marshal
+// to JSON null when the column is NULL
+func scanDestination(kind columnKind) any {
+	switch kind {
+	case kindBytes:
+		return &[]byte{}
+	case kindInt:
+		return &nullInt64{}
+	case kindBool:
+		return &nullBool{}
+	default:
+		return &nullString{}
+	}
+}
+
+// decodeColumn converts a JSON value back to the database value of a column kind
+func decodeColumn(kind columnKind, raw json.RawMessage) (any, error) {
+	var err error
+	switch kind {
+	case kindBytes:
+		var value []byte
+		err = json.Unmarshal(raw, &value)
+		if value == nil {
+			return nil, err
+		}
+		return value, err
+	case kindInt:
+		var value *int64
+		err = json.Unmarshal(raw, &value)
+		if value == nil {
+			return nil, err
+		}
+		return *value, err
+	case kindBool:
+		var value *bool
+		err = json.Unmarshal(raw, &value)
+		if value == nil {
+			return nil, err
+		}
+		return *value, err
+	default:
+		var value *string
+		err = json.Unmarshal(raw, &value)
+		if value == nil {
+			return nil, err
+		}
+		return *value, err
+	}
+}
+
+// nullString, nullInt64 and nullBool marshal NULL columns as JSON null
+type nullString struct{ sql.NullString }
+type nullInt64 struct{ sql.NullInt64 }
+type nullBool struct{ sql.NullBool }
+
+func (n nullString) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.String)
+}
+
+func (n nullInt64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Int64)
+}
+
+func (n nullBool) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Bool)
+}
+
+// credentialsFromDevice copies the identity of a device loaded from the store
+func credentialsFromDevice(device *store.Device) deviceCredentials {
+	credentials := deviceCredentials{
+		JID:                   device.ID.String(),
+		RegistrationID:        device.RegistrationID,
+		NoiseKey:              device.NoiseKey.Priv[:],
+		IdentityKey:           device.IdentityKey.Priv[:],
+		SignedPreKey:          device.SignedPreKey.Priv[:],
+		SignedPreKeyID:        device.SignedPreKey.KeyID,
+		SignedPreKeySig:       device.SignedPreKey.Signature[:],
+		AdvSecretKey:          device.AdvSecretKey,
+		AdvDetails:            device.Account.GetDetails(),
+		AdvAccountSig:         device.Account.GetAccountSignature(),
+		AdvAccountSigKey:      device.Account.GetAccountSignatureKey(),
+		AdvDeviceSig:          device.Account.GetDeviceSignature(),
+		Platform:              device.Platform,
+		BusinessName:          device.BusinessName,
+		PushName:              device.PushName,
+		LIDMigrationTimestamp: device.LIDMigrationTimestamp,
+	}
+
+	if !device.LID.IsEmpty() {
+		credentials.LID = device.LID.String()
+	}
+	if device.FacebookUUID != uuid.Nil {
+		credentials.FacebookUUID = device.FacebookUUID.String()
+	}
+
+	return credentials
+}
+
+// deviceFromCredentials rebuilds a device, checking key sizes like sqlstore does on load
+func deviceFromCredentials(credentials deviceCredentials) (*store.Device, error) {
+	jid, ok := parseJID(credentials.JID)
+	if !ok || jid.User == "" {
+		return nil, whatsapp.ErrInvalidDeviceBackup
+	}
+	if len(credentials.NoiseKey) != 32 || len(credentials.IdentityKey) != 32 || len(credentials.SignedPreKey) != 32 ||
+		len(credentials.SignedPreKeySig) != 64 || len(credentials.AdvAccountSig) != 64 ||
+		len(credentials.AdvAccountSigKey) != 32 || len(credentials.AdvDeviceSig) != 64 ||
+		len(credentials.AdvSecretKey) == 0 || len(credentials.AdvDetails) == 0 {
+		return nil, whatsapp.ErrInvalidDeviceBackup
+	}
+
+	device := &store.Device{
+		NoiseKey:       keys.NewKeyPairFromPrivateKey(*(*[32]byte)(credentials.NoiseKey)),
+		IdentityKey:    keys.NewKeyPairFromPrivateKey(*(*[32]byte)(credentials.IdentityKey)),
+		RegistrationID: credentials.RegistrationID,
+		AdvSecretKey:   credentials.AdvSecretKey,
+		ID:             &jid,
+		Account: &waAdv.ADVSignedDeviceIdentity{
+			Details:             credentials.AdvDetails,
+			AccountSignature:    credentials.AdvAccountSig,
+			AccountSignatureKey: credentials.AdvAccountSigKey,
+			DeviceSignature:     credentials.AdvDeviceSig,
+		},
+		Platform:              credentials.Platform,
+		BusinessName:          credentials.BusinessName,
+		PushName:              credentials.PushName,
+		LIDMigrationTimestamp: credentials.LIDMigrationTimestamp,
+	}
+
+	device.SignedPreKey = &keys.PreKey{
+		KeyPair:   *keys.NewKeyPairFromPrivateKey(*(*[32]byte)(credentials.SignedPreKey)),
+		KeyID:     credentials.SignedPreKeyID,
+		Signature: (*[64]byte)(credentials.SignedPreKeySig),
+	}
+
+	if credentials.LID != "" {
+		lid, err := types.ParseJID(credentials.LID)
+		if err != nil {
+			return nil, whatsapp.ErrInvalidDeviceBackup
+		}
+		device.LID = lid
+	}
+	if credentials.FacebookUUID != "" {
+		fbUUID, err := uuid.Parse(credentials.FacebookUUID)
+		if err != nil {
+			return nil, whatsapp.ErrInvalidDeviceBackup
+		}
+		device.FacebookUUID = fbUUID
+	}
+
+	// The signed pre-key must be signed by the identity key, otherwise the server rejects the device
+	signedPub := append([]byte{ecc.DjbType}, device.SignedPreKey.Pub[:]...)
+	if !ecc.VerifySignature(ecc.NewDjbECPublicKey(*device.IdentityKey.Pub), signedPub, *device.SignedPreKey.Signature) {
+		return nil, whatsapp.ErrInvalidDeviceBackup
+	}
+
+	return device, nil
+}
@@ -0,0 +1,70 @@
+package whats
+
+import (
+	"sync"
+	"time"
+)
+
+// disconnectDebouncer delays disconnection reports so that brief network blips, which
+// whatsmeow reconnects from on its own, do not flip the session status back and forth
+type disconnectDebouncer struct {
+	mu    sync.Mutex
+	grace time.Duration
+	timer *time.Timer
+}
+
+// newDisconnectDebouncer creates a debouncer; a zero grace period reports immediately
+func newDisconnectDebouncer(grace time.Duration) *disconnectDebouncer {
+	return &disconnectDebouncer{grace: grace}
+}
+
+// schedule runs report once the grace period expires unless cancel is called first.
+// A report already pending is kept, so the grace period counts from the first disconnection.
+func (d *disconnectDebouncer) schedule(report func()) {
+	d.mu.Lock()
+	if d.grace <= 0 {
+		d.mu.Unlock()
+		report()
+		return
+	}
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(d.grace, func() {
+		d.mu.Lock()
+		if d.timer != timer {
+			d.mu.Unlock()
+			return
+		}
+		d.timer = nil
+		d.mu.Unlock()
+
+		report()
+	})
+	d.timer = timer
+}
+
+// cancel drops a pending report and returns true if there was one
+func (d *disconnectDebouncer) cancel() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer == nil {
+		return false
+	}
+
+	d.timer.Stop()
+	d.timer = nil
+	return true
+}
+
+// setGrace changes the grace period of the disconnections reported from now on
+func (d *disconnectDebouncer) setGrace(grace time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.grace = grace
+}
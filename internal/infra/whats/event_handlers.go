@@ -0,0 +1,151 @@
+package whats
+
+import (
+	"sync"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// eventHandlerEntry is a handler registered on the chain
+type eventHandlerEntry struct {
+	id      uint64
+	handler whatsapp.EventHandler
+}
+
+// EventHandlerChain passes every event to the base handler, if any, and then to the
+// extra handlers in registration order.
+//
+// The manager and each client keep the same chain for their whole life, so handlers
+// can be added and removed while events are dispatched. The extra
+// handlers are replaced rather than modified, so dispatching iterates a snapshot
+// without holding the lock.
+type EventHandlerChain struct {
+	base whatsapp.EventHandler // Always notified first and never removed; may be nil
+
+	mu     sync.RWMutex
+	extras []eventHandlerEntry
+	nextID uint64
+}
+
+// NewEventHandlerChain creates a chain that always notifies base first
+func NewEventHandlerChain(base whatsapp.EventHandler) *EventHandlerChain {
+	return &EventHandlerChain{base: base}
+}
+
+// Add registers an extra handler and returns a function that removes it
+func (c *EventHandlerChain) Add(handler whatsapp.EventHandler) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextID++
+	id := c.nextID
+
+	extras := make([]eventHandlerEntry, len(c.extras), len(c.extras)+1)
+	copy(extras, c.extras)
+	c.extras = append(extras, eventHandlerEntry{id: id, handler: handler})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { c.remove(id) })
+	}
+}
+
+// remove unregisters the extra handler with the given ID
+func (c *EventHandlerChain) remove(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	extras := make([]eventHandlerEntry, 0, len(c.extras))
+	for _, entry := range c.extras {
+		if entry.id != id {
+			extras = append(extras, entry)
+		}
+	}
+	c.extras = extras
+}
+
+// Replace makes handler the only extra handler; nil removes every extra handler
+func (c *EventHandlerChain) Replace(handler whatsapp.EventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.extras = nil
+	if handler != nil {
+		c.nextID++
+		c.extras = []eventHandlerEntry{{id: c.nextID, handler: handler}}
+	}
+}
+
+// empty reports whether the chain has no handler to notify
+func (c *EventHandlerChain) empty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.base == nil && len(c.extras) == 0
+}
+
+// each calls fn with the base handler and every extra handler
+func (c *EventHandlerChain) each(fn func(whatsapp.EventHandler)) {
+	c.mu.RLock()
+	extras := c.extras
+	c.mu.RUnlock()
+
+	if c.base != nil {
+		fn(c.base)
+	}
+	for _, entry := range extras {
+		fn(entry.handler)
+	}
+}
+
+func (c *EventHandlerChain) OnConnected(sessionID session.SessionID, jid string) {
+	c.each(func(h whatsapp.EventHandler) { h.OnConnected(sessionID, jid) })
+}
+
+func (c *EventHandlerChain) OnDisconnected(sessionID session.SessionID, reason session.DisconnectReason) {
+	c.each(func(h whatsapp.EventHandler) { h.OnDisconnected(sessionID, reason) })
+}
+
+func (c *EventHandlerChain) OnQRCode(sessionID session.SessionID, qrCode string) {
+	c.each(func(h whatsapp.EventHandler) { h.OnQRCode(sessionID, qrCode) })
+}
+
+func (c *EventHandlerChain) OnAuthenticated(sessionID session.SessionID, jid string) {
+	c.each(func(h whatsapp.EventHandler) { h.OnAuthenticated(sessionID, jid) })
+}
+
+func (c *EventHandlerChain) OnAuthenticationFailed(sessionID session.SessionID, reason string) {
+	c.each(func(h whatsapp.EventHandler) { h.OnAuthenticationFailed(sessionID, reason) })
+}
+
+func (c *EventHandlerChain) OnPairingAttempt(sessionID session.SessionID, attempt *session.PairingAttempt) {
+	c.each(func(h whatsapp.EventHandler) { h.OnPairingAttempt(sessionID, attempt) })
+}
+
+func (c *EventHandlerChain) OnMessage(sessionID session.SessionID, message *whatsapp.Message) {
+	c.each(func(h whatsapp.EventHandler) { h.OnMessage(sessionID, message) })
+}
+
+func (c *EventHandlerChain) OnError(sessionID session.SessionID, err error) {
+	c.each(func(h whatsapp.EventHandler) { h.OnError(sessionID, err) })
+}
+
+func (c *EventHandlerChain) OnConnectionFailed(sessionID session.SessionID, err error) {
+	c.each(func(h whatsapp.EventHandler) { h.OnConnectionFailed(sessionID, err) })
+}
+
+func (c *EventHandlerChain) OnSessionReplaced(sessionID session.SessionID) {
+	c.each(func(h whatsapp.EventHandler) { h.OnSessionReplaced(sessionID) })
+}
+
+func (c *EventHandlerChain) OnHealthIssue(sessionID session.SessionID, issue *whatsapp.HealthIssue) {
+	c.each(func(h whatsapp.EventHandler) { h.OnHealthIssue(sessionID, issue) })
+}
+
+func (c *EventHandlerChain) OnChatStateChanged(sessionID session.SessionID, change *whatsapp.ChatStateChange) {
+	c.each(func(h whatsapp.EventHandler) { h.OnChatStateChanged(sessionID, change) })
+}
+
+func (c *EventHandlerChain) OnPollVote(sessionID session.SessionID, vote *whatsapp.PollVote) {
+	c.each(func(h whatsapp.EventHandler) { h.OnPollVote(sessionID, vote) })
+}
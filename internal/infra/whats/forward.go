@@ -0,0 +1,130 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// ForwardMessage re-sends a stored message of fromChat to toChat marked as forwarded, returning
+// the new message ID. Media is forwarded with its stored upload metadata, without downloading it.
+func (c *Client) ForwardMessage(ctx context.Context, fromChat, messageID, toChat string) (string, error) {
+	done := c.BeginSend()
+	defer done()
+
+	if !c.IsAuthenticated() {
+		return "", fmt.Errorf("not authenticated")
+	}
+	if c.messageRepo == nil {
+		return "", whatsapp.ErrMessageNotFound
+	}
+
+	source, err := types.ParseJID(fromChat)
+	if err != nil {
+		return "", fmt.Errorf("invalid source chat JID: %w", err)
+	}
+	recipient, err := types.ParseJID(toChat)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	stored, err := c.messageRepo.GetByID(ctx, c.sessionID, messageID)
+	if err != nil {
+		return "", err
+	}
+	// The message must belong to the chat it is forwarded from
+	if stored.ChatJID != source.String() {
+		return "", whatsapp.ErrMessageNotFound
+	}
+
+	message, err := ToForwardedMessage(stored)
+	if err != nil {
+		return "", err
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	resp, err := c.client.SendMessage(ctx, recipient, message)
+	if err != nil {
+		return "", fmt.Errorf("failed to forward message: %w", err)
+	}
+	c.sendStats.Record(c.sessionID, stored.Type)
+
+	c.logger.InfoWithFields("↪️ Mensagem encaminhada", logger.Fields{
+		"session_id":          c.sessionID.String(),
+		"from_chat":           fromChat,
+		"to":                  toChat,
+		"original_message_id": messageID,
+		"message_id":          resp.ID,
+		"type":                stored.Type.String(),
+	})
+
+	return resp.ID, nil
+}
+
+// ToForwardedMessage rebuilds the content of a stored message flagged as forwarded.
+// Only text and media messages are stored with enough content to be rebuilt.
+func ToForwardedMessage(stored *whatsapp.StoredMessage) (*waE2E.Message, error) {
+	contextInfo := &waE2E.ContextInfo{
+		IsForwarded:     proto.Bool(true),
+		ForwardingScore: proto.Uint32(1),
+	}
+
+	switch stored.Type {
+	case whatsapp.MessageTypeText:
+		return &waE2E.Message{
+			ExtendedTextMessage: &waE2E.ExtendedTextMessage{
+				Text:        proto.String(stored.Body),
+				ContextInfo: contextInfo,
+			},
+		}, nil
+	case whatsapp.MessageTypeImage, whatsapp.MessageTypeVideo, whatsapp.MessageTypeAudio,
+		whatsapp.MessageTypeDocument, whatsapp.MessageTypeSticker:
+		if !stored.HasMedia() {
+			return nil, whatsapp.ErrMessageHasNoMedia
+		}
+	default:
+		return nil, whatsapp.ErrMessageNotForwardable
+	}
+
+	media, err := toDownloadable(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	var caption *string
+	if stored.Body != "" {
+		caption = proto.String(stored.Body)
+	}
+
+	switch m := media.(type) {
+	case *waE2E.ImageMessage:
+		m.Caption = caption
+		m.ContextInfo = contextInfo
+		return &waE2E.Message{ImageMessage: m}, nil
+	case *waE2E.VideoMessage:
+		m.Caption = caption
+		m.ContextInfo = contextInfo
+		return &waE2E.Message{VideoMessage: m}, nil
+	case *waE2E.AudioMessage:
+		m.ContextInfo = contextInfo
+		return &waE2E.Message{AudioMessage: m}, nil
+	case *waE2E.DocumentMessage:
+		m.Caption = caption
+		m.ContextInfo = contextInfo
+		return &waE2E.Message{DocumentMessage: m}, nil
+	case *waE2E.StickerMessage:
+		m.ContextInfo = contextInfo
+		return &waE2E.Message{StickerMessage: m}, nil
+	default:
+		return nil, whatsapp.ErrMessageNotForwardable
+	}
+}
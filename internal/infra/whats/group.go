@@ -0,0 +1,235 @@
+package whats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetJoinedGroups returns the groups the session is a member of
+func (c *Client) GetJoinedGroups(ctx context.Context) ([]*whatsapp.GroupInfo, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	groups, err := c.client.GetJoinedGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get joined groups: %w", err)
+	}
+
+	result := make([]*whatsapp.GroupInfo, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, toDomainGroupInfo(group))
+	}
+
+	c.logger.InfoWithFields("👥 Grupos recuperados", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"count":      len(result),
+	})
+
+	return result, nil
+}
+
+// GetGroupInfo returns metadata of a single group
+func (c *Client) GetGroupInfo(ctx context.Context, groupJID string) (*whatsapp.GroupInfo, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := c.client.GetGroupInfo(jid)
+	if err != nil {
+		return nil, mapGroupError(err)
+	}
+
+	return toDomainGroupInfo(group), nil
+}
+
+// UpdateGroupParticipants adds, removes, promotes or demotes group participants
+func (c *Client) UpdateGroupParticipants(ctx context.Context, groupJID string, participants []string, action string) ([]*whatsapp.ParticipantUpdateResult, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	participantAction, err := whatsapp.ParseParticipantAction(action)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(participants) == 0 {
+		return nil, whatsapp.ErrNoParticipants
+	}
+
+	participantJIDs := make([]types.JID, 0, len(participants))
+	for _, participant := range participants {
+		participantJID, err := parseParticipantJID(participant)
+		if err != nil {
+			c.logger.WarnWithFields("⚠️ JID de participante inválido", logger.Fields{
+				"session_id":  c.sessionID.String(),
+				"group_jid":   groupJID,
+				"participant": participant,
+			})
+			return nil, err
+		}
+		participantJIDs = append(participantJIDs, participantJID)
+	}
+
+	changed, err := c.client.UpdateGroupParticipants(jid, participantJIDs, whatsmeow.ParticipantChange(participantAction))
+	if err != nil {
+		return nil, mapParticipantUpdateError(err)
+	}
+
+	results := toParticipantUpdateResults(participantJIDs, changed)
+
+	c.logger.InfoWithFields("👥 Participantes do grupo atualizados", logger.Fields{
+		"session_id":   c.sessionID.String(),
+		"group_jid":    jid.String(),
+		"action":       participantAction.String(),
+		"participants": len(participantJIDs),
+	})
+
+	return results, nil
+}
+
+// parseGroupJID parses and validates a group JID
+func parseGroupJID(groupJID string) (types.JID, error) {
+	jid, err := types.ParseJID(groupJID)
+	if err != nil || jid.Server != types.GroupServer {
+		return types.JID{}, whatsapp.ErrInvalidGroupJID
+	}
+	return jid, nil
+}
+
+// parseParticipantJID parses and validates a participant (user) JID
+func parseParticipantJID(participant string) (types.JID, error) {
+	jid, err := types.ParseJID(participant)
+	if err != nil || jid.User == "" {
+		return types.JID{}, whatsapp.ErrInvalidParticipantJID
+	}
+	if jid.Server != types.DefaultUserServer && jid.Server != types.HiddenUserServer {
+		return types.JID{}, whatsapp.ErrInvalidParticipantJID
+	}
+	return jid.ToNonAD(), nil
+}
+
+// mapParticipantUpdateError maps whatsmeow participant update errors to domain errors
+func mapParticipantUpdateError(err error) error {
+	switch {
+	case errors.Is(err, whatsmeow.ErrIQForbidden), errors.Is(err, whatsmeow.ErrIQNotAuthorized):
+		return whatsapp.ErrNotGroupAdmin
+	case errors.Is(err, whatsmeow.ErrIQNotFound):
+		return whatsapp.ErrGroupNotFound
+	default:
+		return fmt.Errorf("failed to update group participants: %w", err)
+	}
+}
+
+// toParticipantUpdateResults builds one result per requested participant from the participants reported by WhatsApp
+func toParticipantUpdateResults(requested []types.JID, changed []types.GroupParticipant) []*whatsapp.ParticipantUpdateResult {
+	reported := make(map[types.JID]types.GroupParticipant, len(changed)*2)
+	for _, p := range changed {
+		reported[p.JID.ToNonAD()] = p
+		if !p.PhoneNumber.IsEmpty() {
+			reported[p.PhoneNumber.ToNonAD()] = p
+		}
+		if !p.LID.IsEmpty() {
+			reported[p.LID.ToNonAD()] = p
+		}
+	}
+
+	results := make([]*whatsapp.ParticipantUpdateResult, 0, len(requested))
+	for _, jid := range requested {
+		result := &whatsapp.ParticipantUpdateResult{JID: jid.String()}
+
+		p, ok := reported[jid]
+		switch {
+		case !ok:
+			result.Error = "participant not reported by WhatsApp"
+		case p.Error != 0:
+			result.ErrorCode = p.Error
+			result.Error = participantErrorMessage(p)
+		default:
+			result.Success = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// participantErrorMessage returns a readable message for a participant error code
+func participantErrorMessage(p types.GroupParticipant) string {
+	switch p.Error {
+	case 403:
+		if p.AddRequest != nil {
+			return "not allowed by the user's privacy settings, an invite is required"
+		}
+		return "not allowed"
+	case 404:
+		return "participant not found"
+	case 408:
+		return "participant recently left the group"
+	case 409:
+		return "participant already in the requested state"
+	default:
+		return fmt.Sprintf("failed with code %d", p.Error)
+	}
+}
+
+// mapGroupError maps whatsmeow group errors to domain errors
+func mapGroupError(err error) error {
+	switch {
+	case errors.Is(err, whatsmeow.ErrNotInGroup):
+		return whatsapp.ErrNotGroupMember
+	case errors.Is(err, whatsmeow.ErrGroupNotFound):
+		return whatsapp.ErrGroupNotFound
+	default:
+		return fmt.Errorf("failed to get group info: %w", err)
+	}
+}
+
+// toDomainGroupInfo converts whatsmeow group info to the domain representation
+func toDomainGroupInfo(group *types.GroupInfo) *whatsapp.GroupInfo {
+	participants := make([]whatsapp.GroupParticipant, 0, len(group.Participants))
+	for _, p := range group.Participants {
+		participant := whatsapp.GroupParticipant{
+			JID:          p.JID.String(),
+			IsAdmin:      p.IsAdmin,
+			IsSuperAdmin: p.IsSuperAdmin,
+		}
+		if !p.PhoneNumber.IsEmpty() {
+			participant.PhoneNumber = p.PhoneNumber.User
+		}
+		participants = append(participants, participant)
+	}
+
+	ownerJID := ""
+	if !group.OwnerJID.IsEmpty() {
+		ownerJID = group.OwnerJID.String()
+	}
+
+	return &whatsapp.GroupInfo{
+		JID:          group.JID.String(),
+		Name:         group.Name,
+		Description:  group.Topic,
+		OwnerJID:     ownerJID,
+		Participants: participants,
+		CreatedAt:    group.GroupCreated,
+	}
+}
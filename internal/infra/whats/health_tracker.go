@@ -0,0 +1,129 @@
+package whats
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	waLog "go.mau.fi/whatsmeow/util/log"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// healthTracker keeps the unresolved health issues of a client
+type healthTracker struct {
+	mu        sync.RWMutex
+	issues    map[whatsapp.HealthIssueKind]*whatsapp.HealthIssue
+	updatedAt time.Time
+}
+
+// newHealthTracker creates a new health tracker
+func newHealthTracker() *healthTracker {
+	return &healthTracker{
+		issues: make(map[whatsapp.HealthIssueKind]*whatsapp.HealthIssue),
+	}
+}
+
+// report records an occurrence of an issue and returns a copy of it
+func (t *healthTracker) report(kind whatsapp.HealthIssueKind, errMsg string) *whatsapp.HealthIssue {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	issue, ok := t.issues[kind]
+	if !ok {
+		issue = &whatsapp.HealthIssue{
+			Kind:        kind,
+			Remediation: kind.Remediation(),
+			FirstSeenAt: now,
+		}
+		t.issues[kind] = issue
+	}
+	issue.Error = errMsg
+	issue.Count++
+	issue.LastSeenAt = now
+	t.updatedAt = now
+
+	reported := *issue
+	return &reported
+}
+
+// resolve clears the given issues, returning true if any of them was set
+func (t *healthTracker) resolve(kinds ...whatsapp.HealthIssueKind) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	resolved := false
+	for _, kind := range kinds {
+		if _, ok := t.issues[kind]; ok {
+			delete(t.issues, kind)
+			resolved = true
+		}
+	}
+	if resolved {
+		t.updatedAt = time.Now()
+	}
+	return resolved
+}
+
+// snapshot returns a copy of the current health, oldest issue first
+func (t *healthTracker) snapshot() *whatsapp.ClientHealth {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	issues := make([]whatsapp.HealthIssue, 0, len(t.issues))
+	for _, issue := range t.issues {
+		issues = append(issues, *issue)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].FirstSeenAt.Before(issues[j].FirstSeenAt)
+	})
+
+	return &whatsapp.ClientHealth{
+		Healthy:   len(issues) == 0,
+		Issues:    issues,
+		UpdatedAt: t.updatedAt,
+	}
+}
+
+// preKeyLogWatcher is the whatsmeow logger of a client. whatsmeow only reports
+// prekey upload results through its logs, so they are turned into health updates
+// here; everything else is discarded like the default no-op logger does.
+type preKeyLogWatcher struct {
+	onFailure func(msg string)
+	onSuccess func()
+}
+
+// Messages logged by whatsmeow's uploadPreKeys
+const (
+	preKeyUploadFailurePrefix = "Failed to send request to upload prekeys"
+	preKeyFetchFailurePrefix  = "Failed to get prekeys to upload"
+	preKeyUploadSuccessMsg    = "Got response to uploading prekeys"
+)
+
+// Errorf implements waLog.Logger
+func (w *preKeyLogWatcher) Errorf(msg string, args ...interface{}) {
+	if strings.HasPrefix(msg, preKeyUploadFailurePrefix) || strings.HasPrefix(msg, preKeyFetchFailurePrefix) {
+		w.onFailure(fmt.Sprintf(msg, args...))
+	}
+}
+
+// Debugf implements waLog.Logger
+func (w *preKeyLogWatcher) Debugf(msg string, args ...interface{}) {
+	if msg == preKeyUploadSuccessMsg {
+		w.onSuccess()
+	}
+}
+
+// Warnf implements waLog.Logger
+func (w *preKeyLogWatcher) Warnf(msg string, args ...interface{}) {}
+
+// Infof implements waLog.Logger
+func (w *preKeyLogWatcher) Infof(msg string, args ...interface{}) {}
+
+// Sub implements waLog.Logger
+func (w *preKeyLogWatcher) Sub(module string) waLog.Logger {
+	return w
+}
@@ -0,0 +1,108 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// interactiveSupportNote explains why an interactive message may fail or go unseen.
+// whatsmeow sends these legacy message types as-is; WhatsApp no longer documents them
+// and recent clients only render them when sent by business accounts.
+const interactiveSupportNote = "buttons and list messages are not officially supported by whatsmeow and may be rejected or not rendered unless sent from a WhatsApp Business account"
+
+// SendButtons sends a message with up to three quick reply buttons and returns its message ID
+func (c *Client) SendButtons(ctx context.Context, to, body string, buttons []whatsapp.Button) (string, error) {
+	if err := whatsapp.ValidateButtons(body, buttons); err != nil {
+		return "", err
+	}
+
+	protoButtons := make([]*waE2E.ButtonsMessage_Button, 0, len(buttons))
+	for _, button := range buttons {
+		protoButtons = append(protoButtons, &waE2E.ButtonsMessage_Button{
+			ButtonID:   proto.String(button.ID),
+			ButtonText: &waE2E.ButtonsMessage_Button_ButtonText{DisplayText: proto.String(button.Text)},
+			Type:       waE2E.ButtonsMessage_Button_RESPONSE.Enum(),
+		})
+	}
+
+	return c.sendInteractive(ctx, to, whatsapp.MessageTypeButtons, &waE2E.Message{
+		ButtonsMessage: &waE2E.ButtonsMessage{
+			ContentText: proto.String(body),
+			HeaderType:  waE2E.ButtonsMessage_EMPTY.Enum(),
+			Buttons:     protoButtons,
+		},
+	})
+}
+
+// SendList sends a message with a button that opens a list of selectable rows and returns its message ID
+func (c *Client) SendList(ctx context.Context, to, body, buttonText string, sections []whatsapp.ListSection) (string, error) {
+	if err := whatsapp.ValidateList(body, buttonText, sections); err != nil {
+		return "", err
+	}
+
+	protoSections := make([]*waE2E.ListMessage_Section, 0, len(sections))
+	for _, section := range sections {
+		rows := make([]*waE2E.ListMessage_Row, 0, len(section.Rows))
+		for _, row := range section.Rows {
+			rows = append(rows, &waE2E.ListMessage_Row{
+				RowID:       proto.String(row.ID),
+				Title:       proto.String(row.Title),
+				Description: proto.String(row.Description),
+			})
+		}
+		protoSections = append(protoSections, &waE2E.ListMessage_Section{
+			Title: proto.String(section.Title),
+			Rows:  rows,
+		})
+	}
+
+	return c.sendInteractive(ctx, to, whatsapp.MessageTypeList, &waE2E.Message{
+		ListMessage: &waE2E.ListMessage{
+			Description: proto.String(body),
+			ButtonText:  proto.String(buttonText),
+			ListType:    waE2E.ListMessage_SINGLE_SELECT.Enum(),
+			Sections:    protoSections,
+		},
+	})
+}
+
+// sendInteractive sends a buttons or list message, noting the whatsmeow support limits on failure
+func (c *Client) sendInteractive(ctx context.Context, to string, messageType whatsapp.MessageType, message *waE2E.Message) (string, error) {
+	done := c.BeginSend()
+	defer done()
+
+	if !c.IsAuthenticated() {
+		return "", fmt.Errorf("not authenticated")
+	}
+
+	recipient, err := types.ParseJID(to)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	resp, err := c.client.SendMessage(ctx, recipient, message)
+	if err != nil {
+		return "", fmt.Errorf("failed to send %s message (%s): %w", messageType, interactiveSupportNote, err)
+	}
+	c.sendStats.Record(c.sessionID, messageType)
+
+	c.logger.InfoWithFields("🔘 Mensagem interativa enviada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"to":         to,
+		"type":       messageType.String(),
+		"message_id": resp.ID,
+	})
+
+	return resp.ID, nil
+}
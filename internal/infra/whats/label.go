@@ -0,0 +1,237 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/proto/waSyncAction"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// LabelTracker keeps the labels of a business account as synced through app state. Labels
+// are not persisted: they are loaded with a full app state sync the first time they are needed
+// and kept up to date from label edit events afterwards.
+type LabelTracker struct {
+	mu     sync.Mutex
+	labels map[string]whatsapp.Label
+
+	// loadMu serializes the initial sync; loaded is only set once it succeeded
+	loadMu sync.Mutex
+	loaded bool
+}
+
+// NewLabelTracker creates an empty label tracker
+func NewLabelTracker() *LabelTracker {
+	return &LabelTracker{
+		labels: make(map[string]whatsapp.Label),
+	}
+}
+
+// Apply records a label edit, removing deleted labels
+func (t *LabelTracker) Apply(labelID string, action *waSyncAction.LabelEditAction) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if action.GetDeleted() {
+		delete(t.labels, labelID)
+		return
+	}
+	t.labels[labelID] = whatsapp.Label{
+		ID:    labelID,
+		Name:  action.GetName(),
+		Color: int(action.GetColor()),
+	}
+}
+
+// Get returns a known label
+func (t *LabelTracker) Get(labelID string) (whatsapp.Label, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	label, ok := t.labels[labelID]
+	return label, ok
+}
+
+// List returns the known labels ordered by ID
+func (t *LabelTracker) List() []*whatsapp.Label {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	labels := make([]*whatsapp.Label, 0, len(t.labels))
+	for _, label := range t.labels {
+		labels = append(labels, &label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labelIDLess(labels[i].ID, labels[j].ID)
+	})
+	return labels
+}
+
+// NextID returns an ID not used by any known label. WhatsApp assigns sequential numeric IDs.
+func (t *LabelTracker) NextID() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	highest := 0
+	for id := range t.labels {
+		if n, err := strconv.Atoi(id); err == nil && n > highest {
+			highest = n
+		}
+	}
+	return strconv.Itoa(highest + 1)
+}
+
+// labelIDLess orders numeric label IDs by value and any other ID after them
+func labelIDLess(a, b string) bool {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return na < nb
+	case errA == nil || errB == nil:
+		return errA == nil
+	default:
+		return strings.Compare(a, b) < 0
+	}
+}
+
+// requireBusiness fails unless the client is authenticated as a WhatsApp Business account
+func (c *Client) requireBusiness() error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+	// Only business accounts get a verified business name when pairing
+	if c.client.Store.BusinessName == "" {
+		return whatsapp.ErrNotBusinessSession
+	}
+	return nil
+}
+
+// loadLabels fetches the regular app state collection once, which replays every label edit.
+// Later changes arrive as label edit events.
+func (c *Client) loadLabels(ctx context.Context) error {
+	c.labels.loadMu.Lock()
+	defer c.labels.loadMu.Unlock()
+
+	if c.labels.loaded {
+		return nil
+	}
+
+	if err := c.client.FetchAppState(ctx, appstate.WAPatchRegular, true, false); err != nil {
+		return c.appStateError(types.EmptyJID, err)
+	}
+	c.labels.loaded = true
+
+	c.logger.InfoWithFields("🏷️ Etiquetas sincronizadas", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"labels":     len(c.labels.List()),
+	})
+	return nil
+}
+
+// GetLabels returns the labels of the business account
+func (c *Client) GetLabels(ctx context.Context) ([]*whatsapp.Label, error) {
+	if err := c.requireBusiness(); err != nil {
+		return nil, err
+	}
+	if err := c.loadLabels(ctx); err != nil {
+		return nil, err
+	}
+	return c.labels.List(), nil
+}
+
+// CreateLabel creates a label on all devices of the business account through an app state patch
+func (c *Client) CreateLabel(ctx context.Context, name string, color int) (*whatsapp.Label, error) {
+	if err := c.requireBusiness(); err != nil {
+		return nil, err
+	}
+	if err := whatsapp.ValidateLabel(name, color); err != nil {
+		return nil, err
+	}
+	// The next ID is only known once every existing label is
+	if err := c.loadLabels(ctx); err != nil {
+		return nil, err
+	}
+
+	label := whatsapp.Label{
+		ID:    c.labels.NextID(),
+		Name:  strings.TrimSpace(name),
+		Color: color,
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	patch := appstate.BuildLabelEdit(label.ID, label.Name, int32(label.Color), false)
+	if err := c.client.SendAppState(ctx, patch); err != nil {
+		return nil, c.appStateError(types.EmptyJID, err)
+	}
+	c.labels.Apply(label.ID, patch.Mutations[0].Value.GetLabelEditAction())
+
+	c.logger.InfoWithFields("🏷️ Etiqueta criada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"label_id":   label.ID,
+		"name":       label.Name,
+	})
+
+	return &label, nil
+}
+
+// LabelChat adds a label to or removes it from a chat through an app state patch
+func (c *Client) LabelChat(ctx context.Context, chatJID, labelID string, labeled bool) error {
+	return c.sendLabelAssociation(ctx, chatJID, labelID, labeled, func(chat types.JID) appstate.PatchInfo {
+		return appstate.BuildLabelChat(chat, labelID, labeled)
+	})
+}
+
+// LabelMessage adds a label to or removes it from a message of a chat through an app state patch
+func (c *Client) LabelMessage(ctx context.Context, chatJID, messageID, labelID string, labeled bool) error {
+	return c.sendLabelAssociation(ctx, chatJID, labelID, labeled, func(chat types.JID) appstate.PatchInfo {
+		return appstate.BuildLabelMessage(chat, labelID, messageID, labeled)
+	})
+}
+
+// sendLabelAssociation checks that the label exists and sends the patch labeling the chat or message
+func (c *Client) sendLabelAssociation(ctx context.Context, chatJID, labelID string, labeled bool, build func(types.JID) appstate.PatchInfo) error {
+	if err := c.requireBusiness(); err != nil {
+		return err
+	}
+
+	chat, err := types.ParseJID(chatJID)
+	if err != nil {
+		return whatsapp.ErrInvalidChatJID
+	}
+
+	if err := c.loadLabels(ctx); err != nil {
+		return err
+	}
+	if _, ok := c.labels.Get(labelID); !ok {
+		return whatsapp.ErrLabelNotFound
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	if err := c.client.SendAppState(ctx, build(chat)); err != nil {
+		return c.appStateError(chat, err)
+	}
+
+	c.logger.InfoWithFields("🏷️ Etiqueta do chat atualizada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chat.String(),
+		"label_id":   labelID,
+		"labeled":    labeled,
+	})
+
+	return nil
+}
@@ -3,7 +3,11 @@ package whats
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/internal/domain/whatsapp"
@@ -13,10 +17,30 @@ import (
 	"go.mau.fi/whatsmeow/store/sqlstore"
 )
 
+// sessionReplacedReason is the error reason of a session taken over by another client
+const sessionReplacedReason = "replaced elsewhere"
+
 // SessionEventHandler handles WhatsApp events and updates session state
 type SessionEventHandler struct {
-	sessionRepo session.Repository
-	logger      logger.Logger
+	sessionRepo      session.Repository
+	pairingAuditRepo session.PairingAuditRepository
+	webhook          whatsapp.WebhookHandler // nil when webhooks are disabled
+	logger           logger.Logger
+}
+
+// publish delivers a session event to the webhook receiver without blocking
+func (h *SessionEventHandler) publish(sessionID session.SessionID, eventType whatsapp.EventType, data map[string]interface{}) {
+	if h.webhook == nil {
+		return
+	}
+
+	h.webhook.SendWebhookAsync(&whatsapp.Event{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		SessionID: sessionID,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
 }
 
 // OnConnected handles connection events
@@ -25,13 +49,21 @@ func (h *SessionEventHandler) OnConnected(sessionID session.SessionID, jid strin
 		"session_id": sessionID.String(),
 		"jid":        jid,
 	})
+
+	h.publish(sessionID, whatsapp.EventTypeConnected, map[string]interface{}{
+		"jid": jid,
+	})
 }
 
 // OnDisconnected handles disconnection events
-func (h *SessionEventHandler) OnDisconnected(sessionID session.SessionID, reason string) {
+func (h *SessionEventHandler) OnDisconnected(sessionID session.SessionID, reason session.DisconnectReason) {
 	h.logger.InfoWithFields("📡 Session disconnected - updating status to disconnected", logger.Fields{
 		"session_id": sessionID.String(),
-		"reason":     reason,
+		"reason":     reason.String(),
+	})
+
+	h.publish(sessionID, whatsapp.EventTypeDisconnected, map[string]interface{}{
+		"reason": reason.String(),
 	})
 
 	ctx := context.Background()
@@ -41,20 +73,25 @@ func (h *SessionEventHandler) OnDisconnected(sessionID session.SessionID, reason
 	if err != nil {
 		h.logger.ErrorWithError("Failed to get session for disconnection update", err, logger.Fields{
 			"session_id": sessionID.String(),
-			"reason":     reason,
+			"reason":     reason.String(),
 		})
 		return
 	}
 
-	// Update session status to disconnected
-	sess.Disconnect()
+	// Update session status to disconnected. A logged out device is gone for good,
+	// so its JID is cleared as well.
+	if reason == session.DisconnectReasonLoggedOut {
+		sess.Logout()
+	} else {
+		sess.DisconnectWithReason(reason)
+	}
 
 	// Clear QR code if it exists (since connection failed)
 	if sess.QRCode() != "" {
 		sess.ClearQRCode()
 		h.logger.InfoWithFields("🧹 Clearing QR code due to disconnection", logger.Fields{
 			"session_id": sessionID.String(),
-			"reason":     reason,
+			"reason":     reason.String(),
 		})
 	}
 
@@ -62,15 +99,16 @@ func (h *SessionEventHandler) OnDisconnected(sessionID session.SessionID, reason
 	if err := h.sessionRepo.Update(ctx, sess); err != nil {
 		h.logger.ErrorWithError("Failed to save session disconnection status", err, logger.Fields{
 			"session_id": sessionID.String(),
-			"reason":     reason,
+			"reason":     reason.String(),
 		})
 		return
 	}
 
 	h.logger.InfoWithFields("✅ Session status updated to disconnected", logger.Fields{
-		"session_id": sessionID.String(),
-		"reason":     reason,
-		"status":     sess.Status().String(),
+		"session_id":    sessionID.String(),
+		"session_label": sess.Label(),
+		"reason":        reason.String(),
+		"status":        sess.Status().String(),
 	})
 }
 
@@ -81,6 +119,10 @@ func (h *SessionEventHandler) OnQRCode(sessionID session.SessionID, qrCode strin
 		"qr_length":  len(qrCode),
 	})
 
+	h.publish(sessionID, whatsapp.EventTypeQRCode, map[string]interface{}{
+		"qr_code": qrCode,
+	})
+
 	ctx := context.Background()
 
 	// Get session from database
@@ -92,7 +134,7 @@ func (h *SessionEventHandler) OnQRCode(sessionID session.SessionID, qrCode strin
 		return
 	}
 
-	// Update session with QR code
+	// Update session with QR code, which expires after session.QRCodeLifetime
 	sess.SetQRCode(qrCode)
 
 	// Save updated session
@@ -106,6 +148,7 @@ func (h *SessionEventHandler) OnQRCode(sessionID session.SessionID, qrCode strin
 	h.logger.InfoWithFields("✅ QR code saved to database successfully", logger.Fields{
 		"session_id": sessionID.String(),
 		"qr_length":  len(qrCode),
+		"expires_at": sess.QRCodeExpiresAt(),
 	})
 }
 
@@ -116,6 +159,10 @@ func (h *SessionEventHandler) OnAuthenticated(sessionID session.SessionID, jid s
 		"jid":        jid,
 	})
 
+	h.publish(sessionID, whatsapp.EventTypeAuthenticated, map[string]interface{}{
+		"jid": jid,
+	})
+
 	ctx := context.Background()
 
 	// Get session from database
@@ -150,9 +197,10 @@ func (h *SessionEventHandler) OnAuthenticated(sessionID session.SessionID, jid s
 	}
 
 	h.logger.InfoWithFields("✅ Session JID saved and QR code cleared successfully", logger.Fields{
-		"session_id": sessionID.String(),
-		"jid":        jid,
-		"status":     sess.Status().String(),
+		"session_id":    sessionID.String(),
+		"session_label": sess.Label(),
+		"jid":           jid,
+		"status":        sess.Status().String(),
 	})
 }
 
@@ -162,6 +210,33 @@ func (h *SessionEventHandler) OnAuthenticationFailed(sessionID session.SessionID
 		"session_id": sessionID.String(),
 		"reason":     reason,
 	})
+
+	h.publish(sessionID, whatsapp.EventTypeAuthenticationFailed, map[string]interface{}{
+		"reason": reason,
+	})
+}
+
+// OnPairingAttempt records pairing attempts in the audit log
+func (h *SessionEventHandler) OnPairingAttempt(sessionID session.SessionID, attempt *session.PairingAttempt) {
+	h.logger.InfoWithFields("📝 Pairing attempt - saving audit entry", logger.Fields{
+		"session_id": sessionID.String(),
+		"outcome":    attempt.Outcome.String(),
+		"method":     attempt.Method.String(),
+		"jid":        attempt.JID,
+		"request_id": attempt.RequestID,
+		"reason":     attempt.Reason,
+	})
+
+	if h.pairingAuditRepo == nil {
+		return
+	}
+
+	if err := h.pairingAuditRepo.Create(context.Background(), attempt); err != nil {
+		h.logger.ErrorWithError("Failed to save pairing audit entry", err, logger.Fields{
+			"session_id": sessionID.String(),
+			"outcome":    attempt.Outcome.String(),
+		})
+	}
 }
 
 // OnMessage handles message events
@@ -170,6 +245,16 @@ func (h *SessionEventHandler) OnMessage(sessionID session.SessionID, message *wh
 		"session_id": sessionID.String(),
 		"message_id": message.ID,
 	})
+
+	h.publish(sessionID, whatsapp.EventTypeMessage, map[string]interface{}{
+		"id":        message.ID,
+		"from":      message.Sender,
+		"chat":      message.Chat,
+		"type":      message.Type.String(),
+		"body":      message.Body,
+		"from_me":   message.IsFromMe,
+		"timestamp": message.Timestamp,
+	})
 }
 
 // OnError handles error events
@@ -177,6 +262,130 @@ func (h *SessionEventHandler) OnError(sessionID session.SessionID, err error) {
 	h.logger.ErrorWithError("💥 Session error", err, logger.Fields{
 		"session_id": sessionID.String(),
 	})
+
+	h.publish(sessionID, whatsapp.EventTypeError, map[string]interface{}{
+		"error": err.Error(),
+	})
+}
+
+// OnConnectionFailed moves the session into the error state
+func (h *SessionEventHandler) OnConnectionFailed(sessionID session.SessionID, err error) {
+	h.OnError(sessionID, err)
+
+	ctx := context.Background()
+
+	sess, getErr := h.sessionRepo.GetByID(ctx, sessionID)
+	if getErr != nil {
+		h.logger.ErrorWithError("Failed to get session for error status update", getErr, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return
+	}
+
+	sess.SetError(err.Error())
+	if sess.QRCode() != "" {
+		sess.ClearQRCode()
+	}
+
+	if updateErr := h.sessionRepo.Update(ctx, sess); updateErr != nil {
+		h.logger.ErrorWithError("Failed to save session error status", updateErr, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return
+	}
+
+	h.logger.WarnWithFields("⚠️ Session status updated to error", logger.Fields{
+		"session_id":    sessionID.String(),
+		"session_label": sess.Label(),
+		"reason":        sess.ErrorReason(),
+	})
+}
+
+// OnSessionReplaced moves a session taken over by another client into the error state
+func (h *SessionEventHandler) OnSessionReplaced(sessionID session.SessionID) {
+	h.logger.WarnWithFields("🔀 Session replaced elsewhere", logger.Fields{
+		"session_id": sessionID.String(),
+	})
+
+	h.publish(sessionID, whatsapp.EventTypeSessionReplaced, map[string]interface{}{
+		"reason": sessionReplacedReason,
+	})
+
+	ctx := context.Background()
+
+	sess, err := h.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		h.logger.ErrorWithError("Failed to get session for replaced status update", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return
+	}
+
+	sess.SetError(sessionReplacedReason)
+	if err := h.sessionRepo.Update(ctx, sess); err != nil {
+		h.logger.ErrorWithError("Failed to save session replaced status", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+	}
+}
+
+// OnHealthIssue handles conditions that degrade a connected session
+func (h *SessionEventHandler) OnHealthIssue(sessionID session.SessionID, issue *whatsapp.HealthIssue) {
+	h.logger.ErrorWithFields("🩺 Session health degraded", logger.Fields{
+		"session_id":  sessionID.String(),
+		"issue":       issue.Kind.String(),
+		"error":       issue.Error,
+		"occurrences": issue.Count,
+		"remediation": issue.Remediation,
+	})
+
+	h.publish(sessionID, whatsapp.EventTypeHealthIssue, map[string]interface{}{
+		"kind":        issue.Kind.String(),
+		"error":       issue.Error,
+		"remediation": issue.Remediation,
+		"count":       issue.Count,
+	})
+}
+
+// OnPollVote handles votes cast on polls
+func (h *SessionEventHandler) OnPollVote(sessionID session.SessionID, vote *whatsapp.PollVote) {
+	h.logger.InfoWithFields("🗳️ Poll vote received", logger.Fields{
+		"session_id": sessionID.String(),
+		"poll_id":    vote.PollMessageID,
+		"voter":      vote.VoterJID,
+	})
+
+	h.publish(sessionID, whatsapp.EventTypePollVote, map[string]interface{}{
+		"poll_id":          vote.PollMessageID,
+		"chat":             vote.ChatJID,
+		"voter":            vote.VoterJID,
+		"selected_options": vote.SelectedOptions,
+		"option_hashes":    vote.OptionHashes,
+		"timestamp":        vote.Timestamp,
+	})
+}
+
+// OnChatStateChanged handles chats muted, pinned or archived on another device
+func (h *SessionEventHandler) OnChatStateChanged(sessionID session.SessionID, change *whatsapp.ChatStateChange) {
+	h.logger.InfoWithFields("📌 Chat state changed", logger.Fields{
+		"session_id": sessionID.String(),
+		"chat":       change.State.JID,
+		"change":     string(change.Kind),
+	})
+
+	data := map[string]interface{}{
+		"chat":      change.State.JID,
+		"change":    string(change.Kind),
+		"archived":  change.State.Archived,
+		"pinned":    change.State.Pinned,
+		"muted":     change.State.IsMuted(time.Now()),
+		"timestamp": change.Timestamp,
+	}
+	if !change.State.MutedUntil.IsZero() {
+		data["muted_until"] = change.State.MutedUntil
+	}
+
+	h.publish(sessionID, whatsapp.EventTypeChatStateChanged, data)
 }
 
 // Manager implements whatsapp.Manager with whatsmeow integration
@@ -185,27 +394,70 @@ type Manager struct {
 	logger       logger.Logger
 	container    *sqlstore.Container
 	sessionRepo  session.Repository
+	messageRepo  whatsapp.MessageRepository
+	receiptRepo  whatsapp.ReceiptRepository
+	chatRepo     whatsapp.ChatRepository
+	sendStats    *SendStats
+	receiveStats *ReceiveStats
 	clients      map[session.SessionID]whatsapp.Client
 	clientsMutex sync.RWMutex
 	isRunning    bool
-	eventHandler whatsapp.EventHandler
+
+	// Sessions with a connect, restart or reconnect in flight
+	connecting      map[session.SessionID]bool
+	connectingMutex sync.Mutex
+
+	// Handlers notified of the events of every client; the chain itself never changes
+	eventHandlers *EventHandlerChain
+
+	// Background correction of persisted statuses (nil when disabled)
+	reconciler *statusReconciler
+
+	// Background sending of scheduled messages (nil when disabled)
+	scheduledRepo whatsapp.ScheduledMessageRepository
+	scheduler     *messageScheduler
+
+	// Background deletion of stored messages past the retention (nil when disabled)
+	pruner *messagePruner
+
+	// Device identity announced by every client when pairing
+	identity DeviceIdentity
+
+	// How long clients wait for whatsmeow to reconnect before reporting a disconnection;
+	// guarded by clientsMutex as it can be changed while clients are created
+	disconnectGrace time.Duration
 }
 
 // NewManager creates a new WhatsApp manager
-func NewManager(cfg *config.WhatsAppConfig, container *sqlstore.Container, sessionRepo session.Repository, log logger.Logger) whatsapp.Manager {
+func NewManager(cfg *config.WhatsAppConfig, container *sqlstore.Container, sessionRepo session.Repository, pairingAuditRepo session.PairingAuditRepository, messageRepo whatsapp.MessageRepository, receiptRepo whatsapp.ReceiptRepository, chatRepo whatsapp.ChatRepository, scheduledRepo whatsapp.ScheduledMessageRepository, webhook whatsapp.WebhookHandler, log logger.Logger) whatsapp.Manager {
 	manager := &Manager{
-		config:      cfg,
-		logger:      log,
-		container:   container,
-		sessionRepo: sessionRepo,
-		clients:     make(map[session.SessionID]whatsapp.Client),
-	}
-
-	// Configure global event handler to save JID on authentication
-	manager.eventHandler = &SessionEventHandler{
-		sessionRepo: sessionRepo,
-		logger:      log,
-	}
+		config:        cfg,
+		logger:        log,
+		container:     container,
+		sessionRepo:   sessionRepo,
+		messageRepo:   messageRepo,
+		receiptRepo:   receiptRepo,
+		chatRepo:      chatRepo,
+		scheduledRepo: scheduledRepo,
+		sendStats:     NewSendStats(),
+		receiveStats:  NewReceiveStats(),
+		clients:       make(map[session.SessionID]whatsapp.Client),
+		connecting:    make(map[session.SessionID]bool),
+		identity:      deviceIdentityFromConfig(cfg),
+
+		disconnectGrace: cfg.DisconnectGracePeriod,
+	}
+
+	// The device identity is process-wide in whatsmeow and must be set before clients connect
+	manager.identity.apply()
+
+	// The session handler keeps sessions in sync with their clients, before any other handler
+	manager.eventHandlers = NewEventHandlerChain(&SessionEventHandler{
+		sessionRepo:      sessionRepo,
+		pairingAuditRepo: pairingAuditRepo,
+		webhook:          webhook,
+		logger:           log,
+	})
 
 	return manager
 }
@@ -215,15 +467,66 @@ func (m *Manager) Start(ctx context.Context) error {
 	m.logger.Info("starting WhatsApp manager (simple implementation)")
 
 	m.isRunning = true
+
+	if m.config.StatusSyncInterval > 0 {
+		m.reconciler = newStatusReconciler(m, m.config.StatusSyncInterval, m.logger)
+		go m.reconciler.run()
+
+		m.logger.InfoWithFields("session status reconciliation enabled", logger.Fields{
+			"interval": m.config.StatusSyncInterval.String(),
+		})
+	}
+
+	if m.config.SchedulerInterval > 0 && m.scheduledRepo != nil {
+		m.scheduler = newMessageScheduler(m, m.scheduledRepo, m.config.SchedulerInterval, m.logger)
+		go m.scheduler.run()
+
+		m.logger.InfoWithFields("scheduled message sending enabled", logger.Fields{
+			"interval": m.config.SchedulerInterval.String(),
+		})
+	}
+
+	if m.config.MessageRetention > 0 && m.messageRepo != nil && m.receiptRepo != nil && m.chatRepo != nil {
+		m.pruner = newMessagePruner(m, m.config.MessageRetention, messagePruneInterval, m.logger)
+		go m.pruner.run()
+
+		m.logger.InfoWithFields("stored message retention enabled", logger.Fields{
+			"retention": m.config.MessageRetention.String(),
+		})
+	}
+
 	m.logger.Info("WhatsApp manager started successfully")
 
 	return nil
 }
 
-// Stop shuts down the manager
-func (m *Manager) Stop() error {
+// sendDrainer is implemented by clients that track their in-flight message sends
+type sendDrainer interface {
+	pendingSends() int
+	waitForSends(ctx context.Context) int
+}
+
+// Stop shuts down the manager. In-flight message sends are given until ctx is done
+// to finish before the clients are disconnected; sends still running then are abandoned.
+func (m *Manager) Stop(ctx context.Context) error {
 	m.logger.Info("stopping WhatsApp manager")
 
+	// Stop reconciling, sending scheduled messages and pruning before the clients go away
+	if m.reconciler != nil {
+		m.reconciler.shutdown()
+		m.reconciler = nil
+	}
+	if m.scheduler != nil {
+		m.scheduler.shutdown()
+		m.scheduler = nil
+	}
+	if m.pruner != nil {
+		m.pruner.shutdown()
+		m.pruner = nil
+	}
+
+	m.drainSends(ctx)
+
 	m.clientsMutex.Lock()
 	defer m.clientsMutex.Unlock()
 
@@ -244,6 +547,45 @@ func (m *Manager) Stop() error {
 	return nil
 }
 
+// drainSends waits until the clients finish their in-flight message sends or ctx is done
+func (m *Manager) drainSends(ctx context.Context) {
+	m.clientsMutex.RLock()
+	drainers := make([]sendDrainer, 0, len(m.clients))
+	for _, client := range m.clients {
+		if drainer, ok := client.(sendDrainer); ok {
+			drainers = append(drainers, drainer)
+		}
+	}
+	m.clientsMutex.RUnlock()
+
+	pending := 0
+	for _, drainer := range drainers {
+		pending += drainer.pendingSends()
+	}
+	if pending == 0 {
+		return
+	}
+
+	m.logger.InfoWithFields("waiting for in-flight message sends", logger.Fields{
+		"pending": pending,
+	})
+
+	abandoned := 0
+	for _, drainer := range drainers {
+		abandoned += drainer.waitForSends(ctx)
+	}
+
+	fields := logger.Fields{
+		"drained":   max(pending-abandoned, 0),
+		"abandoned": abandoned,
+	}
+	if abandoned > 0 {
+		m.logger.WarnWithFields("shutdown deadline reached with message sends in flight", fields)
+		return
+	}
+	m.logger.InfoWithFields("in-flight message sends drained", fields)
+}
+
 // IsRunning returns true if the manager is running
 func (m *Manager) IsRunning() bool {
 	return m.isRunning
@@ -268,12 +610,23 @@ func (m *Manager) createClientWithContext(ctx context.Context, sessionID session
 		return client, nil
 	}
 
+	// The limit is checked under the mutex so concurrent connects cannot both take the last slot
+	if m.config.MaxSessions > 0 && len(m.clients) >= m.config.MaxSessions {
+		m.logger.WarnWithFields("🚫 Limite de sessões simultâneas atingido", logger.Fields{
+			"session_id":   sessionID.String(),
+			"max_sessions": m.config.MaxSessions,
+		})
+		return nil, fmt.Errorf("%w: maximum of %d concurrent sessions (MAX_SESSIONS)", whatsapp.ErrSessionLimitReached, m.config.MaxSessions)
+	}
+
 	// Get saved JID and proxy URL from database for proper device management
 	savedJID := ""
 	proxyURL := ""
+	var allowedSenders []string
 	if sess, err := m.sessionRepo.GetByID(ctx, sessionID); err == nil {
 		savedJID = sess.WaJID()
 		proxyURL = sess.ProxyURL()
+		allowedSenders = sess.AllowedSenders()
 		m.logger.InfoWithFields("Retrieved session data for client creation", logger.Fields{
 			"session_id": sessionID.String(),
 			"jid":        savedJID,
@@ -287,15 +640,25 @@ func (m *Manager) createClientWithContext(ctx context.Context, sessionID session
 	}
 
 	// Create new client using whatsmeow with proper device management and proxy
-	client, err := NewClient(sessionID, m.container, savedJID, proxyURL, m.logger)
+	client, err := NewClient(sessionID, m.container, savedJID, proxyURL, ClientOptions{
+		MessageRepo:     m.messageRepo,
+		ReceiptRepo:     m.receiptRepo,
+		ChatRepo:        m.chatRepo,
+		SendStats:       m.sendStats,
+		ReceiveStats:    m.receiveStats,
+		DisconnectGrace: m.disconnectGrace,
+		QRWaitTimeout:   m.config.QRWaitTimeout,
+		QRImage:         QRImageOptions{Size: m.config.QRSize, Level: m.config.QRLevel},
+		Recent:          RecentMessageOptions{Size: m.config.RecentMessagesSize, Retention: m.config.RecentMessagesRetention},
+		Identity:        m.identity,
+	}, m.logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create whatsmeow client: %w", err)
 	}
 
-	// Set global event handler if available
-	if m.eventHandler != nil {
-		client.SetEventHandler(m.eventHandler)
-	}
+	client.AddEventHandler(m.eventHandlers)
+
+	client.SetAllowedSenders(allowedSenders)
 
 	// Store client
 	m.clients[sessionID] = client
@@ -339,6 +702,8 @@ func (m *Manager) RemoveClient(sessionID session.SessionID) error {
 
 	// Remove from map
 	delete(m.clients, sessionID)
+	m.sendStats.Forget(sessionID)
+	m.receiveStats.Forget(sessionID)
 
 	m.logger.InfoWithFields("WhatsApp client removed", logger.Fields{
 		"session_id": sessionID.String(),
@@ -369,30 +734,21 @@ func (m *Manager) HealthCheck() error {
 	return nil
 }
 
-// SetGlobalEventHandler sets a global event handler for all clients
+// SetGlobalEventHandler replaces the handlers added to all clients with handler.
+// The session handler that keeps sessions in sync always stays in place.
 func (m *Manager) SetGlobalEventHandler(handler whatsapp.EventHandler) {
-	m.eventHandler = handler
-
-	// Apply to existing clients
-	m.clientsMutex.RLock()
-	defer m.clientsMutex.RUnlock()
+	m.eventHandlers.Replace(handler)
+}
 
-	for _, client := range m.clients {
-		client.SetEventHandler(handler)
-	}
+// AddGlobalEventHandler adds a handler notified of the events of all clients, after the
+// session handler and the handlers added before it, and returns a function that removes it
+func (m *Manager) AddGlobalEventHandler(handler whatsapp.EventHandler) (remove func()) {
+	return m.eventHandlers.Add(handler)
 }
 
-// RemoveGlobalEventHandler removes the global event handler
+// RemoveGlobalEventHandler removes every handler added to all clients, keeping the session handler
 func (m *Manager) RemoveGlobalEventHandler() {
-	m.eventHandler = nil
-
-	// Remove from existing clients
-	m.clientsMutex.RLock()
-	defer m.clientsMutex.RUnlock()
-
-	for _, client := range m.clients {
-		client.RemoveEventHandler()
-	}
+	m.eventHandlers.Replace(nil)
 }
 
 // GetStats returns manager statistics
@@ -401,7 +757,10 @@ func (m *Manager) GetStats() *whatsapp.ManagerStats {
 	defer m.clientsMutex.RUnlock()
 
 	stats := &whatsapp.ManagerStats{
-		TotalClients: len(m.clients),
+		TotalClients:     len(m.clients),
+		MaxSessions:      m.config.MaxSessions,
+		MessagesSent:     m.sendStats.Snapshot().Total(),
+		MessagesReceived: m.receiveStats.Total(),
 	}
 
 	for _, client := range m.clients {
@@ -419,6 +778,25 @@ func (m *Manager) GetStats() *whatsapp.ManagerStats {
 	return stats
 }
 
+// SetDisconnectGracePeriod changes how long every client, live or created later, waits
+// for whatsmeow to reconnect before reporting a disconnection
+func (m *Manager) SetDisconnectGracePeriod(grace time.Duration) {
+	m.clientsMutex.Lock()
+	defer m.clientsMutex.Unlock()
+
+	m.disconnectGrace = grace
+	for _, client := range m.clients {
+		if c, ok := client.(*Client); ok {
+			c.disconnects.setGrace(grace)
+		}
+	}
+}
+
+// GetMessageSendStats returns the sent message counters by type, in total and per session
+func (m *Manager) GetMessageSendStats() *whatsapp.MessageSendStats {
+	return m.sendStats.Snapshot()
+}
+
 // GetClientStats returns statistics for a specific client
 func (m *Manager) GetClientStats(sessionID session.SessionID) (*whatsapp.ClientStats, error) {
 	client, err := m.GetClient(sessionID)
@@ -427,54 +805,91 @@ func (m *Manager) GetClientStats(sessionID session.SessionID) (*whatsapp.ClientS
 	}
 
 	return &whatsapp.ClientStats{
-		SessionID: sessionID,
-		Status:    client.GetConnectionStatus(),
-		JID:       client.GetJID(),
+		SessionID:        sessionID,
+		Status:           client.GetConnectionStatus(),
+		JID:              client.GetJID(),
+		MessagesSent:     m.sendStats.Count(sessionID),
+		MessagesReceived: m.receiveStats.Count(sessionID),
 	}, nil
 }
 
-// ConnectAll connects all clients
-func (m *Manager) ConnectAll(ctx context.Context) error {
-	m.clientsMutex.RLock()
-	clients := make([]whatsapp.Client, 0, len(m.clients))
-	for _, client := range m.clients {
-		clients = append(clients, client)
-	}
-	m.clientsMutex.RUnlock()
+// ConnectAll connects all clients that are not connected and reports the outcome per client
+func (m *Manager) ConnectAll(ctx context.Context) ([]whatsapp.BulkResult, error) {
+	clients := m.sortedClients()
+	results := make([]whatsapp.BulkResult, 0, len(clients))
 
 	for _, client := range clients {
+		result := whatsapp.BulkResult{SessionID: client.GetSessionID()}
+
 		if !client.IsConnected() {
-			if _, err := client.Connect(ctx); err != nil {
-				m.logger.ErrorWithError("failed to connect client", err, logger.Fields{
-					"session_id": client.GetSessionID().String(),
-				})
-			}
+			result.Changed, result.Error = m.connectClient(ctx, client)
 		}
+
+		result.Status = client.GetConnectionStatus()
+		results = append(results, result)
 	}
 
-	return nil
+	return results, nil
 }
 
-// DisconnectAll disconnects all clients
-func (m *Manager) DisconnectAll(ctx context.Context) error {
-	m.clientsMutex.RLock()
-	clients := make([]whatsapp.Client, 0, len(m.clients))
-	for _, client := range m.clients {
-		clients = append(clients, client)
+// connectClient connects a client unless another connect of the same session is in flight
+func (m *Manager) connectClient(ctx context.Context, client whatsapp.Client) (bool, error) {
+	release, err := m.AcquireConnect(client.GetSessionID())
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	if _, err := client.Connect(ctx); err != nil {
+		m.logger.ErrorWithError("failed to connect client", err, logger.Fields{
+			"session_id": client.GetSessionID().String(),
+		})
+		return false, err
 	}
-	m.clientsMutex.RUnlock()
+
+	return true, nil
+}
+
+// DisconnectAll disconnects all connected clients and reports the outcome per client
+func (m *Manager) DisconnectAll(ctx context.Context) ([]whatsapp.BulkResult, error) {
+	clients := m.sortedClients()
+	results := make([]whatsapp.BulkResult, 0, len(clients))
 
 	for _, client := range clients {
+		result := whatsapp.BulkResult{SessionID: client.GetSessionID()}
+
 		if client.IsConnected() {
 			if err := client.Disconnect(ctx); err != nil {
 				m.logger.ErrorWithError("failed to disconnect client", err, logger.Fields{
 					"session_id": client.GetSessionID().String(),
 				})
+				result.Error = err
+			} else {
+				result.Changed = true
 			}
 		}
+
+		result.Status = client.GetConnectionStatus()
+		results = append(results, result)
 	}
 
-	return nil
+	return results, nil
+}
+
+// sortedClients returns a snapshot of the clients ordered by session ID
+func (m *Manager) sortedClients() []whatsapp.Client {
+	m.clientsMutex.RLock()
+	clients := make([]whatsapp.Client, 0, len(m.clients))
+	for _, client := range m.clients {
+		clients = append(clients, client)
+	}
+	m.clientsMutex.RUnlock()
+
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].GetSessionID().String() < clients[j].GetSessionID().String()
+	})
+
+	return clients
 }
 
 // RestartClient restarts a specific client
@@ -484,6 +899,12 @@ func (m *Manager) RestartClient(sessionID session.SessionID) error {
 		return err
 	}
 
+	release, err := m.AcquireConnect(sessionID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	ctx := context.Background()
 
 	// Disconnect first
@@ -503,6 +924,27 @@ func (m *Manager) RestartClient(sessionID session.SessionID) error {
 	return nil
 }
 
+// AcquireConnect reserves driving Connect for a session until release is called.
+// Concurrent connects can corrupt the pairing state, so a second caller gets ErrConnectInProgress.
+func (m *Manager) AcquireConnect(sessionID session.SessionID) (func(), error) {
+	m.connectingMutex.Lock()
+	defer m.connectingMutex.Unlock()
+
+	if m.connecting[sessionID] {
+		return nil, whatsapp.ErrConnectInProgress
+	}
+	m.connecting[sessionID] = true
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.connectingMutex.Lock()
+			delete(m.connecting, sessionID)
+			m.connectingMutex.Unlock()
+		})
+	}, nil
+}
+
 // GetHealthStatus returns health status for all clients
 func (m *Manager) GetHealthStatus() map[session.SessionID]bool {
 	m.clientsMutex.RLock()
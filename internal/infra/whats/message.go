@@ -0,0 +1,285 @@
+package whats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// storeMessage persists a received or sent message so its media can be downloaded later
+func (c *Client) storeMessage(evt *events.Message) {
+	if c.messageRepo == nil {
+		return
+	}
+
+	message := toStoredMessage(c.sessionID, evt)
+	if err := c.messageRepo.Save(context.Background(), message); err != nil {
+		c.logger.ErrorWithFields("💥 Falha ao salvar mensagem", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"message_id": message.ID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// acceptsMessage applies the session sender allow-list; own messages are always accepted
+func (c *Client) acceptsMessage(evt *events.Message) bool {
+	allowed := c.allowedSenders.Load()
+	if allowed == nil || evt.Info.IsFromMe {
+		return true
+	}
+
+	// The sender may be addressed by phone number or LID; match either
+	senders := []string{evt.Info.Sender.ToNonAD().String()}
+	if !evt.Info.SenderAlt.IsEmpty() {
+		senders = append(senders, evt.Info.SenderAlt.ToNonAD().String())
+	}
+	if session.SenderAllowed(*allowed, senders...) {
+		return true
+	}
+
+	c.logger.DebugWithFields("🚫 Mensagem ignorada - remetente fora da lista permitida", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"message_id": evt.Info.ID,
+		"sender":     evt.Info.Sender.String(),
+	})
+	return false
+}
+
+// notifyMessage passes an accepted message to the event handler
+func (c *Client) notifyMessage(evt *events.Message) {
+	handler := c.handler()
+	if handler == nil {
+		return
+	}
+
+	handler.OnMessage(c.sessionID, toMessage(c.sessionID, evt))
+}
+
+// toMessage converts a whatsmeow message event to the message passed to event handlers
+func toMessage(sessionID session.SessionID, evt *events.Message) *whatsapp.Message {
+	stored := toStoredMessage(sessionID, evt)
+	return &whatsapp.Message{
+		ID:        stored.ID,
+		Chat:      stored.ChatJID,
+		Sender:    stored.SenderJID,
+		Body:      stored.Body,
+		Type:      stored.Type,
+		Timestamp: stored.Timestamp,
+		IsFromMe:  stored.IsFromMe,
+	}
+}
+
+// toStoredMessage converts a whatsmeow message event to a domain stored message
+func toStoredMessage(sessionID session.SessionID, evt *events.Message) *whatsapp.StoredMessage {
+	message := &whatsapp.StoredMessage{
+		SessionID: sessionID,
+		ID:        evt.Info.ID,
+		ChatJID:   evt.Info.Chat.String(),
+		SenderJID: evt.Info.Sender.ToNonAD().String(),
+		IsFromMe:  evt.Info.IsFromMe,
+		Type:      whatsapp.MessageTypeText,
+		Timestamp: evt.Info.Timestamp,
+		CreatedAt: time.Now(),
+	}
+
+	msg := evt.Message
+	switch {
+	case msg.GetImageMessage() != nil:
+		img := msg.GetImageMessage()
+		message.Type = whatsapp.MessageTypeImage
+		message.Body = img.GetCaption()
+		message.Media = mediaInfoFrom(img, img.GetMimetype(), "")
+	case msg.GetVideoMessage() != nil:
+		video := msg.GetVideoMessage()
+		message.Type = whatsapp.MessageTypeVideo
+		message.Body = video.GetCaption()
+		message.Media = mediaInfoFrom(video, video.GetMimetype(), "")
+	case msg.GetAudioMessage() != nil:
+		audio := msg.GetAudioMessage()
+		message.Type = whatsapp.MessageTypeAudio
+		message.Media = mediaInfoFrom(audio, audio.GetMimetype(), "")
+	case msg.GetDocumentMessage() != nil:
+		doc := msg.GetDocumentMessage()
+		message.Type = whatsapp.MessageTypeDocument
+		message.Body = doc.GetCaption()
+		message.Media = mediaInfoFrom(doc, doc.GetMimetype(), doc.GetFileName())
+	case msg.GetStickerMessage() != nil:
+		sticker := msg.GetStickerMessage()
+		message.Type = whatsapp.MessageTypeSticker
+		message.Media = mediaInfoFrom(sticker, sticker.GetMimetype(), "")
+	case msg.GetLocationMessage() != nil:
+		message.Type = whatsapp.MessageTypeLocation
+		message.Body = msg.GetLocationMessage().GetName()
+	case msg.GetContactMessage() != nil:
+		message.Type = whatsapp.MessageTypeContact
+		message.Body = msg.GetContactMessage().GetDisplayName()
+	case pollCreationOf(msg) != nil:
+		message.Type = whatsapp.MessageTypePoll
+		message.Body = pollCreationOf(msg).GetName()
+	case msg.GetButtonsMessage() != nil:
+		message.Type = whatsapp.MessageTypeButtons
+		message.Body = msg.GetButtonsMessage().GetContentText()
+	case msg.GetListMessage() != nil:
+		message.Type = whatsapp.MessageTypeList
+		message.Body = msg.GetListMessage().GetDescription()
+	case msg.GetExtendedTextMessage() != nil:
+		message.Body = msg.GetExtendedTextMessage().GetText()
+	default:
+		message.Body = msg.GetConversation()
+	}
+
+	return message
+}
+
+// downloadableMedia is implemented by every whatsmeow media message that carries a URL
+type downloadableMedia interface {
+	whatsmeow.DownloadableMessage
+	GetURL() string
+	GetFileLength() uint64
+}
+
+// mediaInfoFrom extracts the download metadata of a media message
+func mediaInfoFrom(media downloadableMedia, mimeType, fileName string) *whatsapp.MediaInfo {
+	return &whatsapp.MediaInfo{
+		MimeType:      mimeType,
+		FileName:      fileName,
+		FileLength:    media.GetFileLength(),
+		URL:           media.GetURL(),
+		DirectPath:    media.GetDirectPath(),
+		MediaKey:      media.GetMediaKey(),
+		FileSHA256:    media.GetFileSHA256(),
+		FileEncSHA256: media.GetFileEncSHA256(),
+	}
+}
+
+// toDownloadable rebuilds the whatsmeow media message matching the stored message type
+func toDownloadable(message *whatsapp.StoredMessage) (whatsmeow.DownloadableMessage, error) {
+	media := message.Media
+
+	switch message.Type {
+	case whatsapp.MessageTypeImage:
+		return &waE2E.ImageMessage{
+			URL:           proto.String(media.URL),
+			DirectPath:    proto.String(media.DirectPath),
+			MediaKey:      media.MediaKey,
+			FileSHA256:    media.FileSHA256,
+			FileEncSHA256: media.FileEncSHA256,
+			FileLength:    proto.Uint64(media.FileLength),
+			Mimetype:      proto.String(media.MimeType),
+		}, nil
+	case whatsapp.MessageTypeVideo:
+		return &waE2E.VideoMessage{
+			URL:           proto.String(media.URL),
+			DirectPath:    proto.String(media.DirectPath),
+			MediaKey:      media.MediaKey,
+			FileSHA256:    media.FileSHA256,
+			FileEncSHA256: media.FileEncSHA256,
+			FileLength:    proto.Uint64(media.FileLength),
+			Mimetype:      proto.String(media.MimeType),
+		}, nil
+	case whatsapp.MessageTypeAudio:
+		return &waE2E.AudioMessage{
+			URL:           proto.String(media.URL),
+			DirectPath:    proto.String(media.DirectPath),
+			MediaKey:      media.MediaKey,
+			FileSHA256:    media.FileSHA256,
+			FileEncSHA256: media.FileEncSHA256,
+			FileLength:    proto.Uint64(media.FileLength),
+			Mimetype:      proto.String(media.MimeType),
+		}, nil
+	case whatsapp.MessageTypeDocument:
+		return &waE2E.DocumentMessage{
+			URL:           proto.String(media.URL),
+			DirectPath:    proto.String(media.DirectPath),
+			MediaKey:      media.MediaKey,
+			FileSHA256:    media.FileSHA256,
+			FileEncSHA256: media.FileEncSHA256,
+			FileLength:    proto.Uint64(media.FileLength),
+			Mimetype:      proto.String(media.MimeType),
+			FileName:      proto.String(media.FileName),
+		}, nil
+	case whatsapp.MessageTypeSticker:
+		return &waE2E.StickerMessage{
+			URL:           proto.String(media.URL),
+			DirectPath:    proto.String(media.DirectPath),
+			MediaKey:      media.MediaKey,
+			FileSHA256:    media.FileSHA256,
+			FileEncSHA256: media.FileEncSHA256,
+			FileLength:    proto.Uint64(media.FileLength),
+			Mimetype:      proto.String(media.MimeType),
+		}, nil
+	default:
+		return nil, whatsapp.ErrMessageHasNoMedia
+	}
+}
+
+// DownloadMedia downloads and decrypts the media attachment of a stored message
+func (c *Client) DownloadMedia(ctx context.Context, messageID string) (*whatsapp.MediaContent, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+	if c.messageRepo == nil {
+		return nil, whatsapp.ErrMessageNotFound
+	}
+
+	message, err := c.messageRepo.GetByID(ctx, c.sessionID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if !message.HasMedia() {
+		return nil, whatsapp.ErrMessageHasNoMedia
+	}
+
+	downloadable, err := toDownloadable(message)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.client.Download(ctx, downloadable)
+	switch {
+	case err == nil:
+	case errors.Is(err, whatsmeow.ErrFileLengthMismatch), errors.Is(err, whatsmeow.ErrInvalidMediaSHA256):
+		// Validation warnings still come with the decrypted data
+		c.logger.WarnWithFields("⚠️ Mídia baixada com aviso de validação", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"message_id": messageID,
+			"warning":    err.Error(),
+		})
+	case errors.Is(err, whatsmeow.ErrMediaDownloadFailedWith403),
+		errors.Is(err, whatsmeow.ErrMediaDownloadFailedWith404),
+		errors.Is(err, whatsmeow.ErrMediaDownloadFailedWith410):
+		return nil, whatsapp.ErrMediaUnavailable
+	default:
+		return nil, fmt.Errorf("failed to download media: %w", err)
+	}
+
+	mimeType := message.Media.MimeType
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+
+	c.logger.InfoWithFields("📥 Mídia baixada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"message_id": messageID,
+		"type":       message.Type.String(),
+		"size":       len(data),
+	})
+
+	return &whatsapp.MediaContent{
+		Data:     data,
+		MimeType: mimeType,
+		FileName: message.Media.FileName,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package whats
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/pkg/logger"
+)
+
+// messagePruneInterval is how often stored messages past the retention are deleted
+const messagePruneInterval = time.Hour
+
+// messagePruner deletes stored messages, receipts and chats older than the retention.
+//
+// Chats are deleted once their last message is past the retention, so the chat list
+// only shows chats that still have stored messages.
+type messagePruner struct {
+	manager   *Manager
+	retention time.Duration
+	interval  time.Duration
+	logger    logger.Logger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newMessagePruner creates a pruner for the repositories of the manager
+func newMessagePruner(manager *Manager, retention, interval time.Duration, log logger.Logger) *messagePruner {
+	return &messagePruner{
+		manager:   manager,
+		retention: retention,
+		interval:  interval,
+		logger:    log,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// run prunes on start and then on every tick until stopped
+func (p *messagePruner) run() {
+	defer close(p.done)
+
+	ctx := context.Background()
+	p.prune(ctx)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.prune(ctx)
+		}
+	}
+}
+
+// shutdown stops the pruner and waits for the current pass to finish
+func (p *messagePruner) shutdown() {
+	close(p.stop)
+	<-p.done
+}
+
+// prune deletes the rows older than the retention from each repository
+func (p *messagePruner) prune(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+
+	fields := logger.Fields{"before": before}
+	deleted := 0
+	for name, deleteBefore := range map[string]func(context.Context, time.Time) (int, error){
+		"messages": p.manager.messageRepo.DeleteBefore,
+		"receipts": p.manager.receiptRepo.DeleteBefore,
+		"chats":    p.manager.chatRepo.DeleteBefore,
+	} {
+		count, err := deleteBefore(ctx, before)
+		if err != nil {
+			p.logger.ErrorWithError("Failed to prune stored "+name, err, nil)
+			continue
+		}
+		fields[name] = count
+		deleted += count
+	}
+
+	if deleted > 0 {
+		p.logger.InfoWithFields("🧹 Mensagens antigas removidas", fields)
+	}
+}
@@ -0,0 +1,189 @@
+package whats
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// scheduledBatchSize is the largest number of due messages handled on each pass
+const scheduledBatchSize = 100
+
+// interruptedSendReason is recorded on messages that were being sent when the process stopped
+const interruptedSendReason = "interrupted by a restart while sending; delivery is unknown so it was not retried"
+
+// messageScheduler sends scheduled messages once their send time has passed.
+//
+// Pending messages are read from the repository on every pass, so schedules survive
+// restarts. Only messages of connected sessions are read, so messages of sessions that
+// are not connected stay pending without holding back the others. Failed sends are
+// retried with a growing delay.
+type messageScheduler struct {
+	manager  *Manager
+	repo     whatsapp.ScheduledMessageRepository
+	interval time.Duration
+	logger   logger.Logger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newMessageScheduler creates a scheduler for the clients of the manager
+func newMessageScheduler(manager *Manager, repo whatsapp.ScheduledMessageRepository, interval time.Duration, log logger.Logger) *messageScheduler {
+	return &messageScheduler{
+		manager:  manager,
+		repo:     repo,
+		interval: interval,
+		logger:   log,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// run sends due messages on every tick until stopped
+func (s *messageScheduler) run() {
+	defer close(s.done)
+
+	ctx := context.Background()
+	s.recoverInterrupted(ctx)
+	s.dispatch(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.dispatch(ctx)
+		}
+	}
+}
+
+// shutdown stops the scheduler and waits for the current pass to finish
+func (s *messageScheduler) shutdown() {
+	close(s.stop)
+	<-s.done
+}
+
+// recoverInterrupted fails messages that a previous run stopped in the middle of sending
+func (s *messageScheduler) recoverInterrupted(ctx context.Context) {
+	count, err := s.repo.FailInterrupted(ctx, interruptedSendReason)
+	if err != nil {
+		s.logger.ErrorWithError("Failed to recover interrupted scheduled messages", err, nil)
+		return
+	}
+
+	if count > 0 {
+		s.logger.WarnWithFields("⚠️ Mensagens agendadas interrompidas durante o envio marcadas como falha", logger.Fields{
+			"count": count,
+		})
+	}
+}
+
+// dispatch sends the due messages of the connected sessions
+func (s *messageScheduler) dispatch(ctx context.Context) {
+	clients := s.connectedClients()
+	if len(clients) == 0 {
+		return
+	}
+
+	sessionIDs := make([]session.SessionID, 0, len(clients))
+	for sessionID := range clients {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	messages, err := s.repo.ListDue(ctx, sessionIDs, time.Now(), scheduledBatchSize)
+	if err != nil {
+		s.logger.ErrorWithError("Failed to list due scheduled messages", err, nil)
+		return
+	}
+
+	for _, message := range messages {
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		client := clients[message.SessionID]
+		if !client.IsConnected() {
+			continue // Disconnected during this pass; retried on a later one
+		}
+
+		s.send(ctx, client, message)
+	}
+}
+
+// connectedClients returns the clients of the manager that are connected and logged in
+func (s *messageScheduler) connectedClients() map[session.SessionID]whatsapp.Client {
+	clients := make(map[session.SessionID]whatsapp.Client)
+	for _, sessionID := range s.manager.ListClients() {
+		client, err := s.manager.GetClient(sessionID)
+		if err != nil || !client.IsConnected() || !client.IsAuthenticated() {
+			continue
+		}
+		clients[sessionID] = client
+	}
+	return clients
+}
+
+// send claims a pending message and sends it through the session client
+func (s *messageScheduler) send(ctx context.Context, client whatsapp.Client, message *whatsapp.ScheduledMessage) {
+	// Claim the message so a concurrent cancellation cannot race the send
+	message.Status = whatsapp.ScheduledMessageSending
+	message.Attempts++
+	message.UpdatedAt = time.Now()
+	if err := s.repo.Transition(ctx, message, whatsapp.ScheduledMessagePending); err != nil {
+		if !errors.Is(err, whatsapp.ErrScheduledMessageNotPending) {
+			s.logger.ErrorWithError("Failed to claim scheduled message", err, logger.Fields{
+				"session_id": message.SessionID.String(),
+				"id":         message.ID,
+			})
+		}
+		return
+	}
+
+	messageID, err := client.SendMessage(ctx, message.To, message.Body)
+	if err != nil {
+		message.RetryAfterFailure(err, time.Now())
+	} else {
+		message.Status = whatsapp.ScheduledMessageSent
+		message.MessageID = messageID
+		message.Error = ""
+		message.NextAttemptAt = time.Time{}
+		message.UpdatedAt = time.Now()
+	}
+
+	if err := s.repo.Transition(ctx, message, whatsapp.ScheduledMessageSending); err != nil {
+		s.logger.ErrorWithError("Failed to save scheduled message result", err, logger.Fields{
+			"session_id": message.SessionID.String(),
+			"id":         message.ID,
+			"status":     message.Status.String(),
+		})
+		return
+	}
+
+	fields := logger.Fields{
+		"session_id": message.SessionID.String(),
+		"id":         message.ID,
+		"to":         message.To,
+		"status":     message.Status.String(),
+		"attempts":   message.Attempts,
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		if message.IsPending() {
+			fields["next_attempt_at"] = message.NextAttemptAt
+		}
+		s.logger.WarnWithFields("⚠️ Falha ao enviar mensagem agendada", fields)
+		return
+	}
+	fields["message_id"] = messageID
+	s.logger.InfoWithFields("⏰ Mensagem agendada enviada", fields)
+}
@@ -0,0 +1,97 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetNewsletterInfo returns metadata of a channel (newsletter) the session follows
+func (c *Client) GetNewsletterInfo(ctx context.Context, newsletterJID string) (*whatsapp.NewsletterInfo, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	jid, err := parseNewsletterJID(newsletterJID)
+	if err != nil {
+		return nil, err
+	}
+
+	newsletter, err := c.client.GetNewsletterInfo(jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter info: %w", err)
+	}
+	if newsletter == nil {
+		return nil, whatsapp.ErrNewsletterNotFound
+	}
+
+	return toDomainNewsletterInfo(newsletter), nil
+}
+
+// SendNewsletterMessage posts a text message to a channel (newsletter). Only channel
+// owners and admins can post.
+func (c *Client) SendNewsletterMessage(ctx context.Context, newsletterJID, text string) error {
+	done := c.BeginSend()
+	defer done()
+
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	jid, err := parseNewsletterJID(newsletterJID)
+	if err != nil {
+		return err
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	if _, err := c.client.SendMessage(ctx, jid, &waE2E.Message{Conversation: &text}); err != nil {
+		return fmt.Errorf("failed to send newsletter message: %w", err)
+	}
+	c.sendStats.Record(c.sessionID, whatsapp.MessageTypeText)
+
+	c.logger.InfoWithFields("📢 Mensagem publicada no canal", logger.Fields{
+		"session_id":     c.sessionID.String(),
+		"newsletter_jid": jid.String(),
+	})
+
+	return nil
+}
+
+// parseNewsletterJID parses and validates a newsletter JID
+func parseNewsletterJID(newsletterJID string) (types.JID, error) {
+	if err := whatsapp.ValidateNewsletterJID(newsletterJID); err != nil {
+		return types.JID{}, err
+	}
+
+	jid, err := types.ParseJID(newsletterJID)
+	if err != nil || jid.Server != types.NewsletterServer {
+		return types.JID{}, whatsapp.ErrInvalidNewsletterJID
+	}
+	return jid, nil
+}
+
+// toDomainNewsletterInfo converts whatsmeow newsletter metadata to the domain representation
+func toDomainNewsletterInfo(newsletter *types.NewsletterMetadata) *whatsapp.NewsletterInfo {
+	info := &whatsapp.NewsletterInfo{
+		JID:               newsletter.ID.String(),
+		Name:              newsletter.ThreadMeta.Name.Text,
+		Description:       newsletter.ThreadMeta.Description.Text,
+		InviteCode:        newsletter.ThreadMeta.InviteCode,
+		SubscriberCount:   newsletter.ThreadMeta.SubscriberCount,
+		State:             string(newsletter.State.Type),
+		VerificationState: string(newsletter.ThreadMeta.VerificationState),
+		CreatedAt:         newsletter.ThreadMeta.CreationTime.Time,
+	}
+	if newsletter.ViewerMeta != nil {
+		info.Role = string(newsletter.ViewerMeta.Role)
+	}
+	return info
+}
@@ -0,0 +1,66 @@
+package whats
+
+import (
+	"context"
+	"sync"
+)
+
+// OperationTracker counts in-flight operations so shutdown can wait for them.
+// The zero value is ready to use.
+type OperationTracker struct {
+	mu     sync.Mutex
+	active int
+	idle   chan struct{} // Closed when the last active operation finishes
+}
+
+// Begin records the start of an operation and returns the function that ends it
+func (t *OperationTracker) Begin() (done func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.active == 0 {
+		t.idle = make(chan struct{})
+	}
+	t.active++
+
+	var once sync.Once
+	return func() {
+		once.Do(t.end)
+	}
+}
+
+// end records the end of an operation
+func (t *OperationTracker) end() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.active--
+	if t.active == 0 {
+		close(t.idle)
+	}
+}
+
+// Count returns how many operations are in flight
+func (t *OperationTracker) Count() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.active
+}
+
+// Wait blocks until no operation is in flight or ctx is done, and returns how many
+// operations were still in flight when it returned
+func (t *OperationTracker) Wait(ctx context.Context) int {
+	t.mu.Lock()
+	if t.active == 0 {
+		t.mu.Unlock()
+		return 0
+	}
+	idle := t.idle
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+	case <-ctx.Done():
+	}
+	return t.Count()
+}
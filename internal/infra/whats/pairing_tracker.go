@@ -0,0 +1,72 @@
+package whats
+
+import (
+	"sync"
+	"time"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// pairingTracker keeps the progress of the current connection attempt so it can be polled
+type pairingTracker struct {
+	mu     sync.RWMutex
+	status whatsapp.PairingStatus
+}
+
+// newPairingTracker creates a new pairing tracker
+func newPairingTracker() *pairingTracker {
+	return &pairingTracker{}
+}
+
+// pending starts a new attempt, discarding the previous one
+func (t *pairingTracker) pending() {
+	t.set(whatsapp.PairingStatus{State: whatsapp.PairingStatePending})
+}
+
+// qrReady records a new (or renewed) QR code
+func (t *pairingTracker) qrReady(qrCode string) {
+	t.set(whatsapp.PairingStatus{State: whatsapp.PairingStateQRReady, QRCode: qrCode})
+}
+
+// codeReady records a phone pairing code
+func (t *pairingTracker) codeReady(code string) {
+	t.set(whatsapp.PairingStatus{State: whatsapp.PairingStateCodeReady, PairingCode: code})
+}
+
+// authenticated records a successful login
+func (t *pairingTracker) authenticated(jid string) {
+	t.set(whatsapp.PairingStatus{State: whatsapp.PairingStateAuthenticated, JID: jid})
+}
+
+// failed records a failed attempt; a finished attempt is kept as is
+func (t *pairingTracker) failed(reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.status.State.IsFinal() {
+		return
+	}
+	t.status = whatsapp.PairingStatus{
+		State:     whatsapp.PairingStateFailed,
+		Error:     reason,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// set replaces the tracked status
+func (t *pairingTracker) set(status whatsapp.PairingStatus) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status.UpdatedAt = time.Now()
+	t.status = status
+}
+
+// snapshot returns a copy of the current status
+func (t *pairingTracker) snapshot() *whatsapp.PairingStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	status := t.status
+	return &status
+}
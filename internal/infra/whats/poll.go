@@ -0,0 +1,174 @@
+package whats
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// maxTrackedPolls bounds the number of polls whose options are kept in memory
+const maxTrackedPolls = 1000
+
+// pollTracker remembers the options of recent polls. Votes only carry hashes of the
+// chosen options, so the names can only be resolved for polls seen by this client.
+type pollTracker struct {
+	mu      sync.Mutex
+	options map[string][]string
+	order   []string
+}
+
+// newPollTracker creates an empty poll tracker
+func newPollTracker() *pollTracker {
+	return &pollTracker{
+		options: make(map[string][]string),
+	}
+}
+
+// remember stores the options of a poll, dropping the oldest poll once the limit is reached
+func (t *pollTracker) remember(messageID string, options []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, exists := t.options[messageID]; !exists {
+		if len(t.order) >= maxTrackedPolls {
+			delete(t.options, t.order[0])
+			t.order = t.order[1:]
+		}
+		t.order = append(t.order, messageID)
+	}
+	t.options[messageID] = options
+}
+
+// resolve maps option hashes back to option names, skipping hashes of unknown options
+func (t *pollTracker) resolve(messageID string, hashes [][]byte) []string {
+	t.mu.Lock()
+	options := t.options[messageID]
+	t.mu.Unlock()
+
+	if len(options) == 0 {
+		return nil
+	}
+
+	byHash := make(map[string]string, len(options))
+	for i, hash := range whatsmeow.HashPollOptions(options) {
+		byHash[string(hash)] = options[i]
+	}
+
+	names := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		if name, ok := byHash[string(hash)]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// SendPoll sends a poll and returns its message ID. A selectable count of zero lets
+// voters pick any number of options.
+func (c *Client) SendPoll(ctx context.Context, to, question string, options []string, selectableCount int) (string, error) {
+	done := c.BeginSend()
+	defer done()
+
+	if !c.IsAuthenticated() {
+		return "", fmt.Errorf("not authenticated")
+	}
+
+	if err := whatsapp.ValidatePoll(question, options, selectableCount); err != nil {
+		return "", err
+	}
+
+	recipient, err := types.ParseJID(to)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	resp, err := c.client.SendMessage(ctx, recipient, c.client.BuildPollCreation(question, options, selectableCount))
+	if err != nil {
+		return "", fmt.Errorf("failed to send poll: %w", err)
+	}
+	c.sendStats.Record(c.sessionID, whatsapp.MessageTypePoll)
+	c.polls.remember(resp.ID, options)
+
+	c.logger.InfoWithFields("📊 Enquete enviada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"to":         to,
+		"message_id": resp.ID,
+		"options":    len(options),
+	})
+
+	return resp.ID, nil
+}
+
+// pollCreationOf returns the poll carried by a message, whatever its poll message version
+func pollCreationOf(msg *waE2E.Message) *waE2E.PollCreationMessage {
+	switch {
+	case msg.GetPollCreationMessage() != nil:
+		return msg.GetPollCreationMessage()
+	case msg.GetPollCreationMessageV2() != nil:
+		return msg.GetPollCreationMessageV2()
+	default:
+		return msg.GetPollCreationMessageV3()
+	}
+}
+
+// rememberPoll keeps the options of a poll received or sent from another device
+func (c *Client) rememberPoll(evt *events.Message) {
+	poll := pollCreationOf(evt.Message)
+	if poll == nil {
+		return
+	}
+
+	options := make([]string, 0, len(poll.GetOptions()))
+	for _, option := range poll.GetOptions() {
+		options = append(options, option.GetOptionName())
+	}
+	c.polls.remember(evt.Info.ID, options)
+}
+
+// handlePollVote decrypts a poll vote and passes it to the event handler
+func (c *Client) handlePollVote(evt *events.Message) {
+	pollUpdate := evt.Message.GetPollUpdateMessage()
+
+	vote, err := c.client.DecryptPollVote(context.Background(), evt)
+	if err != nil {
+		c.logger.WarnWithFields("⚠️ Falha ao descriptografar voto de enquete", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"message_id": evt.Info.ID,
+			"error":      err.Error(),
+		})
+		return
+	}
+
+	handler := c.handler()
+	if handler == nil {
+		return
+	}
+
+	pollMessageID := pollUpdate.GetPollCreationMessageKey().GetID()
+	hashes := make([]string, 0, len(vote.GetSelectedOptions()))
+	for _, hash := range vote.GetSelectedOptions() {
+		hashes = append(hashes, hex.EncodeToString(hash))
+	}
+
+	handler.OnPollVote(c.sessionID, &whatsapp.PollVote{
+		PollMessageID:   pollMessageID,
+		ChatJID:         evt.Info.Chat.String(),
+		VoterJID:        evt.Info.Sender.ToNonAD().String(),
+		SelectedOptions: c.polls.resolve(pollMessageID, vote.GetSelectedOptions()),
+		OptionHashes:    hashes,
+		Timestamp:       evt.Info.Timestamp,
+	})
+}
@@ -0,0 +1,93 @@
+package whats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// SendPresence updates the global online state of the session
+func (c *Client) SendPresence(ctx context.Context, state whatsapp.PresenceState) error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	if err := c.client.SendPresence(types.Presence(state)); err != nil {
+		if errors.Is(err, whatsmeow.ErrNoPushName) {
+			return whatsapp.ErrPushNameNotSet
+		}
+		return fmt.Errorf("failed to send presence: %w", err)
+	}
+
+	c.presenceAvailable.Store(state == whatsapp.PresenceAvailable)
+
+	c.logger.InfoWithFields("🟢 Presença enviada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"state":      state.String(),
+	})
+
+	return nil
+}
+
+// HasSentPresence returns true if the session announced itself as available on the current connection
+func (c *Client) HasSentPresence() bool {
+	return c.presenceAvailable.Load()
+}
+
+// SubscribePresence asks WhatsApp to deliver presence updates of a contact.
+// WhatsApp only delivers them after the session announced itself as available.
+func (c *Client) SubscribePresence(ctx context.Context, jid string) error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	if !c.HasSentPresence() {
+		return whatsapp.ErrPresenceNotSent
+	}
+
+	parsedJID, err := types.ParseJID(jid)
+	if err != nil {
+		return fmt.Errorf("invalid JID: %w", err)
+	}
+
+	if err := c.client.SubscribePresence(parsedJID); err != nil {
+		return fmt.Errorf("failed to subscribe to presence: %w", err)
+	}
+
+	c.logger.InfoWithFields("👁️ Inscrito na presença do contato", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"jid":        jid,
+	})
+
+	return nil
+}
+
+// SendChatPresence updates the typing state of the session in a chat
+func (c *Client) SendChatPresence(ctx context.Context, chatJID string, state whatsapp.ChatPresenceState) error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	parsedJID, err := types.ParseJID(chatJID)
+	if err != nil {
+		return fmt.Errorf("invalid JID: %w", err)
+	}
+
+	if err := c.client.SendChatPresence(parsedJID, types.ChatPresence(state), types.ChatPresenceMediaText); err != nil {
+		return fmt.Errorf("failed to send chat presence: %w", err)
+	}
+
+	c.logger.DebugWithFields("⌨️ Presença no chat enviada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"chat":       chatJID,
+		"state":      state.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package whats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetProfilePicture returns the profile picture of a contact or group.
+// When preview is true the low resolution thumbnail is returned instead of the full image.
+func (c *Client) GetProfilePicture(ctx context.Context, jid string, preview bool) (*whatsapp.ProfilePictureInfo, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	parsedJID, err := types.ParseJID(jid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JID: %w", err)
+	}
+
+	info, err := c.client.GetProfilePictureInfo(parsedJID, &whatsmeow.GetProfilePictureParams{
+		Preview: preview,
+	})
+	switch {
+	case errors.Is(err, whatsmeow.ErrProfilePictureNotSet):
+		return nil, whatsapp.ErrProfilePictureNotSet
+	case errors.Is(err, whatsmeow.ErrProfilePictureUnauthorized):
+		return nil, whatsapp.ErrProfilePictureRestricted
+	case err != nil:
+		return nil, fmt.Errorf("failed to get profile picture: %w", err)
+	case info == nil:
+		// Only returned for unchanged pictures, which is not requested here
+		return nil, whatsapp.ErrProfilePictureNotSet
+	}
+
+	c.logger.InfoWithFields("🖼️ Foto de perfil recuperada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"jid":        jid,
+		"preview":    preview,
+	})
+
+	return &whatsapp.ProfilePictureInfo{
+		ID:   info.ID,
+		URL:  info.URL,
+		Type: info.Type,
+	}, nil
+}
+
+// SetProfilePicture replaces the profile picture of the logged-in account and returns the new picture ID.
+// The image must already be a square JPEG.
+func (c *Client) SetProfilePicture(ctx context.Context, imageData []byte) (string, error) {
+	if !c.IsAuthenticated() {
+		return "", fmt.Errorf("not authenticated")
+	}
+
+	// An empty target JID addresses the logged-in account
+	pictureID, err := c.client.SetGroupPhoto(types.EmptyJID, imageData)
+	if errors.Is(err, whatsmeow.ErrInvalidImageFormat) {
+		return "", whatsapp.ErrInvalidProfilePicture
+	} else if err != nil {
+		return "", fmt.Errorf("failed to set profile picture: %w", err)
+	}
+
+	c.logger.InfoWithFields("🖼️ Foto de perfil atualizada", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"picture_id": pictureID,
+		"size":       len(imageData),
+	})
+
+	return pictureID, nil
+}
+
+// missingBusinessProfileError is the error whatsmeow returns when the queried JID has no business profile
+const missingBusinessProfileError = "missing jid in business profile"
+
+// GetBusinessProfile retrieves the public profile of a WhatsApp Business account
+func (c *Client) GetBusinessProfile(ctx context.Context, jid string) (*whatsapp.BusinessProfile, error) {
+	if !c.IsAuthenticated() {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	parsedJID, err := types.ParseJID(jid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JID: %w", err)
+	}
+
+	profile, err := c.client.GetBusinessProfile(parsedJID)
+	switch {
+	case err != nil && strings.Contains(err.Error(), missingBusinessProfileError):
+		// Regular accounts are answered with an empty profile
+		return nil, whatsapp.ErrNotBusinessAccount
+	case err != nil:
+		return nil, fmt.Errorf("failed to get business profile: %w", err)
+	}
+
+	c.logger.InfoWithFields("💼 Perfil comercial recuperado", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"jid":        jid,
+	})
+
+	return toBusinessProfile(profile), nil
+}
+
+// toBusinessProfile converts a whatsmeow business profile to the domain profile
+func toBusinessProfile(profile *types.BusinessProfile) *whatsapp.BusinessProfile {
+	categories := make([]whatsapp.BusinessCategory, 0, len(profile.Categories))
+	for _, category := range profile.Categories {
+		categories = append(categories, whatsapp.BusinessCategory{ID: category.ID, Name: category.Name})
+	}
+
+	hours := make([]whatsapp.BusinessHours, 0, len(profile.BusinessHours))
+	for _, day := range profile.BusinessHours {
+		hours = append(hours, whatsapp.BusinessHours{
+			DayOfWeek: day.DayOfWeek,
+			Mode:      day.Mode,
+			OpenTime:  day.OpenTime,
+			CloseTime: day.CloseTime,
+		})
+	}
+
+	return &whatsapp.BusinessProfile{
+		JID:           profile.JID.String(),
+		Categories:    categories,
+		Email:         profile.Email,
+		Address:       profile.Address,
+		HoursTimeZone: profile.BusinessHoursTimeZone,
+		Hours:         hours,
+	}
+}
@@ -0,0 +1,125 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/proxy"
+	"wazmeow/pkg/logger"
+)
+
+// proxyReconnectTimeout bounds how long a proxy switch waits for the new connection
+const proxyReconnectTimeout = 30 * time.Second
+
+// SetProxy switches the WebSocket and media proxy of a connected client.
+//
+// The connection is re-established through the new proxy while outbound sends are
+// held back; sends already in flight finish before the switch starts and sends issued
+// during the reconnect are delivered once it completes. If the new proxy cannot connect,
+// the previous proxy is restored.
+func (c *Client) SetProxy(ctx context.Context, proxyURL string) error {
+	if !c.IsAuthenticated() {
+		return fmt.Errorf("not authenticated")
+	}
+
+	if proxyURL != "" {
+		if _, err := url.Parse(proxyURL); err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+	}
+
+	// Hold outbound sends until the connection is back
+	c.sendGate.Lock()
+	defer c.sendGate.Unlock()
+
+	previousProxyURL := c.proxyURL
+	startedAt := time.Now()
+
+	c.logger.InfoWithFields("🔀 TROCANDO proxy da sessão", logger.Fields{
+		"session_id":   c.sessionID.String(),
+		"had_proxy":    previousProxyURL != "",
+		"is_connected": c.client.IsConnected(),
+	})
+
+	if err := c.reconnectThroughProxy(ctx, proxyURL); err != nil {
+		c.logger.ErrorWithFields("💥 FALHA ao conectar pelo novo proxy - restaurando proxy anterior", logger.Fields{
+			"session_id": c.sessionID.String(),
+			"error":      err.Error(),
+		})
+
+		if restoreErr := c.reconnectThroughProxy(context.Background(), previousProxyURL); restoreErr != nil {
+			c.logger.ErrorWithFields("💥 FALHA ao restaurar proxy anterior", logger.Fields{
+				"session_id": c.sessionID.String(),
+				"error":      restoreErr.Error(),
+			})
+		}
+
+		return whatsapp.ErrProxyRotationFailed
+	}
+
+	c.proxyURL = proxyURL
+
+	c.logger.InfoWithFields("✅ Proxy trocado com sucesso", logger.Fields{
+		"session_id":  c.sessionID.String(),
+		"has_proxy":   proxyURL != "",
+		"downtime_ms": time.Since(startedAt).Milliseconds(),
+	})
+
+	return nil
+}
+
+// reconnectThroughProxy drops the current connection and connects again using the given proxy
+func (c *Client) reconnectThroughProxy(ctx context.Context, proxyURL string) error {
+	c.client.Disconnect()
+
+	if err := applyProxy(c.client, proxyURL); err != nil {
+		return fmt.Errorf("failed to configure proxy: %w", err)
+	}
+
+	if err := c.client.Connect(); err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+
+	timeout := proxyReconnectTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+
+	if !c.client.WaitForConnection(timeout) {
+		return fmt.Errorf("connection not established within %s", timeout)
+	}
+
+	return nil
+}
+
+// applyProxy routes the WebSocket and media transfers of a whatsmeow client through the proxy.
+// HTTP(S) proxies are used through CONNECT; socks4 and socks5 proxies through a dialer.
+func applyProxy(client *whatsmeow.Client, proxyURL string) error {
+	if proxyURL == "" {
+		client.SetProxy(nil)
+		return nil
+	}
+
+	parsedURL, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	if proxy.IsHTTP(parsedURL) {
+		client.SetProxy(http.ProxyURL(parsedURL))
+		return nil
+	}
+
+	dialer, err := proxy.NewSOCKSDialer(parsedURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	client.SetSOCKSProxy(dialer)
+	return nil
+}
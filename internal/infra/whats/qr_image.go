@@ -0,0 +1,43 @@
+package whats
+
+import (
+	"github.com/skip2/go-qrcode"
+)
+
+// QRImageOptions controls the PNG images rendered for QR codes
+type QRImageOptions struct {
+	// Size is the image width in pixels
+	Size int
+	// Level is the error-correction level: low, medium, high or highest
+	Level string
+}
+
+// DefaultQRImageOptions are the options used when none are configured
+var DefaultQRImageOptions = QRImageOptions{Size: 256, Level: "medium"}
+
+// recoveryLevel maps the configured level to the qrcode library value, defaulting to medium
+func (o QRImageOptions) recoveryLevel() qrcode.RecoveryLevel {
+	switch o.Level {
+	case "low":
+		return qrcode.Low
+	case "high":
+		return qrcode.High
+	case "highest":
+		return qrcode.Highest
+	default:
+		return qrcode.Medium
+	}
+}
+
+// size returns the configured image width, defaulting when unset
+func (o QRImageOptions) size() int {
+	if o.Size <= 0 {
+		return DefaultQRImageOptions.Size
+	}
+	return o.Size
+}
+
+// Encode renders a QR code as PNG
+func (o QRImageOptions) Encode(code string) ([]byte, error) {
+	return qrcode.Encode(code, o.recoveryLevel(), o.size())
+}
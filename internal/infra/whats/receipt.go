@@ -0,0 +1,63 @@
+package whats
+
+import (
+	"context"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// storeReceipts persists the delivery, read and played receipts of sent messages
+func (c *Client) storeReceipts(evt *events.Receipt) {
+	if c.receiptRepo == nil {
+		return
+	}
+
+	for _, receipt := range ToMessageReceipts(c.sessionID, evt) {
+		if err := c.receiptRepo.Save(context.Background(), receipt); err != nil {
+			c.logger.ErrorWithFields("💥 Falha ao salvar confirmação de entrega", logger.Fields{
+				"session_id": c.sessionID.String(),
+				"message_id": receipt.MessageID,
+				"status":     receipt.Status.String(),
+				"error":      err.Error(),
+			})
+		}
+	}
+}
+
+// ToMessageReceipts converts a whatsmeow receipt event to one domain receipt per message.
+// Receipts sent by the session's own devices and other receipt types are ignored.
+func ToMessageReceipts(sessionID session.SessionID, evt *events.Receipt) []*whatsapp.MessageReceipt {
+	if evt.IsFromMe {
+		return nil
+	}
+
+	var status whatsapp.ReceiptStatus
+	switch evt.Type {
+	case types.ReceiptTypeDelivered:
+		status = whatsapp.ReceiptDelivered
+	case types.ReceiptTypeRead:
+		status = whatsapp.ReceiptRead
+	case types.ReceiptTypePlayed:
+		status = whatsapp.ReceiptPlayed
+	default:
+		return nil
+	}
+
+	receipts := make([]*whatsapp.MessageReceipt, 0, len(evt.MessageIDs))
+	for _, messageID := range evt.MessageIDs {
+		receipts = append(receipts, &whatsapp.MessageReceipt{
+			SessionID:    sessionID,
+			MessageID:    messageID,
+			ChatJID:      evt.Chat.String(),
+			RecipientJID: evt.Sender.ToNonAD().String(),
+			Status:       status,
+			Timestamp:    evt.Timestamp,
+		})
+	}
+	return receipts
+}
@@ -0,0 +1,72 @@
+package whats
+
+import (
+	"sync"
+
+	"wazmeow/internal/domain/session"
+)
+
+// ReceiveStats counts received messages per session
+type ReceiveStats struct {
+	mu        sync.Mutex
+	bySession map[session.SessionID]int64
+}
+
+// NewReceiveStats creates empty receive counters
+func NewReceiveStats() *ReceiveStats {
+	return &ReceiveStats{
+		bySession: make(map[session.SessionID]int64),
+	}
+}
+
+// Record counts one received message
+func (s *ReceiveStats) Record(sessionID session.SessionID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bySession[sessionID]++
+}
+
+// Forget drops the counter of a session, so removed sessions do not accumulate
+func (s *ReceiveStats) Forget(sessionID session.SessionID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.bySession, sessionID)
+}
+
+// Count returns the number of messages received by a session
+func (s *ReceiveStats) Count(sessionID session.SessionID) int64 {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.bySession[sessionID]
+}
+
+// Total returns the number of messages received by all sessions
+func (s *ReceiveStats) Total() int64 {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var total int64
+	for _, count := range s.bySession {
+		total += count
+	}
+	return total
+}
@@ -0,0 +1,114 @@
+package whats
+
+import (
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// RecentMessageOptions configures the in-memory buffer of incoming messages
+type RecentMessageOptions struct {
+	// Size is how many messages are kept; older ones are overwritten. Zero disables the buffer.
+	Size int
+	// Retention is how long a message stays available. Zero keeps messages until overwritten.
+	Retention time.Duration
+}
+
+// RecentMessageBuffer is a ring buffer of the latest incoming messages of a client,
+// polled by clients that cannot receive webhooks
+type RecentMessageBuffer struct {
+	retention time.Duration
+
+	mu         sync.Mutex
+	entries    []*whatsapp.RecentMessage // Ring of len(entries) slots; next is the oldest once full
+	next       int
+	count      int
+	lastCursor uint64
+}
+
+// NewRecentMessageBuffer creates a buffer, or returns nil when buffering is disabled
+func NewRecentMessageBuffer(options RecentMessageOptions) *RecentMessageBuffer {
+	if options.Size <= 0 {
+		return nil
+	}
+	return &RecentMessageBuffer{
+		retention: options.Retention,
+		entries:   make([]*whatsapp.RecentMessage, options.Size),
+	}
+}
+
+// Add buffers a message, overwriting the oldest one when the buffer is full
+func (b *RecentMessageBuffer) Add(message *whatsapp.Message, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastCursor++
+	b.entries[b.next] = &whatsapp.RecentMessage{
+		Cursor:     b.lastCursor,
+		Message:    message,
+		ReceivedAt: now,
+	}
+	b.next = (b.next + 1) % len(b.entries)
+	if b.count < len(b.entries) {
+		b.count++
+	}
+}
+
+// Since returns the unexpired messages newer than cursor, oldest first. A cursor ahead
+// of the buffer was issued before the buffer was recreated, so every message is returned.
+func (b *RecentMessageBuffer) Since(cursor uint64, now time.Time) *whatsapp.RecentMessages {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if cursor > b.lastCursor {
+		cursor = 0
+	}
+
+	page := &whatsapp.RecentMessages{
+		Messages:   make([]*whatsapp.RecentMessage, 0),
+		NextCursor: b.lastCursor,
+	}
+	oldest := (b.next - b.count + len(b.entries)) % len(b.entries)
+	for i := 0; i < b.count; i++ {
+		entry := b.entries[(oldest+i)%len(b.entries)]
+		if entry.Cursor <= cursor {
+			continue
+		}
+		if b.retention > 0 && now.Sub(entry.ReceivedAt) > b.retention {
+			continue
+		}
+		page.Messages = append(page.Messages, entry)
+	}
+	return page
+}
+
+// Clear drops every buffered message
+func (b *RecentMessageBuffer) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i := range b.entries {
+		b.entries[i] = nil
+	}
+	b.next = 0
+	b.count = 0
+}
+
+// bufferRecentMessage keeps an accepted incoming message for polling
+func (c *Client) bufferRecentMessage(evt *events.Message) {
+	if c.recent == nil || evt.Info.IsFromMe {
+		return
+	}
+	c.recent.Add(toMessage(c.sessionID, evt), time.Now())
+}
+
+// RecentMessages returns the buffered incoming messages newer than the since cursor
+func (c *Client) RecentMessages(since uint64) (*whatsapp.RecentMessages, error) {
+	if c.recent == nil {
+		return nil, whatsapp.ErrRecentMessagesDisabled
+	}
+	return c.recent.Since(since, time.Now()), nil
+}
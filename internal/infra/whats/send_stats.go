@@ -0,0 +1,96 @@
+package whats
+
+import (
+	"sync"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// SendStats counts sent messages per session and message type. The set of message
+// types is fixed, so the number of counters is bounded by the number of sessions.
+type SendStats struct {
+	mu        sync.Mutex
+	bySession map[session.SessionID]map[whatsapp.MessageType]int64
+}
+
+// NewSendStats creates empty send counters
+func NewSendStats() *SendStats {
+	return &SendStats{
+		bySession: make(map[session.SessionID]map[whatsapp.MessageType]int64),
+	}
+}
+
+// Record counts one sent message of the given type
+func (s *SendStats) Record(sessionID session.SessionID, msgType whatsapp.MessageType) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts, exists := s.bySession[sessionID]
+	if !exists {
+		counts = make(map[whatsapp.MessageType]int64, len(whatsapp.MessageTypes))
+		s.bySession[sessionID] = counts
+	}
+	counts[msgType]++
+}
+
+// Forget drops the counters of a session, so removed sessions do not accumulate
+func (s *SendStats) Forget(sessionID session.SessionID) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.bySession, sessionID)
+}
+
+// Count returns the number of messages of all types sent by a session
+func (s *SendStats) Count(sessionID session.SessionID) int64 {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var total int64
+	for _, count := range s.bySession[sessionID] {
+		total += count
+	}
+	return total
+}
+
+// Snapshot returns a copy of the counters. Every message type is present in the
+// totals, even when nothing of that type was sent.
+func (s *SendStats) Snapshot() *whatsapp.MessageSendStats {
+	stats := &whatsapp.MessageSendStats{
+		ByType:    make(map[whatsapp.MessageType]int64, len(whatsapp.MessageTypes)),
+		BySession: make(map[session.SessionID]map[whatsapp.MessageType]int64),
+	}
+	for _, msgType := range whatsapp.MessageTypes {
+		stats.ByType[msgType] = 0
+	}
+	if s == nil {
+		return stats
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sessionID, counts := range s.bySession {
+		sessionCounts := make(map[whatsapp.MessageType]int64, len(counts))
+		for msgType, count := range counts {
+			sessionCounts[msgType] = count
+			stats.ByType[msgType] += count
+		}
+		stats.BySession[sessionID] = sessionCounts
+	}
+
+	return stats
+}
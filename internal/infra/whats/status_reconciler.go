@@ -0,0 +1,150 @@
+package whats
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// statusReconciler periodically corrects persisted session statuses that drifted from
+// the live whatsmeow connection state (e.g. after a silent drop that emitted no event).
+//
+// A drift is only corrected once it has been observed on two consecutive passes, so a
+// status change that the event handler is about to persist is never overwritten.
+type statusReconciler struct {
+	manager  *Manager
+	interval time.Duration
+	logger   logger.Logger
+
+	// Drifts seen on the previous pass, only touched by the reconciler goroutine
+	pending map[session.SessionID]session.Status
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newStatusReconciler creates a reconciler for the clients of the manager
+func newStatusReconciler(manager *Manager, interval time.Duration, log logger.Logger) *statusReconciler {
+	return &statusReconciler{
+		manager:  manager,
+		interval: interval,
+		logger:   log,
+		pending:  make(map[session.SessionID]session.Status),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// run reconciles statuses on every tick until stopped
+func (r *statusReconciler) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.reconcile(context.Background())
+		}
+	}
+}
+
+// shutdown stops the reconciler and waits for the current pass to finish
+func (r *statusReconciler) shutdown() {
+	close(r.stop)
+	<-r.done
+}
+
+// reconcile compares every active client with its persisted session
+func (r *statusReconciler) reconcile(ctx context.Context) {
+	// Snapshot the clients so the lock is not held during repository calls
+	r.manager.clientsMutex.RLock()
+	clients := make(map[session.SessionID]whatsapp.Client, len(r.manager.clients))
+	for sessionID, client := range r.manager.clients {
+		clients[sessionID] = client
+	}
+	r.manager.clientsMutex.RUnlock()
+
+	pending := make(map[session.SessionID]session.Status)
+	for sessionID, client := range clients {
+		live, ok := liveSessionStatus(client.GetConnectionStatus())
+		if !ok {
+			continue
+		}
+
+		sess, err := r.manager.sessionRepo.GetByID(ctx, sessionID)
+		if err != nil {
+			r.logger.WarnWithFields("⚠️ Sessão não encontrada na reconciliação de status", logger.Fields{
+				"session_id": sessionID.String(),
+				"error":      err.Error(),
+			})
+			continue
+		}
+
+		// Connection attempts in progress are owned by the connect flow, and a failed
+		// session keeps its error state until it connects again
+		if sess.Status() == live || sess.IsConnecting() || (sess.HasError() && live == session.StatusDisconnected) {
+			continue
+		}
+
+		if previous, seen := r.pending[sessionID]; !seen || previous != live {
+			pending[sessionID] = live
+			continue
+		}
+
+		r.correct(ctx, sess, live, client.GetJID())
+	}
+
+	r.pending = pending
+}
+
+// correct persists the live status of a session
+func (r *statusReconciler) correct(ctx context.Context, sess *session.Session, live session.Status, jid string) {
+	persisted := sess.Status()
+
+	switch live {
+	case session.StatusConnected:
+		if err := sess.Connect(jid); err != nil {
+			r.logger.ErrorWithError("Failed to reconcile session status", err, logger.Fields{
+				"session_id": sess.ID().String(),
+				"jid":        jid,
+			})
+			return
+		}
+	default:
+		sess.DisconnectWithReason(session.DisconnectReasonConnectionLost)
+	}
+
+	if err := r.manager.sessionRepo.Update(ctx, sess); err != nil {
+		r.logger.ErrorWithError("Failed to save reconciled session status", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return
+	}
+
+	r.logger.WarnWithFields("🔁 STATUS CORRIGIDO - banco divergia da conexão real", logger.Fields{
+		"session_id":       sess.ID().String(),
+		"session_label":    sess.Label(),
+		"persisted_status": persisted.String(),
+		"live_status":      live.String(),
+	})
+}
+
+// liveSessionStatus maps a live connection status to the session status that should be
+// persisted. Transitional states (QR scanning, authenticating) are not reconciled.
+func liveSessionStatus(status whatsapp.ConnectionStatus) (session.Status, bool) {
+	switch status {
+	case whatsapp.StatusAuthenticated, whatsapp.StatusConnected:
+		return session.StatusConnected, true
+	case whatsapp.StatusDisconnected:
+		return session.StatusDisconnected, true
+	default:
+		return session.StatusDisconnected, false
+	}
+}
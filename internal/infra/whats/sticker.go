@@ -0,0 +1,76 @@
+package whats
+
+import (
+	"context"
+	"fmt"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// stickerMimetype is the only sticker format WhatsApp displays
+const stickerMimetype = "image/webp"
+
+// SendSticker uploads a 512x512 WebP image and sends it as a sticker, returning the message ID
+func (c *Client) SendSticker(ctx context.Context, to string, stickerData []byte) (string, error) {
+	done := c.BeginSend()
+	defer done()
+
+	if !c.IsAuthenticated() {
+		return "", fmt.Errorf("not authenticated")
+	}
+
+	info, err := whatsapp.ValidateSticker(stickerData)
+	if err != nil {
+		return "", err
+	}
+
+	recipient, err := types.ParseJID(to)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	// Wait for any proxy switch in progress to finish
+	c.sendGate.RLock()
+	defer c.sendGate.RUnlock()
+
+	// Stickers are encrypted and uploaded with the image media keys
+	uploaded, err := c.client.Upload(ctx, stickerData, whatsmeow.MediaImage)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload sticker: %w", err)
+	}
+
+	resp, err := c.client.SendMessage(ctx, recipient, &waE2E.Message{
+		StickerMessage: &waE2E.StickerMessage{
+			URL:           proto.String(uploaded.URL),
+			DirectPath:    proto.String(uploaded.DirectPath),
+			MediaKey:      uploaded.MediaKey,
+			FileEncSHA256: uploaded.FileEncSHA256,
+			FileSHA256:    uploaded.FileSHA256,
+			FileLength:    proto.Uint64(uploaded.FileLength),
+			Mimetype:      proto.String(stickerMimetype),
+			Width:         proto.Uint32(uint32(info.Width)),
+			Height:        proto.Uint32(uint32(info.Height)),
+			IsAnimated:    proto.Bool(info.Animated),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to send sticker: %w", err)
+	}
+	c.sendStats.Record(c.sessionID, whatsapp.MessageTypeSticker)
+
+	c.logger.InfoWithFields("🏷️ Sticker enviado", logger.Fields{
+		"session_id": c.sessionID.String(),
+		"to":         to,
+		"message_id": resp.ID,
+		"size":       len(stickerData),
+		"animated":   info.Animated,
+	})
+
+	return resp.ID, nil
+}
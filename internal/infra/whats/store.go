@@ -0,0 +1,97 @@
+package whats
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq"           // PostgreSQL driver for whatsmeow
+	_ "github.com/mattn/go-sqlite3" // SQLite driver for whatsmeow
+	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/store/sqlstore/upgrades"
+
+	"wazmeow/pkg/logger"
+)
+
+// storeVersionTable is the table where whatsmeow records its schema version
+const storeVersionTable = "whatsmeow_version"
+
+// StoreSchema reports the schema version of the WhatsApp device store
+type StoreSchema struct {
+	Version       int `json:"version"`
+	LatestVersion int `json:"latest_version"`
+}
+
+// IsReady returns true if the store schema is at the version this build expects
+func (s *StoreSchema) IsReady() bool {
+	return s.Version >= s.LatestVersion
+}
+
+// StoreDialect maps the database configuration to the driver name and address used by whatsmeow
+func StoreDialect(driver, dbURL string) (string, string, error) {
+	switch driver {
+	case "sqlite", "sqlite3":
+		// whatsmeow requires foreign keys on SQLite (only added for file-based databases)
+		if dbURL == "./data/wazmeow.db" {
+			dbURL = "./data/wazmeow.db?_foreign_keys=on"
+		} else if !strings.Contains(dbURL, ":memory:") && !strings.Contains(dbURL, "mode=memory") && !strings.Contains(dbURL, "_foreign_keys") {
+			if strings.Contains(dbURL, "?") {
+				dbURL += "&_foreign_keys=on"
+			} else {
+				dbURL += "?_foreign_keys=on"
+			}
+		}
+		return "sqlite3", dbURL, nil
+	case "postgres", "postgresql":
+		return "postgres", dbURL, nil
+	default:
+		return "", "", fmt.Errorf("unsupported database driver for WhatsApp store: %s", driver)
+	}
+}
+
+// OpenStore opens the WhatsApp device store without touching its schema.
+// The returned database handle is closed together with the store.
+func OpenStore(driver, dbURL string, log logger.Logger) (*sqlstore.Container, *sql.DB, error) {
+	dialect, address, err := StoreDialect(driver, dbURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := sql.Open(dialect, address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open WhatsApp store database: %w", err)
+	}
+
+	return sqlstore.NewWithDB(db, dialect, NewLoggerAdapter(log, "WhatsApp")), db, nil
+}
+
+// UpgradeStore creates or upgrades the WhatsApp store schema and returns the resulting version
+func UpgradeStore(ctx context.Context, container *sqlstore.Container, db *sql.DB) (*StoreSchema, error) {
+	if err := container.Upgrade(ctx); err != nil {
+		return nil, fmt.Errorf("failed to upgrade WhatsApp store: %w", err)
+	}
+
+	schema, err := GetStoreSchema(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if !schema.IsReady() {
+		return schema, fmt.Errorf("WhatsApp store schema is at version %d, expected %d", schema.Version, schema.LatestVersion)
+	}
+
+	return schema, nil
+}
+
+// GetStoreSchema reads the current WhatsApp store schema version
+func GetStoreSchema(ctx context.Context, db *sql.DB) (*StoreSchema, error) {
+	schema := &StoreSchema{LatestVersion: len(upgrades.Table)}
+
+	err := db.QueryRowContext(ctx, "SELECT version FROM "+storeVersionTable+" LIMIT 1").Scan(&schema.Version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to read WhatsApp store version: %w", err)
+	}
+
+	return schema, nil
+}
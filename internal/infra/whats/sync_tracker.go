@@ -0,0 +1,115 @@
+package whats
+
+import (
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// syncTracker derives the initial sync state of a client from whatsmeow events
+type syncTracker struct {
+	mu            sync.RWMutex
+	status        whatsapp.SyncStatus
+	syncedPatches map[appstate.WAPatchName]bool
+	pairedThisRun bool
+}
+
+// newSyncTracker creates a new sync tracker
+func newSyncTracker() *syncTracker {
+	return &syncTracker{
+		syncedPatches: make(map[appstate.WAPatchName]bool),
+	}
+}
+
+// handleEvent updates the sync state from a whatsmeow event
+func (t *syncTracker) handleEvent(evt interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+
+	switch e := evt.(type) {
+	case *events.PairSuccess:
+		// A freshly paired device receives app state and history from the phone
+		t.status = whatsapp.SyncStatus{StartedAt: now}
+		t.syncedPatches = make(map[appstate.WAPatchName]bool)
+		t.pairedThisRun = true
+
+	case *events.Connected:
+		if t.status.StartedAt.IsZero() {
+			t.status.StartedAt = now
+		}
+		// Reconnecting an existing device does not trigger a full app state or
+		// history sync, so only the offline queue is expected
+		if !t.pairedThisRun {
+			completeProgress(&t.status.AppState, now)
+			completeProgress(&t.status.Contacts, now)
+			completeProgress(&t.status.HistorySync, now)
+		}
+
+	case *events.OfflineSyncPreview:
+		t.status.OfflineSync.Total = e.Total
+		t.status.OfflineSync.UpdatedAt = now
+
+	case *events.OfflineSyncCompleted:
+		t.status.OfflineSync.Processed = e.Count
+		completeProgress(&t.status.OfflineSync, now)
+
+	case *events.AppStateSyncComplete:
+		t.syncedPatches[e.Name] = true
+		t.status.AppState.Processed = len(t.syncedPatches)
+		t.status.AppState.Total = len(appstate.AllPatchNames)
+		t.status.AppState.Percent = t.status.AppState.Processed * 100 / t.status.AppState.Total
+		t.status.AppState.UpdatedAt = now
+		if t.status.AppState.Processed >= t.status.AppState.Total {
+			completeProgress(&t.status.AppState, now)
+		}
+		// The contact list is delivered in the critical_unblock_low patch
+		if e.Name == appstate.WAPatchCriticalUnblockLow {
+			completeProgress(&t.status.Contacts, now)
+		}
+
+	case *events.Contact:
+		if e.FromFullSync {
+			t.status.Contacts.Processed++
+			t.status.Contacts.UpdatedAt = now
+		}
+
+	case *events.HistorySync:
+		if e.Data == nil {
+			return
+		}
+		t.status.HistorySync.Processed += len(e.Data.GetConversations())
+		t.status.HistorySync.UpdatedAt = now
+		if progress := int(e.Data.GetProgress()); progress > t.status.HistorySync.Percent {
+			t.status.HistorySync.Percent = progress
+		}
+		if t.status.HistorySync.Percent >= 100 {
+			completeProgress(&t.status.HistorySync, now)
+		}
+	}
+}
+
+// snapshot returns a copy of the current sync state
+func (t *syncTracker) snapshot() *whatsapp.SyncStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	status := t.status
+	return &status
+}
+
+// completeProgress marks a sync progress as completed
+func completeProgress(p *whatsapp.SyncProgress, now time.Time) {
+	if p.Completed {
+		return
+	}
+	p.Completed = true
+	p.Percent = 100
+	p.UpdatedAt = now
+	p.CompletedAt = now
+}
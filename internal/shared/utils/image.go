@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	// Register decoders for the accepted upload formats
+	_ "image/gif"
+	_ "image/png"
+)
+
+const (
+	// ProfilePictureSize is the side length (in pixels) of uploaded profile pictures
+	ProfilePictureSize = 640
+	// MaxProfilePictureBytes is the largest encoded profile picture sent to WhatsApp
+	MaxProfilePictureBytes = 200 << 10
+)
+
+// profilePictureQualities are the JPEG qualities tried, in order, to fit MaxProfilePictureBytes
+var profilePictureQualities = []int{90, 80, 70, 60, 50, 40}
+
+// PrepareProfilePicture decodes a JPEG, PNG or GIF image, crops it to a centered square,
+// scales it down to at most ProfilePictureSize and encodes it as a JPEG no larger than
+// MaxProfilePictureBytes.
+func PrepareProfilePicture(data []byte) ([]byte, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	if bounds.Empty() {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	// Centered square crop
+	side := min(bounds.Dx(), bounds.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		bounds.Min.X+(bounds.Dx()-side)/2,
+		bounds.Min.Y+(bounds.Dy()-side)/2,
+	))
+
+	dst := scaleSquare(src, crop, min(side, ProfilePictureSize))
+
+	var buf bytes.Buffer
+	for _, quality := range profilePictureQualities {
+		buf.Reset()
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		if buf.Len() <= MaxProfilePictureBytes {
+			return buf.Bytes(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("image exceeds %d bytes after compression", MaxProfilePictureBytes)
+}
+
+// scaleSquare resamples the square crop of src to size x size opaque pixels by averaging
+// the source pixels covered by each destination pixel
+func scaleSquare(src image.Image, crop image.Rectangle, size int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	side := crop.Dx()
+
+	for y := 0; y < size; y++ {
+		y0 := crop.Min.Y + y*side/size
+		y1 := max(crop.Min.Y+(y+1)*side/size, y0+1)
+
+		for x := 0; x < size; x++ {
+			x0 := crop.Min.X + x*side/size
+			x1 := max(crop.Min.X+(x+1)*side/size, x0+1)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					count++
+				}
+			}
+
+			// Colors are alpha-premultiplied; composite over white since JPEG has no alpha
+			background := 0xffff - a/count
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r/count + background),
+				G: uint16(g/count + background),
+				B: uint16(b/count + background),
+				A: 0xffff,
+			})
+		}
+	}
+
+	return dst
+}
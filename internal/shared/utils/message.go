@@ -7,23 +7,20 @@ import (
 	"time"
 )
 
-// FormatWhatsAppJID formats a phone number to WhatsApp JID format
-func FormatWhatsAppJID(phone string) string {
-	// Remove any non-numeric characters except +
-	formatted := strings.ReplaceAll(phone, " ", "")
-	formatted = strings.ReplaceAll(formatted, "-", "")
-	formatted = strings.ReplaceAll(formatted, "(", "")
-	formatted = strings.ReplaceAll(formatted, ")", "")
-	formatted = strings.ReplaceAll(formatted, ".", "")
+// FormatWhatsAppJID formats a phone number to WhatsApp JID format.
+// Numbers without a detectable country code are normalized with countryCode (see NormalizePhoneNumber).
+func FormatWhatsAppJID(phone, countryCode string) string {
+	formatted := strings.TrimSpace(phone)
 
-	// Add @s.whatsapp.net if not present
-	if !strings.Contains(formatted, "@") {
-		// Remove + if present at the beginning
-		formatted = strings.TrimPrefix(formatted, "+")
-		formatted = formatted + "@s.whatsapp.net"
+	// Keep JIDs as they are, except for the legacy contact server used by other clients
+	if user, ok := strings.CutSuffix(formatted, "@c.us"); ok {
+		return user + "@s.whatsapp.net"
+	}
+	if strings.Contains(formatted, "@") {
+		return formatted
 	}
 
-	return formatted
+	return NormalizePhoneNumber(formatted, countryCode) + "@s.whatsapp.net"
 }
 
 // GenerateMessageID generates a unique message ID for WhatsApp messages
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"strings"
+)
+
+// minNationalNumberLength is the shortest national number (without country code)
+// considered when deciding whether a number already carries the country code
+const minNationalNumberLength = 10
+
+// NormalizePhoneNumber converts a phone number to international format (digits only, no +).
+//
+// The country code is only applied when none is detected in the number:
+//   - numbers starting with "+" or "00" are considered international and kept as is
+//   - numbers starting with the trunk prefix "0" have it removed and the country code prepended
+//   - numbers already starting with the country code and long enough to contain a full
+//     national number are kept as is
+//   - any other number is considered national and gets the country code prepended
+//
+// When countryCode is empty the number is only cleaned.
+func NormalizePhoneNumber(phone, countryCode string) string {
+	phone = strings.TrimSpace(phone)
+	international := strings.HasPrefix(phone, "+")
+
+	digits := onlyDigits(phone)
+	if international {
+		return digits
+	}
+
+	if strings.HasPrefix(digits, "00") {
+		return digits[2:]
+	}
+
+	countryCode = onlyDigits(countryCode)
+	if countryCode == "" || digits == "" {
+		return digits
+	}
+
+	if strings.HasPrefix(digits, "0") {
+		return countryCode + strings.TrimLeft(digits, "0")
+	}
+
+	if strings.HasPrefix(digits, countryCode) && len(digits) >= len(countryCode)+minNationalNumberLength {
+		return digits
+	}
+
+	return countryCode + digits
+}
+
+// ResolveCountryCode returns the per-request country code override, falling back to the default
+func ResolveCountryCode(override, defaultCountryCode string) string {
+	if cc := onlyDigits(override); cc != "" {
+		return cc
+	}
+	return onlyDigits(defaultCountryCode)
+}
+
+// onlyDigits strips every non-digit character from a string
+func onlyDigits(value string) string {
+	var b strings.Builder
+	b.Grow(len(value))
+	for _, char := range value {
+		if char >= '0' && char <= '9' {
+			b.WriteRune(char)
+		}
+	}
+	return b.String()
+}
@@ -19,15 +19,19 @@ type SendAudioMessageUseCase struct {
 	waManager   whatsapp.Manager
 	logger      logger.Logger
 	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
 }
 
 // NewSendAudioMessageUseCase creates a new send audio message use case
-func NewSendAudioMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator) *SendAudioMessageUseCase {
+func NewSendAudioMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *SendAudioMessageUseCase {
 	return &SendAudioMessageUseCase{
-		sessionRepo: sessionRepo,
-		waManager:   waManager,
-		logger:      logger,
-		validator:   validator,
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
 	}
 }
 
@@ -35,6 +39,7 @@ func NewSendAudioMessageUseCase(sessionRepo session.Repository, waManager whatsa
 type SendAudioMessageRequest struct {
 	SessionID   session.SessionID `json:"session_id"`
 	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"`    // Overrides the default country code
 	Audio       string            `json:"audio" validate:"required"` // Base64 string
 	MimeType    string            `json:"mime_type"`
 	IsPTT       bool              `json:"is_ptt"` // Push-to-talk
@@ -160,7 +165,7 @@ func (uc *SendAudioMessageUseCase) Execute(ctx context.Context, req SendAudioMes
 	}
 
 	// Format recipient number
-	formattedTo := utils.FormatWhatsAppJID(req.To)
+	formattedTo := utils.FormatWhatsAppJID(req.To, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
 
 	// Send audio message (using placeholder for now)
 	// TODO: Implement SendAudioBase64 in whatsapp client
@@ -20,15 +20,19 @@ type SendDocumentMessageUseCase struct {
 	waManager   whatsapp.Manager
 	logger      logger.Logger
 	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
 }
 
 // NewSendDocumentMessageUseCase creates a new send document message use case
-func NewSendDocumentMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator) *SendDocumentMessageUseCase {
+func NewSendDocumentMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *SendDocumentMessageUseCase {
 	return &SendDocumentMessageUseCase{
-		sessionRepo: sessionRepo,
-		waManager:   waManager,
-		logger:      logger,
-		validator:   validator,
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
 	}
 }
 
@@ -36,6 +40,7 @@ func NewSendDocumentMessageUseCase(sessionRepo session.Repository, waManager wha
 type SendDocumentMessageRequest struct {
 	SessionID   session.SessionID `json:"session_id"`
 	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"`       // Overrides the default country code
 	Document    string            `json:"document" validate:"required"` // Base64 string
 	Filename    string            `json:"filename" validate:"required"`
 	MimeType    string            `json:"mime_type"`
@@ -179,7 +184,7 @@ func (uc *SendDocumentMessageUseCase) Execute(ctx context.Context, req SendDocum
 	}
 
 	// Format recipient number
-	formattedTo := utils.FormatWhatsAppJID(req.To)
+	formattedTo := utils.FormatWhatsAppJID(req.To, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
 
 	// Send document message (using placeholder for now)
 	// TODO: Implement SendDocumentBase64 in whatsapp client
@@ -11,6 +11,7 @@ import (
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
 	"wazmeow/pkg/logger"
 	"wazmeow/pkg/validator"
 )
@@ -21,15 +22,19 @@ type SendImageMessageUseCase struct {
 	waManager   whatsapp.Manager
 	logger      logger.Logger
 	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
 }
 
 // NewSendImageMessageUseCase creates a new send image message use case
-func NewSendImageMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator) *SendImageMessageUseCase {
+func NewSendImageMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *SendImageMessageUseCase {
 	return &SendImageMessageUseCase{
-		sessionRepo: sessionRepo,
-		waManager:   waManager,
-		logger:      logger,
-		validator:   validator,
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
 	}
 }
 
@@ -37,6 +42,7 @@ func NewSendImageMessageUseCase(sessionRepo session.Repository, waManager whatsa
 type SendImageMessageRequest struct {
 	SessionID   session.SessionID `json:"session_id"`
 	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"`    // Overrides the default country code
 	Image       string            `json:"image" validate:"required"` // Base64 string
 	Caption     string            `json:"caption" validate:"max=1024"`
 	MimeType    string            `json:"mime_type"`
@@ -162,7 +168,7 @@ func (uc *SendImageMessageUseCase) Execute(ctx context.Context, req SendImageMes
 	}
 
 	// Format recipient number
-	formattedTo := formatWhatsAppJID(req.To)
+	formattedTo := utils.FormatWhatsAppJID(req.To, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
 
 	// Send image message (using existing method for now)
 	// TODO: Implement SendImageBase64 in whatsapp client
@@ -257,25 +263,6 @@ func (uc *SendImageMessageUseCase) isValidImageMimeType(mimeType string) bool {
 	return false
 }
 
-// formatWhatsAppJID formats a phone number to WhatsApp JID format
-func formatWhatsAppJID(phone string) string {
-	// Remove any non-numeric characters except +
-	formatted := strings.ReplaceAll(phone, " ", "")
-	formatted = strings.ReplaceAll(formatted, "-", "")
-	formatted = strings.ReplaceAll(formatted, "(", "")
-	formatted = strings.ReplaceAll(formatted, ")", "")
-	formatted = strings.ReplaceAll(formatted, ".", "")
-
-	// Add @s.whatsapp.net if not present
-	if !strings.Contains(formatted, "@") {
-		// Remove + if present at the beginning
-		formatted = strings.TrimPrefix(formatted, "+")
-		formatted = formatted + "@s.whatsapp.net"
-	}
-
-	return formatted
-}
-
 // generateMessageID generates a unique message ID
 func generateMessageID() string {
 	// Generate a random 8-byte ID
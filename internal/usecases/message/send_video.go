@@ -19,15 +19,19 @@ type SendVideoMessageUseCase struct {
 	waManager   whatsapp.Manager
 	logger      logger.Logger
 	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
 }
 
 // NewSendVideoMessageUseCase creates a new send video message use case
-func NewSendVideoMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator) *SendVideoMessageUseCase {
+func NewSendVideoMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *SendVideoMessageUseCase {
 	return &SendVideoMessageUseCase{
-		sessionRepo: sessionRepo,
-		waManager:   waManager,
-		logger:      logger,
-		validator:   validator,
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
 	}
 }
 
@@ -35,6 +39,7 @@ func NewSendVideoMessageUseCase(sessionRepo session.Repository, waManager whatsa
 type SendVideoMessageRequest struct {
 	SessionID   session.SessionID `json:"session_id"`
 	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"`    // Overrides the default country code
 	Video       string            `json:"video" validate:"required"` // Base64 string
 	Caption     string            `json:"caption" validate:"max=1024"`
 	MimeType    string            `json:"mime_type"`
@@ -160,7 +165,7 @@ func (uc *SendVideoMessageUseCase) Execute(ctx context.Context, req SendVideoMes
 	}
 
 	// Format recipient number
-	formattedTo := utils.FormatWhatsAppJID(req.To)
+	formattedTo := utils.FormatWhatsAppJID(req.To, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
 
 	// Send video message (using placeholder for now)
 	// TODO: Implement SendVideoBase64 in whatsapp client
@@ -0,0 +1,104 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// SetAllowedSendersUseCase handles updating the sender allow-list of a session
+type SetAllowedSendersUseCase struct {
+	repo      session.Repository
+	waManager whatsapp.Manager
+	logger    logger.Logger
+	validator validator.Validator
+
+	// Country code applied to allow-list phone numbers without one
+	defaultCountryCode string
+}
+
+// NewSetAllowedSendersUseCase creates a new set allowed senders use case
+func NewSetAllowedSendersUseCase(repo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *SetAllowedSendersUseCase {
+	return &SetAllowedSendersUseCase{
+		repo:               repo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// SetAllowedSendersRequest represents the request to set the sender allow-list.
+// Senders may be phone numbers or JIDs; an empty list accepts every sender.
+type SetAllowedSendersRequest struct {
+	SessionID   session.SessionID `json:"session_id" validate:"required"`
+	Senders     []string          `json:"senders"`
+	CountryCode string            `json:"country_code,omitempty"`
+}
+
+// SetAllowedSendersResponse represents the response from setting the sender allow-list
+type SetAllowedSendersResponse struct {
+	Session *session.Session `json:"session"`
+}
+
+// Execute sets the sender allow-list of a session and applies it to the live client
+func (uc *SetAllowedSendersUseCase) Execute(ctx context.Context, req SetAllowedSendersRequest) (*SetAllowedSendersResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for set allowed senders", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Get session from repository
+	sess, err := uc.repo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	countryCode := utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode)
+	senders := make([]string, 0, len(req.Senders))
+	for _, sender := range req.Senders {
+		senders = append(senders, utils.FormatWhatsAppJID(sender, countryCode))
+	}
+
+	if err := sess.SetAllowedSenders(senders); err != nil {
+		uc.logger.WarnWithFields("invalid allowed senders", logger.Fields{
+			"session_id": sess.ID().String(),
+			"count":      len(req.Senders),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	// Update session in repository
+	if err := uc.repo.Update(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to update session allowed senders", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	// Apply to the running client; clients created later load it from the session
+	if client, err := uc.waManager.GetClient(sess.ID()); err == nil {
+		client.SetAllowedSenders(sess.AllowedSenders())
+	}
+
+	uc.logger.InfoWithFields("session allowed senders updated", logger.Fields{
+		"session_id":      sess.ID().String(),
+		"session_name":    sess.Name(),
+		"allowed_senders": len(sess.AllowedSenders()),
+	})
+
+	return &SetAllowedSendersResponse{
+		Session: sess,
+	}, nil
+}
@@ -0,0 +1,284 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/crypto"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// sessionBackupVersion is the format version of session backups
+const sessionBackupVersion = 1
+
+// SessionBackup is the decrypted content of a session backup
+type SessionBackup struct {
+	Version    int                   `json:"version"`
+	ExportedAt time.Time             `json:"exported_at"`
+	Session    SessionBackupMetadata `json:"session"`
+	Device     json.RawMessage       `json:"device"`
+}
+
+// SessionBackupMetadata holds the session settings restored on import
+type SessionBackupMetadata struct {
+	Name           string   `json:"name"`
+	DisplayName    string   `json:"display_name,omitempty"`
+	WaJID          string   `json:"wa_jid"`
+	ProxyURL       string   `json:"proxy_url,omitempty"`
+	AllowedSenders []string `json:"allowed_senders,omitempty"`
+
+	SendRateLimit *session.SendRateLimit `json:"send_rate_limit,omitempty"`
+}
+
+// ExportBackupUseCase handles exporting a session and its device credentials
+type ExportBackupUseCase struct {
+	repo        session.Repository
+	deviceStore whatsapp.DeviceStore
+	logger      logger.Logger
+	validator   validator.Validator
+}
+
+// NewExportBackupUseCase creates a new export session backup use case
+func NewExportBackupUseCase(repo session.Repository, deviceStore whatsapp.DeviceStore, logger logger.Logger, validator validator.Validator) *ExportBackupUseCase {
+	return &ExportBackupUseCase{
+		repo:        repo,
+		deviceStore: deviceStore,
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// ExportBackupRequest represents the request to export a session backup
+type ExportBackupRequest struct {
+	SessionID  session.SessionID `json:"session_id" validate:"required"`
+	Passphrase string            `json:"-" validate:"required,min=8"`
+}
+
+// ExportBackupResponse represents the response from exporting a session backup
+type ExportBackupResponse struct {
+	Session    *session.Session `json:"session"`
+	Backup     *crypto.Envelope `json:"backup"`
+	ExportedAt time.Time        `json:"exported_at"`
+}
+
+// Execute exports the session settings and device credentials encrypted with the passphrase
+func (uc *ExportBackupUseCase) Execute(ctx context.Context, req ExportBackupRequest) (*ExportBackupResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for export session backup", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Get session from repository
+	sess, err := uc.repo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	if sess.WaJID() == "" {
+		return nil, session.ErrSessionNotPaired
+	}
+
+	device, err := uc.deviceStore.ExportDevice(ctx, sess.WaJID())
+	if err != nil {
+		uc.logger.ErrorWithError("failed to export device credentials", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"jid":        sess.WaJID(),
+		})
+		if err == whatsapp.ErrDeviceNotFound {
+			return nil, session.ErrSessionNotPaired
+		}
+		return nil, err
+	}
+
+	exportedAt := time.Now().UTC()
+	plaintext, err := json.Marshal(SessionBackup{
+		Version:    sessionBackupVersion,
+		ExportedAt: exportedAt,
+		Session: SessionBackupMetadata{
+			Name:           sess.Name(),
+			DisplayName:    sess.DisplayName(),
+			WaJID:          sess.WaJID(),
+			ProxyURL:       sess.ProxyURL(),
+			AllowedSenders: sess.AllowedSenders(),
+			SendRateLimit:  sess.SendRateLimit(),
+		},
+		Device: device,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := crypto.Seal(req.Passphrase, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session backup exported", logger.Fields{
+		"session_id":   sess.ID().String(),
+		"session_name": sess.Name(),
+		"jid":          sess.WaJID(),
+	})
+
+	return &ExportBackupResponse{
+		Session:    sess,
+		Backup:     envelope,
+		ExportedAt: exportedAt,
+	}, nil
+}
+
+// ImportBackupUseCase handles recreating a session from a backup
+type ImportBackupUseCase struct {
+	repo        session.Repository
+	deviceStore whatsapp.DeviceStore
+	logger      logger.Logger
+	validator   validator.Validator
+}
+
+// NewImportBackupUseCase creates a new import session backup use case
+func NewImportBackupUseCase(repo session.Repository, deviceStore whatsapp.DeviceStore, logger logger.Logger, validator validator.Validator) *ImportBackupUseCase {
+	return &ImportBackupUseCase{
+		repo:        repo,
+		deviceStore: deviceStore,
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// ImportBackupRequest represents the request to import a session backup.
+// Name overrides the session name stored in the backup.
+type ImportBackupRequest struct {
+	Backup     *crypto.Envelope `json:"backup" validate:"required"`
+	Passphrase string           `json:"-" validate:"required"`
+	Name       string           `json:"name,omitempty" validate:"omitempty,session_name"`
+}
+
+// ImportBackupResponse represents the response from importing a session backup
+type ImportBackupResponse struct {
+	Session *session.Session `json:"session"`
+}
+
+// Execute restores the device credentials and creates a disconnected session bound to them,
+// so connecting it resumes the WhatsApp session without pairing
+func (uc *ImportBackupUseCase) Execute(ctx context.Context, req ImportBackupRequest) (*ImportBackupResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for import session backup", err, logger.Fields{
+			"name": req.Name,
+		})
+		return nil, err
+	}
+
+	plaintext, err := crypto.Open(req.Passphrase, req.Backup)
+	if err != nil {
+		uc.logger.WarnWithFields("failed to decrypt session backup", logger.Fields{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	var backup SessionBackup
+	if err := json.Unmarshal(plaintext, &backup); err != nil {
+		return nil, session.ErrInvalidSessionBackup
+	}
+	if backup.Version != sessionBackupVersion {
+		return nil, session.ErrUnsupportedBackupVersion
+	}
+
+	name := backup.Session.Name
+	if req.Name != "" {
+		name = req.Name
+	}
+
+	sess, err := uc.restoreSession(name, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if session with same name already exists
+	exists, err := uc.repo.ExistsByName(ctx, name)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to check existing session", err, logger.Fields{
+			"name": name,
+		})
+		return nil, err
+	}
+	if exists {
+		return nil, session.ErrSessionAlreadyExists
+	}
+
+	jid, err := uc.deviceStore.ImportDevice(ctx, backup.Device)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to import device credentials", err, logger.Fields{
+			"name": name,
+			"jid":  backup.Session.WaJID,
+		})
+		return nil, err
+	}
+	if jid != backup.Session.WaJID {
+		uc.deviceStore.DeleteDevice(ctx, jid)
+		return nil, session.ErrInvalidSessionBackup
+	}
+
+	// Save to repository, removing the device again if the session cannot be stored
+	if err := uc.repo.Create(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to create imported session", err, logger.Fields{
+			"name":       name,
+			"session_id": sess.ID().String(),
+		})
+		uc.deviceStore.DeleteDevice(ctx, jid)
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session backup imported", logger.Fields{
+		"session_id":  sess.ID().String(),
+		"name":        sess.Name(),
+		"jid":         jid,
+		"exported_at": backup.ExportedAt,
+	})
+
+	return &ImportBackupResponse{
+		Session: sess,
+	}, nil
+}
+
+// restoreSession builds the disconnected session described by the backup
+func (uc *ImportBackupUseCase) restoreSession(name string, backup SessionBackup) (*session.Session, error) {
+	if backup.Session.WaJID == "" || len(backup.Device) == 0 {
+		return nil, session.ErrInvalidSessionBackup
+	}
+
+	now := time.Now()
+	sess := session.RestoreSession(session.NewSessionID(), name, "", session.StatusDisconnected, backup.Session.WaJID, "", "", false, now, now)
+
+	if err := sess.Validate(); err != nil {
+		return nil, err
+	}
+	if backup.Session.DisplayName != "" {
+		if err := sess.SetDisplayName(backup.Session.DisplayName); err != nil {
+			return nil, err
+		}
+	}
+	if backup.Session.ProxyURL != "" {
+		if err := sess.SetProxyURL(backup.Session.ProxyURL); err != nil {
+			return nil, err
+		}
+	}
+	if err := sess.SetAllowedSenders(backup.Session.AllowedSenders); err != nil {
+		return nil, err
+	}
+	if err := sess.SetSendRateLimit(backup.Session.SendRateLimit); err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
@@ -0,0 +1,146 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// MaxBatchCreateSize is the maximum number of sessions created in a single batch
+const MaxBatchCreateSize = 50
+
+// BatchCreateUseCase handles creating several sessions in one request
+type BatchCreateUseCase struct {
+	createUC   *CreateUseCase
+	setProxyUC *SetProxyUseCase
+	logger     logger.Logger
+	validator  validator.Validator
+}
+
+// NewBatchCreateUseCase creates a new batch create sessions use case
+func NewBatchCreateUseCase(createUC *CreateUseCase, setProxyUC *SetProxyUseCase, logger logger.Logger, validator validator.Validator) *BatchCreateUseCase {
+	return &BatchCreateUseCase{
+		createUC:   createUC,
+		setProxyUC: setProxyUC,
+		logger:     logger,
+		validator:  validator,
+	}
+}
+
+// BatchCreateItem describes one session of a batch, with an optional proxy
+type BatchCreateItem struct {
+	Name      string `json:"name" validate:"required,session_name"`
+	ProxyHost string `json:"proxy_host,omitempty" validate:"omitempty,ip|hostname"`
+	ProxyPort int    `json:"proxy_port,omitempty" validate:"omitempty,min=1,max=65535"`
+	ProxyType string `json:"proxy_type,omitempty" validate:"omitempty,oneof=http socks4 socks5"`
+	Username  string `json:"username,omitempty" validate:"omitempty,max=255"`
+	Password  string `json:"password,omitempty" validate:"omitempty,max=255"`
+}
+
+// HasProxy returns true if the item configures a proxy
+func (i BatchCreateItem) HasProxy() bool {
+	return i.ProxyHost != "" && i.ProxyPort > 0
+}
+
+// BatchCreateRequest represents the request to create several sessions
+type BatchCreateRequest struct {
+	Items []BatchCreateItem `json:"items" validate:"required,min=1,max=50"`
+}
+
+// BatchCreateResult is the outcome of one batch item. Session is nil when Error is set;
+// ProxyError is set when the session was created but its proxy could not be configured.
+type BatchCreateResult struct {
+	Index      int              `json:"index"`
+	Name       string           `json:"name"`
+	Session    *session.Session `json:"session,omitempty"`
+	Error      error            `json:"-"`
+	ProxyError error            `json:"-"`
+}
+
+// BatchCreateResponse represents the response from creating several sessions
+type BatchCreateResponse struct {
+	Results []BatchCreateResult `json:"results"`
+	Created int                 `json:"created"`
+	Failed  int                 `json:"failed"`
+}
+
+// Execute creates each session independently, so a failing item does not abort the batch
+func (uc *BatchCreateUseCase) Execute(ctx context.Context, req BatchCreateRequest) (*BatchCreateResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for batch create sessions", err, logger.Fields{
+			"count": len(req.Items),
+		})
+		return nil, err
+	}
+
+	response := &BatchCreateResponse{
+		Results: make([]BatchCreateResult, 0, len(req.Items)),
+	}
+	seen := make(map[string]bool, len(req.Items))
+
+	for i, item := range req.Items {
+		result := BatchCreateResult{Index: i, Name: item.Name}
+
+		if err := uc.validator.Validate(item); err != nil {
+			result.Error = err
+		} else if seen[item.Name] {
+			// Later duplicates within the batch fail like duplicates of existing sessions
+			result.Error = session.ErrSessionAlreadyExists
+		} else {
+			seen[item.Name] = true
+			uc.createItem(ctx, item, &result)
+		}
+
+		if result.Error != nil {
+			response.Failed++
+		} else {
+			response.Created++
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	uc.logger.InfoWithFields("batch session creation finished", logger.Fields{
+		"count":   len(req.Items),
+		"created": response.Created,
+		"failed":  response.Failed,
+	})
+
+	return response, nil
+}
+
+// createItem creates one session and configures its proxy, recording the outcome in result
+func (uc *BatchCreateUseCase) createItem(ctx context.Context, item BatchCreateItem, result *BatchCreateResult) {
+	created, err := uc.createUC.Execute(ctx, CreateRequest{Name: item.Name})
+	if err != nil {
+		result.Error = err
+		return
+	}
+	result.Session = created.Session
+
+	if !item.HasProxy() {
+		return
+	}
+
+	// As with single creation, a proxy failure keeps the session and is reported separately
+	configured, err := uc.setProxyUC.Execute(ctx, SetProxyRequest{
+		SessionID: created.Session.ID(),
+		ProxyHost: item.ProxyHost,
+		ProxyPort: item.ProxyPort,
+		ProxyType: item.ProxyType,
+		Username:  item.Username,
+		Password:  item.Password,
+	})
+	if err != nil {
+		uc.logger.ErrorWithError("failed to configure proxy during batch session creation", err, logger.Fields{
+			"session_id": created.Session.ID().String(),
+			"proxy_host": item.ProxyHost,
+		})
+		result.ProxyError = err
+		return
+	}
+
+	result.Session = configured.Session
+}
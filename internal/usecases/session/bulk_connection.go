@@ -0,0 +1,126 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// BulkConnectionResult is the outcome of a bulk connection operation for one session
+type BulkConnectionResult struct {
+	SessionID session.SessionID         `json:"session_id"`
+	Name      string                    `json:"name,omitempty"`
+	Changed   bool                      `json:"changed"`
+	Status    whatsapp.ConnectionStatus `json:"status"`
+	Error     error                     `json:"-"`
+}
+
+// BulkConnectionResponse represents the response from connecting or disconnecting all sessions
+type BulkConnectionResponse struct {
+	Results []BulkConnectionResult `json:"results"`
+	Total   int                    `json:"total"`
+	Changed int                    `json:"changed"`
+	Failed  int                    `json:"failed"`
+}
+
+// ConnectAllUseCase handles connecting every loaded WhatsApp client
+type ConnectAllUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewConnectAllUseCase creates a new connect all sessions use case
+func NewConnectAllUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *ConnectAllUseCase {
+	return &ConnectAllUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// Execute connects every client that is not connected yet
+func (uc *ConnectAllUseCase) Execute(ctx context.Context) (*BulkConnectionResponse, error) {
+	results, err := uc.waManager.ConnectAll(ctx)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to connect all sessions", err, nil)
+		return nil, err
+	}
+
+	response := summarizeBulkResults(ctx, uc.sessionRepo, results)
+
+	uc.logger.InfoWithFields("connect all sessions finished", logger.Fields{
+		"total":   response.Total,
+		"changed": response.Changed,
+		"failed":  response.Failed,
+	})
+
+	return response, nil
+}
+
+// DisconnectAllUseCase handles disconnecting every loaded WhatsApp client
+type DisconnectAllUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewDisconnectAllUseCase creates a new disconnect all sessions use case
+func NewDisconnectAllUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *DisconnectAllUseCase {
+	return &DisconnectAllUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// Execute disconnects every connected client, keeping their pairing
+func (uc *DisconnectAllUseCase) Execute(ctx context.Context) (*BulkConnectionResponse, error) {
+	results, err := uc.waManager.DisconnectAll(ctx)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to disconnect all sessions", err, nil)
+		return nil, err
+	}
+
+	response := summarizeBulkResults(ctx, uc.sessionRepo, results)
+
+	uc.logger.InfoWithFields("disconnect all sessions finished", logger.Fields{
+		"total":   response.Total,
+		"changed": response.Changed,
+		"failed":  response.Failed,
+	})
+
+	return response, nil
+}
+
+// summarizeBulkResults attaches session names to the manager results and counts the outcomes
+func summarizeBulkResults(ctx context.Context, sessionRepo session.Repository, results []whatsapp.BulkResult) *BulkConnectionResponse {
+	response := &BulkConnectionResponse{
+		Results: make([]BulkConnectionResult, 0, len(results)),
+		Total:   len(results),
+	}
+
+	for _, result := range results {
+		item := BulkConnectionResult{
+			SessionID: result.SessionID,
+			Changed:   result.Changed,
+			Status:    result.Status,
+			Error:     result.Error,
+		}
+		// The name is informative only, so a failed lookup does not fail the operation
+		if sess, err := sessionRepo.GetByID(ctx, result.SessionID); err == nil {
+			item.Name = sess.Name()
+		}
+
+		if result.Error != nil {
+			response.Failed++
+		} else if result.Changed {
+			response.Changed++
+		}
+		response.Results = append(response.Results, item)
+	}
+
+	return response
+}
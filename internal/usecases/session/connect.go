@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"errors"
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/internal/domain/whatsapp"
@@ -50,11 +51,28 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 
 	// Check if session is already connected
 	if sess.Status() == session.StatusConnected {
-		uc.logger.WarnWithFields("session already connected", logger.Fields{
+		_, err := uc.waManager.GetClient(sess.ID())
+		if err == nil {
+			uc.logger.WarnWithFields("session already connected", logger.Fields{
+				"session_id": sess.ID().String(),
+				"status":     sess.Status().String(),
+			})
+			return nil, session.ErrSessionAlreadyConnected
+		}
+		if err != whatsapp.ErrClientNotFound {
+			uc.logger.ErrorWithError("failed to get WhatsApp client", err, logger.Fields{
+				"session_id": sess.ID().String(),
+			})
+			return nil, err
+		}
+
+		// The database says connected but no client exists (e.g. after a crash), so the
+		// stored status is stale: recreate the client and connect again
+		uc.logger.WarnWithFields("session marked as connected without a client, reconnecting", logger.Fields{
 			"session_id": sess.ID().String(),
 			"status":     sess.Status().String(),
 		})
-		return nil, session.ErrSessionAlreadyConnected
+		sess.DisconnectWithReason(session.DisconnectReasonConnectionLost)
 	}
 
 	// Log if reconnecting from connecting state
@@ -74,8 +92,20 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 		return nil, session.ErrSessionInvalidState
 	}
 
+	// Only one request at a time may drive the connection of a session
+	release, err := uc.waManager.AcquireConnect(sess.ID())
+	if err != nil {
+		uc.logger.WarnWithFields("connect already in progress", logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+	defer release()
+
 	// Set session to connecting state
-	sess.SetConnecting()
+	if err := sess.SetConnecting(); err != nil {
+		return nil, err
+	}
 	if err := uc.sessionRepo.Update(ctx, sess); err != nil {
 		uc.logger.ErrorWithError("failed to update session status", err, logger.Fields{
 			"session_id": sess.ID().String(),
@@ -92,7 +122,7 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 			uc.logger.ErrorWithError("failed to create WhatsApp client", err, logger.Fields{
 				"session_id": sess.ID().String(),
 			})
-			sess.Disconnect()
+			sess.DisconnectWithReason(session.DisconnectReasonConnectFailed)
 			uc.sessionRepo.Update(ctx, sess)
 			return nil, err
 		}
@@ -104,7 +134,13 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 		uc.logger.ErrorWithError("failed to connect to WhatsApp", err, logger.Fields{
 			"session_id": sess.ID().String(),
 		})
-		sess.Disconnect()
+		var connErr *whatsapp.ConnectionError
+		if errors.As(err, &connErr) && connErr.Reason == whatsapp.ConnectionFailureNotPaired {
+			// WhatsApp unlinked the device, so its JID is gone for good
+			sess.Logout()
+		} else {
+			sess.DisconnectWithReason(session.DisconnectReasonConnectFailed)
+		}
 		uc.sessionRepo.Update(ctx, sess)
 		return nil, err
 	}
@@ -131,7 +167,9 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 			response.Message = "Connected and authenticated successfully"
 		} else {
 			// Connected but not authenticated yet - mark as connecting
-			sess.SetConnecting()
+			if err := sess.SetConnecting(); err != nil {
+				return nil, err
+			}
 			if err := uc.sessionRepo.Update(ctx, sess); err != nil {
 				return nil, err
 			}
@@ -140,7 +178,9 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 
 	case whatsapp.StatusAuthenticating:
 		// Need authentication (QR code or pairing) - mark as connecting
-		sess.SetConnecting()
+		if err := sess.SetConnecting(); err != nil {
+			return nil, err
+		}
 		if err := uc.sessionRepo.Update(ctx, sess); err != nil {
 			return nil, err
 		}
@@ -150,7 +190,9 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 
 	case whatsapp.StatusConnecting:
 		// Still connecting - mark as connecting
-		sess.SetConnecting()
+		if err := sess.SetConnecting(); err != nil {
+			return nil, err
+		}
 		if err := uc.sessionRepo.Update(ctx, sess); err != nil {
 			return nil, err
 		}
@@ -158,7 +200,7 @@ func (uc *ConnectUseCase) Execute(ctx context.Context, req ConnectRequest) (*Con
 
 	default:
 		// Any other status (including error) - mark as disconnected
-		sess.Disconnect()
+		sess.DisconnectWithReason(session.DisconnectReasonConnectFailed)
 		if err := uc.sessionRepo.Update(ctx, sess); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,118 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+const (
+	// DefaultConnectWait is how long a synchronous connect waits for a QR code, pairing code or login
+	DefaultConnectWait = 60 * time.Second
+	// connectStatusPollInterval is how often the pairing status is checked while waiting
+	connectStatusPollInterval = 250 * time.Millisecond
+)
+
+// ConnectStatusUseCase reports the progress of a connection attempt so clients can poll it
+type ConnectStatusUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewConnectStatusUseCase creates a new connect status use case
+func NewConnectStatusUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *ConnectStatusUseCase {
+	return &ConnectStatusUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// ConnectStatusRequest represents the request to get the progress of a connection attempt.
+// When WaitTimeout is set, the status is returned once it leaves the pending state or the timeout expires.
+type ConnectStatusRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	WaitTimeout time.Duration     `json:"wait_timeout,omitempty"`
+}
+
+// ConnectStatusResponse represents the progress of a connection attempt
+type ConnectStatusResponse struct {
+	Session *session.Session        `json:"session"`
+	Status  *whatsapp.PairingStatus `json:"status"`
+}
+
+// Execute returns the progress of the current connection attempt of a session
+func (uc *ConnectStatusUseCase) Execute(ctx context.Context, req ConnectStatusRequest) (*ConnectStatusResponse, error) {
+	// Get session from repository
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// A client only exists once a connection was requested
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		uc.logger.WarnWithFields("no connection attempt for session", logger.Fields{
+			"session_id": sess.ID().String(),
+			"status":     sess.Status().String(),
+		})
+		return nil, session.ErrConnectionNotStarted
+	}
+
+	status := uc.waitForProgress(ctx, waClient, req.WaitTimeout)
+	if status.State == "" {
+		return nil, session.ErrConnectionNotStarted
+	}
+
+	// Reload the session - event handlers update it while the attempt progresses
+	if req.WaitTimeout > 0 {
+		if refreshed, err := uc.sessionRepo.GetByID(ctx, sess.ID()); err == nil {
+			sess = refreshed
+		}
+	}
+
+	uc.logger.InfoWithFields("connect status retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"state":      status.State.String(),
+		"waited":     req.WaitTimeout > 0,
+	})
+
+	return &ConnectStatusResponse{
+		Session: sess,
+		Status:  status,
+	}, nil
+}
+
+// waitForProgress polls the pairing status until it leaves the pending state, the timeout expires
+// or the context is done. Without a timeout the current status is returned immediately.
+func (uc *ConnectStatusUseCase) waitForProgress(ctx context.Context, waClient whatsapp.Client, timeout time.Duration) *whatsapp.PairingStatus {
+	status := waClient.GetPairingStatus()
+	if timeout <= 0 {
+		return status
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(connectStatusPollInterval)
+	defer ticker.Stop()
+
+	for status.State == "" || status.State == whatsapp.PairingStatePending {
+		select {
+		case <-ctx.Done():
+			return status
+		case <-deadline.C:
+			return status
+		case <-ticker.C:
+			status = waClient.GetPairingStatus()
+		}
+	}
+
+	return status
+}
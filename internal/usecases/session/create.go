@@ -36,6 +36,46 @@ type CreateResponse struct {
 
 // Execute creates a new session
 func (uc *CreateUseCase) Execute(ctx context.Context, req CreateRequest) (*CreateResponse, error) {
+	sess, err := uc.prepare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := uc.repo.Create(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to create session", err, logger.Fields{
+			"name":       req.Name,
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session created successfully", logger.Fields{
+		"name":       sess.Name(),
+		"session_id": sess.ID().String(),
+		"status":     sess.Status().String(),
+	})
+
+	return &CreateResponse{
+		Session: sess,
+	}, nil
+}
+
+// ValidateOnly runs every check of Execute and returns the session that would be
+// created, without persisting it. The returned session ID is not reserved.
+func (uc *CreateUseCase) ValidateOnly(ctx context.Context, req CreateRequest) (*CreateResponse, error) {
+	sess, err := uc.prepare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateResponse{
+		Session: sess,
+	}, nil
+}
+
+// prepare validates the request and builds the new session
+func (uc *CreateUseCase) prepare(ctx context.Context, req CreateRequest) (*session.Session, error) {
 	// Validate request
 	if err := uc.validator.Validate(req); err != nil {
 		uc.logger.ErrorWithError("validation failed for create session", err, logger.Fields{
@@ -73,22 +113,5 @@ func (uc *CreateUseCase) Execute(ctx context.Context, req CreateRequest) (*Creat
 		return nil, err
 	}
 
-	// Save to repository
-	if err := uc.repo.Create(ctx, sess); err != nil {
-		uc.logger.ErrorWithError("failed to create session", err, logger.Fields{
-			"name":       req.Name,
-			"session_id": sess.ID().String(),
-		})
-		return nil, err
-	}
-
-	uc.logger.InfoWithFields("session created successfully", logger.Fields{
-		"name":       sess.Name(),
-		"session_id": sess.ID().String(),
-		"status":     sess.Status().String(),
-	})
-
-	return &CreateResponse{
-		Session: sess,
-	}, nil
+	return sess, nil
 }
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"wazmeow/internal/domain/session"
@@ -13,14 +14,19 @@ import (
 type DeleteUseCase struct {
 	sessionRepo session.Repository
 	waManager   whatsapp.Manager
+	deviceStore whatsapp.DeviceStore
+	sessionData []whatsapp.SessionDataRepository
 	logger      logger.Logger
 }
 
-// NewDeleteUseCase creates a new delete session use case
-func NewDeleteUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *DeleteUseCase {
+// NewDeleteUseCase creates a new delete session use case. The data that sessionData
+// repositories hold for a session is purged along with it.
+func NewDeleteUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, deviceStore whatsapp.DeviceStore, sessionData []whatsapp.SessionDataRepository, logger logger.Logger) *DeleteUseCase {
 	return &DeleteUseCase{
 		sessionRepo: sessionRepo,
 		waManager:   waManager,
+		deviceStore: deviceStore,
+		sessionData: sessionData,
 		logger:      logger,
 	}
 }
@@ -37,7 +43,8 @@ type DeleteResponse struct {
 	Message   string            `json:"message"`
 }
 
-// Execute deletes a session
+// Execute deletes a session along with its WhatsApp client, stored device credentials
+// and stored messages, receipts, chats and scheduled messages
 func (uc *DeleteUseCase) Execute(ctx context.Context, req DeleteRequest) (*DeleteResponse, error) {
 	// Get session from repository to verify it exists
 	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
@@ -73,14 +80,38 @@ func (uc *DeleteUseCase) Execute(ctx context.Context, req DeleteRequest) (*Delet
 				// Continue with deletion even if disconnect fails
 			}
 		}
+	}
+
+	// Remove WhatsApp client, which may exist for sessions that are connecting or pairing
+	if err := uc.waManager.RemoveClient(sess.ID()); err != nil && !errors.Is(err, whatsapp.ErrClientNotFound) {
+		uc.logger.ErrorWithError("failed to remove WhatsApp client", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		// Continue with deletion even if client removal fails
+	}
 
-		// Remove WhatsApp client
-		if err := uc.waManager.RemoveClient(sess.ID()); err != nil {
-			uc.logger.ErrorWithError("failed to remove WhatsApp client", err, logger.Fields{
+	// Delete the device credentials before the session row, which holds the only reference to them
+	if jid := sess.WaJID(); jid != "" {
+		if err := uc.deviceStore.DeleteDevice(ctx, jid); err != nil && !errors.Is(err, whatsapp.ErrDeviceNotFound) {
+			uc.logger.ErrorWithError("failed to delete WhatsApp device", err, logger.Fields{
+				"session_id": sess.ID().String(),
+				"jid":        jid,
+			})
+			return nil, err
+		}
+	}
+
+	// Purge the session data first so that a failure leaves the session to delete again
+	purged := 0
+	for _, repo := range uc.sessionData {
+		count, err := repo.DeleteBySession(ctx, sess.ID())
+		if err != nil {
+			uc.logger.ErrorWithError("failed to purge session data", err, logger.Fields{
 				"session_id": sess.ID().String(),
 			})
-			// Continue with deletion even if client removal fails
+			return nil, err
 		}
+		purged += count
 	}
 
 	// Delete session from repository
@@ -95,6 +126,7 @@ func (uc *DeleteUseCase) Execute(ctx context.Context, req DeleteRequest) (*Delet
 		"session_id": req.SessionID.String(),
 		"name":       sess.Name(),
 		"force":      req.Force,
+		"purged":     purged,
 	})
 
 	return &DeleteResponse{
@@ -24,9 +24,11 @@ func NewDisconnectUseCase(sessionRepo session.Repository, waManager whatsapp.Man
 	}
 }
 
-// DisconnectRequest represents the request to disconnect a session
+// DisconnectRequest represents the request to disconnect a session.
+// Logout also unregisters the device from the WhatsApp account instead of only closing the connection.
 type DisconnectRequest struct {
 	SessionID session.SessionID `json:"session_id"`
+	Logout    bool              `json:"logout"`
 }
 
 // DisconnectResponse represents the response from disconnecting a session
@@ -46,6 +48,10 @@ func (uc *DisconnectUseCase) Execute(ctx context.Context, req DisconnectRequest)
 		return nil, err
 	}
 
+	if req.Logout {
+		return uc.logout(ctx, sess)
+	}
+
 	// Check if session is connected
 	if sess.Status() == session.StatusDisconnected {
 		uc.logger.InfoWithFields("session already disconnected", logger.Fields{
@@ -75,7 +81,7 @@ func (uc *DisconnectUseCase) Execute(ctx context.Context, req DisconnectRequest)
 	}
 
 	// Update session status
-	sess.Disconnect()
+	sess.DisconnectWithReason(session.DisconnectReasonRequested)
 	if err := uc.sessionRepo.Update(ctx, sess); err != nil {
 		uc.logger.ErrorWithError("failed to update session status", err, logger.Fields{
 			"session_id": sess.ID().String(),
@@ -93,3 +99,42 @@ func (uc *DisconnectUseCase) Execute(ctx context.Context, req DisconnectRequest)
 		Message: "Session disconnected successfully",
 	}, nil
 }
+
+// logout unregisters the device of a session and clears its WhatsApp JID. The device can
+// only be removed over a live connection, so a session without a client cannot log out.
+func (uc *DisconnectUseCase) logout(ctx context.Context, sess *session.Session) (*DisconnectResponse, error) {
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		if err != whatsapp.ErrClientNotFound {
+			uc.logger.ErrorWithError("failed to get WhatsApp client", err, logger.Fields{
+				"session_id": sess.ID().String(),
+			})
+			return nil, err
+		}
+		return nil, session.ErrSessionNotConnected
+	}
+
+	if err := waClient.Logout(ctx); err != nil {
+		uc.logger.ErrorWithError("failed to log out from WhatsApp", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	sess.Logout()
+	if err := uc.sessionRepo.Update(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to update session status", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session logged out successfully", logger.Fields{
+		"session_id": sess.ID().String(),
+	})
+
+	return &DisconnectResponse{
+		Session: sess,
+		Message: "Session logged out successfully",
+	}, nil
+}
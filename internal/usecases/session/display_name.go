@@ -0,0 +1,86 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// SetDisplayNameUseCase handles updating the free-form display name of a session
+type SetDisplayNameUseCase struct {
+	repo      session.Repository
+	logger    logger.Logger
+	validator validator.Validator
+}
+
+// NewSetDisplayNameUseCase creates a new set display name use case
+func NewSetDisplayNameUseCase(repo session.Repository, logger logger.Logger, validator validator.Validator) *SetDisplayNameUseCase {
+	return &SetDisplayNameUseCase{
+		repo:      repo,
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+// SetDisplayNameRequest represents the request to set the session display name.
+// An empty DisplayName clears it.
+type SetDisplayNameRequest struct {
+	SessionID   session.SessionID `json:"session_id" validate:"required"`
+	DisplayName string            `json:"display_name"`
+}
+
+// SetDisplayNameResponse represents the response from setting the session display name
+type SetDisplayNameResponse struct {
+	Session *session.Session `json:"session"`
+}
+
+// Execute sets the display name of a session
+func (uc *SetDisplayNameUseCase) Execute(ctx context.Context, req SetDisplayNameRequest) (*SetDisplayNameResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for set display name", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Get session from repository
+	sess, err := uc.repo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	previousDisplayName := sess.DisplayName()
+
+	if err := sess.SetDisplayName(req.DisplayName); err != nil {
+		uc.logger.WarnWithFields("invalid display name", logger.Fields{
+			"session_id": sess.ID().String(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	// Update session in repository
+	if err := uc.repo.Update(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to update session display name", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session display name updated", logger.Fields{
+		"session_id":            sess.ID().String(),
+		"session_name":          sess.Name(),
+		"display_name":          sess.DisplayName(),
+		"previous_display_name": previousDisplayName,
+	})
+
+	return &SetDisplayNameResponse{
+		Session: sess,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/pkg/logger"
+)
+
+// exportBatchSize is the number of sessions loaded per page while exporting
+const exportBatchSize = 100
+
+// ExportUseCase handles exporting every session in batches
+type ExportUseCase struct {
+	repo   session.Repository
+	logger logger.Logger
+}
+
+// NewExportUseCase creates a new export sessions use case
+func NewExportUseCase(repo session.Repository, logger logger.Logger) *ExportUseCase {
+	return &ExportUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ExportResponse represents the result of exporting sessions
+type ExportResponse struct {
+	Exported int `json:"exported"`
+}
+
+// Execute pages through all sessions, passing each batch to write so callers can stream
+// them without holding every session in memory. Exporting stops at the first write error.
+func (uc *ExportUseCase) Execute(ctx context.Context, write func([]*session.Session) error) (*ExportResponse, error) {
+	exported := 0
+
+	for offset := 0; ; offset += exportBatchSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		sessions, _, err := uc.repo.List(ctx, exportBatchSize, offset)
+		if err != nil {
+			uc.logger.ErrorWithError("failed to list sessions for export", err, logger.Fields{
+				"offset": offset,
+			})
+			return nil, err
+		}
+
+		if len(sessions) > 0 {
+			if err := write(sessions); err != nil {
+				uc.logger.ErrorWithError("failed to write exported sessions", err, logger.Fields{
+					"exported": exported,
+				})
+				return nil, err
+			}
+			exported += len(sessions)
+		}
+
+		if len(sessions) < exportBatchSize {
+			break
+		}
+	}
+
+	uc.logger.InfoWithFields("sessions exported successfully", logger.Fields{
+		"exported": exported,
+	})
+
+	return &ExportResponse{
+		Exported: exported,
+	}, nil
+}
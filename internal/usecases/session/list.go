@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"strings"
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/pkg/logger"
@@ -21,39 +22,82 @@ func NewListUseCase(repo session.Repository, logger logger.Logger) *ListUseCase
 	}
 }
 
-// ListRequest represents the request to list sessions
+// ListRequest represents the request to list sessions.
+// Sort defaults to created_at and Order to desc. All ignores Limit and Offset
+// and returns every matching session.
 type ListRequest struct {
-	Limit  int `json:"limit" validate:"min=1,max=100"`
-	Offset int `json:"offset" validate:"min=0"`
+	Limit  int                `json:"limit" validate:"min=1,max=100"`
+	Offset int                `json:"offset" validate:"min=0"`
+	All    bool               `json:"all"`
+	Filter session.ListFilter `json:"filter"`
+	Sort   string             `json:"sort"`
+	Order  string             `json:"order"`
 }
 
-// ListResponse represents the response from listing sessions
+// ListResponse represents the response from listing sessions.
+// Filter, Sort and Order echo what was applied, defaults included. Limit is 0 when
+// every session was requested.
 type ListResponse struct {
 	Sessions []*session.Session `json:"sessions"`
 	Total    int                `json:"total"`
 	Limit    int                `json:"limit"`
 	Offset   int                `json:"offset"`
+	Filter   session.ListFilter `json:"filter"`
+	Sort     string             `json:"sort"`
+	Order    string             `json:"order"`
 }
 
-// Execute lists sessions with pagination
+// Execute lists the sessions matching the request filter with sorting and pagination
 func (uc *ListUseCase) Execute(ctx context.Context, req ListRequest) (*ListResponse, error) {
 	// Set default values
-	if req.Limit <= 0 {
-		req.Limit = 10
+	if req.All {
+		req.Limit, req.Offset = 0, 0
+	} else {
+		if req.Limit <= 0 {
+			req.Limit = 10
+		}
+		if req.Limit > 100 {
+			req.Limit = 100
+		}
+		if req.Offset < 0 {
+			req.Offset = 0
+		}
 	}
-	if req.Limit > 100 {
-		req.Limit = 100
+	if req.Sort == "" {
+		req.Sort = session.SortByCreatedAt
 	}
-	if req.Offset < 0 {
-		req.Offset = 0
+	if req.Order == "" {
+		req.Order = session.SortOrderDesc
+	}
+	req.Order = strings.ToLower(req.Order)
+
+	// Validate sorting and filter
+	if !session.IsValidSortField(req.Sort) {
+		return nil, session.ErrInvalidSortField
+	}
+	if !session.IsValidSortOrder(req.Order) {
+		return nil, session.ErrInvalidSortOrder
+	}
+	if req.Filter.Status != nil && !req.Filter.Status.IsValid() {
+		return nil, session.ErrInvalidStatus
+	}
+	if req.Filter.CreatedAfter != nil && req.Filter.CreatedBefore != nil && req.Filter.CreatedAfter.After(*req.Filter.CreatedBefore) {
+		return nil, session.ErrInvalidDateRange
 	}
 
 	// Get sessions from repository
-	sessions, total, err := uc.repo.List(ctx, req.Limit, req.Offset)
+	sessions, total, err := uc.repo.ListWithFilter(ctx, req.Filter, session.ListOptions{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   req.Sort,
+		Order:  req.Order,
+	})
 	if err != nil {
 		uc.logger.ErrorWithError("failed to list sessions", err, logger.Fields{
 			"limit":  req.Limit,
 			"offset": req.Offset,
+			"sort":   req.Sort,
+			"order":  req.Order,
 		})
 		return nil, err
 	}
@@ -63,6 +107,8 @@ func (uc *ListUseCase) Execute(ctx context.Context, req ListRequest) (*ListRespo
 		"total":  total,
 		"limit":  req.Limit,
 		"offset": req.Offset,
+		"sort":   req.Sort,
+		"order":  req.Order,
 	})
 
 	return &ListResponse{
@@ -70,6 +116,9 @@ func (uc *ListUseCase) Execute(ctx context.Context, req ListRequest) (*ListRespo
 		Total:    total,
 		Limit:    req.Limit,
 		Offset:   req.Offset,
+		Filter:   req.Filter,
+		Sort:     req.Sort,
+		Order:    req.Order,
 	}, nil
 }
 
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+const (
+	// StatusSourceClient marks a status read from the in-memory WhatsApp client
+	StatusSourceClient = "client"
+	// StatusSourceDatabase marks a status read from the persisted session because no client is loaded
+	StatusSourceDatabase = "database"
+)
+
+// LiveStatusUseCase reports the real-time connection status of a session
+type LiveStatusUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewLiveStatusUseCase creates a new live status use case
+func NewLiveStatusUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *LiveStatusUseCase {
+	return &LiveStatusUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// LiveStatusRequest represents the request to get the live status of a session
+type LiveStatusRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// LiveStatusResponse represents the live status of a session.
+// Source tells whether the values come from the WhatsApp client or, when no client
+// is loaded, from the persisted session.
+type LiveStatusResponse struct {
+	Session         *session.Session `json:"session"`
+	Status          string           `json:"status"`
+	IsConnected     bool             `json:"is_connected"`
+	IsAuthenticated bool             `json:"is_authenticated"`
+	JID             string           `json:"jid"`
+	Source          string           `json:"source"`
+}
+
+// Execute returns the connection status of the session client, falling back to the
+// persisted session when the client is not loaded
+func (uc *LiveStatusUseCase) Execute(ctx context.Context, req LiveStatusRequest) (*LiveStatusResponse, error) {
+	// Get session from repository
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		if err != whatsapp.ErrClientNotFound {
+			uc.logger.ErrorWithError("failed to get WhatsApp client", err, logger.Fields{
+				"session_id": sess.ID().String(),
+			})
+			return nil, err
+		}
+
+		// Without a client there is no socket, whatever the persisted status says
+		return &LiveStatusResponse{
+			Session:         sess,
+			Status:          sess.Status().String(),
+			IsConnected:     false,
+			IsAuthenticated: sess.WaJID() != "",
+			JID:             sess.WaJID(),
+			Source:          StatusSourceDatabase,
+		}, nil
+	}
+
+	jid := waClient.GetJID()
+	if jid == "" {
+		jid = sess.WaJID()
+	}
+
+	return &LiveStatusResponse{
+		Session:         sess,
+		Status:          waClient.GetConnectionStatus().String(),
+		IsConnected:     waClient.IsConnected(),
+		IsAuthenticated: waClient.IsAuthenticated(),
+		JID:             jid,
+		Source:          StatusSourceClient,
+	}, nil
+}
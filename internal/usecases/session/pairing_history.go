@@ -0,0 +1,76 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/pkg/logger"
+)
+
+// PairingHistoryUseCase handles retrieving the pairing audit log of a session
+type PairingHistoryUseCase struct {
+	auditRepo session.PairingAuditRepository
+	logger    logger.Logger
+}
+
+// NewPairingHistoryUseCase creates a new pairing history use case
+func NewPairingHistoryUseCase(auditRepo session.PairingAuditRepository, logger logger.Logger) *PairingHistoryUseCase {
+	return &PairingHistoryUseCase{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// PairingHistoryRequest represents the request to list pairing attempts of a session
+type PairingHistoryRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	Limit     int               `json:"limit" validate:"min=1,max=100"`
+	Offset    int               `json:"offset" validate:"min=0"`
+}
+
+// PairingHistoryResponse represents the response with pairing attempts of a session
+type PairingHistoryResponse struct {
+	SessionID session.SessionID         `json:"session_id"`
+	Attempts  []*session.PairingAttempt `json:"attempts"`
+	Total     int                       `json:"total"`
+	Limit     int                       `json:"limit"`
+	Offset    int                       `json:"offset"`
+}
+
+// Execute lists pairing attempts of a session, newest first
+func (uc *PairingHistoryUseCase) Execute(ctx context.Context, req PairingHistoryRequest) (*PairingHistoryResponse, error) {
+	// Set default values
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	attempts, total, err := uc.auditRepo.ListBySessionID(ctx, req.SessionID, req.Limit, req.Offset)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to list pairing history", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+			"limit":      req.Limit,
+			"offset":     req.Offset,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("pairing history retrieved", logger.Fields{
+		"session_id": req.SessionID.String(),
+		"count":      len(attempts),
+		"total":      total,
+	})
+
+	return &PairingHistoryResponse{
+		SessionID: req.SessionID,
+		Attempts:  attempts,
+		Total:     total,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	}, nil
+}
@@ -64,11 +64,11 @@ func (uc *SetProxyUseCase) Execute(ctx context.Context, req SetProxyRequest) (*S
 	}
 
 	// Build complete proxy URL with type, credentials and proper format
-	proxyURL := uc.buildProxyURL(req.ProxyHost, req.ProxyPort, req.ProxyType, req.Username, req.Password)
+	proxyURL := buildProxyURL(req.ProxyHost, req.ProxyPort, req.ProxyType, req.Username, req.Password)
 
 	// Validate proxy URL format (only if not empty)
 	if proxyURL != "" {
-		if err := uc.validateProxyURL(proxyURL); err != nil {
+		if err := validateProxyURL(proxyURL); err != nil {
 			uc.logger.ErrorWithError("invalid proxy URL", err, logger.Fields{
 				"session_id": req.SessionID.String(),
 				"proxy_url":  proxyURL,
@@ -102,7 +102,7 @@ func (uc *SetProxyUseCase) Execute(ctx context.Context, req SetProxyRequest) (*S
 }
 
 // validateProxyURL validates the proxy URL format
-func (uc *SetProxyUseCase) validateProxyURL(proxyURL string) error {
+func validateProxyURL(proxyURL string) error {
 	parsedURL, err := url.Parse(proxyURL)
 	if err != nil {
 		return err
@@ -125,7 +125,7 @@ func (uc *SetProxyUseCase) validateProxyURL(proxyURL string) error {
 }
 
 // buildProxyURL builds a complete proxy URL with type, credentials and proper format
-func (uc *SetProxyUseCase) buildProxyURL(proxyHost string, proxyPort int, proxyType, username, password string) string {
+func buildProxyURL(proxyHost string, proxyPort int, proxyType, username, password string) string {
 	if proxyHost == "" {
 		return ""
 	}
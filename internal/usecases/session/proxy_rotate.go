@@ -0,0 +1,185 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// ProxySource identifies where a rotated proxy came from
+type ProxySource string
+
+const (
+	// ProxySourcePool indicates the proxy was taken from the configured pool
+	ProxySourcePool ProxySource = "pool"
+	// ProxySourceRequest indicates the proxy was supplied in the request
+	ProxySourceRequest ProxySource = "request"
+)
+
+// RotateProxyUseCase switches the proxy of a connected session without logging it out
+type RotateProxyUseCase struct {
+	repo      session.Repository
+	waManager whatsapp.Manager
+	logger    logger.Logger
+
+	// Proxy URLs rotated through when the request does not supply one
+	proxyPool []string
+}
+
+// NewRotateProxyUseCase creates a new rotate proxy use case
+func NewRotateProxyUseCase(repo session.Repository, waManager whatsapp.Manager, logger logger.Logger, proxyPool []string) *RotateProxyUseCase {
+	return &RotateProxyUseCase{
+		repo:      repo,
+		waManager: waManager,
+		logger:    logger,
+		proxyPool: proxyPool,
+	}
+}
+
+// RotateProxyRequest represents the request to rotate the proxy of a session.
+// When ProxyHost is empty the next proxy of the configured pool is used.
+type RotateProxyRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	ProxyHost string            `json:"proxy_host,omitempty"`
+	ProxyPort int               `json:"proxy_port,omitempty"`
+	ProxyType string            `json:"proxy_type,omitempty"`
+	Username  string            `json:"username,omitempty"`
+	Password  string            `json:"password,omitempty"`
+}
+
+// RotateProxyResponse represents the response from rotating a session proxy
+type RotateProxyResponse struct {
+	Session          *session.Session `json:"session"`
+	PreviousProxyURL string           `json:"previous_proxy_url"`
+	ProxyURL         string           `json:"proxy_url"`
+	Source           ProxySource      `json:"source"`
+	Downtime         time.Duration    `json:"downtime"`
+}
+
+// Execute reconnects the session through the new proxy and persists it
+func (uc *RotateProxyUseCase) Execute(ctx context.Context, req RotateProxyRequest) (*RotateProxyResponse, error) {
+	// Get session from repository
+	sess, err := uc.repo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Rotation only applies to live connections
+	if !sess.IsConnected() {
+		uc.logger.WarnWithFields("session not connected", logger.Fields{
+			"session_id": sess.ID().String(),
+			"status":     sess.Status().String(),
+		})
+		return nil, session.ErrSessionNotConnected
+	}
+
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		uc.logger.ErrorWithError("WhatsApp client not found", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, whatsapp.ErrClientNotFound
+	}
+
+	if !waClient.IsAuthenticated() {
+		uc.logger.WarnWithFields("WhatsApp client not authenticated", logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, whatsapp.ErrAuthenticationFailed
+	}
+
+	previousProxyURL := sess.ProxyURL()
+
+	proxyURL, source, err := uc.resolveProxy(req, previousProxyURL)
+	if err != nil {
+		uc.logger.WarnWithFields("no proxy to rotate to", logger.Fields{
+			"session_id": sess.ID().String(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	// Rotating reconnects the client, so it must not overlap a connect of the same session
+	release, err := uc.waManager.AcquireConnect(sess.ID())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Reconnect through the new proxy; outbound sends are held by the client meanwhile
+	startedAt := time.Now()
+	if err := waClient.SetProxy(ctx, proxyURL); err != nil {
+		uc.logger.ErrorWithError("failed to rotate proxy", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"source":     string(source),
+		})
+		return nil, err
+	}
+	downtime := time.Since(startedAt)
+
+	if err := sess.SetProxyURL(proxyURL); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to update session with rotated proxy", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session proxy rotated", logger.Fields{
+		"session_id":  sess.ID().String(),
+		"source":      string(source),
+		"downtime_ms": downtime.Milliseconds(),
+	})
+
+	return &RotateProxyResponse{
+		Session:          sess,
+		PreviousProxyURL: previousProxyURL,
+		ProxyURL:         proxyURL,
+		Source:           source,
+		Downtime:         downtime,
+	}, nil
+}
+
+// resolveProxy returns the supplied proxy, or the pool entry following the current one
+func (uc *RotateProxyUseCase) resolveProxy(req RotateProxyRequest, currentProxyURL string) (string, ProxySource, error) {
+	if req.ProxyHost != "" {
+		proxyURL := buildProxyURL(req.ProxyHost, req.ProxyPort, req.ProxyType, req.Username, req.Password)
+		if err := validateProxyURL(proxyURL); err != nil {
+			return "", "", err
+		}
+		return proxyURL, ProxySourceRequest, nil
+	}
+
+	proxyURL := nextPoolProxy(uc.proxyPool, currentProxyURL)
+	if proxyURL == "" {
+		return "", "", session.ErrNoProxyAvailable
+	}
+	if err := validateProxyURL(proxyURL); err != nil {
+		return "", "", err
+	}
+	return proxyURL, ProxySourcePool, nil
+}
+
+// nextPoolProxy returns the pool entry after the current proxy, wrapping around.
+// The first entry is returned when the current proxy is not part of the pool.
+func nextPoolProxy(pool []string, currentProxyURL string) string {
+	if len(pool) == 0 {
+		return ""
+	}
+
+	for i, proxyURL := range pool {
+		if proxyURL == currentProxyURL {
+			return pool[(i+1)%len(pool)]
+		}
+	}
+
+	return pool[0]
+}
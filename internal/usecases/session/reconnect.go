@@ -230,6 +230,19 @@ func (uc *AutoReconnectUseCase) reconnectSession(
 		"wa_jid":       sess.WaJID(),
 	})
 
+	// Skip sessions that are already being connected by another request
+	release, err := uc.waManager.AcquireConnect(sessionID)
+	if err != nil {
+		return SessionReconnectionResult{
+			SessionID:   sessionID,
+			SessionName: sessionName,
+			Success:     false,
+			Error:       err.Error(),
+			Duration:    time.Since(startTime),
+		}
+	}
+	defer release()
+
 	// Create context with timeout
 	reconnectCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -250,7 +263,7 @@ func (uc *AutoReconnectUseCase) reconnectSession(
 			})
 
 			// Update session status to disconnected
-			sess.Disconnect()
+			sess.DisconnectWithReason(session.DisconnectReasonConnectFailed)
 			uc.sessionRepo.Update(ctx, sess)
 
 			return SessionReconnectionResult{
@@ -276,7 +289,7 @@ func (uc *AutoReconnectUseCase) reconnectSession(
 		})
 
 		// Update session status to disconnected
-		sess.Disconnect()
+		sess.DisconnectWithReason(session.DisconnectReasonConnectFailed)
 		uc.sessionRepo.Update(ctx, sess)
 
 		return SessionReconnectionResult{
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/pkg/logger"
+)
+
+// RenameUseCase handles changing the unique name of a session
+type RenameUseCase struct {
+	repo   session.Repository
+	logger logger.Logger
+}
+
+// NewRenameUseCase creates a new rename session use case
+func NewRenameUseCase(repo session.Repository, logger logger.Logger) *RenameUseCase {
+	return &RenameUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// RenameRequest represents the request to rename a session
+type RenameRequest struct {
+	SessionID session.SessionID `json:"session_id" validate:"required"`
+	Name      string            `json:"name"`
+}
+
+// RenameResponse represents the response from renaming a session
+type RenameResponse struct {
+	Session *session.Session `json:"session"`
+}
+
+// Execute renames a session, rejecting names that break the naming rules or belong to another session
+func (uc *RenameUseCase) Execute(ctx context.Context, req RenameRequest) (*RenameResponse, error) {
+	name := strings.TrimSpace(req.Name)
+
+	// Get session from repository
+	sess, err := uc.repo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	previousName := sess.Name()
+	if name == previousName {
+		return &RenameResponse{Session: sess}, nil
+	}
+
+	// Check if another session already uses the name
+	existing, err := uc.repo.GetByName(ctx, name)
+	if err != nil && err != session.ErrSessionNotFound {
+		uc.logger.ErrorWithError("failed to check existing session", err, logger.Fields{
+			"name": name,
+		})
+		return nil, err
+	}
+
+	if existing != nil && existing.ID() != sess.ID() {
+		uc.logger.WarnWithFields("session with name already exists", logger.Fields{
+			"name":       name,
+			"session_id": existing.ID().String(),
+		})
+		return nil, session.ErrSessionAlreadyExists
+	}
+
+	if err := sess.UpdateName(name); err != nil {
+		uc.logger.WarnWithFields("invalid session name", logger.Fields{
+			"session_id": sess.ID().String(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	// Update session in repository
+	if err := uc.repo.Update(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to rename session", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session renamed", logger.Fields{
+		"session_id":    sess.ID().String(),
+		"session_name":  sess.Name(),
+		"previous_name": previousName,
+	})
+
+	return &RenameResponse{
+		Session: sess,
+	}, nil
+}
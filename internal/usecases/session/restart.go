@@ -0,0 +1,91 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// RestartUseCase handles restarting the WhatsApp client of a session
+type RestartUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewRestartUseCase creates a new restart session use case
+func NewRestartUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *RestartUseCase {
+	return &RestartUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// RestartRequest represents the request to restart a session
+type RestartRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// RestartResponse represents the response from restarting a session.
+// Status is the client connection status right after the restart; the session status
+// is updated asynchronously by the connection events.
+type RestartResponse struct {
+	Session *session.Session          `json:"session"`
+	Status  whatsapp.ConnectionStatus `json:"status"`
+}
+
+// Execute disconnects and reconnects the session client, recreating it for paired
+// sessions whose client is not loaded (e.g. after a server restart)
+func (uc *RestartUseCase) Execute(ctx context.Context, req RestartRequest) (*RestartResponse, error) {
+	// Get session from repository
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	err = uc.waManager.RestartClient(sess.ID())
+	if err == whatsapp.ErrClientNotFound {
+		// Only paired sessions can be resumed without a new QR code or pairing code
+		if sess.WaJID() == "" {
+			return nil, session.ErrSessionNotPaired
+		}
+
+		if _, err := uc.waManager.CreateClient(sess.ID()); err != nil {
+			uc.logger.ErrorWithError("failed to create WhatsApp client", err, logger.Fields{
+				"session_id": sess.ID().String(),
+			})
+			return nil, err
+		}
+		err = uc.waManager.RestartClient(sess.ID())
+	}
+	if err != nil {
+		uc.logger.ErrorWithError("failed to restart WhatsApp client", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	response := &RestartResponse{
+		Session: sess,
+		Status:  whatsapp.StatusDisconnected,
+	}
+	if client, err := uc.waManager.GetClient(sess.ID()); err == nil {
+		response.Status = client.GetConnectionStatus()
+	}
+	if updated, err := uc.sessionRepo.GetByID(ctx, sess.ID()); err == nil {
+		response.Session = updated
+	}
+
+	uc.logger.InfoWithFields("session restarted", logger.Fields{
+		"session_id": sess.ID().String(),
+		"status":     response.Status.String(),
+	})
+
+	return response, nil
+}
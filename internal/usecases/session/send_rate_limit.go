@@ -0,0 +1,90 @@
+package session
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// SetSendRateLimitUseCase handles overriding the outbound message limit of a session
+type SetSendRateLimitUseCase struct {
+	repo      session.Repository
+	logger    logger.Logger
+	validator validator.Validator
+}
+
+// NewSetSendRateLimitUseCase creates a new set send rate limit use case
+func NewSetSendRateLimitUseCase(repo session.Repository, logger logger.Logger, validator validator.Validator) *SetSendRateLimitUseCase {
+	return &SetSendRateLimitUseCase{
+		repo:      repo,
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+// SetSendRateLimitRequest represents the request to set the send rate limit of a session.
+// A nil limit goes back to the global limit; a zero rate sends without limit.
+type SetSendRateLimitRequest struct {
+	SessionID session.SessionID      `json:"session_id" validate:"required"`
+	Limit     *session.SendRateLimit `json:"limit,omitempty"`
+}
+
+// SetSendRateLimitResponse represents the response from setting the send rate limit
+type SetSendRateLimitResponse struct {
+	Session *session.Session `json:"session"`
+}
+
+// Execute sets the send rate limit of a session. Sends read it from the session, so it
+// applies to the next message without reconnecting.
+func (uc *SetSendRateLimitUseCase) Execute(ctx context.Context, req SetSendRateLimitRequest) (*SetSendRateLimitResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for set send rate limit", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Get session from repository
+	sess, err := uc.repo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	if err := sess.SetSendRateLimit(req.Limit); err != nil {
+		uc.logger.WarnWithFields("invalid send rate limit", logger.Fields{
+			"session_id": sess.ID().String(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	// Update session in repository
+	if err := uc.repo.Update(ctx, sess); err != nil {
+		uc.logger.ErrorWithError("failed to update session send rate limit", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	fields := logger.Fields{
+		"session_id":   sess.ID().String(),
+		"session_name": sess.Name(),
+		"global":       true,
+	}
+	if limit := sess.SendRateLimit(); limit != nil {
+		fields["global"] = false
+		fields["per_second"] = limit.PerSecond
+		fields["burst"] = limit.Burst
+	}
+	uc.logger.InfoWithFields("session send rate limit updated", fields)
+
+	return &SetSendRateLimitResponse{
+		Session: sess,
+	}, nil
+}
@@ -0,0 +1,487 @@
+package whatsapp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// MarkReadUseCase handles sending read receipts (blue ticks) for messages of a chat
+type MarkReadUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewMarkReadUseCase creates a new mark read use case
+func NewMarkReadUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *MarkReadUseCase {
+	return &MarkReadUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// MarkReadRequest represents the request to mark messages as read.
+// Chat and Sender accept a phone number or a JID; Sender is required in group chats.
+type MarkReadRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	Sender      string            `json:"sender,omitempty"`
+	MessageIDs  []string          `json:"message_ids" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// MarkReadResponse represents the response from marking messages as read
+type MarkReadResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	Chat      string            `json:"chat"`
+	Count     int               `json:"count"`
+}
+
+// Execute sends a single read receipt covering all given messages
+func (uc *MarkReadUseCase) Execute(ctx context.Context, req MarkReadRequest) (*MarkReadResponse, error) {
+	messageIDs := uniqueMessageIDs(req.MessageIDs)
+	if len(messageIDs) == 0 {
+		return nil, whatsapp.ErrNoMessageIDs
+	}
+	if len(messageIDs) > whatsapp.MaxReadReceiptMessages {
+		return nil, whatsapp.ErrTooManyMessageIDs
+	}
+
+	countryCode := utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode)
+	chat := utils.FormatWhatsAppJID(req.Chat, countryCode)
+
+	var sender string
+	if strings.TrimSpace(req.Sender) != "" {
+		sender = utils.FormatWhatsAppJID(req.Sender, countryCode)
+	} else if strings.HasSuffix(chat, "@g.us") {
+		return nil, whatsapp.ErrMessageSenderNeeded
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := waClient.MarkRead(ctx, chat, sender, messageIDs); err != nil {
+		uc.logger.ErrorWithError("failed to mark messages as read", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("messages marked as read", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+		"count":      len(messageIDs),
+	})
+
+	return &MarkReadResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		Count:     len(messageIDs),
+	}, nil
+}
+
+// MarkUnreadUseCase handles flagging a chat as unread, e.g. to hand it over to a human agent
+type MarkUnreadUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewMarkUnreadUseCase creates a new mark unread use case
+func NewMarkUnreadUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *MarkUnreadUseCase {
+	return &MarkUnreadUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// MarkUnreadRequest represents the request to mark a chat as unread
+type MarkUnreadRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// MarkUnreadResponse represents the chat state after marking it as unread
+type MarkUnreadResponse struct {
+	SessionID session.SessionID   `json:"session_id"`
+	Chat      string              `json:"chat"`
+	State     *whatsapp.ChatState `json:"state"`
+}
+
+// Execute marks a chat as unread on all devices of the session account
+func (uc *MarkUnreadUseCase) Execute(ctx context.Context, req MarkUnreadRequest) (*MarkUnreadResponse, error) {
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := waClient.MarkChatUnread(ctx, chat)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to mark chat as unread", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("chat marked as unread", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+	})
+
+	return &MarkUnreadResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		State:     state,
+	}, nil
+}
+
+// UpdateChatStateResponse represents the chat state after archiving or muting it
+type UpdateChatStateResponse struct {
+	SessionID session.SessionID   `json:"session_id"`
+	Chat      string              `json:"chat"`
+	State     *whatsapp.ChatState `json:"state"`
+}
+
+// ArchiveChatUseCase handles archiving and unarchiving chats
+type ArchiveChatUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewArchiveChatUseCase creates a new archive chat use case
+func NewArchiveChatUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *ArchiveChatUseCase {
+	return &ArchiveChatUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// ArchiveChatRequest represents the request to archive or unarchive a chat
+type ArchiveChatRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	Archive     bool              `json:"archive"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// Execute archives or unarchives a chat on all devices of the session account
+func (uc *ArchiveChatUseCase) Execute(ctx context.Context, req ArchiveChatRequest) (*UpdateChatStateResponse, error) {
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	if err := whatsapp.ValidateChatJID(chat); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := waClient.ArchiveChat(ctx, chat, req.Archive)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to update chat archive", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+			"archive":    req.Archive,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("chat archive updated", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+		"archive":    req.Archive,
+	})
+
+	return &UpdateChatStateResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		State:     state,
+	}, nil
+}
+
+// MuteChatUseCase handles muting and unmuting chats
+type MuteChatUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewMuteChatUseCase creates a new mute chat use case
+func NewMuteChatUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *MuteChatUseCase {
+	return &MuteChatUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// MuteChatRequest represents the request to mute a chat until a given time.
+// A zero Until unmutes the chat.
+type MuteChatRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	Until       time.Time         `json:"until"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// Execute mutes or unmutes a chat on all devices of the session account
+func (uc *MuteChatUseCase) Execute(ctx context.Context, req MuteChatRequest) (*UpdateChatStateResponse, error) {
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	if err := whatsapp.ValidateChatJID(chat); err != nil {
+		return nil, err
+	}
+	if err := whatsapp.ValidateMuteUntil(req.Until, time.Now()); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := waClient.MuteChat(ctx, chat, req.Until)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to update chat mute", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("chat mute updated", logger.Fields{
+		"session_id":  sess.ID().String(),
+		"chat":        chat,
+		"muted_until": req.Until,
+	})
+
+	return &UpdateChatStateResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		State:     state,
+	}, nil
+}
+
+// ChatActionRequest represents a request to delete or clear a chat
+type ChatActionRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// ChatActionResponse represents the response from deleting or clearing a chat
+type ChatActionResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	Chat      string            `json:"chat"`
+}
+
+// DeleteChatUseCase handles deleting chats from the chat list of the session account
+type DeleteChatUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewDeleteChatUseCase creates a new delete chat use case
+func NewDeleteChatUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *DeleteChatUseCase {
+	return &DeleteChatUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// Execute sends the delete chat request for the chat list of the session account.
+// WhatsApp applies it to the other devices asynchronously.
+func (uc *DeleteChatUseCase) Execute(ctx context.Context, req ChatActionRequest) (*ChatActionResponse, error) {
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	if err := whatsapp.ValidateChatJID(chat); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := waClient.DeleteChat(ctx, chat); err != nil {
+		uc.logger.ErrorWithError("failed to delete chat", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("chat delete requested", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+	})
+
+	return &ChatActionResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+	}, nil
+}
+
+// ClearChatUseCase handles clearing the message history of chats of the session account
+type ClearChatUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewClearChatUseCase creates a new clear chat use case
+func NewClearChatUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *ClearChatUseCase {
+	return &ClearChatUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// Execute sends the clear chat request for the chat list of the session account.
+// WhatsApp applies it to the other devices asynchronously.
+func (uc *ClearChatUseCase) Execute(ctx context.Context, req ChatActionRequest) (*ChatActionResponse, error) {
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	if err := whatsapp.ValidateChatJID(chat); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := waClient.ClearChat(ctx, chat); err != nil {
+		uc.logger.ErrorWithError("failed to clear chat", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("chat clear requested", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+	})
+
+	return &ChatActionResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+	}, nil
+}
+
+// ListChatsUseCase handles listing the known chats of a session
+type ListChatsUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewListChatsUseCase creates a new list chats use case
+func NewListChatsUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *ListChatsUseCase {
+	return &ListChatsUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// ListChatsRequest represents the request to list the chats of a session
+type ListChatsRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// ListChatsResponse represents the chat list of a session, most recent first.
+// SyncComplete is false while the history sync is still delivering conversations.
+type ListChatsResponse struct {
+	SessionID    session.SessionID       `json:"session_id"`
+	Chats        []*whatsapp.ChatSummary `json:"chats"`
+	SyncComplete bool                    `json:"sync_complete"`
+}
+
+// Execute returns the chats known so far, without waiting for the history sync
+func (uc *ListChatsUseCase) Execute(ctx context.Context, req ListChatsRequest) (*ListChatsResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := waClient.GetChats(ctx)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to list chats", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("chats listed", logger.Fields{
+		"session_id":    sess.ID().String(),
+		"count":         len(list.Chats),
+		"sync_complete": list.SyncComplete,
+	})
+
+	return &ListChatsResponse{
+		SessionID:    sess.ID(),
+		Chats:        list.Chats,
+		SyncComplete: list.SyncComplete,
+	}, nil
+}
+
+// uniqueMessageIDs trims message IDs and drops empty and duplicate entries, keeping their order
+func uniqueMessageIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,202 @@
+package whatsapp
+
+import (
+	"context"
+	"sort"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// GetContactsUseCase handles listing the contacts stored for a session
+type GetContactsUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewGetContactsUseCase creates a new get contacts use case
+func NewGetContactsUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *GetContactsUseCase {
+	return &GetContactsUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// ListContactsRequest represents the request to list contacts of a session.
+// When After is set the page starts right after that JID and Offset is ignored.
+type ListContactsRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	Limit     int               `json:"limit" validate:"min=1,max=500"`
+	Offset    int               `json:"offset" validate:"min=0"`
+	After     string            `json:"after,omitempty"`
+}
+
+// ListContactsResponse represents a page of contacts of a session.
+// NextAfter is the JID to pass as After for the next page, empty on the last page.
+type ListContactsResponse struct {
+	SessionID session.SessionID       `json:"session_id"`
+	Contacts  []*whatsapp.ContactInfo `json:"contacts"`
+	Total     int                     `json:"total"`
+	Limit     int                     `json:"limit"`
+	Offset    int                     `json:"offset"`
+	NextAfter string                  `json:"next_after,omitempty"`
+}
+
+// Execute lists a page of the contacts stored for the session
+func (uc *GetContactsUseCase) Execute(ctx context.Context, req ListContactsRequest) (*ListContactsResponse, error) {
+	// Set default values
+	if req.Limit <= 0 {
+		req.Limit = 100
+	}
+	if req.Limit > 500 {
+		req.Limit = 500
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := waClient.GetContacts(ctx)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get contacts", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	total := len(contacts)
+	start := min(req.Offset, total)
+	if req.After != "" {
+		// Contacts are sorted by JID, so the page starts at the first JID past the cursor
+		start = sort.Search(total, func(i int) bool {
+			return contacts[i].JID > req.After
+		})
+	}
+	end := min(start+req.Limit, total)
+
+	var nextAfter string
+	if end < total && end > start {
+		nextAfter = contacts[end-1].JID
+	}
+
+	uc.logger.InfoWithFields("contacts retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"count":      end - start,
+		"total":      total,
+	})
+
+	return &ListContactsResponse{
+		SessionID: sess.ID(),
+		Contacts:  contacts[start:end],
+		Total:     total,
+		Limit:     req.Limit,
+		Offset:    start,
+		NextAfter: nextAfter,
+	}, nil
+}
+
+// CheckNumbersUseCase handles checking whether phone numbers are registered on WhatsApp
+type CheckNumbersUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewCheckNumbersUseCase creates a new check numbers use case
+func NewCheckNumbersUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *CheckNumbersUseCase {
+	return &CheckNumbersUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// CheckNumbersRequest represents the request to check phone numbers on WhatsApp
+type CheckNumbersRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Phones      []string          `json:"phones"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// CheckNumbersResponse represents the per-number results of a check, in input order
+type CheckNumbersResponse struct {
+	SessionID session.SessionID             `json:"session_id"`
+	Results   []*whatsapp.NumberCheckResult `json:"results"`
+}
+
+// Execute normalizes the phone numbers and checks which are registered on WhatsApp
+func (uc *CheckNumbersUseCase) Execute(ctx context.Context, req CheckNumbersRequest) (*CheckNumbersResponse, error) {
+	if len(req.Phones) == 0 {
+		return nil, whatsapp.ErrNoPhoneNumbers
+	}
+	if len(req.Phones) > whatsapp.MaxNumbersPerCheck {
+		return nil, whatsapp.ErrTooManyPhoneNumbers
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalize numbers, querying each distinct valid number only once
+	countryCode := utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode)
+	normalized := make([]string, len(req.Phones))
+	queries := make([]string, 0, len(req.Phones))
+	seen := make(map[string]bool, len(req.Phones))
+	for i, phone := range req.Phones {
+		normalized[i] = utils.NormalizePhoneNumber(phone, countryCode)
+		if normalized[i] == "" || seen[normalized[i]] {
+			continue
+		}
+		seen[normalized[i]] = true
+		queries = append(queries, normalized[i])
+	}
+
+	found := make(map[string]*whatsapp.NumberCheckResult, len(queries))
+	if len(queries) > 0 {
+		checked, err := waClient.IsOnWhatsApp(ctx, queries)
+		if err != nil {
+			uc.logger.ErrorWithError("failed to check numbers on WhatsApp", err, logger.Fields{
+				"session_id": sess.ID().String(),
+				"count":      len(queries),
+			})
+			return nil, err
+		}
+		for _, result := range checked {
+			found[result.Query] = result
+		}
+	}
+
+	// Report results against the numbers as they were sent
+	results := make([]*whatsapp.NumberCheckResult, 0, len(req.Phones))
+	for i, phone := range req.Phones {
+		result := &whatsapp.NumberCheckResult{Query: phone}
+		if checked, ok := found[normalized[i]]; ok {
+			result.Exists = checked.Exists
+			result.JID = checked.JID
+		}
+		results = append(results, result)
+	}
+
+	uc.logger.InfoWithFields("numbers checked on WhatsApp", logger.Fields{
+		"session_id": sess.ID().String(),
+		"count":      len(results),
+	})
+
+	return &CheckNumbersResponse{
+		SessionID: sess.ID(),
+		Results:   results,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// ForwardMessageUseCase handles forwarding a stored message to another chat
+type ForwardMessageUseCase struct {
+	sessionRepo session.Repository
+	messageRepo whatsapp.MessageRepository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
+}
+
+// NewForwardMessageUseCase creates a new forward message use case
+func NewForwardMessageUseCase(sessionRepo session.Repository, messageRepo whatsapp.MessageRepository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, limiter *SendLimiter) *ForwardMessageUseCase {
+	return &ForwardMessageUseCase{
+		sessionRepo:        sessionRepo,
+		messageRepo:        messageRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		limiter:            limiter,
+	}
+}
+
+// ForwardMessageRequest represents the request to forward a message
+type ForwardMessageRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	MessageID   string            `json:"message_id" validate:"required"`
+	Chat        string            `json:"chat" validate:"required"` // Chat the message was sent or received in
+	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// ForwardMessageResponse represents the response from forwarding a message
+type ForwardMessageResponse struct {
+	SessionID         session.SessionID `json:"session_id"`
+	Chat              string            `json:"chat"`
+	To                string            `json:"to"`
+	OriginalMessageID string            `json:"original_message_id"`
+	MessageID         string            `json:"message_id"`
+}
+
+// Execute forwards a message stored for the session from its chat to the recipient
+func (uc *ForwardMessageUseCase) Execute(ctx context.Context, req ForwardMessageRequest) (*ForwardMessageResponse, error) {
+	chat, err := formatRecipientJID(req.Chat, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject unknown or foreign messages before taking a send token
+	stored, err := uc.messageRepo.GetByID(ctx, sess.ID(), req.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	if stored.ChatJID != chat {
+		uc.logger.WarnWithFields("message does not belong to chat", logger.Fields{
+			"session_id":   sess.ID().String(),
+			"message_id":   req.MessageID,
+			"chat":         chat,
+			"message_chat": stored.ChatJID,
+		})
+		return nil, whatsapp.ErrMessageNotFound
+	}
+
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	messageID, err := waClient.ForwardMessage(ctx, chat, req.MessageID, formattedTo)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to forward message", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+			"message_id": req.MessageID,
+			"to":         formattedTo,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("message forwarded successfully", logger.Fields{
+		"session_id":          sess.ID().String(),
+		"chat":                chat,
+		"to":                  formattedTo,
+		"original_message_id": req.MessageID,
+		"message_id":          messageID,
+	})
+
+	return &ForwardMessageResponse{
+		SessionID:         sess.ID(),
+		Chat:              chat,
+		To:                formattedTo,
+		OriginalMessageID: req.MessageID,
+		MessageID:         messageID,
+	}, nil
+}
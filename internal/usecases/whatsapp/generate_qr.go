@@ -2,6 +2,7 @@ package whatsapp
 
 import (
 	"context"
+	"time"
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/internal/domain/whatsapp"
@@ -24,15 +25,29 @@ func NewGenerateQRUseCase(sessionRepo session.Repository, waManager whatsapp.Man
 	}
 }
 
+// QR code formats returned by GenerateQRUseCase
+const (
+	// QRFormatPNG returns the QR code as a base64 PNG data URI (the default)
+	QRFormatPNG = "png"
+	// QRFormatRaw returns the raw pairing string, for clients that render the QR code themselves
+	QRFormatRaw = "raw"
+)
+
 // GenerateQRRequest represents the request to generate a QR code
 type GenerateQRRequest struct {
 	SessionID session.SessionID `json:"session_id"`
+	Format    string            `json:"format"`
 }
 
-// GenerateQRResponse represents the response from generating a QR code
+// GenerateQRResponse represents the response from generating a QR code.
+// QRCode is set for the png format and RawCode for the raw format; ExpiresAt tells
+// when the code must be fetched again and is zero when no code is returned.
 type GenerateQRResponse struct {
 	SessionID session.SessionID `json:"session_id"`
 	QRCode    string            `json:"qr_code"`
+	RawCode   string            `json:"raw_code"`
+	Format    string            `json:"format"`
+	ExpiresAt time.Time         `json:"expires_at"`
 	Message   string            `json:"message"`
 }
 
@@ -56,15 +71,21 @@ func (uc *GenerateQRUseCase) Execute(ctx context.Context, req GenerateQRRequest)
 		return nil, session.ErrSessionAlreadyConnected
 	}
 
-	// Check if session already has a QR code saved
-	if sess.QRCode() != "" {
+	if req.Format == "" {
+		req.Format = QRFormatPNG
+	}
+
+	// The database keeps the raw pairing string of the last QR code until it expires
+	if req.Format == QRFormatRaw && sess.HasValidQRCode() {
 		uc.logger.InfoWithFields("returning saved QR code from database", logger.Fields{
 			"session_id": sess.ID().String(),
 			"qr_length":  len(sess.QRCode()),
 		})
 		return &GenerateQRResponse{
 			SessionID: sess.ID(),
-			QRCode:    sess.QRCode(),
+			RawCode:   sess.QRCode(),
+			Format:    req.Format,
+			ExpiresAt: sess.QRCodeExpiresAt(),
 			Message:   "QR code retrieved from database. Scan with WhatsApp mobile app.",
 		}, nil
 	}
@@ -90,12 +111,19 @@ func (uc *GenerateQRUseCase) Execute(ctx context.Context, req GenerateQRRequest)
 		})
 		return &GenerateQRResponse{
 			SessionID: sess.ID(),
+			Format:    req.Format,
 			Message:   "Session already authenticated",
 		}, nil
 	}
 
 	// Generate QR code
 	qrCode, err := waClient.GenerateQR(ctx)
+	if err == whatsapp.ErrQRNotReady {
+		uc.logger.InfoWithFields("QR code not ready yet", logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
 	if err != nil {
 		uc.logger.ErrorWithError("failed to generate QR code", err, logger.Fields{
 			"session_id": sess.ID().String(),
@@ -106,13 +134,22 @@ func (uc *GenerateQRUseCase) Execute(ctx context.Context, req GenerateQRRequest)
 	uc.logger.InfoWithFields("QR code generated successfully", logger.Fields{
 		"session_id": sess.ID().String(),
 		"qr_length":  len(qrCode),
+		"format":     req.Format,
 	})
 
-	return &GenerateQRResponse{
+	response := &GenerateQRResponse{
 		SessionID: sess.ID(),
-		QRCode:    qrCode,
+		Format:    req.Format,
+		ExpiresAt: waClient.QRCodeExpiresAt(),
 		Message:   "QR code generated successfully. Scan with WhatsApp mobile app.",
-	}, nil
+	}
+	if req.Format == QRFormatRaw {
+		response.RawCode = waClient.GetQRCode()
+	} else {
+		response.QRCode = qrCode
+	}
+
+	return response, nil
 }
 
 // RefreshQRRequest represents the request to refresh a QR code
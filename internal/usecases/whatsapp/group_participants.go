@@ -0,0 +1,118 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+	"wazmeow/pkg/validator"
+)
+
+// UpdateGroupParticipantsUseCase handles adding, removing, promoting and demoting group participants
+type UpdateGroupParticipantsUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewUpdateGroupParticipantsUseCase creates a new update group participants use case
+func NewUpdateGroupParticipantsUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *UpdateGroupParticipantsUseCase {
+	return &UpdateGroupParticipantsUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// UpdateGroupParticipantsRequest represents the request to change group participants
+type UpdateGroupParticipantsRequest struct {
+	SessionID    session.SessionID `json:"session_id"`
+	GroupJID     string            `json:"group_jid" validate:"required"`
+	Action       string            `json:"action" validate:"required"`
+	Participants []string          `json:"participants" validate:"required,min=1"`
+	CountryCode  string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// UpdateGroupParticipantsResponse represents the per-participant results of a participant change
+type UpdateGroupParticipantsResponse struct {
+	SessionID session.SessionID                   `json:"session_id"`
+	GroupJID  string                              `json:"group_jid"`
+	Action    whatsapp.ParticipantAction          `json:"action"`
+	Results   []*whatsapp.ParticipantUpdateResult `json:"results"`
+}
+
+// Execute applies the participant change and returns the outcome for each participant
+func (uc *UpdateGroupParticipantsUseCase) Execute(ctx context.Context, req UpdateGroupParticipantsRequest) (*UpdateGroupParticipantsResponse, error) {
+	// Validate request
+	if err := uc.validator.Validate(req); err != nil {
+		uc.logger.ErrorWithError("validation failed for update group participants", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+			"group_jid":  req.GroupJID,
+		})
+		return nil, err
+	}
+
+	action, err := whatsapp.ParseParticipantAction(req.Action)
+	if err != nil {
+		uc.logger.WarnWithFields("invalid participant action", logger.Fields{
+			"session_id": req.SessionID.String(),
+			"action":     req.Action,
+		})
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupJID := formatGroupJID(req.GroupJID)
+
+	// Normalize phone numbers to JIDs
+	countryCode := utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode)
+	participants := make([]string, 0, len(req.Participants))
+	for _, participant := range req.Participants {
+		participants = append(participants, utils.FormatWhatsAppJID(participant, countryCode))
+	}
+
+	results, err := waClient.UpdateGroupParticipants(ctx, groupJID, participants, action.String())
+	if err != nil {
+		uc.logger.ErrorWithError("failed to update group participants", err, logger.Fields{
+			"session_id":   sess.ID().String(),
+			"group_jid":    groupJID,
+			"action":       action.String(),
+			"participants": len(participants),
+		})
+		return nil, err
+	}
+
+	succeeded := 0
+	for _, result := range results {
+		if result.Success {
+			succeeded++
+		}
+	}
+
+	uc.logger.InfoWithFields("group participants updated", logger.Fields{
+		"session_id": sess.ID().String(),
+		"group_jid":  groupJID,
+		"action":     action.String(),
+		"succeeded":  succeeded,
+		"failed":     len(results) - succeeded,
+	})
+
+	return &UpdateGroupParticipantsResponse{
+		SessionID: sess.ID(),
+		GroupJID:  groupJID,
+		Action:    action,
+		Results:   results,
+	}, nil
+}
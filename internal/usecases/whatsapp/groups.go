@@ -0,0 +1,110 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetGroupsUseCase handles retrieving group metadata for a session
+type GetGroupsUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewGetGroupsUseCase creates a new get groups use case
+func NewGetGroupsUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *GetGroupsUseCase {
+	return &GetGroupsUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// ListGroupsRequest represents the request to list joined groups
+type ListGroupsRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// ListGroupsResponse represents the response from listing joined groups
+type ListGroupsResponse struct {
+	SessionID session.SessionID     `json:"session_id"`
+	Groups    []*whatsapp.GroupInfo `json:"groups"`
+	Total     int                   `json:"total"`
+}
+
+// Execute lists the groups the session is a member of
+func (uc *GetGroupsUseCase) Execute(ctx context.Context, req ListGroupsRequest) (*ListGroupsResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := waClient.GetJoinedGroups(ctx)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get joined groups", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("joined groups retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"count":      len(groups),
+	})
+
+	return &ListGroupsResponse{
+		SessionID: sess.ID(),
+		Groups:    groups,
+		Total:     len(groups),
+	}, nil
+}
+
+// GetGroupInfoRequest represents the request to get a group's metadata
+type GetGroupInfoRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	GroupJID  string            `json:"group_jid" validate:"required"`
+}
+
+// GetGroupInfoResponse represents the response with a group's metadata
+type GetGroupInfoResponse struct {
+	SessionID session.SessionID   `json:"session_id"`
+	Group     *whatsapp.GroupInfo `json:"group"`
+}
+
+// ExecuteGetInfo returns metadata of a single group
+func (uc *GetGroupsUseCase) ExecuteGetInfo(ctx context.Context, req GetGroupInfoRequest) (*GetGroupInfoResponse, error) {
+	if req.GroupJID == "" {
+		return nil, whatsapp.ErrInvalidGroupJID
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupJID := formatGroupJID(req.GroupJID)
+
+	group, err := waClient.GetGroupInfo(ctx, groupJID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get group info", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"group_jid":  groupJID,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("group info retrieved", logger.Fields{
+		"session_id":   sess.ID().String(),
+		"group_jid":    group.JID,
+		"participants": len(group.Participants),
+	})
+
+	return &GetGroupInfoResponse{
+		SessionID: sess.ID(),
+		Group:     group,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetHealthUseCase handles retrieving the health issues of a session client
+type GetHealthUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewGetHealthUseCase creates a new get health use case
+func NewGetHealthUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *GetHealthUseCase {
+	return &GetHealthUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// GetHealthRequest represents the request to get the session health
+type GetHealthRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// GetHealthResponse represents the response with the session health
+type GetHealthResponse struct {
+	SessionID session.SessionID      `json:"session_id"`
+	Health    *whatsapp.ClientHealth `json:"health"`
+}
+
+// Execute returns the unresolved health issues of the session client.
+// The session does not need to be connected, since some issues (such as a
+// rejected credential refresh) are what caused the disconnection.
+func (uc *GetHealthUseCase) Execute(ctx context.Context, req GetHealthRequest) (*GetHealthResponse, error) {
+	// Get session from repository
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Get WhatsApp client
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		uc.logger.WarnWithFields("WhatsApp client not found", logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, whatsapp.ErrClientNotFound
+	}
+
+	health := waClient.GetHealth()
+
+	uc.logger.InfoWithFields("session health retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"healthy":    health.Healthy,
+		"issues":     len(health.Issues),
+	})
+
+	return &GetHealthResponse{
+		SessionID: sess.ID(),
+		Health:    health,
+	}, nil
+}
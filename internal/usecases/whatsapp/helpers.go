@@ -0,0 +1,77 @@
+package whatsapp
+
+import (
+	"context"
+	"strings"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// getAuthenticatedClient loads a session and returns its connected, authenticated WhatsApp client
+func getAuthenticatedClient(
+	ctx context.Context,
+	sessionRepo session.Repository,
+	waManager whatsapp.Manager,
+	log logger.Logger,
+	sessionID session.SessionID,
+) (*session.Session, whatsapp.Client, error) {
+	// Get session from repository
+	sess, err := sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		log.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": sessionID.String(),
+		})
+		return nil, nil, err
+	}
+
+	// Check if session is connected
+	if !sess.IsConnected() {
+		log.WarnWithFields("session not connected", logger.Fields{
+			"session_id": sess.ID().String(),
+			"status":     sess.Status().String(),
+		})
+		return nil, nil, session.ErrSessionNotConnected
+	}
+
+	// Get WhatsApp client
+	waClient, err := waManager.GetClient(sess.ID())
+	if err != nil {
+		log.ErrorWithError("WhatsApp client not found", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, nil, whatsapp.ErrClientNotFound
+	}
+
+	// Check if client is authenticated
+	if !waClient.IsAuthenticated() {
+		log.WarnWithFields("WhatsApp client not authenticated", logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, nil, whatsapp.ErrAuthenticationFailed
+	}
+
+	return sess, waClient, nil
+}
+
+// formatGroupJID formats a group identifier to WhatsApp group JID format
+func formatGroupJID(groupID string) string {
+	groupID = strings.TrimSpace(groupID)
+	if !strings.Contains(groupID, "@") {
+		return groupID + "@g.us"
+	}
+	return groupID
+}
+
+// formatRecipientJID converts a phone number in any common format (with or without +,
+// spaces, dashes or parentheses) to a contact JID, keeping JIDs as they are, and rejects
+// recipients that cannot be sent to
+func formatRecipientJID(to, countryCode, defaultCountryCode string) (string, error) {
+	jid := utils.FormatWhatsAppJID(to, utils.ResolveCountryCode(countryCode, defaultCountryCode))
+	if err := whatsapp.ValidateRecipient(jid); err != nil {
+		return "", err
+	}
+	return jid, nil
+}
@@ -0,0 +1,210 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// ListLabelsUseCase handles listing the labels of a business session
+type ListLabelsUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewListLabelsUseCase creates a new list labels use case
+func NewListLabelsUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *ListLabelsUseCase {
+	return &ListLabelsUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// ListLabelsRequest represents the request to list the labels of a session
+type ListLabelsRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// ListLabelsResponse represents the labels of a session
+type ListLabelsResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	Labels    []*whatsapp.Label `json:"labels"`
+}
+
+// Execute lists the labels synced for the business account of the session
+func (uc *ListLabelsUseCase) Execute(ctx context.Context, req ListLabelsRequest) (*ListLabelsResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := waClient.GetLabels(ctx)
+	if err != nil {
+		uc.logger.WarnWithFields("failed to list labels", logger.Fields{
+			"session_id": sess.ID().String(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("labels listed", logger.Fields{
+		"session_id": sess.ID().String(),
+		"count":      len(labels),
+	})
+
+	return &ListLabelsResponse{
+		SessionID: sess.ID(),
+		Labels:    labels,
+	}, nil
+}
+
+// CreateLabelUseCase handles creating labels on a business session
+type CreateLabelUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewCreateLabelUseCase creates a new create label use case
+func NewCreateLabelUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *CreateLabelUseCase {
+	return &CreateLabelUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// CreateLabelRequest represents the request to create a label
+type CreateLabelRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	Name      string            `json:"name" validate:"required"`
+	Color     int               `json:"color"` // index in the WhatsApp label palette
+}
+
+// CreateLabelResponse represents the label created
+type CreateLabelResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	Label     *whatsapp.Label   `json:"label"`
+}
+
+// Execute validates the label and creates it on all devices of the business account
+func (uc *CreateLabelUseCase) Execute(ctx context.Context, req CreateLabelRequest) (*CreateLabelResponse, error) {
+	if err := whatsapp.ValidateLabel(req.Name, req.Color); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	label, err := waClient.CreateLabel(ctx, req.Name, req.Color)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to create label", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"name":       req.Name,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("label created", logger.Fields{
+		"session_id": sess.ID().String(),
+		"label_id":   label.ID,
+		"name":       label.Name,
+	})
+
+	return &CreateLabelResponse{
+		SessionID: sess.ID(),
+		Label:     label,
+	}, nil
+}
+
+// LabelChatUseCase handles adding labels to and removing them from chats and messages
+type LabelChatUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewLabelChatUseCase creates a new label chat use case
+func NewLabelChatUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *LabelChatUseCase {
+	return &LabelChatUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// LabelChatRequest represents the request to label a chat, or one of its messages when
+// MessageID is set
+type LabelChatRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	LabelID     string            `json:"label_id" validate:"required"`
+	MessageID   string            `json:"message_id,omitempty"`
+	Labeled     bool              `json:"labeled"`                // false removes the label
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// LabelChatResponse represents the label change applied
+type LabelChatResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	Chat      string            `json:"chat"`
+	LabelID   string            `json:"label_id"`
+	MessageID string            `json:"message_id,omitempty"`
+	Labeled   bool              `json:"labeled"`
+}
+
+// Execute adds or removes the label on all devices of the business account
+func (uc *LabelChatUseCase) Execute(ctx context.Context, req LabelChatRequest) (*LabelChatResponse, error) {
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	if err := whatsapp.ValidateChatJID(chat); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MessageID != "" {
+		err = waClient.LabelMessage(ctx, chat, req.MessageID, req.LabelID, req.Labeled)
+	} else {
+		err = waClient.LabelChat(ctx, chat, req.LabelID, req.Labeled)
+	}
+	if err != nil {
+		uc.logger.ErrorWithError("failed to update label", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+			"label_id":   req.LabelID,
+			"message_id": req.MessageID,
+			"labeled":    req.Labeled,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("label updated", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+		"label_id":   req.LabelID,
+		"message_id": req.MessageID,
+		"labeled":    req.Labeled,
+	})
+
+	return &LabelChatResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		LabelID:   req.LabelID,
+		MessageID: req.MessageID,
+		Labeled:   req.Labeled,
+	}, nil
+}
@@ -0,0 +1,272 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// DownloadMediaUseCase handles downloading the media attachment of a stored message
+type DownloadMediaUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewDownloadMediaUseCase creates a new download media use case
+func NewDownloadMediaUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *DownloadMediaUseCase {
+	return &DownloadMediaUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// DownloadMediaRequest represents the request to download the media of a message
+type DownloadMediaRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	MessageID string            `json:"message_id" validate:"required"`
+}
+
+// DownloadMediaResponse represents a decrypted media attachment
+type DownloadMediaResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	MessageID string            `json:"message_id"`
+	Data      []byte            `json:"-"`
+	MimeType  string            `json:"mime_type"`
+	FileName  string            `json:"file_name,omitempty"`
+}
+
+// Execute downloads and decrypts the media of a message received or sent by the session
+func (uc *DownloadMediaUseCase) Execute(ctx context.Context, req DownloadMediaRequest) (*DownloadMediaResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := waClient.DownloadMedia(ctx, req.MessageID)
+	if err != nil {
+		uc.logger.WarnWithFields("failed to download message media", logger.Fields{
+			"session_id": sess.ID().String(),
+			"message_id": req.MessageID,
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("message media downloaded", logger.Fields{
+		"session_id": sess.ID().String(),
+		"message_id": req.MessageID,
+		"mime_type":  media.MimeType,
+		"size":       len(media.Data),
+	})
+
+	return &DownloadMediaResponse{
+		SessionID: sess.ID(),
+		MessageID: req.MessageID,
+		Data:      media.Data,
+		MimeType:  media.MimeType,
+		FileName:  media.FileName,
+	}, nil
+}
+
+// GetMessageContextUseCase handles retrieving the stored messages surrounding a message of a chat
+type GetMessageContextUseCase struct {
+	sessionRepo session.Repository
+	messageRepo whatsapp.MessageRepository
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewGetMessageContextUseCase creates a new get message context use case
+func NewGetMessageContextUseCase(sessionRepo session.Repository, messageRepo whatsapp.MessageRepository, logger logger.Logger, defaultCountryCode string) *GetMessageContextUseCase {
+	return &GetMessageContextUseCase{
+		sessionRepo:        sessionRepo,
+		messageRepo:        messageRepo,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// GetMessageContextRequest represents the request to get the context of a message.
+// Before and After are clamped to [0, whatsapp.MaxMessageContextSize].
+type GetMessageContextRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	MessageID   string            `json:"message_id" validate:"required"`
+	Before      int               `json:"before"`
+	After       int               `json:"after"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// GetMessageContextResponse represents the anchor message and its surrounding messages.
+// Messages are in chronological order and include the anchor.
+type GetMessageContextResponse struct {
+	SessionID session.SessionID         `json:"session_id"`
+	Chat      string                    `json:"chat"`
+	Anchor    *whatsapp.StoredMessage   `json:"anchor"`
+	Messages  []*whatsapp.StoredMessage `json:"messages"`
+}
+
+// Execute loads the anchor message and the messages stored before and after it in the same chat
+func (uc *GetMessageContextUseCase) Execute(ctx context.Context, req GetMessageContextRequest) (*GetMessageContextResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	before := clampContextSize(req.Before)
+	after := clampContextSize(req.After)
+
+	anchor, err := uc.messageRepo.GetByID(ctx, sess.ID(), req.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The anchor must belong to the requested chat
+	if anchor.ChatJID != chat {
+		uc.logger.WarnWithFields("message does not belong to chat", logger.Fields{
+			"session_id":   sess.ID().String(),
+			"message_id":   req.MessageID,
+			"chat":         chat,
+			"message_chat": anchor.ChatJID,
+		})
+		return nil, whatsapp.ErrMessageNotFound
+	}
+
+	previous, err := uc.messageRepo.ListBefore(ctx, anchor, before)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := uc.messageRepo.ListAfter(ctx, anchor, after)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*whatsapp.StoredMessage, 0, len(previous)+1+len(next))
+	messages = append(messages, previous...)
+	messages = append(messages, anchor)
+	messages = append(messages, next...)
+
+	uc.logger.InfoWithFields("message context retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+		"message_id": req.MessageID,
+		"before":     len(previous),
+		"after":      len(next),
+	})
+
+	return &GetMessageContextResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		Anchor:    anchor,
+		Messages:  messages,
+	}, nil
+}
+
+// clampContextSize bounds the number of messages requested on one side of an anchor
+func clampContextSize(size int) int {
+	if size < 0 {
+		return 0
+	}
+	if size > whatsapp.MaxMessageContextSize {
+		return whatsapp.MaxMessageContextSize
+	}
+	return size
+}
+
+// ListChatMessagesUseCase handles paging through the stored history of a chat
+type ListChatMessagesUseCase struct {
+	sessionRepo session.Repository
+	messageRepo whatsapp.MessageRepository
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewListChatMessagesUseCase creates a new list chat messages use case
+func NewListChatMessagesUseCase(sessionRepo session.Repository, messageRepo whatsapp.MessageRepository, logger logger.Logger, defaultCountryCode string) *ListChatMessagesUseCase {
+	return &ListChatMessagesUseCase{
+		sessionRepo:        sessionRepo,
+		messageRepo:        messageRepo,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// ListChatMessagesRequest represents the request to list a page of a chat history.
+// Limit is clamped to [1, whatsapp.MaxChatMessagesPageSize].
+type ListChatMessagesRequest struct {
+	SessionID   session.SessionID       `json:"session_id"`
+	Chat        string                  `json:"chat" validate:"required"`
+	Before      *whatsapp.MessageCursor `json:"before,omitempty"` // Nil starts with the latest message
+	Limit       int                     `json:"limit"`
+	CountryCode string                  `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// ListChatMessagesResponse represents a page of a chat history, newest first.
+// Next is the cursor of the following (older) page, nil on the last page.
+type ListChatMessagesResponse struct {
+	SessionID session.SessionID         `json:"session_id"`
+	Chat      string                    `json:"chat"`
+	Messages  []*whatsapp.StoredMessage `json:"messages"`
+	Next      *whatsapp.MessageCursor   `json:"next,omitempty"`
+}
+
+// Execute loads the page of stored chat messages that precedes the cursor
+func (uc *ListChatMessagesUseCase) Execute(ctx context.Context, req ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+	limit := req.Limit
+	if limit <= 0 {
+		limit = whatsapp.DefaultChatMessagesPageSize
+	}
+	if limit > whatsapp.MaxChatMessagesPageSize {
+		limit = whatsapp.MaxChatMessagesPageSize
+	}
+
+	// Fetch one extra message to know whether another page follows
+	messages, err := uc.messageRepo.ListByChat(ctx, sess.ID(), chat, req.Before, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *whatsapp.MessageCursor
+	if len(messages) > limit {
+		messages = messages[:limit]
+		last := messages[limit-1]
+		next = &whatsapp.MessageCursor{Timestamp: last.Timestamp, ID: last.ID}
+	}
+
+	uc.logger.InfoWithFields("chat messages listed", logger.Fields{
+		"session_id": sess.ID().String(),
+		"chat":       chat,
+		"count":      len(messages),
+		"has_more":   next != nil,
+	})
+
+	return &ListChatMessagesResponse{
+		SessionID: sess.ID(),
+		Chat:      chat,
+		Messages:  messages,
+		Next:      next,
+	}, nil
+}
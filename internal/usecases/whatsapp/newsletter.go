@@ -0,0 +1,141 @@
+package whatsapp
+
+import (
+	"context"
+	"strings"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetNewsletterUseCase handles retrieving channel (newsletter) metadata for a session
+type GetNewsletterUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewGetNewsletterUseCase creates a new get newsletter use case
+func NewGetNewsletterUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *GetNewsletterUseCase {
+	return &GetNewsletterUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// GetNewsletterRequest represents the request to get a newsletter's metadata
+type GetNewsletterRequest struct {
+	SessionID     session.SessionID `json:"session_id"`
+	NewsletterJID string            `json:"newsletter_jid" validate:"required"`
+}
+
+// GetNewsletterResponse represents the response with a newsletter's metadata
+type GetNewsletterResponse struct {
+	SessionID  session.SessionID        `json:"session_id"`
+	Newsletter *whatsapp.NewsletterInfo `json:"newsletter"`
+}
+
+// Execute returns metadata of a newsletter
+func (uc *GetNewsletterUseCase) Execute(ctx context.Context, req GetNewsletterRequest) (*GetNewsletterResponse, error) {
+	newsletterJID := strings.TrimSpace(req.NewsletterJID)
+	if err := whatsapp.ValidateNewsletterJID(newsletterJID); err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	newsletter, err := waClient.GetNewsletterInfo(ctx, newsletterJID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get newsletter info", err, logger.Fields{
+			"session_id":     sess.ID().String(),
+			"newsletter_jid": newsletterJID,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("newsletter info retrieved", logger.Fields{
+		"session_id":     sess.ID().String(),
+		"newsletter_jid": newsletter.JID,
+	})
+
+	return &GetNewsletterResponse{
+		SessionID:  sess.ID(),
+		Newsletter: newsletter,
+	}, nil
+}
+
+// SendNewsletterMessageUseCase handles posting messages to channels (newsletters)
+type SendNewsletterMessageUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+	limiter     *SendLimiter
+}
+
+// NewSendNewsletterMessageUseCase creates a new send newsletter message use case
+func NewSendNewsletterMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, limiter *SendLimiter) *SendNewsletterMessageUseCase {
+	return &SendNewsletterMessageUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+		limiter:     limiter,
+	}
+}
+
+// SendNewsletterMessageRequest represents the request to post a message to a newsletter
+type SendNewsletterMessageRequest struct {
+	SessionID     session.SessionID `json:"session_id"`
+	NewsletterJID string            `json:"newsletter_jid" validate:"required"`
+	Text          string            `json:"text" validate:"required,max=4096"`
+}
+
+// SendNewsletterMessageResponse represents the response from posting a message to a newsletter
+type SendNewsletterMessageResponse struct {
+	SessionID     session.SessionID `json:"session_id"`
+	NewsletterJID string            `json:"newsletter_jid"`
+	Success       bool              `json:"success"`
+}
+
+// Execute posts a text message to a newsletter
+func (uc *SendNewsletterMessageUseCase) Execute(ctx context.Context, req SendNewsletterMessageRequest) (*SendNewsletterMessageResponse, error) {
+	newsletterJID := strings.TrimSpace(req.NewsletterJID)
+	if err := whatsapp.ValidateNewsletterJID(newsletterJID); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, whatsapp.ErrEmptyNewsletterText
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	if err := waClient.SendNewsletterMessage(ctx, newsletterJID, req.Text); err != nil {
+		uc.logger.ErrorWithError("failed to send newsletter message", err, logger.Fields{
+			"session_id":     sess.ID().String(),
+			"newsletter_jid": newsletterJID,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("newsletter message sent", logger.Fields{
+		"session_id":     sess.ID().String(),
+		"newsletter_jid": newsletterJID,
+	})
+
+	return &SendNewsletterMessageResponse{
+		SessionID:     sess.ID(),
+		NewsletterJID: newsletterJID,
+		Success:       true,
+	}, nil
+}
@@ -2,10 +2,12 @@ package whatsapp
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
 	"wazmeow/pkg/logger"
 	"wazmeow/pkg/validator"
 )
@@ -16,15 +18,19 @@ type PairPhoneUseCase struct {
 	waManager   whatsapp.Manager
 	logger      logger.Logger
 	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
 }
 
 // NewPairPhoneUseCase creates a new pair phone use case
-func NewPairPhoneUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator) *PairPhoneUseCase {
+func NewPairPhoneUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string) *PairPhoneUseCase {
 	return &PairPhoneUseCase{
-		sessionRepo: sessionRepo,
-		waManager:   waManager,
-		logger:      logger,
-		validator:   validator,
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
 	}
 }
 
@@ -32,18 +38,27 @@ func NewPairPhoneUseCase(sessionRepo session.Repository, waManager whatsapp.Mana
 type PairPhoneRequest struct {
 	SessionID   session.SessionID `json:"session_id"`
 	PhoneNumber string            `json:"phone_number" validate:"required,phone_number"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+
+	// Optional client type and "Browser (OS)" display name shown on the phone; empty keeps the configured defaults
+	ClientType  whatsapp.PairClientType `json:"client_type,omitempty"`
+	DisplayName string                  `json:"display_name,omitempty"`
 }
 
 // PairPhoneResponse represents the response from pairing with a phone number
 type PairPhoneResponse struct {
 	SessionID   session.SessionID `json:"session_id"`
 	PhoneNumber string            `json:"phone_number"`
+	PairingCode string            `json:"pairing_code,omitempty"` // Code to enter on the phone, valid for whatsapp.PairingCodeValidity
 	Message     string            `json:"message"`
 	Success     bool              `json:"success"`
 }
 
 // Execute pairs a session with a phone number
 func (uc *PairPhoneUseCase) Execute(ctx context.Context, req PairPhoneRequest) (*PairPhoneResponse, error) {
+	// Normalize national-format numbers to international format
+	req.PhoneNumber = formatPhoneNumber(req.PhoneNumber, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+
 	// Validate request
 	if err := uc.validator.Validate(req); err != nil {
 		uc.logger.ErrorWithError("validation failed for pair phone", err, logger.Fields{
@@ -62,6 +77,14 @@ func (uc *PairPhoneUseCase) Execute(ctx context.Context, req PairPhoneRequest) (
 		return nil, whatsapp.ErrInvalidPhoneNumber
 	}
 
+	pairOptions := whatsapp.PairPhoneOptions{
+		ClientType:  req.ClientType,
+		DisplayName: req.DisplayName,
+	}
+	if err := pairOptions.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Get session from repository
 	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
 	if err != nil {
@@ -108,7 +131,7 @@ func (uc *PairPhoneUseCase) Execute(ctx context.Context, req PairPhoneRequest) (
 	}
 
 	// Attempt to pair with phone number
-	err = waClient.PairPhone(ctx, req.PhoneNumber)
+	pairingCode, err := waClient.PairPhone(ctx, req.PhoneNumber, pairOptions)
 	if err != nil {
 		uc.logger.ErrorWithError("failed to pair with phone number", err, logger.Fields{
 			"session_id":   sess.ID().String(),
@@ -130,7 +153,8 @@ func (uc *PairPhoneUseCase) Execute(ctx context.Context, req PairPhoneRequest) (
 	return &PairPhoneResponse{
 		SessionID:   sess.ID(),
 		PhoneNumber: req.PhoneNumber,
-		Message:     "Pairing code sent. Check your WhatsApp mobile app for the pairing code.",
+		PairingCode: pairingCode,
+		Message:     fmt.Sprintf("Enter the pairing code in WhatsApp > Linked devices > Link with phone number within %d seconds", int(whatsapp.PairingCodeValidity.Seconds())),
 		Success:     true,
 	}, nil
 }
@@ -220,39 +244,11 @@ func (uc *PairPhoneUseCase) ExecuteFormatPhone(ctx context.Context, req FormatPh
 	return response, nil
 }
 
-// formatPhoneNumber formats a phone number to international format
+// formatPhoneNumber formats a phone number to international format (+ followed by digits)
 func formatPhoneNumber(phoneNumber, countryCode string) string {
-	// Remove all non-digit characters except +
-	phoneRegex := regexp.MustCompile(`[^\d+]`)
-	cleaned := phoneRegex.ReplaceAllString(phoneNumber, "")
-
-	// If already starts with +, return as is
-	if len(cleaned) > 0 && cleaned[0] == '+' {
-		return cleaned
+	normalized := utils.NormalizePhoneNumber(phoneNumber, countryCode)
+	if normalized == "" {
+		return normalized
 	}
-
-	// If starts with 00, replace with +
-	if len(cleaned) >= 2 && cleaned[:2] == "00" {
-		return "+" + cleaned[2:]
-	}
-
-	// If country code provided, prepend it
-	if countryCode != "" {
-		// Remove + from country code if present
-		countryCode = regexp.MustCompile(`[^\d]`).ReplaceAllString(countryCode, "")
-		
-		// Remove leading 0 from phone number if present
-		if len(cleaned) > 0 && cleaned[0] == '0' {
-			cleaned = cleaned[1:]
-		}
-		
-		return "+" + countryCode + cleaned
-	}
-
-	// Default: assume it's already in correct format, just add +
-	if len(cleaned) > 0 && cleaned[0] != '+' {
-		return "+" + cleaned
-	}
-
-	return cleaned
+	return "+" + normalized
 }
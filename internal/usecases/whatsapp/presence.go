@@ -0,0 +1,250 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// SubscribePresenceUseCase handles subscribing to the presence of a contact
+type SubscribePresenceUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+	// Whether the session may be marked as available automatically
+	autoSendPresence bool
+}
+
+// NewSubscribePresenceUseCase creates a new subscribe presence use case
+func NewSubscribePresenceUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, autoSendPresence bool) *SubscribePresenceUseCase {
+	return &SubscribePresenceUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		autoSendPresence:   autoSendPresence,
+	}
+}
+
+// SubscribePresenceRequest represents the request to subscribe to a contact's presence
+type SubscribePresenceRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	JID         string            `json:"jid" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// SubscribePresenceResponse represents the response from subscribing to a contact's presence
+type SubscribePresenceResponse struct {
+	SessionID    session.SessionID `json:"session_id"`
+	JID          string            `json:"jid"`
+	PresenceSent bool              `json:"presence_sent"` // Presence was sent automatically to satisfy the prerequisite
+}
+
+// Execute subscribes to the contact's presence, announcing the session as available first when allowed.
+//
+// WhatsApp silently ignores presence subscriptions from sessions that have not sent
+// "available" on the current connection, so the prerequisite is enforced here.
+func (uc *SubscribePresenceUseCase) Execute(ctx context.Context, req SubscribePresenceRequest) (*SubscribePresenceResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	jid := utils.FormatWhatsAppJID(req.JID, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+
+	presenceSent := false
+	if !waClient.HasSentPresence() {
+		if !uc.autoSendPresence {
+			uc.logger.WarnWithFields("presence not sent and automatic presence is disabled", logger.Fields{
+				"session_id": sess.ID().String(),
+				"jid":        jid,
+			})
+			return nil, whatsapp.ErrPresenceNotSent
+		}
+
+		if err := waClient.SendPresence(ctx, whatsapp.PresenceAvailable); err != nil {
+			uc.logger.ErrorWithError("failed to send presence before subscribing", err, logger.Fields{
+				"session_id": sess.ID().String(),
+			})
+			return nil, err
+		}
+		presenceSent = true
+	}
+
+	if err := waClient.SubscribePresence(ctx, jid); err != nil {
+		uc.logger.ErrorWithError("failed to subscribe to presence", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"jid":        jid,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("subscribed to contact presence", logger.Fields{
+		"session_id":    sess.ID().String(),
+		"jid":           jid,
+		"presence_sent": presenceSent,
+	})
+
+	return &SubscribePresenceResponse{
+		SessionID:    sess.ID(),
+		JID:          jid,
+		PresenceSent: presenceSent,
+	}, nil
+}
+
+// SendPresenceUseCase handles updating the global online state of the session
+type SendPresenceUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewSendPresenceUseCase creates a new send presence use case
+func NewSendPresenceUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *SendPresenceUseCase {
+	return &SendPresenceUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// SendPresenceRequest represents the request to update the session presence
+type SendPresenceRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	State     string            `json:"state" validate:"required"` // available or unavailable
+}
+
+// SendPresenceResponse represents the response from updating the session presence
+type SendPresenceResponse struct {
+	SessionID session.SessionID      `json:"session_id"`
+	State     whatsapp.PresenceState `json:"state"`
+}
+
+// Execute marks the session as available (online) or unavailable (offline)
+func (uc *SendPresenceUseCase) Execute(ctx context.Context, req SendPresenceRequest) (*SendPresenceResponse, error) {
+	state, err := whatsapp.ParsePresenceState(req.State)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := waClient.SendPresence(ctx, state); err != nil {
+		uc.logger.ErrorWithError("failed to send presence", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"state":      state.String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("session presence updated", logger.Fields{
+		"session_id": sess.ID().String(),
+		"state":      state.String(),
+	})
+
+	return &SendPresenceResponse{
+		SessionID: sess.ID(),
+		State:     state,
+	}, nil
+}
+
+// SendChatPresenceUseCase handles showing or clearing the typing indicator in a chat
+type SendChatPresenceUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+	// Whether the session may be marked as available automatically
+	autoSendPresence bool
+}
+
+// NewSendChatPresenceUseCase creates a new send chat presence use case
+func NewSendChatPresenceUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, autoSendPresence bool) *SendChatPresenceUseCase {
+	return &SendChatPresenceUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		autoSendPresence:   autoSendPresence,
+	}
+}
+
+// SendChatPresenceRequest represents the request to update the typing state in a chat.
+// Chat accepts a phone number, a user JID or a group JID.
+type SendChatPresenceRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	Chat        string            `json:"chat" validate:"required"`
+	State       string            `json:"state" validate:"required"` // composing or paused
+	CountryCode string            `json:"country_code,omitempty"`    // Overrides the default country code
+}
+
+// SendChatPresenceResponse represents the response from updating the typing state in a chat
+type SendChatPresenceResponse struct {
+	SessionID    session.SessionID          `json:"session_id"`
+	Chat         string                     `json:"chat"`
+	State        whatsapp.ChatPresenceState `json:"state"`
+	PresenceSent bool                       `json:"presence_sent"` // Presence was sent automatically before the chat state
+}
+
+// Execute sends the chat state, announcing the session as available first when allowed.
+//
+// Recipients only render typing indicators from contacts that are online, so the
+// session is marked as available on the current connection if it was not yet.
+func (uc *SendChatPresenceUseCase) Execute(ctx context.Context, req SendChatPresenceRequest) (*SendChatPresenceResponse, error) {
+	state, err := whatsapp.ParseChatPresenceState(req.State)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	chat := utils.FormatWhatsAppJID(req.Chat, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+
+	presenceSent := false
+	if uc.autoSendPresence && !waClient.HasSentPresence() {
+		if err := waClient.SendPresence(ctx, whatsapp.PresenceAvailable); err != nil {
+			uc.logger.ErrorWithError("failed to send presence before chat presence", err, logger.Fields{
+				"session_id": sess.ID().String(),
+			})
+			return nil, err
+		}
+		presenceSent = true
+	}
+
+	if err := waClient.SendChatPresence(ctx, chat, state); err != nil {
+		uc.logger.ErrorWithError("failed to send chat presence", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"chat":       chat,
+			"state":      state.String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.DebugWithFields("chat presence sent", logger.Fields{
+		"session_id":    sess.ID().String(),
+		"chat":          chat,
+		"state":         state.String(),
+		"presence_sent": presenceSent,
+	})
+
+	return &SendChatPresenceResponse{
+		SessionID:    sess.ID(),
+		Chat:         chat,
+		State:        state,
+		PresenceSent: presenceSent,
+	}, nil
+}
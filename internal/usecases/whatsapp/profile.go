@@ -0,0 +1,280 @@
+package whatsapp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+const (
+	// profilePictureDownloadTimeout bounds how long a profile picture download may take
+	profilePictureDownloadTimeout = 15 * time.Second
+	// maxProfilePictureSize is the largest profile picture that is downloaded (5 MB)
+	maxProfilePictureSize = 5 << 20
+)
+
+// GetProfilePictureUseCase handles retrieving the profile picture of a contact or group
+type GetProfilePictureUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+	httpClient  *http.Client
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewGetProfilePictureUseCase creates a new get profile picture use case
+func NewGetProfilePictureUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *GetProfilePictureUseCase {
+	return &GetProfilePictureUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		httpClient:         &http.Client{Timeout: profilePictureDownloadTimeout},
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// GetProfilePictureRequest represents the request to get a profile picture.
+// JID accepts a phone number, a user JID or a group JID.
+type GetProfilePictureRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	JID         string            `json:"jid" validate:"required"`
+	Preview     bool              `json:"preview"`
+	Download    bool              `json:"download"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// GetProfilePictureResponse represents the profile picture of a contact or group.
+// Data and ContentType are only set when the image was downloaded.
+type GetProfilePictureResponse struct {
+	SessionID   session.SessionID            `json:"session_id"`
+	JID         string                       `json:"jid"`
+	Picture     *whatsapp.ProfilePictureInfo `json:"picture"`
+	Data        []byte                       `json:"data,omitempty"`
+	ContentType string                       `json:"content_type,omitempty"`
+}
+
+// Execute gets the profile picture info, downloading the image when requested
+func (uc *GetProfilePictureUseCase) Execute(ctx context.Context, req GetProfilePictureRequest) (*GetProfilePictureResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	jid := utils.FormatWhatsAppJID(req.JID, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+
+	picture, err := waClient.GetProfilePicture(ctx, jid, req.Preview)
+	if err != nil {
+		uc.logger.WarnWithFields("failed to get profile picture", logger.Fields{
+			"session_id": sess.ID().String(),
+			"jid":        jid,
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	response := &GetProfilePictureResponse{
+		SessionID: sess.ID(),
+		JID:       jid,
+		Picture:   picture,
+	}
+
+	if req.Download {
+		data, contentType, err := uc.download(ctx, picture.URL)
+		if err != nil {
+			uc.logger.ErrorWithError("failed to download profile picture", err, logger.Fields{
+				"session_id": sess.ID().String(),
+				"jid":        jid,
+			})
+			return nil, err
+		}
+		response.Data = data
+		response.ContentType = contentType
+	}
+
+	uc.logger.InfoWithFields("profile picture retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"jid":        jid,
+		"preview":    req.Preview,
+		"downloaded": req.Download,
+	})
+
+	return response, nil
+}
+
+// download fetches the image from the WhatsApp CDN
+func (uc *GetProfilePictureUseCase) download(ctx context.Context, pictureURL string) ([]byte, string, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, pictureURL, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid profile picture URL: %w", err)
+	}
+
+	resp, err := uc.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download profile picture: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to download profile picture: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxProfilePictureSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read profile picture: %w", err)
+	}
+	if len(data) > maxProfilePictureSize {
+		return nil, "", fmt.Errorf("profile picture exceeds %d bytes", maxProfilePictureSize)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	return data, contentType, nil
+}
+
+// GetBusinessProfileUseCase handles retrieving the business profile of a contact
+type GetBusinessProfileUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewGetBusinessProfileUseCase creates a new get business profile use case
+func NewGetBusinessProfileUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string) *GetBusinessProfileUseCase {
+	return &GetBusinessProfileUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// GetBusinessProfileRequest represents the request to get a business profile.
+// JID accepts a phone number or a user JID.
+type GetBusinessProfileRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	JID         string            `json:"jid" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+}
+
+// GetBusinessProfileResponse represents the business profile of a contact
+type GetBusinessProfileResponse struct {
+	SessionID session.SessionID         `json:"session_id"`
+	JID       string                    `json:"jid"`
+	Profile   *whatsapp.BusinessProfile `json:"profile"`
+}
+
+// Execute gets the business profile of a contact, failing with whatsapp.ErrNotBusinessAccount
+// for regular accounts
+func (uc *GetBusinessProfileUseCase) Execute(ctx context.Context, req GetBusinessProfileRequest) (*GetBusinessProfileResponse, error) {
+	jid, err := formatRecipientJID(req.JID, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := waClient.GetBusinessProfile(ctx, jid)
+	if err != nil {
+		uc.logger.WarnWithFields("failed to get business profile", logger.Fields{
+			"session_id": sess.ID().String(),
+			"jid":        jid,
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("business profile retrieved", logger.Fields{
+		"session_id": sess.ID().String(),
+		"jid":        jid,
+	})
+
+	return &GetBusinessProfileResponse{
+		SessionID: sess.ID(),
+		JID:       jid,
+		Profile:   profile,
+	}, nil
+}
+
+// SetProfilePictureUseCase handles replacing the profile picture of the session account
+type SetProfilePictureUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewSetProfilePictureUseCase creates a new set profile picture use case
+func NewSetProfilePictureUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *SetProfilePictureUseCase {
+	return &SetProfilePictureUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// SetProfilePictureRequest represents the request to set the session profile picture
+type SetProfilePictureRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	ImageData []byte            `json:"-"` // JPEG, PNG or GIF image
+}
+
+// SetProfilePictureResponse represents the response from setting the session profile picture
+type SetProfilePictureResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	PictureID string            `json:"picture_id"`
+}
+
+// Execute crops and resizes the image to a square JPEG and uploads it as the profile picture
+func (uc *SetProfilePictureUseCase) Execute(ctx context.Context, req SetProfilePictureRequest) (*SetProfilePictureResponse, error) {
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	picture, err := utils.PrepareProfilePicture(req.ImageData)
+	if err != nil {
+		uc.logger.WarnWithFields("invalid profile picture image", logger.Fields{
+			"session_id": sess.ID().String(),
+			"size":       len(req.ImageData),
+			"error":      err.Error(),
+		})
+		return nil, whatsapp.ErrInvalidProfilePicture
+	}
+
+	pictureID, err := waClient.SetProfilePicture(ctx, picture)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to set profile picture", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("profile picture updated", logger.Fields{
+		"session_id":    sess.ID().String(),
+		"picture_id":    pictureID,
+		"original_size": len(req.ImageData),
+		"uploaded_size": len(picture),
+	})
+
+	return &SetProfilePictureResponse{
+		SessionID: sess.ID(),
+		PictureID: pictureID,
+	}, nil
+}
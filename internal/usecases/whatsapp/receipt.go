@@ -0,0 +1,65 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetMessageStatusUseCase handles reporting the receipt state of sent messages
+type GetMessageStatusUseCase struct {
+	sessionRepo session.Repository
+	receiptRepo whatsapp.ReceiptRepository
+	logger      logger.Logger
+}
+
+// NewGetMessageStatusUseCase creates a new get message status use case
+func NewGetMessageStatusUseCase(sessionRepo session.Repository, receiptRepo whatsapp.ReceiptRepository, logger logger.Logger) *GetMessageStatusUseCase {
+	return &GetMessageStatusUseCase{
+		sessionRepo: sessionRepo,
+		receiptRepo: receiptRepo,
+		logger:      logger,
+	}
+}
+
+// GetMessageStatusRequest represents the request to get the status of a sent message
+type GetMessageStatusRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	MessageID string            `json:"message_id" validate:"required"`
+}
+
+// GetMessageStatusResponse represents the receipt state of a sent message
+type GetMessageStatusResponse struct {
+	SessionID session.SessionID       `json:"session_id"`
+	Status    *whatsapp.MessageStatus `json:"status"`
+}
+
+// Execute returns the furthest receipt state of a message and the receipt of each recipient.
+// Receipts are only recorded while the session is connected; ErrReceiptNotFound is
+// returned until the first one arrives.
+func (uc *GetMessageStatusUseCase) Execute(ctx context.Context, req GetMessageStatusRequest) (*GetMessageStatusResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	receipts, err := uc.receiptRepo.ListByMessage(ctx, sess.ID(), req.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := whatsapp.NewMessageStatus(req.MessageID, receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetMessageStatusResponse{
+		SessionID: sess.ID(),
+		Status:    status,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetRecentMessagesUseCase handles polling the incoming messages buffered in memory
+type GetRecentMessagesUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewGetRecentMessagesUseCase creates a new get recent messages use case
+func NewGetRecentMessagesUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *GetRecentMessagesUseCase {
+	return &GetRecentMessagesUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// GetRecentMessagesRequest represents the request to poll recent incoming messages.
+// Since is the cursor returned by the previous poll; zero returns every buffered message.
+type GetRecentMessagesRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	Since     uint64            `json:"since"`
+}
+
+// GetRecentMessagesResponse represents the incoming messages newer than the cursor
+type GetRecentMessagesResponse struct {
+	SessionID session.SessionID        `json:"session_id"`
+	Messages  *whatsapp.RecentMessages `json:"messages"`
+}
+
+// Execute returns the buffered incoming messages of the session client newer than the cursor.
+// The session does not need to be connected: messages received before a disconnection
+// stay available until the client is removed.
+func (uc *GetRecentMessagesUseCase) Execute(ctx context.Context, req GetRecentMessagesRequest) (*GetRecentMessagesResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		uc.logger.WarnWithFields("WhatsApp client not found", logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, whatsapp.ErrClientNotFound
+	}
+
+	messages, err := waClient.RecentMessages(req.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetRecentMessagesResponse{
+		SessionID: sess.ID(),
+		Messages:  messages,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package whatsapp
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// ScheduleMessageUseCase handles queuing text messages to be sent later
+type ScheduleMessageUseCase struct {
+	sessionRepo   session.Repository
+	scheduledRepo whatsapp.ScheduledMessageRepository
+	logger        logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+}
+
+// NewScheduleMessageUseCase creates a new schedule message use case
+func NewScheduleMessageUseCase(sessionRepo session.Repository, scheduledRepo whatsapp.ScheduledMessageRepository, logger logger.Logger, defaultCountryCode string) *ScheduleMessageUseCase {
+	return &ScheduleMessageUseCase{
+		sessionRepo:        sessionRepo,
+		scheduledRepo:      scheduledRepo,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+	}
+}
+
+// ScheduleMessageRequest represents the request to schedule a text message
+type ScheduleMessageRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+	Body        string            `json:"body" validate:"required"`
+	SendAt      time.Time         `json:"send_at" validate:"required"`
+}
+
+// ScheduledMessageResponse represents a scheduled message
+type ScheduledMessageResponse struct {
+	SessionID session.SessionID          `json:"session_id"`
+	Message   *whatsapp.ScheduledMessage `json:"message"`
+}
+
+// Execute validates and queues a message. The session does not need to be connected;
+// the message is sent once it is due and the session is connected.
+func (uc *ScheduleMessageUseCase) Execute(ctx context.Context, req ScheduleMessageRequest) (*ScheduledMessageResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	formattedTo := utils.FormatWhatsAppJID(req.To, utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode))
+
+	message, err := whatsapp.NewScheduledMessage(sess.ID(), formattedTo, req.Body, req.SendAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduledRepo.Create(ctx, message); err != nil {
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("message scheduled", logger.Fields{
+		"session_id": sess.ID().String(),
+		"id":         message.ID,
+		"to":         formattedTo,
+		"send_at":    message.SendAt,
+	})
+
+	return &ScheduledMessageResponse{
+		SessionID: sess.ID(),
+		Message:   message,
+	}, nil
+}
+
+// ListScheduledMessagesUseCase handles listing the scheduled messages of a session
+type ListScheduledMessagesUseCase struct {
+	sessionRepo   session.Repository
+	scheduledRepo whatsapp.ScheduledMessageRepository
+	logger        logger.Logger
+}
+
+// NewListScheduledMessagesUseCase creates a new list scheduled messages use case
+func NewListScheduledMessagesUseCase(sessionRepo session.Repository, scheduledRepo whatsapp.ScheduledMessageRepository, logger logger.Logger) *ListScheduledMessagesUseCase {
+	return &ListScheduledMessagesUseCase{
+		sessionRepo:   sessionRepo,
+		scheduledRepo: scheduledRepo,
+		logger:        logger,
+	}
+}
+
+// ListScheduledMessagesRequest represents the request to list scheduled messages.
+// An empty status lists messages in every status.
+type ListScheduledMessagesRequest struct {
+	SessionID session.SessionID               `json:"session_id"`
+	Status    whatsapp.ScheduledMessageStatus `json:"status,omitempty"`
+}
+
+// ListScheduledMessagesResponse represents the scheduled messages of a session
+type ListScheduledMessagesResponse struct {
+	SessionID session.SessionID            `json:"session_id"`
+	Messages  []*whatsapp.ScheduledMessage `json:"messages"`
+}
+
+// Execute lists the scheduled messages of a session by send time
+func (uc *ListScheduledMessagesUseCase) Execute(ctx context.Context, req ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	if req.Status != "" && !req.Status.IsValid() {
+		return nil, whatsapp.ErrInvalidScheduledStatus
+	}
+
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	messages, err := uc.scheduledRepo.ListBySession(ctx, sess.ID(), req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListScheduledMessagesResponse{
+		SessionID: sess.ID(),
+		Messages:  messages,
+	}, nil
+}
+
+// CancelScheduledMessageUseCase handles canceling pending scheduled messages
+type CancelScheduledMessageUseCase struct {
+	sessionRepo   session.Repository
+	scheduledRepo whatsapp.ScheduledMessageRepository
+	logger        logger.Logger
+}
+
+// NewCancelScheduledMessageUseCase creates a new cancel scheduled message use case
+func NewCancelScheduledMessageUseCase(sessionRepo session.Repository, scheduledRepo whatsapp.ScheduledMessageRepository, logger logger.Logger) *CancelScheduledMessageUseCase {
+	return &CancelScheduledMessageUseCase{
+		sessionRepo:   sessionRepo,
+		scheduledRepo: scheduledRepo,
+		logger:        logger,
+	}
+}
+
+// CancelScheduledMessageRequest represents the request to cancel a scheduled message
+type CancelScheduledMessageRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+	ID        string            `json:"id" validate:"required"`
+}
+
+// Execute cancels a scheduled message that has not been sent yet
+func (uc *CancelScheduledMessageUseCase) Execute(ctx context.Context, req CancelScheduledMessageRequest) (*ScheduledMessageResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	message, err := uc.scheduledRepo.GetByID(ctx, sess.ID(), req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !message.IsPending() {
+		return nil, whatsapp.ErrScheduledMessageNotPending
+	}
+
+	// The transition fails if the scheduler claimed the message in the meantime
+	message.Status = whatsapp.ScheduledMessageCanceled
+	message.UpdatedAt = time.Now()
+	if err := uc.scheduledRepo.Transition(ctx, message, whatsapp.ScheduledMessagePending); err != nil {
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("scheduled message canceled", logger.Fields{
+		"session_id": sess.ID().String(),
+		"id":         message.ID,
+	})
+
+	return &ScheduledMessageResponse{
+		SessionID: sess.ID(),
+		Message:   message,
+	}, nil
+}
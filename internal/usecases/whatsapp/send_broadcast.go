@@ -0,0 +1,171 @@
+package whatsapp
+
+import (
+	"context"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/shared/utils"
+	"wazmeow/pkg/logger"
+)
+
+// SendBroadcastUseCase handles sending one text message to several recipients
+type SendBroadcastUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Pause between sends and maximum recipients per broadcast
+	delay         time.Duration
+	maxRecipients int
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
+}
+
+// NewSendBroadcastUseCase creates a new send broadcast use case.
+// A maxRecipients of zero or less keeps whatsapp.DefaultBroadcastMaxRecipients.
+func NewSendBroadcastUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, delay time.Duration, maxRecipients int, limiter *SendLimiter) *SendBroadcastUseCase {
+	if maxRecipients <= 0 {
+		maxRecipients = whatsapp.DefaultBroadcastMaxRecipients
+	}
+
+	return &SendBroadcastUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		delay:              delay,
+		maxRecipients:      maxRecipients,
+		limiter:            limiter,
+	}
+}
+
+// SendBroadcastRequest represents the request to send a message to several recipients
+type SendBroadcastRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	To          []string          `json:"to" validate:"required"` // Phone numbers or JIDs
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+	Message     string            `json:"message" validate:"required"`
+}
+
+// BroadcastRecipientResult represents the outcome of a broadcast for one recipient
+type BroadcastRecipientResult struct {
+	To        string `json:"to"` // Recipient as given in the request
+	JID       string `json:"jid,omitempty"`
+	MessageID string `json:"message_id,omitempty"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// SendBroadcastResponse represents the response from sending a broadcast
+type SendBroadcastResponse struct {
+	SessionID    session.SessionID          `json:"session_id"`
+	TotalCount   int                        `json:"total_count"`
+	SuccessCount int                        `json:"success_count"`
+	FailedCount  int                        `json:"failed_count"`
+	Results      []BroadcastRecipientResult `json:"results"`
+}
+
+// Execute sends the message to each recipient in order, pausing between sends.
+// A failed recipient does not stop the broadcast; results are reported per recipient.
+// If the context is canceled, the recipients not reached yet are reported as failed.
+func (uc *SendBroadcastUseCase) Execute(ctx context.Context, req SendBroadcastRequest) (*SendBroadcastResponse, error) {
+	if err := whatsapp.ValidateBroadcastMessage(req.Message); err != nil {
+		return nil, err
+	}
+	if len(req.To) == 0 {
+		return nil, whatsapp.ErrNoBroadcastRecipients
+	}
+	if len(req.To) > uc.maxRecipients {
+		return nil, whatsapp.ErrTooManyBroadcastRecipients
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	countryCode := utils.ResolveCountryCode(req.CountryCode, uc.defaultCountryCode)
+	response := &SendBroadcastResponse{
+		SessionID:  sess.ID(),
+		TotalCount: len(req.To),
+		Results:    make([]BroadcastRecipientResult, 0, len(req.To)),
+	}
+
+	seen := make(map[string]bool, len(req.To))
+	sent := 0
+	for _, recipient := range req.To {
+		result := BroadcastRecipientResult{To: recipient}
+		jid := utils.FormatWhatsAppJID(recipient, countryCode)
+
+		switch {
+		case whatsapp.ValidateRecipient(jid) != nil:
+			result.Error = whatsapp.ErrInvalidRecipient.Error()
+		case seen[jid]:
+			result.JID = jid
+			result.Error = whatsapp.ErrDuplicateRecipient.Error()
+		case ctx.Err() != nil || (sent > 0 && !sleepContext(ctx, uc.delay)):
+			result.JID = jid
+			result.Error = ctx.Err().Error()
+		default:
+			seen[jid] = true
+			result.JID = jid
+			sent++
+
+			if err := uc.limiter.Wait(ctx, sess); err != nil {
+				result.Error = err.Error()
+				break
+			}
+
+			messageID, err := waClient.SendMessage(ctx, jid, req.Message)
+			if err != nil {
+				uc.logger.ErrorWithError("failed to send broadcast message", err, logger.Fields{
+					"session_id": sess.ID().String(),
+					"to":         jid,
+				})
+				result.Error = err.Error()
+				break
+			}
+			result.MessageID = messageID
+			result.Success = true
+		}
+
+		if result.Success {
+			response.SuccessCount++
+		} else {
+			response.FailedCount++
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	uc.logger.InfoWithFields("broadcast completed", logger.Fields{
+		"session_id":    sess.ID().String(),
+		"total_count":   response.TotalCount,
+		"success_count": response.SuccessCount,
+		"failed_count":  response.FailedCount,
+	})
+
+	return response, nil
+}
+
+// sleepContext waits for the given duration, returning false if the context ends first
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
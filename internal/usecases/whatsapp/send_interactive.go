@@ -0,0 +1,170 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// SendInteractiveResponse represents the response from sending a buttons or list message
+type SendInteractiveResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	To        string            `json:"to"`
+	MessageID string            `json:"message_id"`
+}
+
+// SendButtonsUseCase handles sending messages with quick reply buttons
+type SendButtonsUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
+}
+
+// NewSendButtonsUseCase creates a new send buttons use case
+func NewSendButtonsUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, limiter *SendLimiter) *SendButtonsUseCase {
+	return &SendButtonsUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		limiter:            limiter,
+	}
+}
+
+// SendButtonsRequest represents the request to send a buttons message
+type SendButtonsRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+	Body        string            `json:"body" validate:"required"`
+	Buttons     []whatsapp.Button `json:"buttons" validate:"required"`
+}
+
+// Execute validates and sends a buttons message
+func (uc *SendButtonsUseCase) Execute(ctx context.Context, req SendButtonsRequest) (*SendInteractiveResponse, error) {
+	if err := whatsapp.ValidateButtons(req.Body, req.Buttons); err != nil {
+		return nil, err
+	}
+
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	messageID, err := waClient.SendButtons(ctx, formattedTo, req.Body, req.Buttons)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to send buttons message", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"to":         formattedTo,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("buttons message sent successfully", logger.Fields{
+		"session_id": sess.ID().String(),
+		"to":         formattedTo,
+		"message_id": messageID,
+		"buttons":    len(req.Buttons),
+	})
+
+	return &SendInteractiveResponse{
+		SessionID: sess.ID(),
+		To:        formattedTo,
+		MessageID: messageID,
+	}, nil
+}
+
+// SendListUseCase handles sending list messages
+type SendListUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
+}
+
+// NewSendListUseCase creates a new send list use case
+func NewSendListUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, limiter *SendLimiter) *SendListUseCase {
+	return &SendListUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		limiter:            limiter,
+	}
+}
+
+// SendListRequest represents the request to send a list message.
+// ButtonText labels the button that opens the list.
+type SendListRequest struct {
+	SessionID   session.SessionID      `json:"session_id"`
+	To          string                 `json:"to" validate:"required"`
+	CountryCode string                 `json:"country_code,omitempty"` // Overrides the default country code
+	Body        string                 `json:"body" validate:"required"`
+	ButtonText  string                 `json:"button_text" validate:"required"`
+	Sections    []whatsapp.ListSection `json:"sections" validate:"required"`
+}
+
+// Execute validates and sends a list message
+func (uc *SendListUseCase) Execute(ctx context.Context, req SendListRequest) (*SendInteractiveResponse, error) {
+	if err := whatsapp.ValidateList(req.Body, req.ButtonText, req.Sections); err != nil {
+		return nil, err
+	}
+
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	messageID, err := waClient.SendList(ctx, formattedTo, req.Body, req.ButtonText, req.Sections)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to send list message", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"to":         formattedTo,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("list message sent successfully", logger.Fields{
+		"session_id": sess.ID().String(),
+		"to":         formattedTo,
+		"message_id": messageID,
+		"sections":   len(req.Sections),
+	})
+
+	return &SendInteractiveResponse{
+		SessionID: sess.ID(),
+		To:        formattedTo,
+		MessageID: messageID,
+	}, nil
+}
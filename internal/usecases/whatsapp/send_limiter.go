@@ -0,0 +1,132 @@
+package whatsapp
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// SendLimiter paces the outbound messages of each session with a token bucket, since
+// WhatsApp bans numbers that send too fast. Sessions use the global limit unless they
+// override it. A send waits for its turn for up to maxWait and is refused beyond that.
+type SendLimiter struct {
+	mu      sync.Mutex
+	global  session.SendRateLimit
+	maxWait time.Duration
+	buckets map[session.SessionID]*sendBucket
+}
+
+// sendBucket holds the tokens of one session. Tokens go negative while sends are queued.
+type sendBucket struct {
+	limit      session.SendRateLimit
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewSendLimiter creates a send limiter. A zero global rate sends without limit, and
+// a zero maxWait refuses every send that would have to wait.
+func NewSendLimiter(global session.SendRateLimit, maxWait time.Duration) *SendLimiter {
+	limiter := &SendLimiter{
+		buckets: make(map[session.SessionID]*sendBucket),
+	}
+	limiter.SetGlobal(global, maxWait)
+	return limiter
+}
+
+// SetGlobal replaces the global limit and the longest wait. Sessions using the global
+// limit keep the sends already made, within the new burst.
+func (l *SendLimiter) SetGlobal(global session.SendRateLimit, maxWait time.Duration) {
+	if global.Enabled() && global.Burst < 1 {
+		global.Burst = 1
+	}
+	if maxWait < 0 {
+		maxWait = 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.global = global
+	l.maxWait = maxWait
+}
+
+// globalLimit returns the limit of the sessions without an override
+func (l *SendLimiter) globalLimit() session.SendRateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.global
+}
+
+// Wait takes a send slot of the session, sleeping until its turn. It returns a
+// *whatsapp.SendRateLimitError without waiting when the turn is more than maxWait away,
+// and the context error if the context ends first. A nil limiter never waits.
+func (l *SendLimiter) Wait(ctx context.Context, sess *session.Session) error {
+	if l == nil {
+		return nil
+	}
+
+	limit := l.globalLimit()
+	if override := sess.SendRateLimit(); override != nil {
+		limit = *override
+	}
+	if !limit.Enabled() {
+		return nil
+	}
+
+	wait, ok := l.reserve(sess.ID(), limit, time.Now())
+	if !ok {
+		return &whatsapp.SendRateLimitError{RetryAfter: wait}
+	}
+
+	if !sleepContext(ctx, wait) {
+		// The slot was never used, so give it back to the sends queued after it
+		l.release(sess.ID())
+		return ctx.Err()
+	}
+	return nil
+}
+
+// reserve takes a token of the session, returning how long the send must wait for it.
+// Nothing is taken and false is returned when the wait would exceed maxWait.
+func (l *SendLimiter) reserve(sessionID session.SessionID, limit session.SendRateLimit, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, exists := l.buckets[sessionID]
+	switch {
+	case !exists:
+		bucket = &sendBucket{limit: limit, tokens: float64(limit.Burst), lastRefill: now}
+		l.buckets[sessionID] = bucket
+	case bucket.limit != limit:
+		// A changed limit keeps the sends already made, within the new burst
+		bucket.limit = limit
+		bucket.tokens = math.Min(bucket.tokens, float64(limit.Burst))
+	}
+
+	bucket.tokens = math.Min(float64(limit.Burst), bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*limit.PerSecond)
+	bucket.lastRefill = now
+
+	var wait time.Duration
+	if bucket.tokens < 1 {
+		wait = time.Duration((1 - bucket.tokens) / limit.PerSecond * float64(time.Second))
+	}
+	if wait > l.maxWait {
+		return wait, false
+	}
+
+	bucket.tokens--
+	return wait, true
+}
+
+// release returns a reserved token of the session
+func (l *SendLimiter) release(sessionID session.SessionID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if bucket, exists := l.buckets[sessionID]; exists {
+		bucket.tokens = math.Min(float64(bucket.limit.Burst), bucket.tokens+1)
+	}
+}
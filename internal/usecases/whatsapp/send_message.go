@@ -18,23 +18,32 @@ type SendMessageUseCase struct {
 	waManager   whatsapp.Manager
 	logger      logger.Logger
 	validator   validator.Validator
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
 }
 
 // NewSendMessageUseCase creates a new send message use case
-func NewSendMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator) *SendMessageUseCase {
+func NewSendMessageUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, validator validator.Validator, defaultCountryCode string, limiter *SendLimiter) *SendMessageUseCase {
 	return &SendMessageUseCase{
-		sessionRepo: sessionRepo,
-		waManager:   waManager,
-		logger:      logger,
-		validator:   validator,
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		validator:          validator,
+		defaultCountryCode: defaultCountryCode,
+		limiter:            limiter,
 	}
 }
 
 // SendMessageRequest represents the request to send a message
 type SendMessageRequest struct {
-	SessionID session.SessionID `json:"session_id"`
-	To        string            `json:"to" validate:"required"`
-	Message   string            `json:"message" validate:"required,max=4096"`
+	SessionID   session.SessionID `json:"session_id"`
+	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+	Message     string            `json:"message" validate:"required,max=4096"`
 }
 
 // SendMessageResponse represents the response from sending a message
@@ -101,11 +110,19 @@ func (uc *SendMessageUseCase) Execute(ctx context.Context, req SendMessageReques
 		return nil, whatsapp.ErrAuthenticationFailed
 	}
 
-	// Format recipient number
-	formattedTo := formatRecipient(req.To)
+	// Format and validate recipient number
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for a send slot of the session
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
 
 	// Send message
-	err = waClient.SendMessage(ctx, formattedTo, req.Message)
+	messageID, err := waClient.SendMessage(ctx, formattedTo, req.Message)
 	if err != nil {
 		uc.logger.ErrorWithError("failed to send WhatsApp message", err, logger.Fields{
 			"session_id": sess.ID().String(),
@@ -131,7 +148,7 @@ func (uc *SendMessageUseCase) Execute(ctx context.Context, req SendMessageReques
 		To:        req.To,
 		Message:   req.Message,
 		Success:   true,
-		MessageID: generateMessageID(), // In real implementation, this would come from WhatsApp
+		MessageID: messageID,
 	}, nil
 }
 
@@ -214,10 +231,11 @@ func (uc *SendMessageUseCase) ExecuteBulk(ctx context.Context, req SendBulkMessa
 
 // SendImageRequest represents the request to send an image
 type SendImageRequest struct {
-	SessionID session.SessionID `json:"session_id"`
-	To        string            `json:"to" validate:"required"`
-	ImagePath string            `json:"image_path" validate:"required"`
-	Caption   string            `json:"caption,omitempty" validate:"max=1024"`
+	SessionID   session.SessionID `json:"session_id"`
+	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+	ImagePath   string            `json:"image_path" validate:"required"`
+	Caption     string            `json:"caption,omitempty" validate:"max=1024"`
 }
 
 // SendImageResponse represents the response from sending an image
@@ -260,8 +278,16 @@ func (uc *SendMessageUseCase) ExecuteSendImage(ctx context.Context, req SendImag
 		return nil, whatsapp.ErrAuthenticationFailed
 	}
 
-	// Format recipient number
-	formattedTo := formatRecipient(req.To)
+	// Format and validate recipient number
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for a send slot of the session
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
 
 	// Send image
 	err = waClient.SendImage(ctx, formattedTo, req.ImagePath, req.Caption)
@@ -298,24 +324,6 @@ func (uc *SendMessageUseCase) ExecuteSendImage(ctx context.Context, req SendImag
 
 // Helper functions
 
-// formatRecipient formats a recipient number to WhatsApp JID format
-func formatRecipient(recipient string) string {
-	// Remove any non-digit characters except +
-	cleaned := strings.ReplaceAll(recipient, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-
-	// If it doesn't contain @, assume it's a phone number and add @s.whatsapp.net
-	if !strings.Contains(cleaned, "@") {
-		// Remove + if present
-		cleaned = strings.TrimPrefix(cleaned, "+")
-		return cleaned + "@s.whatsapp.net"
-	}
-
-	return cleaned
-}
-
 // truncateMessage truncates a message for logging purposes
 func truncateMessage(message string, maxLength int) string {
 	if len(message) <= maxLength {
@@ -0,0 +1,94 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// SendPollUseCase handles sending polls
+type SendPollUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
+}
+
+// NewSendPollUseCase creates a new send poll use case
+func NewSendPollUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, limiter *SendLimiter) *SendPollUseCase {
+	return &SendPollUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		limiter:            limiter,
+	}
+}
+
+// SendPollRequest represents the request to send a poll.
+// A selectable count of zero lets voters pick any number of options.
+type SendPollRequest struct {
+	SessionID       session.SessionID `json:"session_id"`
+	To              string            `json:"to" validate:"required"`
+	CountryCode     string            `json:"country_code,omitempty"` // Overrides the default country code
+	Question        string            `json:"question" validate:"required"`
+	Options         []string          `json:"options" validate:"required"`
+	SelectableCount int               `json:"selectable_count"`
+}
+
+// SendPollResponse represents the response from sending a poll
+type SendPollResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	To        string            `json:"to"`
+	MessageID string            `json:"message_id"`
+}
+
+// Execute validates and sends a poll
+func (uc *SendPollUseCase) Execute(ctx context.Context, req SendPollRequest) (*SendPollResponse, error) {
+	if err := whatsapp.ValidatePoll(req.Question, req.Options, req.SelectableCount); err != nil {
+		return nil, err
+	}
+
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	messageID, err := waClient.SendPoll(ctx, formattedTo, req.Question, req.Options, req.SelectableCount)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to send poll", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"to":         formattedTo,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("poll sent successfully", logger.Fields{
+		"session_id": sess.ID().String(),
+		"to":         formattedTo,
+		"message_id": messageID,
+		"options":    len(req.Options),
+	})
+
+	return &SendPollResponse{
+		SessionID: sess.ID(),
+		To:        formattedTo,
+		MessageID: messageID,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// SendStickerUseCase handles sending stickers
+type SendStickerUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+
+	// Default country code applied to numbers without one
+	defaultCountryCode string
+
+	// Paces outbound messages per session
+	limiter *SendLimiter
+}
+
+// NewSendStickerUseCase creates a new send sticker use case
+func NewSendStickerUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger, defaultCountryCode string, limiter *SendLimiter) *SendStickerUseCase {
+	return &SendStickerUseCase{
+		sessionRepo:        sessionRepo,
+		waManager:          waManager,
+		logger:             logger,
+		defaultCountryCode: defaultCountryCode,
+		limiter:            limiter,
+	}
+}
+
+// SendStickerRequest represents the request to send a sticker
+type SendStickerRequest struct {
+	SessionID   session.SessionID `json:"session_id"`
+	To          string            `json:"to" validate:"required"`
+	CountryCode string            `json:"country_code,omitempty"` // Overrides the default country code
+	StickerData []byte            `json:"-"`                      // 512x512 WebP image
+}
+
+// SendStickerResponse represents the response from sending a sticker
+type SendStickerResponse struct {
+	SessionID session.SessionID `json:"session_id"`
+	To        string            `json:"to"`
+	MessageID string            `json:"message_id"`
+	Animated  bool              `json:"animated"`
+}
+
+// Execute validates the WebP image and sends it as a sticker
+func (uc *SendStickerUseCase) Execute(ctx context.Context, req SendStickerRequest) (*SendStickerResponse, error) {
+	info, err := whatsapp.ValidateSticker(req.StickerData)
+	if err != nil {
+		uc.logger.WarnWithFields("invalid sticker image", logger.Fields{
+			"session_id": req.SessionID.String(),
+			"size":       len(req.StickerData),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	formattedTo, err := formatRecipientJID(req.To, req.CountryCode, uc.defaultCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, waClient, err := getAuthenticatedClient(ctx, uc.sessionRepo, uc.waManager, uc.logger, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.limiter.Wait(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	messageID, err := waClient.SendSticker(ctx, formattedTo, req.StickerData)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to send sticker", err, logger.Fields{
+			"session_id": sess.ID().String(),
+			"to":         formattedTo,
+		})
+		return nil, err
+	}
+
+	uc.logger.InfoWithFields("sticker sent successfully", logger.Fields{
+		"session_id": sess.ID().String(),
+		"to":         formattedTo,
+		"message_id": messageID,
+		"size":       len(req.StickerData),
+	})
+
+	return &SendStickerResponse{
+		SessionID: sess.ID(),
+		To:        formattedTo,
+		MessageID: messageID,
+		Animated:  info.Animated,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package whatsapp
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/pkg/logger"
+)
+
+// GetSyncStatusUseCase handles retrieving the initial sync status of a session
+type GetSyncStatusUseCase struct {
+	sessionRepo session.Repository
+	waManager   whatsapp.Manager
+	logger      logger.Logger
+}
+
+// NewGetSyncStatusUseCase creates a new get sync status use case
+func NewGetSyncStatusUseCase(sessionRepo session.Repository, waManager whatsapp.Manager, logger logger.Logger) *GetSyncStatusUseCase {
+	return &GetSyncStatusUseCase{
+		sessionRepo: sessionRepo,
+		waManager:   waManager,
+		logger:      logger,
+	}
+}
+
+// GetSyncStatusRequest represents the request to get the sync status
+type GetSyncStatusRequest struct {
+	SessionID session.SessionID `json:"session_id"`
+}
+
+// GetSyncStatusResponse represents the response with the sync status
+type GetSyncStatusResponse struct {
+	SessionID session.SessionID    `json:"session_id"`
+	Status    *whatsapp.SyncStatus `json:"status"`
+	Completed bool                 `json:"completed"`
+	Message   string               `json:"message"`
+}
+
+// Execute returns the initial contact/app-state/history sync status of a session
+func (uc *GetSyncStatusUseCase) Execute(ctx context.Context, req GetSyncStatusRequest) (*GetSyncStatusResponse, error) {
+	// Get session from repository
+	sess, err := uc.sessionRepo.GetByID(ctx, req.SessionID)
+	if err != nil {
+		uc.logger.ErrorWithError("failed to get session", err, logger.Fields{
+			"session_id": req.SessionID.String(),
+		})
+		return nil, err
+	}
+
+	// Sync only happens while the session is connected
+	if !sess.IsConnected() {
+		uc.logger.WarnWithFields("session not connected", logger.Fields{
+			"session_id": sess.ID().String(),
+			"status":     sess.Status().String(),
+		})
+		return nil, session.ErrSessionNotConnected
+	}
+
+	// Get WhatsApp client
+	waClient, err := uc.waManager.GetClient(sess.ID())
+	if err != nil {
+		uc.logger.ErrorWithError("WhatsApp client not found", err, logger.Fields{
+			"session_id": sess.ID().String(),
+		})
+		return nil, whatsapp.ErrClientNotFound
+	}
+
+	status := waClient.GetSyncStatus()
+	completed := status.IsCompleted()
+
+	response := &GetSyncStatusResponse{
+		SessionID: sess.ID(),
+		Status:    status,
+		Completed: completed,
+		Message:   "Initial sync completed",
+	}
+	if !completed {
+		response.Message = "Still syncing, please wait"
+	}
+
+	uc.logger.InfoWithFields("sync status retrieved", logger.Fields{
+		"session_id":       sess.ID().String(),
+		"completed":        completed,
+		"offline_sync":     status.OfflineSync.Completed,
+		"app_state_sync":   status.AppState.Completed,
+		"contacts_sync":    status.Contacts.Completed,
+		"history_sync_pct": status.HistorySync.Percent,
+	})
+
+	return response, nil
+}
@@ -0,0 +1,133 @@
+// Package crypto provides passphrase-based encryption for sensitive exports
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Envelope parameters
+const (
+	EnvelopeVersion = 1
+	CipherAES256GCM = "aes-256-gcm"
+	KDFScrypt       = "scrypt"
+
+	// MinPassphraseLength is the minimum accepted passphrase length
+	MinPassphraseLength = 8
+
+	saltSize = 16
+	keySize  = 32
+
+	// scrypt cost parameters, recorded in the envelope so they can be raised later
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// ErrPassphraseTooShort is returned when sealing with a passphrase shorter than MinPassphraseLength
+	ErrPassphraseTooShort = errors.New("passphrase too short (minimum 8 characters)")
+
+	// ErrInvalidEnvelope is returned when an envelope is malformed or uses unsupported parameters
+	ErrInvalidEnvelope = errors.New("invalid encrypted envelope")
+
+	// ErrDecryptionFailed is returned when the passphrase is wrong or the data was tampered with
+	ErrDecryptionFailed = errors.New("decryption failed: wrong passphrase or corrupted data")
+)
+
+// Envelope holds data encrypted with a key derived from a passphrase
+type Envelope struct {
+	Version int    `json:"version"`
+	Cipher  string `json:"cipher"`
+	KDF     string `json:"kdf"`
+	N       int    `json:"n"`
+	R       int    `json:"r"`
+	P       int    `json:"p"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// Seal encrypts plaintext with AES-256-GCM using a scrypt key derived from the passphrase
+func Seal(passphrase string, plaintext []byte) (*Envelope, error) {
+	if len(passphrase) < MinPassphraseLength {
+		return nil, ErrPassphraseTooShort
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	envelope := &Envelope{
+		Version: EnvelopeVersion,
+		Cipher:  CipherAES256GCM,
+		KDF:     KDFScrypt,
+		N:       scryptN,
+		R:       scryptR,
+		P:       scryptP,
+		Salt:    salt,
+	}
+
+	aead, err := envelope.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(envelope.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	envelope.Data = aead.Seal(nil, envelope.Nonce, plaintext, nil)
+	return envelope, nil
+}
+
+// Open decrypts the envelope with the passphrase
+func Open(passphrase string, envelope *Envelope) ([]byte, error) {
+	if envelope == nil || envelope.Version != EnvelopeVersion || envelope.Cipher != CipherAES256GCM || envelope.KDF != KDFScrypt {
+		return nil, ErrInvalidEnvelope
+	}
+	if len(envelope.Salt) == 0 || len(envelope.Data) == 0 {
+		return nil, ErrInvalidEnvelope
+	}
+
+	aead, err := envelope.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if len(envelope.Nonce) != aead.NonceSize() {
+		return nil, ErrInvalidEnvelope
+	}
+
+	plaintext, err := aead.Open(nil, envelope.Nonce, envelope.Data, nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+// aead derives the key from the passphrase and the envelope KDF parameters
+func (e *Envelope) aead(passphrase string) (cipher.AEAD, error) {
+	// Bound the parameters so a crafted envelope cannot exhaust memory or CPU
+	if e.N < 2 || e.N > 1<<20 || e.N&(e.N-1) != 0 || e.R < 1 || e.R > 32 || e.P < 1 || e.P > 16 {
+		return nil, ErrInvalidEnvelope
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), e.Salt, e.N, e.R, e.P, keySize)
+	if err != nil {
+		return nil, ErrInvalidEnvelope
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
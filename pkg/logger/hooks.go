@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// hookQueueSize is how many entries may wait for hooks before new ones are dropped
+const hookQueueSize = 256
+
+// hookDelivery is an entry waiting to be passed to a hook
+type hookDelivery struct {
+	hook  Hook
+	entry *Entry
+}
+
+// hookDispatcher fires hooks from a single background worker so a slow hook never
+// blocks the logging call. It is shared by a logger and every logger derived from it.
+type hookDispatcher struct {
+	mu    sync.RWMutex
+	hooks []Hook
+
+	queue     chan hookDelivery
+	startOnce sync.Once
+}
+
+// newHookDispatcher creates a dispatcher without hooks
+func newHookDispatcher() *hookDispatcher {
+	return &hookDispatcher{
+		queue: make(chan hookDelivery, hookQueueSize),
+	}
+}
+
+// add registers a hook and starts the worker on first use
+func (d *hookDispatcher) add(hook Hook) {
+	d.mu.Lock()
+	d.hooks = append(d.hooks, hook)
+	d.mu.Unlock()
+
+	d.startOnce.Do(func() {
+		go d.worker()
+	})
+}
+
+// remove unregisters a hook
+func (d *hookDispatcher) remove(hook Hook) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, h := range d.hooks {
+		if h == hook {
+			d.hooks = append(d.hooks[:i], d.hooks[i+1:]...)
+			return
+		}
+	}
+}
+
+// fire queues the entry for every hook registered for its level, dropping it when the queue is full
+func (d *hookDispatcher) fire(level Level, msg string, err error, base, fields Fields) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if len(d.hooks) == 0 {
+		return
+	}
+
+	var entry *Entry
+	for _, hook := range d.hooks {
+		if !hookHandlesLevel(hook, level) {
+			continue
+		}
+
+		if entry == nil {
+			entry = newEntry(level, msg, err, base, fields)
+		}
+
+		select {
+		case d.queue <- hookDelivery{hook: hook, entry: entry}:
+		default:
+			// Never block the logging path; the hook misses this entry
+		}
+	}
+}
+
+// worker passes queued entries to their hooks
+func (d *hookDispatcher) worker() {
+	for delivery := range d.queue {
+		if err := delivery.hook.Fire(delivery.entry); err != nil {
+			// Logging through the logger would fire the hook again
+			fmt.Fprintf(os.Stderr, "log hook failed: %v\n", err)
+		}
+	}
+}
+
+// hookHandlesLevel reports whether a hook wants entries of the given level
+func hookHandlesLevel(hook Hook, level Level) bool {
+	for _, l := range hook.Levels() {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// newEntry builds a log entry, letting call fields override the logger's context fields
+func newEntry(level Level, msg string, err error, base, fields Fields) *Entry {
+	entry := &Entry{
+		Level:     level,
+		Message:   msg,
+		Timestamp: time.Now().Format(time.RFC3339Nano),
+	}
+
+	if len(base)+len(fields) > 0 {
+		entry.Fields = make(map[string]interface{}, len(base)+len(fields))
+		for k, v := range base {
+			entry.Fields[k] = v
+		}
+		for k, v := range fields {
+			entry.Fields[k] = v
+		}
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	return entry
+}
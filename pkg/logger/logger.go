@@ -45,6 +45,11 @@ func (l Level) String() string {
 	}
 }
 
+// MarshalText encodes the level by name, e.g. in JSON log entries
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
 // Fields represents a map of key-value pairs for structured logging
 type Fields map[string]interface{}
 
@@ -214,6 +219,10 @@ func (n *NoopLogger) IsErrorEnabled() bool                                { retu
 type ZerologLogger struct {
 	logger zerolog.Logger
 	level  Level
+
+	// Hooks are shared with derived loggers; fields are the context fields added by With*
+	hooks  *hookDispatcher
+	fields Fields
 }
 
 // New creates a new logger with the given configuration
@@ -310,6 +319,7 @@ func New(config *Config) Logger {
 	return &ZerologLogger{
 		logger: logger,
 		level:  level,
+		hooks:  newHookDispatcher(),
 	}
 }
 
@@ -352,26 +362,33 @@ func parseZerologLevel(level Level) zerolog.Level {
 // Implement Logger interface methods
 
 func (z *ZerologLogger) Debug(msg string) {
+	z.fireHooks(DebugLevel, msg, nil, nil)
 	z.logger.Debug().Msg(msg)
 }
 
 func (z *ZerologLogger) Info(msg string) {
+	z.fireHooks(InfoLevel, msg, nil, nil)
 	z.logger.Info().Msg(msg)
 }
 
 func (z *ZerologLogger) Warn(msg string) {
+	z.fireHooks(WarnLevel, msg, nil, nil)
 	z.logger.Warn().Msg(msg)
 }
 
 func (z *ZerologLogger) Error(msg string) {
+	z.fireHooks(ErrorLevel, msg, nil, nil)
 	z.logger.Error().Msg(msg)
 }
 
 func (z *ZerologLogger) Fatal(msg string) {
+	z.fireHooks(FatalLevel, msg, nil, nil)
 	z.logger.Fatal().Msg(msg)
 }
 
 func (z *ZerologLogger) DebugWithFields(msg string, fields Fields) {
+	z.fireHooks(DebugLevel, msg, nil, fields)
+
 	event := z.logger.Debug()
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -380,6 +397,8 @@ func (z *ZerologLogger) DebugWithFields(msg string, fields Fields) {
 }
 
 func (z *ZerologLogger) InfoWithFields(msg string, fields Fields) {
+	z.fireHooks(InfoLevel, msg, nil, fields)
+
 	event := z.logger.Info()
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -388,6 +407,8 @@ func (z *ZerologLogger) InfoWithFields(msg string, fields Fields) {
 }
 
 func (z *ZerologLogger) WarnWithFields(msg string, fields Fields) {
+	z.fireHooks(WarnLevel, msg, nil, fields)
+
 	event := z.logger.Warn()
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -396,6 +417,8 @@ func (z *ZerologLogger) WarnWithFields(msg string, fields Fields) {
 }
 
 func (z *ZerologLogger) ErrorWithFields(msg string, fields Fields) {
+	z.fireHooks(ErrorLevel, msg, nil, fields)
+
 	event := z.logger.Error()
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -404,6 +427,8 @@ func (z *ZerologLogger) ErrorWithFields(msg string, fields Fields) {
 }
 
 func (z *ZerologLogger) FatalWithFields(msg string, fields Fields) {
+	z.fireHooks(FatalLevel, msg, nil, fields)
+
 	event := z.logger.Fatal()
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -412,6 +437,8 @@ func (z *ZerologLogger) FatalWithFields(msg string, fields Fields) {
 }
 
 func (z *ZerologLogger) DebugWithError(msg string, err error, fields Fields) {
+	z.fireHooks(DebugLevel, msg, err, fields)
+
 	event := z.logger.Debug().Err(err)
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -420,6 +447,8 @@ func (z *ZerologLogger) DebugWithError(msg string, err error, fields Fields) {
 }
 
 func (z *ZerologLogger) InfoWithError(msg string, err error, fields Fields) {
+	z.fireHooks(InfoLevel, msg, err, fields)
+
 	event := z.logger.Info().Err(err)
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -428,6 +457,8 @@ func (z *ZerologLogger) InfoWithError(msg string, err error, fields Fields) {
 }
 
 func (z *ZerologLogger) WarnWithError(msg string, err error, fields Fields) {
+	z.fireHooks(WarnLevel, msg, err, fields)
+
 	event := z.logger.Warn().Err(err)
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -436,6 +467,8 @@ func (z *ZerologLogger) WarnWithError(msg string, err error, fields Fields) {
 }
 
 func (z *ZerologLogger) ErrorWithError(msg string, err error, fields Fields) {
+	z.fireHooks(ErrorLevel, msg, err, fields)
+
 	event := z.logger.Error().Err(err)
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -444,6 +477,8 @@ func (z *ZerologLogger) ErrorWithError(msg string, err error, fields Fields) {
 }
 
 func (z *ZerologLogger) FatalWithError(msg string, err error, fields Fields) {
+	z.fireHooks(FatalLevel, msg, err, fields)
+
 	event := z.logger.Fatal().Err(err)
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -453,24 +488,31 @@ func (z *ZerologLogger) FatalWithError(msg string, err error, fields Fields) {
 
 func (z *ZerologLogger) WithContext(ctx context.Context) Logger {
 	newLogger := z.logger.With().Logger()
+	contextFields := Fields{}
 
 	// Extract common context values
 	if requestID := ctx.Value(ContextKeyRequestID); requestID != nil {
 		newLogger = newLogger.With().Interface("request_id", requestID).Logger()
+		contextFields["request_id"] = requestID
 	}
 	if userID := ctx.Value(ContextKeyUserID); userID != nil {
 		newLogger = newLogger.With().Interface("user_id", userID).Logger()
+		contextFields["user_id"] = userID
 	}
 	if sessionID := ctx.Value(ContextKeySessionID); sessionID != nil {
 		newLogger = newLogger.With().Interface("session_id", sessionID).Logger()
+		contextFields["session_id"] = sessionID
 	}
 	if correlationID := ctx.Value(ContextKeyCorrelationID); correlationID != nil {
 		newLogger = newLogger.With().Interface("correlation_id", correlationID).Logger()
+		contextFields["correlation_id"] = correlationID
 	}
 
 	return &ZerologLogger{
 		logger: newLogger,
 		level:  z.level,
+		hooks:  z.hooks,
+		fields: z.withFields(contextFields),
 	}
 }
 
@@ -483,6 +525,8 @@ func (z *ZerologLogger) WithFields(fields Fields) Logger {
 	return &ZerologLogger{
 		logger: event.Logger(),
 		level:  z.level,
+		hooks:  z.hooks,
+		fields: z.withFields(fields),
 	}
 }
 
@@ -490,13 +534,22 @@ func (z *ZerologLogger) WithField(key string, value interface{}) Logger {
 	return &ZerologLogger{
 		logger: z.logger.With().Interface(key, value).Logger(),
 		level:  z.level,
+		hooks:  z.hooks,
+		fields: z.withFields(Fields{key: value}),
 	}
 }
 
 func (z *ZerologLogger) WithError(err error) Logger {
+	errorFields := Fields{}
+	if err != nil {
+		errorFields["error"] = err.Error()
+	}
+
 	return &ZerologLogger{
 		logger: z.logger.With().Err(err).Logger(),
 		level:  z.level,
+		hooks:  z.hooks,
+		fields: z.withFields(errorFields),
 	}
 }
 
@@ -528,3 +581,34 @@ func (z *ZerologLogger) IsWarnEnabled() bool {
 func (z *ZerologLogger) IsErrorEnabled() bool {
 	return z.level <= ErrorLevel
 }
+
+// AddHook registers a hook fired for every entry of the levels it handles.
+// Hooks run on a background worker and never block the logging call.
+func (z *ZerologLogger) AddHook(hook Hook) {
+	z.hooks.add(hook)
+}
+
+// RemoveHook unregisters a hook
+func (z *ZerologLogger) RemoveHook(hook Hook) {
+	z.hooks.remove(hook)
+}
+
+// fireHooks passes the entry to the registered hooks when its level is enabled
+func (z *ZerologLogger) fireHooks(level Level, msg string, err error, fields Fields) {
+	if z.hooks == nil || level < z.level {
+		return
+	}
+	z.hooks.fire(level, msg, err, z.fields, fields)
+}
+
+// withFields returns the context fields extended with the given fields
+func (z *ZerologLogger) withFields(fields Fields) Fields {
+	merged := make(Fields, len(z.fields)+len(fields))
+	for k, v := range z.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WebhookHook forwards log entries to an external HTTP endpoint as JSON,
+// e.g. to alert operators on error-level logs
+type WebhookHook struct {
+	url    string
+	levels []Level
+	client *http.Client
+}
+
+// NewWebhookHook creates a hook that POSTs entries of the given levels to url.
+// Without levels, error and fatal entries are forwarded.
+func NewWebhookHook(url string, timeout time.Duration, levels ...Level) *WebhookHook {
+	if len(levels) == 0 {
+		levels = []Level{ErrorLevel, FatalLevel}
+	}
+
+	return &WebhookHook{
+		url:    url,
+		levels: levels,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Levels returns the levels forwarded by the hook
+func (h *WebhookHook) Levels() []Level {
+	return h.levels
+}
+
+// Fire posts the entry to the endpoint
+func (h *WebhookHook) Fire(entry *Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode log entry: %w", err)
+	}
+
+	resp, err := h.client.Post(h.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to forward log entry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("log webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package domain_session_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+)
+
+func TestSession_AllowedSenders(t *testing.T) {
+	t.Run("should accept every sender when the list is empty", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("allow-all")
+
+		// Act & Assert
+		assert.False(t, sess.HasSenderFilter())
+		assert.True(t, sess.AllowsSender("5511999999999@s.whatsapp.net"))
+	})
+
+	t.Run("should only accept listed senders ignoring device suffixes", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("allow-some")
+
+		// Act
+		err := sess.SetAllowedSenders([]string{"5511999999999:12@s.whatsapp.net", "5511999999999@s.whatsapp.net"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"5511999999999@s.whatsapp.net"}, sess.AllowedSenders())
+		assert.True(t, sess.AllowsSender("5511999999999:3@s.whatsapp.net"))
+		assert.False(t, sess.AllowsSender("5511888888888@s.whatsapp.net"))
+	})
+
+	t.Run("should match any of the sender addresses", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("allow-alt")
+		require.NoError(t, sess.SetAllowedSenders([]string{"5511999999999@s.whatsapp.net"}))
+
+		// Act & Assert
+		assert.True(t, sess.AllowsSender("123456789@lid", "5511999999999@s.whatsapp.net"))
+	})
+
+	t.Run("should reject entries that are not JIDs", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("allow-invalid")
+
+		// Act
+		err := sess.SetAllowedSenders([]string{"5511999999999", "@s.whatsapp.net"})
+
+		// Assert
+		assert.ErrorIs(t, err, session.ErrInvalidAllowedSender)
+		assert.False(t, sess.HasSenderFilter())
+	})
+
+	t.Run("should reject too many senders", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("allow-many")
+		senders := make([]string, session.MaxAllowedSenders+1)
+		for i := range senders {
+			senders[i] = fmt.Sprintf("55119%08d@s.whatsapp.net", i)
+		}
+
+		// Act
+		err := sess.SetAllowedSenders(senders)
+
+		// Assert
+		assert.ErrorIs(t, err, session.ErrTooManyAllowedSenders)
+	})
+
+	t.Run("should clear the filter with an empty list", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("allow-clear")
+		require.NoError(t, sess.SetAllowedSenders([]string{"5511999999999@s.whatsapp.net"}))
+
+		// Act
+		err := sess.SetAllowedSenders(nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, sess.HasSenderFilter())
+		assert.Nil(t, sess.AllowedSenders())
+	})
+}
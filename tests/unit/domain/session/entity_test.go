@@ -1,6 +1,7 @@
 package domain_session_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -55,6 +56,7 @@ func TestRestoreSession(t *testing.T) {
 	t.Run("should restore session with all fields", func(t *testing.T) {
 		id := session.NewSessionID()
 		name := "restored-session"
+		displayName := "Atendimento Loja Centro"
 		status := session.StatusConnected
 		waJID := "test@s.whatsapp.net"
 		qrCode := "test-qr-code"
@@ -62,10 +64,11 @@ func TestRestoreSession(t *testing.T) {
 		createdAt := time.Now().Add(-1 * time.Hour)
 		updatedAt := time.Now()
 
-		sess := session.RestoreSession(id, name, status, waJID, qrCode, "", isActive, createdAt, updatedAt)
+		sess := session.RestoreSession(id, name, displayName, status, waJID, qrCode, "", isActive, createdAt, updatedAt)
 
 		assert.Equal(t, id, sess.ID())
 		assert.Equal(t, name, sess.Name())
+		assert.Equal(t, displayName, sess.DisplayName())
 		assert.Equal(t, status, sess.Status())
 		assert.Equal(t, waJID, sess.WaJID())
 		assert.Equal(t, qrCode, sess.QRCode())
@@ -81,7 +84,7 @@ func TestRestoreSession(t *testing.T) {
 		createdAt := time.Now().Add(-1 * time.Hour)
 		updatedAt := time.Now()
 
-		sess := session.RestoreSession(id, name, status, "", "", "", false, createdAt, updatedAt)
+		sess := session.RestoreSession(id, name, "", status, "", "", "", false, createdAt, updatedAt)
 
 		assert.Equal(t, id, sess.ID())
 		assert.Equal(t, name, sess.Name())
@@ -224,6 +227,49 @@ func TestSessionDisconnect(t *testing.T) {
 	})
 }
 
+func TestSessionDisconnectReason(t *testing.T) {
+	t.Run("should record why the session was disconnected", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		sess.DisconnectWithReason(session.DisconnectReasonLoggedOut)
+
+		assert.Equal(t, session.StatusDisconnected, sess.Status())
+		assert.Equal(t, session.DisconnectReasonLoggedOut, sess.DisconnectReason())
+	})
+
+	t.Run("should default to an unknown reason", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+
+		sess.Disconnect()
+
+		assert.Equal(t, session.DisconnectReasonUnknown, sess.DisconnectReason())
+	})
+
+	t.Run("should clear the reason once connected again", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		sess.DisconnectWithReason(session.DisconnectReasonConnectionLost)
+
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		assert.Empty(t, sess.DisconnectReason())
+	})
+}
+
+func TestSessionLogout(t *testing.T) {
+	t.Run("should disconnect and forget the WhatsApp JID", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		sess.Logout()
+
+		assert.Equal(t, session.StatusDisconnected, sess.Status())
+		assert.Equal(t, session.DisconnectReasonLoggedOut, sess.DisconnectReason())
+		assert.Empty(t, sess.WaJID())
+		assert.False(t, sess.IsActive())
+	})
+}
+
 func TestSessionSetConnecting(t *testing.T) {
 	t.Run("should set session to connecting state", func(t *testing.T) {
 		sess := session.NewSession("test-session")
@@ -239,20 +285,17 @@ func TestSessionSetConnecting(t *testing.T) {
 		assert.False(t, sess.IsActive()) // Should not be active when just connecting
 	})
 
-	t.Run("should set connected session to connecting state", func(t *testing.T) {
+	t.Run("should refuse to set connected session to connecting state", func(t *testing.T) {
 		sess := session.NewSession("test-session")
 		err := sess.Connect("test@s.whatsapp.net")
 		require.NoError(t, err)
 		initialUpdatedAt := sess.UpdatedAt()
 
-		// Wait a bit to ensure timestamp difference
-		time.Sleep(1 * time.Millisecond)
+		err = sess.SetConnecting()
 
-		sess.SetConnecting()
-
-		assert.Equal(t, session.StatusConnecting, sess.Status())
-		assert.True(t, sess.UpdatedAt().After(initialUpdatedAt))
-		// Should remain active when going from connected to connecting
+		assert.ErrorIs(t, err, session.ErrSessionInvalidState)
+		assert.Equal(t, session.StatusConnected, sess.Status())
+		assert.Equal(t, initialUpdatedAt, sess.UpdatedAt())
 		assert.True(t, sess.IsActive())
 	})
 
@@ -267,6 +310,113 @@ func TestSessionSetConnecting(t *testing.T) {
 	})
 }
 
+func TestSessionCanTransitionTo(t *testing.T) {
+	testCases := []struct {
+		from    session.Status
+		to      session.Status
+		allowed bool
+	}{
+		{session.StatusDisconnected, session.StatusDisconnected, true},
+		{session.StatusDisconnected, session.StatusConnecting, true},
+		{session.StatusDisconnected, session.StatusConnected, true},
+		{session.StatusDisconnected, session.StatusError, true},
+		{session.StatusConnecting, session.StatusDisconnected, true},
+		{session.StatusConnecting, session.StatusConnecting, true},
+		{session.StatusConnecting, session.StatusConnected, true},
+		{session.StatusConnecting, session.StatusError, true},
+		{session.StatusConnected, session.StatusDisconnected, true},
+		{session.StatusConnected, session.StatusConnecting, false},
+		{session.StatusConnected, session.StatusConnected, false},
+		{session.StatusConnected, session.StatusError, true},
+		{session.StatusError, session.StatusDisconnected, true},
+		{session.StatusError, session.StatusConnecting, true},
+		{session.StatusError, session.StatusConnected, true},
+		{session.StatusError, session.StatusError, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.from.String()+"_to_"+tc.to.String(), func(t *testing.T) {
+			// Arrange
+			sess := session.RestoreSession(session.NewSessionID(), "test-session", "", tc.from, "", "", "", tc.from == session.StatusConnected, time.Now(), time.Now())
+
+			// Act
+			err := sess.CanTransitionTo(tc.to)
+
+			// Assert
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, session.ErrSessionInvalidState)
+			}
+		})
+	}
+
+	t.Run("should allow connecting again once disconnected", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+		require.Error(t, sess.SetConnecting())
+
+		// Act
+		sess.Disconnect()
+		err := sess.SetConnecting()
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, session.StatusConnecting, sess.Status())
+	})
+
+	t.Run("should connect a session that failed when whatsmeow reconnects it", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("test-session")
+		sess.SetError("connection failure: 503")
+
+		// Act
+		err := sess.Connect("test@s.whatsapp.net")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, session.StatusConnected, sess.Status())
+	})
+}
+
+func TestSessionSetError(t *testing.T) {
+	t.Run("should move connected session to error state with reason", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		sess.SetError("stream error: code=503")
+
+		assert.Equal(t, session.StatusError, sess.Status())
+		assert.True(t, sess.HasError())
+		assert.False(t, sess.IsActive())
+		assert.Equal(t, "stream error: code=503", sess.ErrorReason())
+		assert.NoError(t, sess.Validate())
+	})
+
+	t.Run("should clear the reason when connecting again", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		sess.SetError("connection failure: 403")
+
+		sess.SetConnecting()
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		assert.Equal(t, session.StatusConnected, sess.Status())
+		assert.False(t, sess.HasError())
+		assert.Empty(t, sess.ErrorReason())
+	})
+
+	t.Run("should clear the reason when disconnected", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		sess.SetError("connection failure: 403")
+
+		sess.Disconnect()
+
+		assert.Equal(t, session.StatusDisconnected, sess.Status())
+		assert.Empty(t, sess.ErrorReason())
+	})
+}
+
 func TestSessionQRCode(t *testing.T) {
 	t.Run("should set QR code", func(t *testing.T) {
 		sess := session.NewSession("test-session")
@@ -321,6 +471,37 @@ func TestSessionQRCode(t *testing.T) {
 		sess.ClearQRCode()
 		assert.Empty(t, sess.QRCode())
 	})
+
+	t.Run("should expire the QR code after its lifetime", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		before := time.Now()
+
+		sess.SetQRCode("test-qr-code")
+
+		assert.True(t, sess.HasValidQRCode())
+		assert.False(t, sess.QRCodeExpiresAt().Before(before.Add(session.QRCodeLifetime)))
+		assert.True(t, sess.QRCodeExpiresAt().Before(time.Now().Add(session.QRCodeLifetime+time.Second)))
+	})
+
+	t.Run("should clear the expiry with the QR code", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		sess.SetQRCode("test-qr-code")
+
+		sess.ClearQRCode()
+
+		assert.True(t, sess.QRCodeExpiresAt().IsZero())
+		assert.False(t, sess.HasValidQRCode())
+	})
+
+	t.Run("should not treat an expired QR code as valid", func(t *testing.T) {
+		sess := session.NewSession("test-session")
+		sess.SetQRCode("test-qr-code")
+
+		sess.RestoreQRCodeExpiresAt(time.Now().Add(-time.Second))
+
+		assert.Equal(t, "test-qr-code", sess.QRCode())
+		assert.False(t, sess.HasValidQRCode())
+	})
 }
 
 func TestSessionUpdateName(t *testing.T) {
@@ -366,6 +547,14 @@ func TestSessionUpdateName(t *testing.T) {
 		assert.True(t, sess.UpdatedAt().After(initialUpdatedAt))
 	})
 
+	t.Run("should enforce the naming rules", func(t *testing.T) {
+		sess := session.NewSession("original-name")
+
+		assert.Equal(t, session.ErrSessionNameTooShort, sess.UpdateName("ab"))
+		assert.Equal(t, session.ErrInvalidSessionNameChars, sess.UpdateName("name/with/slashes"))
+		assert.Equal(t, "original-name", sess.Name())
+	})
+
 	t.Run("should handle special characters in name", func(t *testing.T) {
 		sess := session.NewSession("old-name")
 		specialNames := []string{
@@ -383,6 +572,61 @@ func TestSessionUpdateName(t *testing.T) {
 	})
 }
 
+func TestSessionSetDisplayName(t *testing.T) {
+	t.Run("should set free-form display name", func(t *testing.T) {
+		sess := session.NewSession("loja-centro")
+		initialUpdatedAt := sess.UpdatedAt()
+
+		// Wait a bit to ensure timestamp difference
+		time.Sleep(1 * time.Millisecond)
+
+		err := sess.SetDisplayName("  Atendimento – Loja Centro 🛍️  ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Atendimento – Loja Centro 🛍️", sess.DisplayName())
+		assert.Equal(t, "loja-centro", sess.Name()) // Unique name is untouched
+		assert.Equal(t, "Atendimento – Loja Centro 🛍️", sess.Label())
+		assert.True(t, sess.UpdatedAt().After(initialUpdatedAt))
+	})
+
+	t.Run("should clear display name and fall back to name", func(t *testing.T) {
+		sess := session.NewSession("loja-centro")
+		require.NoError(t, sess.SetDisplayName("Loja Centro"))
+
+		err := sess.SetDisplayName("   ")
+
+		assert.NoError(t, err)
+		assert.Empty(t, sess.DisplayName())
+		assert.Equal(t, "loja-centro", sess.Label())
+	})
+
+	t.Run("should reject display name that is too long", func(t *testing.T) {
+		sess := session.NewSession("loja-centro")
+
+		err := sess.SetDisplayName(strings.Repeat("ã", session.MaxDisplayNameLength+1))
+
+		assert.Equal(t, session.ErrDisplayNameTooLong, err)
+		assert.Empty(t, sess.DisplayName())
+	})
+
+	t.Run("should accept display name at maximum length", func(t *testing.T) {
+		sess := session.NewSession("loja-centro")
+
+		err := sess.SetDisplayName(strings.Repeat("ã", session.MaxDisplayNameLength))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject control characters", func(t *testing.T) {
+		sess := session.NewSession("loja-centro")
+
+		err := sess.SetDisplayName("Loja\nCentro")
+
+		assert.Equal(t, session.ErrInvalidDisplayName, err)
+		assert.Empty(t, sess.DisplayName())
+	})
+}
+
 func TestCanConnect(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -392,6 +636,7 @@ func TestCanConnect(t *testing.T) {
 		{"disconnected", session.StatusDisconnected, true},
 		{"connecting", session.StatusConnecting, true},
 		{"connected", session.StatusConnected, false},
+		{"error", session.StatusError, true},
 	}
 
 	for _, tc := range testCases {
@@ -399,6 +644,7 @@ func TestCanConnect(t *testing.T) {
 			sess := session.RestoreSession(
 				session.NewSessionID(),
 				"test-session",
+				"",
 				tc.status,
 				"",
 				"",
@@ -432,6 +678,7 @@ func TestIsConnected(t *testing.T) {
 		sess := session.RestoreSession(
 			session.NewSessionID(),
 			"test-session",
+			"",
 			session.StatusConnected,
 			"test@s.whatsapp.net",
 			"",
@@ -448,6 +695,7 @@ func TestIsConnected(t *testing.T) {
 		sess := session.RestoreSession(
 			session.NewSessionID(),
 			"test-session",
+			"",
 			session.StatusConnected,
 			"test@s.whatsapp.net",
 			"",
@@ -526,6 +774,7 @@ func TestSessionValidation(t *testing.T) {
 		sess := session.RestoreSession(
 			session.NewSessionID(),
 			"",
+			"",
 			session.StatusDisconnected,
 			"",
 			"",
@@ -542,6 +791,7 @@ func TestSessionValidation(t *testing.T) {
 		sess := session.RestoreSession(
 			session.NewSessionID(),
 			"complete-session",
+			"",
 			session.StatusConnected,
 			"test@s.whatsapp.net",
 			"qr-code-data",
@@ -566,6 +816,7 @@ func TestSessionValidation(t *testing.T) {
 				sess := session.RestoreSession(
 					session.NewSessionID(),
 					"test-session",
+					"",
 					status,
 					"",
 					"",
@@ -0,0 +1,67 @@
+package domain_session_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+)
+
+func TestSession_SendRateLimit(t *testing.T) {
+	t.Run("should use the global limit by default", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("rate-default")
+
+		// Act & Assert
+		assert.Nil(t, sess.SendRateLimit())
+	})
+
+	t.Run("should override the global limit with at least one message of burst", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("rate-override")
+
+		// Act
+		err := sess.SetSendRateLimit(&session.SendRateLimit{PerSecond: 0.5})
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, sess.SendRateLimit())
+		assert.Equal(t, session.SendRateLimit{PerSecond: 0.5, Burst: 1}, *sess.SendRateLimit())
+	})
+
+	t.Run("should go back to the global limit when cleared", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("rate-clear")
+		require.NoError(t, sess.SetSendRateLimit(&session.SendRateLimit{PerSecond: 2, Burst: 5}))
+
+		// Act
+		err := sess.SetSendRateLimit(nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, sess.SendRateLimit())
+	})
+
+	t.Run("should reject invalid limits", func(t *testing.T) {
+		// Arrange
+		sess := session.NewSession("rate-invalid")
+		invalid := []session.SendRateLimit{
+			{PerSecond: -1, Burst: 1},
+			{PerSecond: session.MaxSendRatePerSecond + 1, Burst: 1},
+			{PerSecond: math.NaN(), Burst: 1},
+			{PerSecond: 1, Burst: -1},
+		}
+
+		for _, limit := range invalid {
+			// Act
+			err := sess.SetSendRateLimit(&limit)
+
+			// Assert
+			assert.ErrorIs(t, err, session.ErrInvalidSendRateLimit)
+		}
+		assert.Nil(t, sess.SendRateLimit())
+	})
+}
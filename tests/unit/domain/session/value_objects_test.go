@@ -222,6 +222,7 @@ func TestStatus(t *testing.T) {
 			{session.StatusDisconnected, "disconnected"},
 			{session.StatusConnecting, "connecting"},
 			{session.StatusConnected, "connected"},
+			{session.StatusError, "error"},
 		}
 
 		for _, tc := range testCases {
@@ -258,6 +259,28 @@ func TestStatus(t *testing.T) {
 			assert.NotEmpty(t, status.String())
 		}
 	})
+
+	t.Run("should parse every valid status", func(t *testing.T) {
+		for _, status := range []session.Status{
+			session.StatusDisconnected,
+			session.StatusConnecting,
+			session.StatusConnected,
+			session.StatusError,
+		} {
+			parsed, err := session.StatusFromString(status.String())
+
+			assert.NoError(t, err)
+			assert.Equal(t, status, parsed)
+			assert.True(t, parsed.IsValid())
+		}
+	})
+
+	t.Run("should reject unknown statuses", func(t *testing.T) {
+		_, err := session.StatusFromString("unknown")
+
+		assert.Error(t, err)
+		assert.False(t, session.Status(99).IsValid())
+	})
 }
 
 func TestWhatsAppJID(t *testing.T) {
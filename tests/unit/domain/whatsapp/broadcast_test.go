@@ -0,0 +1,49 @@
+package domain_whatsapp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestValidateBroadcastMessage(t *testing.T) {
+	t.Run("should accept messages up to the text limit", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateBroadcastMessage("Seu pedido foi enviado!"))
+		assert.NoError(t, whatsapp.ValidateBroadcastMessage(strings.Repeat("á", whatsapp.MaxTextMessageLength)))
+	})
+
+	t.Run("should reject blank or oversized messages", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateBroadcastMessage("   "), whatsapp.ErrInvalidBroadcastMessage)
+		assert.ErrorIs(t, whatsapp.ValidateBroadcastMessage(strings.Repeat("a", whatsapp.MaxTextMessageLength+1)), whatsapp.ErrInvalidBroadcastMessage)
+	})
+}
+
+func TestValidateRecipient(t *testing.T) {
+	t.Run("should accept international phone numbers and group JIDs", func(t *testing.T) {
+		for _, jid := range []string{
+			"5511999999999@s.whatsapp.net",
+			"14155552671@s.whatsapp.net",
+			"120363025246125486@g.us",
+			"123456789012345@lid",
+		} {
+			assert.NoError(t, whatsapp.ValidateRecipient(jid), jid)
+		}
+	})
+
+	t.Run("should reject short, malformed or unsupported recipients", func(t *testing.T) {
+		for _, jid := range []string{
+			"12345@s.whatsapp.net",
+			"0511999999999@s.whatsapp.net",
+			"5511abc999999@s.whatsapp.net",
+			"1234567890123456@s.whatsapp.net",
+			"@s.whatsapp.net",
+			"status@broadcast",
+			"5511999999999",
+		} {
+			assert.ErrorIs(t, whatsapp.ValidateRecipient(jid), whatsapp.ErrInvalidRecipient, jid)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestChatState_IsMuted(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should be muted until the mute expires", func(t *testing.T) {
+		// Arrange
+		state := whatsapp.ChatState{MutedUntil: now.Add(time.Hour)}
+
+		// Act & Assert
+		assert.True(t, state.IsMuted(now))
+		assert.False(t, state.IsMuted(now.Add(2*time.Hour)))
+	})
+
+	t.Run("should not be muted without a mute date", func(t *testing.T) {
+		// Arrange
+		state := whatsapp.ChatState{}
+
+		// Act & Assert
+		assert.False(t, state.IsMuted(now))
+	})
+}
+
+func TestEventTypeChatStateChanged_String(t *testing.T) {
+	t.Run("should use the chat.state_changed webhook name", func(t *testing.T) {
+		assert.Equal(t, "chat.state_changed", whatsapp.EventTypeChatStateChanged.String())
+	})
+}
+
+func TestValidateChatJID(t *testing.T) {
+	t.Run("should accept contact and group JIDs", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateChatJID("5511999999999@s.whatsapp.net"))
+		assert.NoError(t, whatsapp.ValidateChatJID("120363025246125486@g.us"))
+		assert.NoError(t, whatsapp.ValidateChatJID("123456789012345@lid"))
+	})
+
+	t.Run("should reject other servers and malformed JIDs", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateChatJID("120363144038483540@newsletter"), whatsapp.ErrInvalidChatJID)
+		assert.ErrorIs(t, whatsapp.ValidateChatJID("@s.whatsapp.net"), whatsapp.ErrInvalidChatJID)
+		assert.ErrorIs(t, whatsapp.ValidateChatJID("5511999999999"), whatsapp.ErrInvalidChatJID)
+	})
+}
+
+func TestValidateMuteUntil(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should accept a future end or a zero time to unmute", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateMuteUntil(now.Add(8*time.Hour), now))
+		assert.NoError(t, whatsapp.ValidateMuteUntil(time.Time{}, now))
+	})
+
+	t.Run("should reject an end that is not in the future", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateMuteUntil(now, now), whatsapp.ErrInvalidMuteUntil)
+		assert.ErrorIs(t, whatsapp.ValidateMuteUntil(now.Add(-time.Minute), now), whatsapp.ErrInvalidMuteUntil)
+	})
+}
@@ -0,0 +1,37 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestEventTypeSessionReplaced_String(t *testing.T) {
+	t.Run("should use the session.replaced webhook name", func(t *testing.T) {
+		assert.Equal(t, "session.replaced", whatsapp.EventTypeSessionReplaced.String())
+	})
+}
+
+func TestWebhookLatencyHistogram_Observe(t *testing.T) {
+	t.Run("should count attempts in the first bucket they fit and slower ones in the last entry", func(t *testing.T) {
+		// Arrange
+		var histogram whatsapp.WebhookLatencyHistogram
+
+		// Act
+		histogram.Observe(5 * time.Millisecond)
+		histogram.Observe(30 * time.Millisecond)
+		histogram.Observe(time.Minute)
+
+		// Assert
+		require.Len(t, histogram.Counts, len(whatsapp.WebhookLatencyBuckets)+1)
+		assert.Equal(t, int64(1), histogram.Counts[0])
+		assert.Equal(t, int64(1), histogram.Counts[3])
+		assert.Equal(t, int64(1), histogram.Counts[len(whatsapp.WebhookLatencyBuckets)])
+		assert.Equal(t, int64(3), histogram.Count)
+		assert.Equal(t, time.Minute+35*time.Millisecond, histogram.Sum)
+	})
+}
@@ -0,0 +1,20 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestParseParticipantAction(t *testing.T) {
+	for _, action := range []string{"add", "remove", "promote", "demote"} {
+		parsed, err := whatsapp.ParseParticipantAction(action)
+		assert.NoError(t, err)
+		assert.Equal(t, action, parsed.String())
+	}
+
+	_, err := whatsapp.ParseParticipantAction("kick")
+	assert.ErrorIs(t, err, whatsapp.ErrInvalidParticipantAction)
+}
@@ -0,0 +1,81 @@
+package domain_whatsapp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestValidateButtons(t *testing.T) {
+	buttons := []whatsapp.Button{{ID: "yes", Text: "Yes"}, {ID: "no", Text: "No"}}
+
+	t.Run("should accept a valid buttons message", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateButtons("Confirm?", buttons))
+	})
+
+	t.Run("should require a body", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateButtons(" ", buttons), whatsapp.ErrInvalidInteractiveBody)
+	})
+
+	t.Run("should require between 1 and 3 buttons", func(t *testing.T) {
+		tooMany := append(buttons, whatsapp.Button{ID: "maybe", Text: "Maybe"}, whatsapp.Button{ID: "later", Text: "Later"})
+
+		assert.ErrorIs(t, whatsapp.ValidateButtons("Confirm?", nil), whatsapp.ErrInvalidButtonCount)
+		assert.ErrorIs(t, whatsapp.ValidateButtons("Confirm?", tooMany), whatsapp.ErrInvalidButtonCount)
+	})
+
+	t.Run("should reject duplicated IDs and invalid texts", func(t *testing.T) {
+		duplicated := []whatsapp.Button{{ID: "yes", Text: "Yes"}, {ID: "yes", Text: "Sure"}}
+		longText := []whatsapp.Button{{ID: "yes", Text: "This text is far too long"}}
+
+		assert.ErrorIs(t, whatsapp.ValidateButtons("Confirm?", duplicated), whatsapp.ErrInvalidButton)
+		assert.ErrorIs(t, whatsapp.ValidateButtons("Confirm?", longText), whatsapp.ErrInvalidButton)
+		assert.ErrorIs(t, whatsapp.ValidateButtons("Confirm?", []whatsapp.Button{{ID: "yes"}}), whatsapp.ErrInvalidButton)
+	})
+}
+
+func TestValidateList(t *testing.T) {
+	sections := []whatsapp.ListSection{
+		{Title: "Morning", Rows: []whatsapp.ListRow{{ID: "8", Title: "8 AM"}, {ID: "10", Title: "10 AM"}}},
+		{Title: "Afternoon", Rows: []whatsapp.ListRow{{ID: "14", Title: "2 PM", Description: "After lunch"}}},
+	}
+
+	t.Run("should accept a valid list message", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateList("Pick a time", "Times", sections))
+	})
+
+	t.Run("should require a body and a button text", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateList("", "Times", sections), whatsapp.ErrInvalidInteractiveBody)
+		assert.ErrorIs(t, whatsapp.ValidateList("Pick a time", "", sections), whatsapp.ErrInvalidListButtonText)
+	})
+
+	t.Run("should require non-empty sections", func(t *testing.T) {
+		empty := []whatsapp.ListSection{{Title: "Nothing"}}
+
+		assert.ErrorIs(t, whatsapp.ValidateList("Pick a time", "Times", nil), whatsapp.ErrInvalidListSections)
+		assert.ErrorIs(t, whatsapp.ValidateList("Pick a time", "Times", empty), whatsapp.ErrInvalidListSections)
+	})
+
+	t.Run("should limit the rows across all sections", func(t *testing.T) {
+		rows := make([]whatsapp.ListRow, 0, whatsapp.MaxListRows)
+		for i := 0; i < whatsapp.MaxListRows; i++ {
+			rows = append(rows, whatsapp.ListRow{ID: fmt.Sprint(i), Title: fmt.Sprint("Row ", i)})
+		}
+		tooMany := []whatsapp.ListSection{{Rows: rows}, {Rows: []whatsapp.ListRow{{ID: "extra", Title: "Extra"}}}}
+
+		assert.NoError(t, whatsapp.ValidateList("Pick", "Open", []whatsapp.ListSection{{Rows: rows}}))
+		assert.ErrorIs(t, whatsapp.ValidateList("Pick", "Open", tooMany), whatsapp.ErrInvalidListRowCount)
+	})
+
+	t.Run("should reject row IDs repeated across sections", func(t *testing.T) {
+		duplicated := []whatsapp.ListSection{
+			{Rows: []whatsapp.ListRow{{ID: "8", Title: "8 AM"}}},
+			{Rows: []whatsapp.ListRow{{ID: "8", Title: "8 PM"}}},
+		}
+
+		assert.ErrorIs(t, whatsapp.ValidateList("Pick a time", "Times", duplicated), whatsapp.ErrInvalidListRow)
+	})
+}
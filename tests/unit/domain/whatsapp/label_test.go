@@ -0,0 +1,31 @@
+package domain_whatsapp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestValidateLabel(t *testing.T) {
+	t.Run("should accept a name and a palette color", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateLabel("Novo cliente", 0))
+		assert.NoError(t, whatsapp.ValidateLabel("Pago", whatsapp.MaxLabelColor))
+	})
+
+	t.Run("should reject blank or too long names", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateLabel("   ", 1), whatsapp.ErrInvalidLabelName)
+		assert.ErrorIs(t, whatsapp.ValidateLabel(strings.Repeat("a", whatsapp.MaxLabelNameLength+1), 1), whatsapp.ErrInvalidLabelName)
+	})
+
+	t.Run("should count name length in characters", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateLabel(strings.Repeat("ç", whatsapp.MaxLabelNameLength), 1))
+	})
+
+	t.Run("should reject colors outside the palette", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidateLabel("Pago", -1), whatsapp.ErrInvalidLabelColor)
+		assert.ErrorIs(t, whatsapp.ValidateLabel("Pago", whatsapp.MaxLabelColor+1), whatsapp.ErrInvalidLabelColor)
+	})
+}
@@ -0,0 +1,27 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestValidateNewsletterJID(t *testing.T) {
+	t.Run("should accept newsletter JIDs", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidateNewsletterJID("120363144038483540@newsletter"))
+	})
+
+	t.Run("should reject JIDs of other servers", func(t *testing.T) {
+		for _, jid := range []string{
+			"120363144038483540",
+			"120363025246125486@g.us",
+			"5511999999999@s.whatsapp.net",
+			"@newsletter",
+			"120363144038483540@newsletter.com",
+		} {
+			assert.ErrorIs(t, whatsapp.ValidateNewsletterJID(jid), whatsapp.ErrInvalidNewsletterJID, jid)
+		}
+	})
+}
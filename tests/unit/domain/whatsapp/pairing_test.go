@@ -0,0 +1,37 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestPairPhoneOptions_Validate(t *testing.T) {
+	t.Run("should accept empty options to keep the configured defaults", func(t *testing.T) {
+		assert.NoError(t, whatsapp.PairPhoneOptions{}.Validate())
+	})
+
+	t.Run("should accept every supported client type with a valid display name", func(t *testing.T) {
+		for _, clientType := range whatsapp.PairClientTypes {
+			opts := whatsapp.PairPhoneOptions{ClientType: clientType, DisplayName: "Firefox (Windows)"}
+
+			assert.NoError(t, opts.Validate(), clientType)
+		}
+	})
+
+	t.Run("should reject unknown client types", func(t *testing.T) {
+		opts := whatsapp.PairPhoneOptions{ClientType: "netscape"}
+
+		assert.ErrorIs(t, opts.Validate(), whatsapp.ErrInvalidPairClientType)
+	})
+
+	t.Run("should reject display names not formatted as Browser (OS)", func(t *testing.T) {
+		for _, name := range []string{"WazMeow", "Chrome(Linux)", "Chrome (Linux", "Chrome ((Linux))"} {
+			opts := whatsapp.PairPhoneOptions{DisplayName: name}
+
+			assert.ErrorIs(t, opts.Validate(), whatsapp.ErrInvalidPairDisplayName, name)
+		}
+	})
+}
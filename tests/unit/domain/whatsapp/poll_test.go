@@ -0,0 +1,44 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestValidatePoll(t *testing.T) {
+	options := []string{"Yes", "No", "Maybe"}
+
+	t.Run("should accept a valid poll", func(t *testing.T) {
+		assert.NoError(t, whatsapp.ValidatePoll("Lunch?", options, 1))
+		assert.NoError(t, whatsapp.ValidatePoll("Lunch?", options, 0))
+		assert.NoError(t, whatsapp.ValidatePoll("Lunch?", options, len(options)))
+	})
+
+	t.Run("should require a question", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidatePoll("  ", options, 1), whatsapp.ErrInvalidPollQuestion)
+	})
+
+	t.Run("should require between 2 and 12 options", func(t *testing.T) {
+		tooMany := make([]string, whatsapp.MaxPollOptions+1)
+		for i := range tooMany {
+			tooMany[i] = string(rune('a' + i))
+		}
+
+		assert.ErrorIs(t, whatsapp.ValidatePoll("Lunch?", []string{"Yes"}, 1), whatsapp.ErrInvalidPollOptionCount)
+		assert.ErrorIs(t, whatsapp.ValidatePoll("Lunch?", tooMany, 1), whatsapp.ErrInvalidPollOptionCount)
+		assert.NoError(t, whatsapp.ValidatePoll("Lunch?", tooMany[:whatsapp.MaxPollOptions], 1))
+	})
+
+	t.Run("should reject empty or duplicated options", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidatePoll("Lunch?", []string{"Yes", ""}, 1), whatsapp.ErrInvalidPollOption)
+		assert.ErrorIs(t, whatsapp.ValidatePoll("Lunch?", []string{"Yes", "Yes"}, 1), whatsapp.ErrInvalidPollOption)
+	})
+
+	t.Run("should reject a selectable count out of range", func(t *testing.T) {
+		assert.ErrorIs(t, whatsapp.ValidatePoll("Lunch?", options, -1), whatsapp.ErrInvalidPollSelectableCount)
+		assert.ErrorIs(t, whatsapp.ValidatePoll("Lunch?", options, 4), whatsapp.ErrInvalidPollSelectableCount)
+	})
+}
@@ -0,0 +1,31 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestParsePresenceState(t *testing.T) {
+	for _, state := range []string{"available", "unavailable"} {
+		parsed, err := whatsapp.ParsePresenceState(state)
+		assert.NoError(t, err)
+		assert.Equal(t, state, parsed.String())
+	}
+
+	_, err := whatsapp.ParsePresenceState("composing")
+	assert.ErrorIs(t, err, whatsapp.ErrInvalidPresenceState)
+}
+
+func TestParseChatPresenceState(t *testing.T) {
+	for _, state := range []string{"composing", "paused"} {
+		parsed, err := whatsapp.ParseChatPresenceState(state)
+		assert.NoError(t, err)
+		assert.Equal(t, state, parsed.String())
+	}
+
+	_, err := whatsapp.ParseChatPresenceState("available")
+	assert.ErrorIs(t, err, whatsapp.ErrInvalidChatPresenceState)
+}
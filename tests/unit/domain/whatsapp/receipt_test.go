@@ -0,0 +1,51 @@
+package domain_whatsapp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestNewMessageStatus(t *testing.T) {
+	sent := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should report the furthest status and the latest receipt time", func(t *testing.T) {
+		// Arrange
+		receipts := []*whatsapp.MessageReceipt{
+			{MessageID: "3EB0", ChatJID: "120363025246125486@g.us", RecipientJID: "a", Status: whatsapp.ReceiptRead, Timestamp: sent.Add(time.Minute)},
+			{MessageID: "3EB0", ChatJID: "120363025246125486@g.us", RecipientJID: "b", Status: whatsapp.ReceiptDelivered, Timestamp: sent.Add(2 * time.Minute)},
+		}
+
+		// Act
+		status, err := whatsapp.NewMessageStatus("3EB0", receipts)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, whatsapp.ReceiptRead, status.Status)
+		assert.Equal(t, "120363025246125486@g.us", status.ChatJID)
+		assert.True(t, status.UpdatedAt.Equal(sent.Add(2*time.Minute)))
+		assert.Len(t, status.Receipts, 2)
+	})
+
+	t.Run("should report a missing receipt when none was recorded", func(t *testing.T) {
+		// Act
+		status, err := whatsapp.NewMessageStatus("3EB0", nil)
+
+		// Assert
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, whatsapp.ErrReceiptNotFound)
+	})
+}
+
+func TestReceiptStatus_Rank(t *testing.T) {
+	t.Run("should order statuses by progress", func(t *testing.T) {
+		// Assert
+		assert.Less(t, whatsapp.ReceiptDelivered.Rank(), whatsapp.ReceiptRead.Rank())
+		assert.Less(t, whatsapp.ReceiptRead.Rank(), whatsapp.ReceiptPlayed.Rank())
+		assert.Equal(t, 0, whatsapp.ReceiptStatus("unknown").Rank())
+	})
+}
@@ -0,0 +1,97 @@
+package domain_whatsapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+)
+
+func TestNewScheduledMessage(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	sessionID := session.NewSessionID()
+
+	t.Run("should create a pending message with a new ID", func(t *testing.T) {
+		// Arrange
+		sendAt := now.Add(time.Hour).In(time.FixedZone("BRT", -3*60*60))
+
+		// Act
+		message, err := whatsapp.NewScheduledMessage(sessionID, "5511999999999@s.whatsapp.net", "Lembrete", sendAt, now)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, message.ID)
+		assert.Equal(t, sessionID, message.SessionID)
+		assert.Equal(t, whatsapp.ScheduledMessagePending, message.Status)
+		assert.True(t, message.IsPending())
+		assert.Equal(t, time.UTC, message.SendAt.Location())
+		assert.True(t, message.SendAt.Equal(sendAt))
+	})
+
+	t.Run("should reject send times in the past or too far ahead", func(t *testing.T) {
+		for _, sendAt := range []time.Time{now, now.Add(-time.Minute), now.Add(whatsapp.MaxScheduleAhead + time.Minute)} {
+			_, err := whatsapp.NewScheduledMessage(sessionID, "5511999999999@s.whatsapp.net", "Lembrete", sendAt, now)
+			assert.ErrorIs(t, err, whatsapp.ErrInvalidSendAt, sendAt)
+		}
+	})
+
+	t.Run("should reject invalid recipients and bodies", func(t *testing.T) {
+		_, err := whatsapp.NewScheduledMessage(sessionID, "12345@s.whatsapp.net", "Lembrete", now.Add(time.Hour), now)
+		assert.ErrorIs(t, err, whatsapp.ErrInvalidRecipient)
+
+		_, err = whatsapp.NewScheduledMessage(sessionID, "5511999999999@s.whatsapp.net", "  ", now.Add(time.Hour), now)
+		assert.ErrorIs(t, err, whatsapp.ErrInvalidScheduledBody)
+	})
+}
+
+func TestScheduledMessage_RetryAfterFailure(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	sendErr := errors.New("failed to send message: timeout")
+
+	t.Run("should put the message back to pending with a growing delay", func(t *testing.T) {
+		// Arrange
+		message, err := whatsapp.NewScheduledMessage(session.NewSessionID(), "5511999999999@s.whatsapp.net", "Lembrete", now.Add(time.Minute), now)
+		require.NoError(t, err)
+
+		// Act
+		message.Attempts = 1
+		message.RetryAfterFailure(sendErr, now)
+		first := message.NextAttemptAt
+		message.Attempts = 2
+		message.RetryAfterFailure(sendErr, now)
+
+		// Assert
+		assert.Equal(t, whatsapp.ScheduledMessagePending, message.Status)
+		assert.Equal(t, sendErr.Error(), message.Error)
+		assert.Equal(t, now.Add(whatsapp.ScheduledMessageRetryDelay), first)
+		assert.Equal(t, now.Add(2*whatsapp.ScheduledMessageRetryDelay), message.NextAttemptAt)
+	})
+
+	t.Run("should fail the message after the last attempt", func(t *testing.T) {
+		// Arrange
+		message, err := whatsapp.NewScheduledMessage(session.NewSessionID(), "5511999999999@s.whatsapp.net", "Lembrete", now.Add(time.Minute), now)
+		require.NoError(t, err)
+		message.Attempts = whatsapp.MaxScheduledMessageAttempts
+
+		// Act
+		message.RetryAfterFailure(sendErr, now)
+
+		// Assert
+		assert.Equal(t, whatsapp.ScheduledMessageFailed, message.Status)
+		assert.True(t, message.NextAttemptAt.IsZero())
+	})
+}
+
+func TestScheduledMessageStatus_IsValid(t *testing.T) {
+	t.Run("should accept known statuses only", func(t *testing.T) {
+		for _, status := range whatsapp.ScheduledMessageStatuses {
+			assert.True(t, status.IsValid(), status)
+		}
+		assert.False(t, whatsapp.ScheduledMessageStatus("delivered").IsValid())
+	})
+}
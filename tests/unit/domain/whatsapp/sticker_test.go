@@ -0,0 +1,98 @@
+package domain_whatsapp_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+)
+
+// webpHeader builds the RIFF container and first chunk of a WebP image, padded to size bytes
+func webpHeader(chunk string, payload []byte, size int) []byte {
+	data := make([]byte, size)
+	copy(data, "RIFF")
+	binary.LittleEndian.PutUint32(data[4:8], uint32(size-8))
+	copy(data[8:], "WEBP")
+	copy(data[12:], chunk)
+	binary.LittleEndian.PutUint32(data[16:20], uint32(len(payload)))
+	copy(data[20:], payload)
+	return data
+}
+
+func lossyWebP(width, height uint16, size int) []byte {
+	payload := make([]byte, 10)
+	copy(payload[3:6], []byte{0x9d, 0x01, 0x2a})
+	binary.LittleEndian.PutUint16(payload[6:8], width)
+	binary.LittleEndian.PutUint16(payload[8:10], height)
+	return webpHeader("VP8 ", payload, size)
+}
+
+func losslessWebP(width, height uint32, size int) []byte {
+	payload := make([]byte, 5)
+	payload[0] = 0x2f
+	binary.LittleEndian.PutUint32(payload[1:5], (width-1)|(height-1)<<14)
+	return webpHeader("VP8L", payload, size)
+}
+
+func extendedWebP(width, height uint32, animated bool, size int) []byte {
+	payload := make([]byte, 10)
+	if animated {
+		payload[0] = 0x02
+	}
+	payload[4], payload[5], payload[6] = byte(width-1), byte((width-1)>>8), byte((width-1)>>16)
+	payload[7], payload[8], payload[9] = byte(height-1), byte((height-1)>>8), byte((height-1)>>16)
+	return webpHeader("VP8X", payload, size)
+}
+
+func TestValidateSticker(t *testing.T) {
+	t.Run("should accept 512x512 lossy, lossless and extended WebP images", func(t *testing.T) {
+		for name, data := range map[string][]byte{
+			"lossy":    lossyWebP(512, 512, 4096),
+			"lossless": losslessWebP(512, 512, 4096),
+			"extended": extendedWebP(512, 512, false, 4096),
+		} {
+			info, err := whatsapp.ValidateSticker(data)
+
+			require.NoError(t, err, name)
+			assert.Equal(t, whatsapp.StickerSize, info.Width, name)
+			assert.Equal(t, whatsapp.StickerSize, info.Height, name)
+			assert.False(t, info.Animated, name)
+		}
+	})
+
+	t.Run("should detect animated stickers and allow their larger size", func(t *testing.T) {
+		info, err := whatsapp.ValidateSticker(extendedWebP(512, 512, true, whatsapp.MaxAnimatedStickerBytes))
+
+		require.NoError(t, err)
+		assert.True(t, info.Animated)
+	})
+
+	t.Run("should reject images with other dimensions", func(t *testing.T) {
+		_, err := whatsapp.ValidateSticker(lossyWebP(640, 480, 4096))
+		assert.ErrorIs(t, err, whatsapp.ErrInvalidSticker)
+
+		_, err = whatsapp.ValidateSticker(losslessWebP(256, 256, 4096))
+		assert.ErrorIs(t, err, whatsapp.ErrInvalidSticker)
+	})
+
+	t.Run("should reject data that is not WebP", func(t *testing.T) {
+		png := []byte("\x89PNG\r\n\x1a\n0000000000000000000000000000")
+
+		_, err := whatsapp.ValidateSticker(png)
+		assert.ErrorIs(t, err, whatsapp.ErrInvalidSticker)
+
+		_, err = whatsapp.ValidateSticker(nil)
+		assert.ErrorIs(t, err, whatsapp.ErrInvalidSticker)
+	})
+
+	t.Run("should reject stickers over the size limit", func(t *testing.T) {
+		_, err := whatsapp.ValidateSticker(lossyWebP(512, 512, whatsapp.MaxStickerBytes+1))
+		assert.ErrorIs(t, err, whatsapp.ErrStickerTooLarge)
+
+		_, err = whatsapp.ValidateSticker(extendedWebP(512, 512, true, whatsapp.MaxAnimatedStickerBytes+1))
+		assert.ErrorIs(t, err, whatsapp.ErrStickerTooLarge)
+	})
+}
@@ -0,0 +1,46 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+)
+
+func TestChatStateResponse(t *testing.T) {
+	t.Run("should omit mute when the chat is not muted", func(t *testing.T) {
+		// Act
+		response := dto.ToChatStateResponse(&whatsapp.ChatState{
+			JID:    "5511999999999@s.whatsapp.net",
+			Unread: true,
+			Pinned: true,
+		})
+
+		// Assert
+		assert.Equal(t, "5511999999999@s.whatsapp.net", response.Chat)
+		assert.True(t, response.Unread)
+		assert.True(t, response.Pinned)
+		assert.False(t, response.Archived)
+		assert.Nil(t, response.MutedUntil)
+	})
+
+	t.Run("should include the mute expiration", func(t *testing.T) {
+		// Arrange
+		mutedUntil := time.Now().Add(8 * time.Hour)
+
+		// Act
+		response := dto.ToChatStateResponse(&whatsapp.ChatState{
+			JID:        "120363025246125486@g.us",
+			Unread:     true,
+			MutedUntil: mutedUntil,
+		})
+
+		// Assert
+		require.NotNil(t, response.MutedUntil)
+		assert.True(t, mutedUntil.Equal(*response.MutedUntil))
+	})
+}
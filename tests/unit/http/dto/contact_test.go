@@ -0,0 +1,125 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+)
+
+func TestContactListResponse(t *testing.T) {
+	t.Run("should convert contacts with pagination", func(t *testing.T) {
+		contacts := []*whatsapp.ContactInfo{
+			{
+				JID:          "5511999999999@s.whatsapp.net",
+				PushName:     "João",
+				BusinessName: "Padaria do João",
+				FirstName:    "João",
+				FullName:     "João da Silva",
+			},
+		}
+
+		response := dto.ToContactListResponse("session-1", contacts, 250, 100, 200)
+
+		require.Len(t, response.Contacts, 1)
+		assert.Equal(t, "5511999999999@s.whatsapp.net", response.Contacts[0].JID)
+		assert.Equal(t, "João", response.Contacts[0].PushName)
+		assert.Equal(t, "Padaria do João", response.Contacts[0].BusinessName)
+		assert.Equal(t, "João da Silva", response.Contacts[0].FullName)
+		assert.Equal(t, 250, response.Total)
+		assert.Equal(t, 100, response.Limit)
+		assert.Equal(t, 200, response.Offset)
+	})
+
+	t.Run("should serialize empty contact store as empty list", func(t *testing.T) {
+		response := dto.ToContactListResponse("session-1", nil, 0, 100, 0)
+
+		data, err := json.Marshal(response)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"contacts":[]`)
+		assert.Equal(t, 0, response.Total)
+	})
+}
+
+func TestCheckNumbersResponse(t *testing.T) {
+	results := []*whatsapp.NumberCheckResult{
+		{Query: "+55 11 98888-8888", Exists: true, JID: "5511988888888@s.whatsapp.net"},
+		{Query: "5511000000000", Exists: false},
+	}
+
+	response := dto.ToCheckNumbersResponse(results)
+
+	require.Len(t, response.Results, 2)
+	assert.Equal(t, "+55 11 98888-8888", response.Results[0].Query)
+	assert.True(t, response.Results[0].Exists)
+	assert.Equal(t, "5511988888888@s.whatsapp.net", response.Results[0].JID)
+	assert.False(t, response.Results[1].Exists)
+	assert.Empty(t, response.Results[1].JID)
+}
+
+func TestProfilePictureResponse(t *testing.T) {
+	picture := &whatsapp.ProfilePictureInfo{
+		ID:   "1718000000",
+		URL:  "https://pps.whatsapp.net/v/t61.24694-24/example.jpg",
+		Type: "preview",
+	}
+
+	t.Run("should omit image data when not downloaded", func(t *testing.T) {
+		response := dto.ToProfilePictureResponse("5511999999999@s.whatsapp.net", picture, nil, "")
+
+		assert.Equal(t, "1718000000", response.PictureID)
+		assert.Equal(t, picture.URL, response.URL)
+		assert.Equal(t, "preview", response.Type)
+		assert.Empty(t, response.Data)
+		assert.Empty(t, response.ContentType)
+	})
+
+	t.Run("should encode downloaded image as base64", func(t *testing.T) {
+		response := dto.ToProfilePictureResponse("5511999999999@s.whatsapp.net", picture, []byte{0xff, 0xd8, 0xff}, "image/jpeg")
+
+		assert.Equal(t, "/9j/", response.Data)
+		assert.Equal(t, "image/jpeg", response.ContentType)
+	})
+}
+
+func TestBusinessProfileResponse(t *testing.T) {
+	t.Run("should convert categories and opening hours", func(t *testing.T) {
+		profile := &whatsapp.BusinessProfile{
+			JID:           "5511999999999@s.whatsapp.net",
+			Categories:    []whatsapp.BusinessCategory{{ID: "1223524174334504", Name: "Loja de varejo"}},
+			Email:         "contato@loja.com.br",
+			Address:       "Av. Paulista, 1000 - São Paulo",
+			HoursTimeZone: "America/Sao_Paulo",
+			Hours: []whatsapp.BusinessHours{
+				{DayOfWeek: "mon", Mode: "specific_hours", OpenTime: "540", CloseTime: "1080"},
+				{DayOfWeek: "sat", Mode: "open_24h"},
+			},
+		}
+
+		response := dto.ToBusinessProfileResponse(profile.JID, profile)
+
+		require.Len(t, response.Categories, 1)
+		assert.Equal(t, "Loja de varejo", response.Categories[0].Name)
+		assert.Equal(t, "contato@loja.com.br", response.Email)
+		assert.Equal(t, "America/Sao_Paulo", response.HoursTimeZone)
+		require.Len(t, response.Hours, 2)
+		assert.Equal(t, "540", response.Hours[0].OpenTime)
+		assert.Equal(t, "open_24h", response.Hours[1].Mode)
+		assert.Empty(t, response.Hours[1].OpenTime)
+	})
+
+	t.Run("should serialize a profile without categories or hours as empty lists", func(t *testing.T) {
+		response := dto.ToBusinessProfileResponse("5511999999999@s.whatsapp.net", &whatsapp.BusinessProfile{})
+
+		data, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), `"categories":[]`)
+		assert.Contains(t, string(data), `"hours":[]`)
+		assert.NotContains(t, string(data), `"email"`)
+	})
+}
@@ -0,0 +1,69 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/http/dto"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("should round trip a time and ID cursor to the nanosecond", func(t *testing.T) {
+		// Arrange
+		cursor := dto.Cursor{
+			Time: time.Date(2024, 1, 1, 12, 0, 0, 123456789, time.UTC),
+			ID:   "3EB0C127D7BACB8323A4",
+		}
+
+		// Act
+		decoded, err := dto.DecodeCursor(dto.EncodeCursor(cursor))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, cursor.Time.Equal(decoded.Time))
+		assert.Equal(t, cursor.ID, decoded.ID)
+	})
+
+	t.Run("should round trip an ID only cursor", func(t *testing.T) {
+		// Act
+		decoded, err := dto.DecodeCursor(dto.EncodeCursor(dto.Cursor{ID: "5511999999999@s.whatsapp.net"}))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, decoded.Time.IsZero())
+		assert.Equal(t, "5511999999999@s.whatsapp.net", decoded.ID)
+	})
+
+	t.Run("should encode cursors safe for query strings", func(t *testing.T) {
+		// Act
+		encoded := dto.EncodeCursor(dto.Cursor{ID: "a/b+c?d"})
+
+		// Assert
+		assert.NotContains(t, encoded, "/")
+		assert.NotContains(t, encoded, "+")
+		assert.NotContains(t, encoded, "=")
+	})
+
+	t.Run("should decode an empty cursor as the first page", func(t *testing.T) {
+		// Act
+		decoded, err := dto.DecodeCursor("")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should reject cursors not issued by the API", func(t *testing.T) {
+		for _, value := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+			// Act
+			decoded, err := dto.DecodeCursor(value)
+
+			// Assert
+			assert.ErrorIs(t, err, dto.ErrInvalidCursor, value)
+			assert.Nil(t, decoded)
+		}
+	})
+}
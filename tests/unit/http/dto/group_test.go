@@ -0,0 +1,75 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+)
+
+func TestGroupResponse(t *testing.T) {
+	t.Run("should convert group with participants", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Now()
+		group := &whatsapp.GroupInfo{
+			JID:         "120363025246125486@g.us",
+			Name:        "Support",
+			Description: "Support team",
+			OwnerJID:    "5511999999999@s.whatsapp.net",
+			Participants: []whatsapp.GroupParticipant{
+				{JID: "5511999999999@s.whatsapp.net", IsAdmin: true, IsSuperAdmin: true},
+				{JID: "5511888888888@s.whatsapp.net"},
+			},
+			CreatedAt: createdAt,
+		}
+
+		// Act
+		response := dto.ToGroupResponse(group)
+
+		// Assert
+		assert.Equal(t, group.JID, response.JID)
+		assert.Equal(t, "Support", response.Name)
+		assert.Equal(t, "Support team", response.Description)
+		assert.Equal(t, 2, response.ParticipantCount)
+		assert.True(t, response.Participants[0].IsAdmin)
+		assert.False(t, response.Participants[1].IsAdmin)
+		require.NotNil(t, response.CreatedAt)
+		assert.True(t, createdAt.Equal(*response.CreatedAt))
+	})
+
+	t.Run("should convert empty group list", func(t *testing.T) {
+		// Act
+		response := dto.ToGroupListResponse(nil)
+
+		// Assert
+		assert.NotNil(t, response.Groups)
+		assert.Equal(t, 0, response.Total)
+	})
+}
+
+func TestUpdateGroupParticipantsResponse(t *testing.T) {
+	t.Run("should report per-participant results and counts", func(t *testing.T) {
+		// Arrange
+		results := []*whatsapp.ParticipantUpdateResult{
+			{JID: "5511999999999@s.whatsapp.net", Success: true},
+			{JID: "5511888888888@s.whatsapp.net", ErrorCode: 403, Error: "not allowed"},
+		}
+
+		// Act
+		response := dto.ToUpdateGroupParticipantsResponse("120363025246125486@g.us", "add", results)
+
+		// Assert
+		assert.Equal(t, "120363025246125486@g.us", response.GroupJID)
+		assert.Equal(t, "add", response.Action)
+		require.Len(t, response.Results, 2)
+		assert.True(t, response.Results[0].Success)
+		assert.False(t, response.Results[1].Success)
+		assert.Equal(t, 403, response.Results[1].ErrorCode)
+		assert.Equal(t, 1, response.SuccessCount)
+		assert.Equal(t, 1, response.FailureCount)
+	})
+}
@@ -0,0 +1,79 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+)
+
+func TestMessageContextResponse(t *testing.T) {
+	t.Run("should flag the anchor and count surrounding messages", func(t *testing.T) {
+		// Arrange
+		sessionID := session.NewSessionID()
+		chat := "5511999999999@s.whatsapp.net"
+		now := time.Now()
+		newMessage := func(id string, fromMe bool, offset time.Duration) *whatsapp.StoredMessage {
+			return &whatsapp.StoredMessage{
+				SessionID: sessionID,
+				ID:        id,
+				ChatJID:   chat,
+				IsFromMe:  fromMe,
+				Type:      whatsapp.MessageTypeText,
+				Body:      id,
+				Timestamp: now.Add(offset),
+			}
+		}
+		anchor := newMessage("anchor", false, 0)
+		messages := []*whatsapp.StoredMessage{
+			newMessage("first", true, -2*time.Minute),
+			newMessage("second", false, -time.Minute),
+			anchor,
+			newMessage("reply", true, time.Minute),
+		}
+
+		// Act
+		response := dto.ToMessageContextResponse(chat, anchor, messages)
+
+		// Assert
+		assert.Equal(t, "anchor", response.AnchorID)
+		assert.Equal(t, 2, response.Before)
+		assert.Equal(t, 1, response.After)
+		require.Len(t, response.Messages, 4)
+		assert.Equal(t, "outgoing", response.Messages[0].Direction)
+		assert.Equal(t, "incoming", response.Messages[1].Direction)
+		assert.True(t, response.Messages[2].IsAnchor)
+		assert.False(t, response.Messages[3].IsAnchor)
+		assert.Equal(t, "reply", response.Messages[3].Body)
+	})
+
+	t.Run("should expose media metadata", func(t *testing.T) {
+		// Arrange
+		message := &whatsapp.StoredMessage{
+			SessionID: session.NewSessionID(),
+			ID:        "doc",
+			ChatJID:   "5511999999999@s.whatsapp.net",
+			Type:      whatsapp.MessageTypeDocument,
+			Media: &whatsapp.MediaInfo{
+				MimeType:   "application/pdf",
+				FileName:   "contrato.pdf",
+				DirectPath: "/v/t62.7119-24/1",
+				MediaKey:   []byte{1},
+			},
+		}
+
+		// Act
+		response := dto.ToMessageResponse(message)
+
+		// Assert
+		assert.Equal(t, "document", response.Type)
+		assert.True(t, response.HasMedia)
+		assert.Equal(t, "application/pdf", response.MimeType)
+		assert.Equal(t, "contrato.pdf", response.FileName)
+	})
+}
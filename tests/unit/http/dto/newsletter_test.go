@@ -0,0 +1,48 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/http/dto"
+)
+
+func TestNewsletterResponse(t *testing.T) {
+	t.Run("should convert newsletter metadata", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Now()
+		newsletter := &whatsapp.NewsletterInfo{
+			JID:               "120363144038483540@newsletter",
+			Name:              "Store news",
+			InviteCode:        "0029Va4K0PZ5a245NkngBA2M",
+			SubscriberCount:   1520,
+			State:             "active",
+			VerificationState: "unverified",
+			Role:              "owner",
+			CreatedAt:         createdAt,
+		}
+
+		// Act
+		response := dto.ToNewsletterResponse(newsletter)
+
+		// Assert
+		assert.Equal(t, newsletter.JID, response.JID)
+		assert.Equal(t, "Store news", response.Name)
+		assert.Equal(t, 1520, response.SubscriberCount)
+		assert.Equal(t, "owner", response.Role)
+		require.NotNil(t, response.CreatedAt)
+		assert.True(t, createdAt.Equal(*response.CreatedAt))
+	})
+
+	t.Run("should omit an unknown creation time", func(t *testing.T) {
+		// Act
+		response := dto.ToNewsletterResponse(&whatsapp.NewsletterInfo{JID: "120363144038483540@newsletter"})
+
+		// Assert
+		assert.Nil(t, response.CreatedAt)
+	})
+}
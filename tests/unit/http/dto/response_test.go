@@ -263,3 +263,22 @@ func TestResponseHelpers(t *testing.T) {
 		assert.Contains(t, string(errorJSON), `"error":"error"`)
 	})
 }
+
+func TestTypedSuccessResponse(t *testing.T) {
+	t.Run("should marshal to the same JSON as the untyped response", func(t *testing.T) {
+		// Arrange
+		data := &dto.SessionListResponse{
+			Sessions: []*dto.SessionResponse{{ID: "123", Name: "test", Status: "connected"}},
+			Total:    1,
+		}
+
+		// Act
+		typed, err := json.Marshal(dto.NewTypedSuccessResponse("Sessions retrieved successfully", data))
+		require.NoError(t, err)
+		untyped, err := json.Marshal(dto.NewSuccessResponse("Sessions retrieved successfully", data))
+		require.NoError(t, err)
+
+		// Assert
+		assert.JSONEq(t, string(untyped), string(typed))
+	})
+}
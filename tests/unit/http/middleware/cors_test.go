@@ -74,6 +74,51 @@ func TestCORSMiddleware(t *testing.T) {
 		assert.Equal(t, "GET, POST, PUT, PATCH, DELETE, OPTIONS", w.Header().Get("Access-Control-Allow-Methods"))
 		assert.Equal(t, "Accept, Authorization, Content-Type, X-CSRF-Token, X-Request-ID", w.Header().Get("Access-Control-Allow-Headers"))
 		assert.Equal(t, "", w.Header().Get("Access-Control-Allow-Credentials")) // Default is false
+		assert.Equal(t, "86400", w.Header().Get("Access-Control-Max-Age"))      // Default is 24 hours
+	})
+
+	t.Run("should emit configured max age on preflight only", func(t *testing.T) {
+		// Arrange
+		config := middleware.DefaultCORSConfig()
+		config.MaxAge = 600
+		wrappedHandler := middleware.CORSMiddleware(config)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		preflight := httptest.NewRequest("OPTIONS", "/test", nil)
+		preflight.Header.Set("Origin", "https://example.com")
+		preflight.Header.Set("Access-Control-Request-Method", "POST")
+		preflightRecorder := httptest.NewRecorder()
+
+		actual := httptest.NewRequest("GET", "/test", nil)
+		actual.Header.Set("Origin", "https://example.com")
+		actualRecorder := httptest.NewRecorder()
+
+		// Act
+		wrappedHandler.ServeHTTP(preflightRecorder, preflight)
+		wrappedHandler.ServeHTTP(actualRecorder, actual)
+
+		// Assert
+		assert.Equal(t, "600", preflightRecorder.Header().Get("Access-Control-Max-Age"))
+		assert.Empty(t, actualRecorder.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("should omit max age when disabled", func(t *testing.T) {
+		// Arrange
+		config := middleware.DefaultCORSConfig()
+		config.MaxAge = 0
+		wrappedHandler := middleware.CORSMiddleware(config)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		req := httptest.NewRequest("OPTIONS", "/test", nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		wrappedHandler.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		_, present := w.Header()["Access-Control-Max-Age"]
+		assert.False(t, present)
 	})
 
 	t.Run("should preserve existing headers", func(t *testing.T) {
@@ -0,0 +1,174 @@
+package http_middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/http/middleware"
+	"wazmeow/pkg/logger"
+)
+
+// newIdempotency creates the idempotency middleware stopped at the end of the test
+func newIdempotency(t *testing.T, config *middleware.IdempotencyConfig) func(http.Handler) http.Handler {
+	idempotency := middleware.NewIdempotency(config, &logger.NoopLogger{})
+	t.Cleanup(idempotency.Stop)
+	return idempotency.Middleware()
+}
+
+// newIdempotentHandler wraps a handler that counts its calls with the idempotency middleware
+func newIdempotentHandler(t *testing.T, status int, calls *int32) http.Handler {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		n := atomic.AddInt32(calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(`{"message_id":"` + strconv.Itoa(int(n)) + `"}`))
+	})
+	return newIdempotency(t, &middleware.IdempotencyConfig{TTL: time.Hour, MaxBodySize: 64, HeaderName: "X-API-Key"})(next)
+}
+
+// doSend posts a body to a send endpoint with an optional idempotency key
+func doSend(handler http.Handler, path, key, body string) *httptest.ResponseRecorder {
+	return doSendAs(handler, "", path, key, body)
+}
+
+// doSendAs is doSend authenticated with an API key
+func doSendAs(handler http.Handler, apiKey, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	if apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Run("should replay the original result for a repeated key", func(t *testing.T) {
+		// Arrange
+		var calls int32
+		handler := newIdempotentHandler(t, http.StatusOK, &calls)
+
+		// Act
+		first := doSend(handler, "/sessions/a/send/poll", "key-1", `{"to":"5511999999999"}`)
+		replay := doSend(handler, "/sessions/a/send/poll", "key-1", `{"to":"5511999999999"}`)
+
+		// Assert
+		assert.Equal(t, int32(1), calls)
+		assert.Equal(t, http.StatusOK, replay.Code)
+		assert.Equal(t, first.Body.String(), replay.Body.String())
+		assert.Equal(t, "application/json", replay.Header().Get("Content-Type"))
+		assert.Equal(t, "true", replay.Header().Get(middleware.IdempotentReplayedHeader))
+		assert.Empty(t, first.Header().Get(middleware.IdempotentReplayedHeader))
+	})
+
+	t.Run("should not deduplicate requests without a key or on other paths", func(t *testing.T) {
+		// Arrange
+		var calls int32
+		handler := newIdempotentHandler(t, http.StatusOK, &calls)
+
+		// Act
+		doSend(handler, "/sessions/a/send/poll", "", `{}`)
+		doSend(handler, "/sessions/a/send/poll", "", `{}`)
+		doSend(handler, "/sessions/a/send/poll", "key-1", `{}`)
+		doSend(handler, "/sessions/b/send/poll", "key-1", `{}`)
+
+		// Assert
+		assert.Equal(t, int32(4), calls)
+	})
+
+	t.Run("should reject a key reused with a different body", func(t *testing.T) {
+		// Arrange
+		var calls int32
+		handler := newIdempotentHandler(t, http.StatusOK, &calls)
+		doSend(handler, "/sessions/a/send/poll", "key-1", `{"to":"5511999999999"}`)
+
+		// Act
+		rec := doSend(handler, "/sessions/a/send/poll", "key-1", `{"to":"5511888888888"}`)
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), "IDEMPOTENCY_KEY_MISMATCH")
+		assert.Equal(t, int32(1), calls)
+	})
+
+	t.Run("should let failed requests be retried", func(t *testing.T) {
+		// Arrange
+		var calls int32
+		handler := newIdempotentHandler(t, http.StatusInternalServerError, &calls)
+
+		// Act
+		doSend(handler, "/sessions/a/send/poll", "key-1", `{}`)
+		rec := doSend(handler, "/sessions/a/send/poll", "key-1", `{}`)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, int32(2), calls)
+	})
+
+	t.Run("should reject a key while its first request is running", func(t *testing.T) {
+		// Arrange
+		started := make(chan struct{})
+		release := make(chan struct{})
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := newIdempotency(t, &middleware.IdempotencyConfig{TTL: time.Hour})(next)
+		done := make(chan struct{})
+		go func() {
+			doSend(handler, "/sessions/a/send/poll", "key-1", `{}`)
+			close(done)
+		}()
+		<-started
+
+		// Act
+		rec := doSend(handler, "/sessions/a/send/poll", "key-1", `{}`)
+		close(release)
+		<-done
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), "IDEMPOTENCY_KEY_IN_USE")
+	})
+	t.Run("should not replay results across callers", func(t *testing.T) {
+		// Arrange
+		var calls int32
+		handler := newIdempotentHandler(t, http.StatusOK, &calls)
+		first := doSendAs(handler, "key-a", "/sessions/a/send/poll", "key-1", `{}`)
+
+		// Act
+		other := doSendAs(handler, "key-b", "/sessions/a/send/poll", "key-1", `{}`)
+
+		// Assert
+		assert.Equal(t, int32(2), calls)
+		assert.NotEqual(t, first.Body.String(), other.Body.String())
+		assert.Empty(t, other.Header().Get(middleware.IdempotentReplayedHeader))
+	})
+
+	t.Run("should reject a body larger than the limit", func(t *testing.T) {
+		// Arrange
+		var calls int32
+		handler := newIdempotentHandler(t, http.StatusOK, &calls)
+
+		// Act
+		rec := doSend(handler, "/sessions/a/send/poll", "key-1", strings.Repeat("a", 65))
+
+		// Assert
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.Contains(t, rec.Body.String(), "REQUEST_BODY_TOO_LARGE")
+		assert.Equal(t, int32(0), calls)
+	})
+}
@@ -0,0 +1,119 @@
+package http_middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/http/middleware"
+	"wazmeow/pkg/logger"
+)
+
+const testJWTSecret = "test-secret"
+
+// serveWithJWT runs a request through the jwt middleware and reports the subject seen by the handler
+func serveWithJWT(authorization string) (*httptest.ResponseRecorder, string) {
+	var subject string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject, _ = middleware.SubjectFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := middleware.NewAuthMiddleware(&middleware.AuthSettings{
+		Type: middleware.AuthTypeJWT,
+		JWT:  &middleware.JWTConfig{Secret: testJWTSecret, SkipPaths: []string{"/health"}},
+	}, &logger.NoopLogger{})(next)
+
+	req := httptest.NewRequest(http.MethodGet, "/sessions/list", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec, subject
+}
+
+func TestJWTMiddleware(t *testing.T) {
+	t.Run("should authenticate valid tokens and expose the subject", func(t *testing.T) {
+		// Arrange
+		token, _, err := middleware.IssueToken(testJWTSecret, "admin", time.Hour)
+		require.NoError(t, err)
+
+		// Act
+		rec, subject := serveWithJWT("Bearer " + token)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "admin", subject)
+	})
+
+	t.Run("should reject requests without a bearer token", func(t *testing.T) {
+		// Act
+		rec, _ := serveWithJWT("")
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Bearer")
+	})
+
+	t.Run("should reject tokens signed with another secret", func(t *testing.T) {
+		// Arrange
+		token, _, err := middleware.IssueToken("other-secret", "admin", time.Hour)
+		require.NoError(t, err)
+
+		// Act
+		rec, _ := serveWithJWT("Bearer " + token)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Invalid token")
+	})
+
+	t.Run("should reject expired tokens", func(t *testing.T) {
+		// Arrange
+		token, _, err := middleware.IssueToken(testJWTSecret, "admin", -time.Minute)
+		require.NoError(t, err)
+
+		// Act
+		rec, _ := serveWithJWT("Bearer " + token)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Token expired")
+	})
+}
+
+func TestParseToken(t *testing.T) {
+	t.Run("should reject malformed tokens", func(t *testing.T) {
+		for _, token := range []string{"", "abc", "a.b.c", "a.b"} {
+			// Act
+			claims, err := middleware.ParseToken(testJWTSecret, token)
+
+			// Assert
+			assert.Nil(t, claims)
+			assert.ErrorIs(t, err, middleware.ErrInvalidToken)
+		}
+	})
+}
+
+func TestNewAuthMiddleware(t *testing.T) {
+	t.Run("should reject every request for unsupported auth types", func(t *testing.T) {
+		// Arrange
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := middleware.NewAuthMiddleware(&middleware.AuthSettings{Type: "oauth"}, &logger.NoopLogger{})(next)
+		rec := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sessions/list", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,148 @@
+package http_middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/http/middleware"
+	"wazmeow/pkg/logger"
+)
+
+// newRateLimitedHandler wraps an OK handler with a rate limiter stopped at the end of the test
+func newRateLimitedHandler(t *testing.T, config *middleware.RateLimitConfig) http.Handler {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	limiter := middleware.NewRateLimiter(config, &logger.NoopLogger{})
+	t.Cleanup(limiter.Stop)
+	return limiter.Middleware()(next)
+}
+
+// doRequest sends a request from the given address with optional headers
+func doRequest(handler http.Handler, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/sessions/list", nil)
+	req.RemoteAddr = remoteAddr
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Run("should allow the burst and reject further requests with Retry-After", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(t, &middleware.RateLimitConfig{RequestsPerMinute: 60, BurstSize: 2})
+
+		// Act
+		first := doRequest(handler, "10.0.0.1:1000", nil)
+		second := doRequest(handler, "10.0.0.1:1001", nil)
+		third := doRequest(handler, "10.0.0.1:1002", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, "1", first.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, "0", second.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, http.StatusTooManyRequests, third.Code)
+		assert.Equal(t, "1", third.Header().Get("Retry-After"))
+		assert.Contains(t, third.Body.String(), "RATE_LIMIT_EXCEEDED")
+	})
+
+	t.Run("should keep separate buckets per client IP", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(t, &middleware.RateLimitConfig{RequestsPerMinute: 60, BurstSize: 1})
+
+		// Act
+		first := doRequest(handler, "10.0.0.1:1000", nil)
+		other := doRequest(handler, "10.0.0.2:1000", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusOK, other.Code)
+	})
+
+	t.Run("should key by valid API keys only", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(t, &middleware.RateLimitConfig{
+			RequestsPerMinute: 60,
+			BurstSize:         1,
+			KeyFunc:           middleware.APIKeyOrIPKey("X-API-Key", []string{"valid-key"}),
+		})
+
+		// Act
+		withKey := doRequest(handler, "10.0.0.1:1000", map[string]string{"X-API-Key": "valid-key"})
+		sameIP := doRequest(handler, "10.0.0.1:1000", nil)
+		inventedKey := doRequest(handler, "10.0.0.1:1000", map[string]string{"X-API-Key": "made-up"})
+
+		// Assert
+		assert.Equal(t, http.StatusOK, withKey.Code)
+		assert.Equal(t, http.StatusOK, sameIP.Code)
+		assert.Equal(t, http.StatusTooManyRequests, inventedKey.Code)
+	})
+
+	t.Run("should be disabled without a request rate", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(t, &middleware.RateLimitConfig{RequestsPerMinute: 0, BurstSize: 1})
+
+		// Act
+		first := doRequest(handler, "10.0.0.1:1000", nil)
+		second := doRequest(handler, "10.0.0.1:1000", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusOK, second.Code)
+	})
+
+	t.Run("should apply changed limits to every client", func(t *testing.T) {
+		// Arrange
+		limiter := middleware.NewRateLimiter(&middleware.RateLimitConfig{RequestsPerMinute: 60, BurstSize: 1}, &logger.NoopLogger{})
+		t.Cleanup(limiter.Stop)
+		handler := limiter.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		first := doRequest(handler, "10.0.0.1:1000", nil)
+		limited := doRequest(handler, "10.0.0.1:1000", nil)
+
+		// Act
+		limiter.SetLimits(120, 2)
+		afterRaise := []*httptest.ResponseRecorder{
+			doRequest(handler, "10.0.0.1:1000", nil),
+			doRequest(handler, "10.0.0.1:1000", nil),
+		}
+		limiter.SetLimits(0, 0)
+		afterDisable := doRequest(handler, "10.0.0.1:1000", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+		for _, rec := range afterRaise {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "120", rec.Header().Get("X-RateLimit-Limit"))
+		}
+		assert.Equal(t, http.StatusOK, afterDisable.Code)
+		assert.Empty(t, afterDisable.Header().Get("X-RateLimit-Limit"))
+	})
+	t.Run("should keep limiting after being stopped", func(t *testing.T) {
+		// Arrange
+		limiter := middleware.NewRateLimiter(&middleware.RateLimitConfig{RequestsPerMinute: 0}, &logger.NoopLogger{})
+		handler := limiter.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		// Act
+		limiter.Stop()
+		limiter.Stop()
+		limiter.SetLimits(60, 1)
+		first := doRequest(handler, "10.0.0.1:1000", nil)
+		limited := doRequest(handler, "10.0.0.1:1000", nil)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	})
+}
@@ -0,0 +1,96 @@
+package http_middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/http/middleware"
+	"wazmeow/pkg/logger"
+)
+
+// serveWithRequestID runs a request through the request ID middleware and returns
+// the response and the ID seen in the handler context
+func serveWithRequestID(req *http.Request) (*httptest.ResponseRecorder, string) {
+	var seen string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = middleware.RequestIDFromContext(r.Context())
+	})
+
+	w := httptest.NewRecorder()
+	middleware.RequestIDMiddleware()(next).ServeHTTP(w, req)
+	return w, seen
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	t.Run("should reuse the request ID sent by the client", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+		req.Header.Set("X-Request-ID", "checkout-42")
+
+		// Act
+		w, seen := serveWithRequestID(req)
+
+		// Assert
+		assert.Equal(t, "checkout-42", seen)
+		assert.Equal(t, "checkout-42", w.Header().Get("X-Request-ID"))
+	})
+
+	t.Run("should generate a UUID when the client sends none", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+
+		// Act
+		w, seen := serveWithRequestID(req)
+
+		// Assert
+		_, err := uuid.Parse(seen)
+		assert.NoError(t, err)
+		assert.Equal(t, seen, w.Header().Get("X-Request-ID"))
+		assert.Equal(t, seen, req.Header.Get("X-Request-ID"))
+	})
+
+	t.Run("should generate different IDs for different requests", func(t *testing.T) {
+		// Act
+		_, first := serveWithRequestID(httptest.NewRequest(http.MethodGet, "/sessions", nil))
+		_, second := serveWithRequestID(httptest.NewRequest(http.MethodGet, "/sessions", nil))
+
+		// Assert
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("should replace IDs that are too long or not printable", func(t *testing.T) {
+		for _, invalid := range []string{strings.Repeat("a", 129), "with space", "tab\tid"} {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+			req.Header.Set("X-Request-ID", invalid)
+
+			// Act
+			_, seen := serveWithRequestID(req)
+
+			// Assert
+			_, err := uuid.Parse(seen)
+			assert.NoError(t, err, invalid)
+		}
+	})
+
+	t.Run("should store the ID under the logger context key", func(t *testing.T) {
+		// Arrange
+		var stored any
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			stored = r.Context().Value(logger.ContextKeyRequestID)
+		})
+		req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+		req.Header.Set("X-Request-ID", "checkout-42")
+
+		// Act
+		middleware.RequestIDMiddleware()(next).ServeHTTP(httptest.NewRecorder(), req)
+
+		// Assert
+		assert.Equal(t, "checkout-42", stored)
+	})
+}
@@ -0,0 +1,158 @@
+package http_middleware_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/http/middleware"
+	"wazmeow/pkg/logger"
+)
+
+// newSlowServer starts a server with a short write timeout whose handler responds after delay
+func newSlowServer(t *testing.T, handler func(http.Handler) http.Handler, delay time.Duration) *httptest.Server {
+	t.Helper()
+
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Write([]byte("paired"))
+	})
+
+	server := httptest.NewUnstartedServer(handler(slow))
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestWriteTimeoutMiddleware(t *testing.T) {
+	t.Run("should let slow handlers outlive the server write timeout", func(t *testing.T) {
+		// Arrange
+		mockLogger := new(MockMiddlewareLogger)
+		mockLogger.On("InfoWithFields", "HTTP request completed", mock.Anything).Return()
+
+		// Chain through the logging middleware to cover wrapped response writers
+		chain := func(next http.Handler) http.Handler {
+			return middleware.LoggingMiddleware(mockLogger)(
+				middleware.WriteTimeoutMiddleware(time.Second, mockLogger)(next),
+			)
+		}
+		server := newSlowServer(t, chain, 150*time.Millisecond)
+
+		// Act
+		resp, err := http.Get(server.URL)
+
+		// Assert
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "paired", string(body))
+		mockLogger.AssertNotCalled(t, "WarnWithFields", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep the server write timeout when zero", func(t *testing.T) {
+		// Arrange
+		mockLogger := new(MockMiddlewareLogger)
+		server := newSlowServer(t, middleware.WriteTimeoutMiddleware(0, mockLogger), 150*time.Millisecond)
+
+		// Act
+		resp, err := http.Get(server.URL)
+
+		// Assert - the connection is closed before the response is written
+		if err == nil {
+			defer resp.Body.Close()
+			_, err = io.ReadAll(resp.Body)
+		}
+		assert.Error(t, err)
+	})
+}
+
+// deadlineOf runs a request through handler and returns the time left on its context
+// deadline, or false when it has none
+func deadlineOf(handler func(http.Handler) http.Handler, req *http.Request) (time.Duration, bool) {
+	var left time.Duration
+	var ok bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var deadline time.Time
+		deadline, ok = r.Context().Deadline()
+		left = time.Until(deadline)
+	})
+	handler(next).ServeHTTP(httptest.NewRecorder(), req)
+	return left, ok
+}
+
+func TestRequestTimeoutMiddleware(t *testing.T) {
+	t.Run("should bound the request context by the timeout", func(t *testing.T) {
+		// Act
+		left, ok := deadlineOf(middleware.RequestTimeoutMiddleware(time.Minute), httptest.NewRequest(http.MethodGet, "/sessions/list", nil))
+
+		// Assert
+		require.True(t, ok)
+		assert.InDelta(t, time.Minute.Seconds(), left.Seconds(), 1)
+	})
+
+	t.Run("should leave the context without deadline when disabled", func(t *testing.T) {
+		// Act
+		_, ok := deadlineOf(middleware.RequestTimeoutMiddleware(0), httptest.NewRequest(http.MethodGet, "/sessions/list", nil))
+
+		// Assert
+		assert.False(t, ok)
+	})
+
+	t.Run("should let write timeout routes extend the deadline", func(t *testing.T) {
+		// Arrange
+		chain := func(next http.Handler) http.Handler {
+			return middleware.RequestTimeoutMiddleware(time.Second)(
+				middleware.WriteTimeoutMiddleware(time.Hour, &logger.NoopLogger{})(next))
+		}
+		var err error
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+				err = r.Context().Err()
+			case <-time.After(1500 * time.Millisecond):
+			}
+		})
+
+		// Act
+		left, ok := deadlineOf(chain, httptest.NewRequest(http.MethodPost, "/sessions/x/connect", nil))
+		chain(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/sessions/x/connect", nil))
+
+		// Assert
+		require.True(t, ok)
+		assert.InDelta(t, time.Hour.Seconds(), left.Seconds(), 1)
+		assert.NoError(t, err, "the outer deadline must not cancel an extended request")
+	})
+
+	t.Run("should still cancel extended requests when the client goes away", func(t *testing.T) {
+		// Arrange
+		clientCtx, disconnect := context.WithCancel(context.Background())
+		req := httptest.NewRequest(http.MethodPost, "/sessions/x/connect", nil).WithContext(clientCtx)
+		chain := middleware.RequestTimeoutMiddleware(time.Minute)(
+			middleware.WriteTimeoutMiddleware(time.Hour, &logger.NoopLogger{})(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					disconnect()
+					select {
+					case <-r.Context().Done():
+					case <-time.After(time.Second):
+					}
+					w.Write([]byte(r.Context().Err().Error()))
+				})))
+		w := httptest.NewRecorder()
+
+		// Act
+		chain.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, context.Canceled.Error(), w.Body.String())
+	})
+}
@@ -0,0 +1,76 @@
+package routes_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/http/routes"
+	"wazmeow/internal/infra/config"
+	"wazmeow/pkg/logger"
+)
+
+func newTestRouter(t *testing.T, cfg *config.Config) http.Handler {
+	t.Helper()
+
+	cfg.Auth.HeaderName = "X-API-Key"
+	router := routes.NewRouter(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, cfg, &logger.NoopLogger{})
+	t.Cleanup(router.Stop)
+	return router.SetupRoutes()
+}
+
+func TestPrometheusRoute(t *testing.T) {
+	t.Run("should not serve prometheus metrics when metrics are disabled", func(t *testing.T) {
+		// Arrange
+		cfg := &config.Config{}
+		cfg.Auth.APIKeys = []string{"api-key"}
+		handler := newTestRouter(t, cfg)
+		req := httptest.NewRequest(http.MethodGet, "/metrics/prometheus", nil)
+		req.Header.Set("X-API-Key", "api-key")
+		rec := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rec, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("should not serve prometheus metrics without any key configured", func(t *testing.T) {
+		// Arrange
+		cfg := &config.Config{}
+		cfg.Features.EnableMetrics = true
+		handler := newTestRouter(t, cfg)
+		rec := httptest.NewRecorder()
+
+		// Act
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics/prometheus", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("should require a key for prometheus metrics", func(t *testing.T) {
+		// Arrange
+		cfg := &config.Config{}
+		cfg.Features.EnableMetrics = true
+		cfg.Auth.AdminAPIKeys = []string{"admin-key"}
+		handler := newTestRouter(t, cfg)
+
+		for _, key := range []string{"", "wrong-key"} {
+			req := httptest.NewRequest(http.MethodGet, "/metrics/prometheus", nil)
+			if key != "" {
+				req.Header.Set("X-API-Key", key)
+			}
+			rec := httptest.NewRecorder()
+
+			// Act
+			handler.ServeHTTP(rec, req)
+
+			// Assert
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, "key %q", key)
+		}
+	})
+}
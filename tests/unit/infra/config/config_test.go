@@ -3,6 +3,7 @@ package config_test
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -33,6 +34,7 @@ func TestConfig_Load(t *testing.T) {
 		assert.Equal(t, "info", cfg.Log.Level)
 		assert.Equal(t, "console", cfg.Log.Output)
 		assert.Equal(t, ":memory:", cfg.Database.URL)
+		assert.Equal(t, 5*time.Second, cfg.Database.SQLite.BusyTimeout)
 	})
 
 	t.Run("should load config from environment variables", func(t *testing.T) {
@@ -200,6 +202,540 @@ func TestConfig_Load(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database driver is required")
 	})
+
+	t.Run("should send presence automatically unless disabled", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, cfg.WhatsApp.AutoSendPresence)
+
+		// Act - disable automatic presence
+		os.Setenv("WHATSAPP_AUTO_SEND_PRESENCE", "false")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, cfg.WhatsApp.AutoSendPresence)
+	})
+
+	t.Run("should restore sessions on startup unless disabled", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, cfg.WhatsApp.AutoReconnect)
+
+		// Act - disable startup restore
+		os.Setenv("WHATSAPP_AUTO_RECONNECT", "false")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, cfg.WhatsApp.AutoReconnect)
+	})
+
+	t.Run("should debounce disconnections for five seconds unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 5*time.Second, cfg.WhatsApp.DisconnectGracePeriod)
+
+		// Act - report disconnections immediately
+		os.Setenv("WHATSAPP_DISCONNECT_GRACE_PERIOD", "0s")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, cfg.WhatsApp.DisconnectGracePeriod)
+
+		// Act - reject a negative grace period
+		os.Setenv("WHATSAPP_DISCONNECT_GRACE_PERIOD", "-1s")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should render medium QR codes of 256 pixels unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 256, cfg.WhatsApp.QRSize)
+		assert.Equal(t, "medium", cfg.WhatsApp.QRLevel)
+
+		// Act - clamp the size and accept any letter case
+		os.Setenv("WHATSAPP_QR_SIZE", "4096")
+		os.Setenv("WHATSAPP_QR_LEVEL", "HIGHEST")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, config.MaxQRSize, cfg.WhatsApp.QRSize)
+		assert.Equal(t, "highest", cfg.WhatsApp.QRLevel)
+
+		// Act - reject an unknown level
+		os.Setenv("WHATSAPP_QR_LEVEL", "ultra")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should announce a WazMeow desktop device unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "WazMeow", cfg.WhatsApp.DeviceName)
+		assert.Equal(t, "desktop", cfg.WhatsApp.DevicePlatform)
+		assert.Equal(t, "Chrome (Linux)", cfg.WhatsApp.PairClientName)
+		assert.Empty(t, cfg.WhatsApp.AppVersion)
+
+		// Act - custom identity
+		os.Setenv("WHATSAPP_DEVICE_NAME", "Atendimento")
+		os.Setenv("WHATSAPP_DEVICE_PLATFORM", "Firefox")
+		os.Setenv("WHATSAPP_APP_VERSION", "2.3000.1025357550")
+		os.Setenv("WHATSAPP_PAIR_CLIENT_NAME", "Firefox (Windows)")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Atendimento", cfg.WhatsApp.DeviceName)
+		assert.Equal(t, "firefox", cfg.WhatsApp.DevicePlatform)
+		assert.Equal(t, "2.3000.1025357550", cfg.WhatsApp.AppVersion)
+		assert.Equal(t, "Firefox (Windows)", cfg.WhatsApp.PairClientName)
+
+		// Act - reject a malformed app version
+		os.Setenv("WHATSAPP_APP_VERSION", "2.2412")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+
+		// Act - reject a pair client name without the OS
+		os.Setenv("WHATSAPP_APP_VERSION", "")
+		os.Setenv("WHATSAPP_PAIR_CLIENT_NAME", "Firefox")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+
+		// Act - reject an unknown platform
+		os.Setenv("WHATSAPP_PAIR_CLIENT_NAME", "Firefox (Windows)")
+		os.Setenv("WHATSAPP_DEVICE_PLATFORM", "toaster")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should wait five seconds for the first QR code unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 5*time.Second, cfg.WhatsApp.QRWaitTimeout)
+
+		// Act - answer immediately
+		os.Setenv("WHATSAPP_QR_WAIT_TIMEOUT", "0s")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, cfg.WhatsApp.QRWaitTimeout)
+
+		// Act - reject a negative timeout
+		os.Setenv("WHATSAPP_QR_WAIT_TIMEOUT", "-1s")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should space broadcasts one second apart unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second, cfg.WhatsApp.BroadcastDelay)
+		assert.Equal(t, 50, cfg.WhatsApp.BroadcastMaxRecipients)
+
+		// Act - custom delay and limit
+		os.Setenv("WHATSAPP_BROADCAST_DELAY", "3s")
+		os.Setenv("WHATSAPP_BROADCAST_MAX_RECIPIENTS", "10")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 3*time.Second, cfg.WhatsApp.BroadcastDelay)
+		assert.Equal(t, 10, cfg.WhatsApp.BroadcastMaxRecipients)
+
+		// Act - reject a negative delay
+		os.Setenv("WHATSAPP_BROADCAST_DELAY", "-1s")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+
+		// Act - reject a negative limit
+		os.Setenv("WHATSAPP_BROADCAST_DELAY", "1s")
+		os.Setenv("WHATSAPP_BROADCAST_MAX_RECIPIENTS", "-1")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should buffer the last 100 messages for an hour unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 100, cfg.WhatsApp.RecentMessagesSize)
+		assert.Equal(t, time.Hour, cfg.WhatsApp.RecentMessagesRetention)
+
+		// Act - disabled buffer
+		os.Setenv("WHATSAPP_RECENT_MESSAGES_SIZE", "0")
+		os.Setenv("WHATSAPP_RECENT_MESSAGES_RETENTION", "0")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, cfg.WhatsApp.RecentMessagesSize)
+		assert.Equal(t, time.Duration(0), cfg.WhatsApp.RecentMessagesRetention)
+
+		// Act - reject a negative size
+		os.Setenv("WHATSAPP_RECENT_MESSAGES_SIZE", "-1")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+
+		// Act - reject a negative retention
+		os.Setenv("WHATSAPP_RECENT_MESSAGES_SIZE", "10")
+		os.Setenv("WHATSAPP_RECENT_MESSAGES_RETENTION", "-1m")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should keep stored messages unless a retention is configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, time.Duration(0), cfg.WhatsApp.MessageRetention)
+
+		// Act - configured retention
+		os.Setenv("WHATSAPP_MESSAGE_RETENTION", "720h")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 720*time.Hour, cfg.WhatsApp.MessageRetention)
+
+		// Act - reject a negative retention
+		os.Setenv("WHATSAPP_MESSAGE_RETENTION", "-1h")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should not limit concurrent sessions unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, cfg.WhatsApp.MaxSessions)
+
+		// Act - configured limit
+		os.Setenv("MAX_SESSIONS", "10")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 10, cfg.WhatsApp.MaxSessions)
+
+		// Act - reject a negative limit
+		os.Setenv("MAX_SESSIONS", "-1")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should use the default session conversion parallelism unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, cfg.Server.ConversionParallelThreshold)
+		assert.Equal(t, 0, cfg.Server.ConversionWorkers)
+
+		// Act - configured parallelism
+		os.Setenv("SERVER_CONVERSION_PARALLEL_THRESHOLD", "200")
+		os.Setenv("SERVER_CONVERSION_WORKERS", "8")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 200, cfg.Server.ConversionParallelThreshold)
+		assert.Equal(t, 8, cfg.Server.ConversionWorkers)
+
+		// Act - reject a negative worker count
+		os.Setenv("SERVER_CONVERSION_WORKERS", "-1")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should not limit the send rate unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, float64(0), cfg.WhatsApp.SendRatePerSecond)
+		assert.Equal(t, 5, cfg.WhatsApp.SendRateBurst)
+		assert.Equal(t, 10*time.Second, cfg.WhatsApp.SendRateMaxWait)
+
+		// Act - configured rate
+		os.Setenv("WHATSAPP_SEND_RATE_PER_SECOND", "0.5")
+		os.Setenv("WHATSAPP_SEND_RATE_BURST", "3")
+		os.Setenv("WHATSAPP_SEND_RATE_MAX_WAIT", "2s")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0.5, cfg.WhatsApp.SendRatePerSecond)
+		assert.Equal(t, 3, cfg.WhatsApp.SendRateBurst)
+		assert.Equal(t, 2*time.Second, cfg.WhatsApp.SendRateMaxWait)
+
+		// Act - reject a rate above the maximum
+		os.Setenv("WHATSAPP_SEND_RATE_PER_SECOND", "101")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should retry connecting to the database five times unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 5, cfg.Database.ConnectRetries)
+		assert.Equal(t, time.Second, cfg.Database.ConnectRetryInterval)
+
+		// Act - single attempt
+		os.Setenv("DB_CONNECT_RETRIES", "0")
+		os.Setenv("DB_CONNECT_RETRY_INTERVAL", "0")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, cfg.Database.ConnectRetries)
+
+		// Act - reject retries without an interval
+		os.Setenv("DB_CONNECT_RETRIES", "3")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+
+		// Act - reject more idle than open connections
+		os.Setenv("DB_CONNECT_RETRY_INTERVAL", "1s")
+		os.Setenv("DB_MAX_OPEN_CONNS", "5")
+		os.Setenv("DB_MAX_IDLE_CONNS", "10")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+
+		// Act - reject a negative lifetime
+		os.Setenv("DB_MAX_IDLE_CONNS", "5")
+		os.Setenv("DB_CONN_MAX_LIFETIME", "-1m")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("should reconcile session statuses every minute unless configured", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, cfg.WhatsApp.StatusSyncInterval)
+
+		// Act - disable reconciliation
+		os.Setenv("WHATSAPP_STATUS_SYNC_INTERVAL", "0s")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, cfg.WhatsApp.StatusSyncInterval)
+
+		// Act - reject a negative interval
+		os.Setenv("WHATSAPP_STATUS_SYNC_INTERVAL", "-5s")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "status sync interval")
+	})
+
+	t.Run("should require a webhook URL only when webhooks are enabled", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 10*time.Second, cfg.Webhook.Timeout)
+
+		// Act - enable webhooks without a receiver
+		os.Setenv("ENABLE_WEBHOOKS", "true")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid webhook URL")
+
+		// Act - configure the receiver and a custom timeout
+		os.Setenv("WEBHOOK_URL", "https://example.com/hooks")
+		os.Setenv("WEBHOOK_TIMEOUT", "3s")
+		cfg, err = config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 3*time.Second, cfg.Webhook.Timeout)
+
+		// Act - reject a non-positive timeout
+		os.Setenv("WEBHOOK_TIMEOUT", "0s")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid webhook timeout")
+	})
+
+	t.Run("should default to the rich webhook format and reject unknown formats", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		os.Setenv("ENABLE_WEBHOOKS", "true")
+		os.Setenv("WEBHOOK_URL", "https://example.com/hooks")
+		defer os.Clearenv()
+
+		// Act
+		cfg, err := config.Load()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "rich", cfg.Webhook.Format)
+
+		// Act - reject an unknown format
+		os.Setenv("WEBHOOK_FORMAT", "compact")
+		_, err = config.Load()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid webhook format")
+	})
 }
 
 func TestServerConfig(t *testing.T) {
@@ -390,3 +926,96 @@ func TestDatabaseConfig(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestLogWebhookConfig(t *testing.T) {
+	t.Run("should reject invalid log webhook URL", func(t *testing.T) {
+		// Arrange
+		cfg := &config.Config{
+			Server: config.ServerConfig{
+				Host: "localhost",
+				Port: 8080,
+			},
+			Database: config.DatabaseConfig{
+				Driver: "sqlite3",
+				URL:    "./test.db",
+			},
+			Log: config.LogConfig{
+				Level:          "info",
+				Output:         "console",
+				ConsoleFormat:  "console",
+				FileFormat:     "json",
+				WebhookURL:     "not-a-url",
+				WebhookTimeout: 5 * time.Second,
+			},
+		}
+
+		// Act
+		err := cfg.Validate()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid log webhook URL")
+	})
+}
+
+func TestAuthConfig(t *testing.T) {
+	newConfig := func(auth config.AuthConfig, jwtSecret string) *config.Config {
+		return &config.Config{
+			Server:   config.ServerConfig{Host: "localhost", Port: 8080},
+			Database: config.DatabaseConfig{Driver: "sqlite3", URL: "./test.db"},
+			Log: config.LogConfig{
+				Level:         "info",
+				Output:        "console",
+				ConsoleFormat: "console",
+				FileFormat:    "json",
+			},
+			Security: config.SecurityConfig{JWTSecret: jwtSecret},
+			Auth:     auth,
+		}
+	}
+
+	t.Run("should accept jwt auth with secret and credentials", func(t *testing.T) {
+		// Arrange
+		cfg := newConfig(config.AuthConfig{
+			Enabled:   true,
+			Type:      "jwt",
+			BasicAuth: config.BasicAuthConfig{Username: "admin", Password: "secret"},
+			TokenTTL:  time.Hour,
+		}, "jwt-secret")
+
+		// Act
+		err := cfg.Validate()
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("should require a secret for jwt auth", func(t *testing.T) {
+		// Arrange
+		cfg := newConfig(config.AuthConfig{
+			Enabled:   true,
+			Type:      "jwt",
+			BasicAuth: config.BasicAuthConfig{Username: "admin", Password: "secret"},
+			TokenTTL:  time.Hour,
+		}, "")
+
+		// Act
+		err := cfg.Validate()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "JWT_SECRET")
+	})
+
+	t.Run("should reject unsupported auth types", func(t *testing.T) {
+		// Arrange
+		cfg := newConfig(config.AuthConfig{Enabled: true, Type: "oauth"}, "")
+
+		// Act
+		err := cfg.Validate()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported auth type")
+	})
+}
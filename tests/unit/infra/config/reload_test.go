@@ -0,0 +1,103 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/infra/config"
+)
+
+func TestPlanReload(t *testing.T) {
+	t.Run("should split reloadable changes from the ones requiring a restart", func(t *testing.T) {
+		// Arrange
+		current := &config.Config{}
+		current.Log.Level = "info"
+		current.Server.Port = 8080
+		current.Database.Driver = "sqlite3"
+		current.Database.URL = "postgres://user:secret@db/wazmeow"
+		next := *current
+		next.Log.Level = "debug"
+		next.WhatsApp.DisconnectGracePeriod = 10 * time.Second
+		next.Server.Port = 9090
+		next.Database.URL = "postgres://user:other@db/wazmeow"
+
+		// Act
+		plan := config.PlanReload(current, &next)
+
+		// Assert
+		assert.True(t, plan.HasChanges())
+		assert.Equal(t, []config.SettingChange{
+			{Name: "LOG_LEVEL", Previous: "info", Current: "debug"},
+			{Name: "WHATSAPP_DISCONNECT_GRACE_PERIOD", Previous: "0s", Current: "10s"},
+		}, plan.Reloadable)
+		assert.Equal(t, []config.SettingChange{
+			{Name: "SERVER_PORT", Previous: "8080", Current: "9090"},
+			{Name: "DB_URL"},
+		}, plan.RestartRequired)
+	})
+
+	t.Run("should report nothing when the configuration is unchanged", func(t *testing.T) {
+		// Arrange
+		current := &config.Config{}
+		current.Server.RateLimit.RequestsPerMinute = 100
+
+		// Act
+		plan := config.PlanReload(current, current)
+
+		// Assert
+		assert.False(t, plan.HasChanges())
+	})
+
+	t.Run("should keep restart-only settings when applying the reloadable ones", func(t *testing.T) {
+		// Arrange
+		current := &config.Config{}
+		current.Server.Port = 8080
+		next := *current
+		next.Server.Port = 9090
+		next.Server.RateLimit.RequestsPerMinute = 30
+
+		// Act
+		current.ApplyReloadable(&next)
+
+		// Assert
+		assert.Equal(t, 8080, current.Server.Port)
+		assert.Equal(t, 30, current.Server.RateLimit.RequestsPerMinute)
+	})
+}
+
+func TestLoad_ReloadsDotEnv(t *testing.T) {
+	t.Run("should pick up changes to the .env file without overriding the environment", func(t *testing.T) {
+		// Arrange
+		os.Clearenv()
+		os.Setenv("DB_URL", ":memory:")
+		os.Setenv("SERVER_PORT", "7070")
+		defer os.Clearenv()
+
+		dir := t.TempDir()
+		wd, err := os.Getwd()
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(dir))
+		defer os.Chdir(wd)
+
+		envFile := filepath.Join(dir, ".env")
+		require.NoError(t, os.WriteFile(envFile, []byte("LOG_LEVEL=warn\nSERVER_PORT=9090\n"), 0o600))
+		first, err := config.Load()
+		require.NoError(t, err)
+
+		// Act
+		require.NoError(t, os.WriteFile(envFile, []byte("LOG_LEVEL=debug\n"), 0o600))
+		second, err := config.Load()
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, "warn", first.Log.Level)
+		assert.Equal(t, "debug", second.Log.Level)
+		assert.Equal(t, 7070, first.Server.Port)
+		assert.Equal(t, 7070, second.Server.Port)
+	})
+}
@@ -0,0 +1,85 @@
+package drivers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/infra/database/drivers"
+)
+
+func TestPingWithRetry(t *testing.T) {
+	errNotReady := errors.New("connection refused")
+
+	t.Run("should retry until the database answers", func(t *testing.T) {
+		// Arrange
+		attempts := 0
+		ping := func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return errNotReady
+			}
+			return nil
+		}
+
+		// Act
+		err := drivers.PingWithRetry(context.Background(), ping, 5, time.Millisecond, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("should give up after the configured retries", func(t *testing.T) {
+		// Arrange
+		attempts := 0
+		ping := func(ctx context.Context) error {
+			attempts++
+			return errNotReady
+		}
+
+		// Act
+		err := drivers.PingWithRetry(context.Background(), ping, 2, time.Millisecond, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, errNotReady)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("should try once when retries are disabled", func(t *testing.T) {
+		// Arrange
+		attempts := 0
+		ping := func(ctx context.Context) error {
+			attempts++
+			return errNotReady
+		}
+
+		// Act
+		err := drivers.PingWithRetry(context.Background(), ping, 0, time.Hour, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, errNotReady)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("should stop waiting when the context is canceled", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		attempts := 0
+		ping := func(ctx context.Context) error {
+			attempts++
+			cancel()
+			return errNotReady
+		}
+
+		// Act
+		err := drivers.PingWithRetry(ctx, ping, 5, time.Hour, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, errNotReady)
+		assert.Equal(t, 1, attempts)
+	})
+}
@@ -1,6 +1,7 @@
 package drivers_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
 
 	"wazmeow/internal/infra/config"
 	"wazmeow/internal/infra/database/drivers"
@@ -221,3 +223,62 @@ func TestSQLiteDriver_PragmaConfiguration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 2000, cacheSize)
 }
+
+func TestSQLiteDriver_PragmasOnEveryConnection(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "pragmas.db")
+
+	config := &config.DatabaseConfig{
+		Driver:          "sqlite3",
+		URL:             dbPath,
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 5 * time.Minute,
+		SQLite: config.SQLiteConfig{
+			Path:        dbPath,
+			ForeignKeys: true,
+			JournalMode: "WAL",
+			Synchronous: "NORMAL",
+			CacheSize:   2000,
+			TempStore:   "memory",
+			MmapSize:    134217728,
+			BusyTimeout: 3 * time.Second,
+		},
+	}
+
+	conn, err := drivers.NewSQLiteConnection(config, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	db := conn.GetDB()
+	ctx := context.Background()
+
+	// Hold two connections at once so the second one is freshly opened
+	first, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer first.Close()
+	second, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer second.Close()
+
+	for _, c := range []bun.Conn{first, second} {
+		var journalMode string
+		err = c.QueryRowContext(ctx, "PRAGMA journal_mode").Scan(&journalMode)
+		assert.NoError(t, err)
+		assert.Equal(t, "wal", journalMode)
+
+		var busyTimeout int
+		err = c.QueryRowContext(ctx, "PRAGMA busy_timeout").Scan(&busyTimeout)
+		assert.NoError(t, err)
+		assert.Equal(t, 3000, busyTimeout)
+
+		var foreignKeys int
+		err = c.QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&foreignKeys)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, foreignKeys)
+
+		var cacheSize int
+		err = c.QueryRowContext(ctx, "PRAGMA cache_size").Scan(&cacheSize)
+		assert.NoError(t, err)
+		assert.Equal(t, 2000, cacheSize)
+	}
+}
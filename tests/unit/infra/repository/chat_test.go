@@ -0,0 +1,177 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/repository"
+)
+
+func TestChatRepository_SaveAll(t *testing.T) {
+	t.Run("should list synced chats most recent first", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewChatRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		base := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+		// Act
+		require.NoError(t, repo.SaveAll(ctx, []*whatsapp.ChatSummary{
+			{SessionID: sessionID, JID: "5511999999999@s.whatsapp.net", LastMessageAt: base, UnreadCount: 2},
+			{SessionID: sessionID, JID: "120363025246125486@g.us", Name: "Família", LastMessageAt: base.Add(time.Minute)},
+		}))
+		chats, err := repo.ListBySession(ctx, sessionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, chats, 2)
+		assert.Equal(t, "120363025246125486@g.us", chats[0].JID)
+		assert.Equal(t, "Família", chats[0].Name)
+		assert.Equal(t, "5511999999999@s.whatsapp.net", chats[1].JID)
+		assert.Equal(t, 2, chats[1].UnreadCount)
+	})
+
+	t.Run("should keep the stored name and newest time when a chat is synced again", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewChatRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		base := time.Now().Add(-time.Hour).Truncate(time.Second)
+		group := "120363025246125486@g.us"
+		require.NoError(t, repo.SaveAll(ctx, []*whatsapp.ChatSummary{
+			{SessionID: sessionID, JID: group, Name: "Família", LastMessageAt: base.Add(time.Minute)},
+		}))
+
+		// Act
+		require.NoError(t, repo.SaveAll(ctx, []*whatsapp.ChatSummary{
+			{SessionID: sessionID, JID: group, LastMessageAt: base, UnreadCount: 4},
+		}))
+		chats, err := repo.ListBySession(ctx, sessionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, chats, 1)
+		assert.Equal(t, "Família", chats[0].Name)
+		assert.True(t, chats[0].LastMessageAt.Equal(base.Add(time.Minute)))
+		assert.Equal(t, 4, chats[0].UnreadCount)
+	})
+}
+
+func TestChatRepository_RecordMessage(t *testing.T) {
+	t.Run("should count incoming messages as unread until the chat is read", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewChatRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		chat := "5511999999999@s.whatsapp.net"
+		base := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+		// Act
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, chat, base, true))
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, chat, base.Add(time.Minute), true))
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, chat, base.Add(2*time.Minute), false))
+		unread, err := repo.ListBySession(ctx, sessionID)
+		require.NoError(t, err)
+		require.NoError(t, repo.MarkRead(ctx, sessionID, chat))
+		read, err := repo.ListBySession(ctx, sessionID)
+		require.NoError(t, err)
+
+		// Assert
+		require.Len(t, unread, 1)
+		assert.Equal(t, 2, unread[0].UnreadCount)
+		assert.True(t, unread[0].LastMessageAt.Equal(base.Add(2*time.Minute)))
+		require.Len(t, read, 1)
+		assert.Equal(t, 0, read[0].UnreadCount)
+	})
+
+	t.Run("should not move the last message time back for late messages", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewChatRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		chat := "5511999999999@s.whatsapp.net"
+		base := time.Now().Add(-time.Hour).Truncate(time.Second)
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, chat, base, false))
+
+		// Act
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, chat, base.Add(-time.Minute), false))
+		chats, err := repo.ListBySession(ctx, sessionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, chats, 1)
+		assert.True(t, chats[0].LastMessageAt.Equal(base))
+	})
+}
+
+func TestChatRepository_Delete(t *testing.T) {
+	t.Run("should delete only the chats of the given session", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewChatRepository(db, &NullLogger{})
+		ctx := context.Background()
+		deleted := session.NewSessionID()
+		kept := session.NewSessionID()
+		chat := "5511999999999@s.whatsapp.net"
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, repo.RecordMessage(ctx, deleted, chat, now, true))
+		require.NoError(t, repo.RecordMessage(ctx, kept, chat, now, true))
+
+		// Act
+		count, err := repo.DeleteBySession(ctx, deleted)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		chats, err := repo.ListBySession(ctx, deleted)
+		require.NoError(t, err)
+		assert.Empty(t, chats)
+		chats, err = repo.ListBySession(ctx, kept)
+		require.NoError(t, err)
+		assert.Len(t, chats, 1)
+	})
+
+	t.Run("should delete the chats whose last message is older than the given time", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewChatRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, "5511999999999@s.whatsapp.net", now.Add(-48*time.Hour), true))
+		require.NoError(t, repo.RecordMessage(ctx, sessionID, "5511888888888@s.whatsapp.net", now, true))
+
+		// Act
+		count, err := repo.DeleteBefore(ctx, now.Add(-24*time.Hour))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		chats, err := repo.ListBySession(ctx, sessionID)
+		require.NoError(t, err)
+		require.Len(t, chats, 1)
+		assert.Equal(t, "5511888888888@s.whatsapp.net", chats[0].JID)
+	})
+}
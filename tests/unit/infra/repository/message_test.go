@@ -0,0 +1,329 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/repository"
+)
+
+func newStoredImageMessage(sessionID session.SessionID, id string) *whatsapp.StoredMessage {
+	return &whatsapp.StoredMessage{
+		SessionID: sessionID,
+		ID:        id,
+		ChatJID:   "5511999999999@s.whatsapp.net",
+		SenderJID: "5511999999999@s.whatsapp.net",
+		Type:      whatsapp.MessageTypeImage,
+		Body:      "caption",
+		Timestamp: time.Now().Truncate(time.Second),
+		Media: &whatsapp.MediaInfo{
+			MimeType:      "image/jpeg",
+			FileLength:    1024,
+			DirectPath:    "/v/t62.7118-24/123",
+			MediaKey:      []byte{1, 2, 3},
+			FileSHA256:    []byte{4, 5, 6},
+			FileEncSHA256: []byte{7, 8, 9},
+		},
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestMessageRepository_SaveAndGetByID(t *testing.T) {
+	t.Run("should round-trip media metadata", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		message := newStoredImageMessage(sessionID, "3EB0ABC")
+
+		// Act
+		require.NoError(t, repo.Save(ctx, message))
+		stored, err := repo.GetByID(ctx, sessionID, "3EB0ABC")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, whatsapp.MessageTypeImage, stored.Type)
+		assert.Equal(t, "caption", stored.Body)
+		assert.True(t, stored.HasMedia())
+		assert.Equal(t, "image/jpeg", stored.Media.MimeType)
+		assert.Equal(t, uint64(1024), stored.Media.FileLength)
+		assert.Equal(t, "/v/t62.7118-24/123", stored.Media.DirectPath)
+		assert.Equal(t, []byte{1, 2, 3}, stored.Media.MediaKey)
+		assert.Equal(t, []byte{7, 8, 9}, stored.Media.FileEncSHA256)
+	})
+
+	t.Run("should ignore duplicate deliveries", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+
+		// Act
+		require.NoError(t, repo.Save(ctx, newStoredImageMessage(sessionID, "3EB0ABC")))
+		err := repo.Save(ctx, newStoredImageMessage(sessionID, "3EB0ABC"))
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found for messages of other sessions", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		require.NoError(t, repo.Save(ctx, newStoredImageMessage(session.NewSessionID(), "3EB0ABC")))
+
+		// Act
+		stored, err := repo.GetByID(ctx, session.NewSessionID(), "3EB0ABC")
+
+		// Assert
+		assert.Nil(t, stored)
+		assert.ErrorIs(t, err, whatsapp.ErrMessageNotFound)
+	})
+
+	t.Run("should store text messages without media", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		message := &whatsapp.StoredMessage{
+			SessionID: sessionID,
+			ID:        "3EB0TXT",
+			ChatJID:   "120363025246125486@g.us",
+			SenderJID: "5511999999999@s.whatsapp.net",
+			Type:      whatsapp.MessageTypeText,
+			Body:      "hello",
+			Timestamp: time.Now(),
+			CreatedAt: time.Now(),
+		}
+
+		// Act
+		require.NoError(t, repo.Save(ctx, message))
+		stored, err := repo.GetByID(ctx, sessionID, "3EB0TXT")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "hello", stored.Body)
+		assert.Nil(t, stored.Media)
+		assert.False(t, stored.HasMedia())
+	})
+}
+
+func TestMessageRepository_ListAroundAnchor(t *testing.T) {
+	t.Run("should return neighbours of the same chat in chronological order", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		var anchor *whatsapp.StoredMessage
+		for i, id := range []string{"m1", "m2", "m3", "m4", "m5"} {
+			message := newStoredImageMessage(sessionID, id)
+			message.Timestamp = base.Add(time.Duration(i) * time.Minute)
+			require.NoError(t, repo.Save(ctx, message))
+			if id == "m3" {
+				anchor = message
+			}
+		}
+		other := newStoredImageMessage(sessionID, "other-chat")
+		other.ChatJID = "5511888888888@s.whatsapp.net"
+		other.Timestamp = base.Add(90 * time.Second)
+		require.NoError(t, repo.Save(ctx, other))
+
+		// Act
+		before, err := repo.ListBefore(ctx, anchor, 10)
+		require.NoError(t, err)
+		after, err := repo.ListAfter(ctx, anchor, 1)
+		require.NoError(t, err)
+		none, err := repo.ListBefore(ctx, anchor, 0)
+		require.NoError(t, err)
+
+		// Assert
+		require.Len(t, before, 2)
+		assert.Equal(t, "m1", before[0].ID)
+		assert.Equal(t, "m2", before[1].ID)
+		require.Len(t, after, 1)
+		assert.Equal(t, "m4", after[0].ID)
+		assert.Empty(t, none)
+	})
+}
+
+func TestMessageRepository_GetLatestByChat(t *testing.T) {
+	t.Run("should return the most recent message of the chat", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		older := newStoredImageMessage(sessionID, "older")
+		older.Timestamp = time.Now().Add(-time.Hour)
+		newer := newStoredImageMessage(sessionID, "newer")
+		require.NoError(t, repo.Save(ctx, newer))
+		require.NoError(t, repo.Save(ctx, older))
+
+		// Act
+		latest, err := repo.GetLatestByChat(ctx, sessionID, newer.ChatJID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "newer", latest.ID)
+	})
+
+	t.Run("should return not found for chats without messages", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+
+		// Act
+		latest, err := repo.GetLatestByChat(context.Background(), session.NewSessionID(), "5511999999999@s.whatsapp.net")
+
+		// Assert
+		assert.Nil(t, latest)
+		assert.ErrorIs(t, err, whatsapp.ErrMessageNotFound)
+	})
+}
+
+func TestMessageRepository_ListByChat(t *testing.T) {
+	t.Run("should page through a chat newest first without gaps or repeats", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		base := time.Date(2024, 1, 1, 12, 0, 0, 123456789, time.UTC)
+
+		// m2 and m3 share a timestamp, so the ID breaks the tie
+		timestamps := map[string]time.Time{
+			"m1": base,
+			"m2": base.Add(time.Millisecond),
+			"m3": base.Add(time.Millisecond),
+			"m4": base.Add(time.Minute),
+			"m5": base.Add(2 * time.Minute),
+		}
+		for id, timestamp := range timestamps {
+			message := newStoredImageMessage(sessionID, id)
+			message.Timestamp = timestamp
+			require.NoError(t, repo.Save(ctx, message))
+		}
+		other := newStoredImageMessage(sessionID, "other-chat")
+		other.ChatJID = "5511888888888@s.whatsapp.net"
+		require.NoError(t, repo.Save(ctx, other))
+		chat := newStoredImageMessage(sessionID, "").ChatJID
+
+		// Act
+		var seen []string
+		var cursor *whatsapp.MessageCursor
+		for page := 0; page < 5; page++ {
+			messages, err := repo.ListByChat(ctx, sessionID, chat, cursor, 2)
+			require.NoError(t, err)
+			if len(messages) == 0 {
+				break
+			}
+			for _, message := range messages {
+				seen = append(seen, message.ID)
+			}
+			last := messages[len(messages)-1]
+			cursor = &whatsapp.MessageCursor{Timestamp: last.Timestamp, ID: last.ID}
+		}
+
+		// Assert
+		assert.Equal(t, []string{"m5", "m4", "m3", "m2", "m1"}, seen)
+	})
+
+	t.Run("should return nothing for a zero limit", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		sessionID := session.NewSessionID()
+		message := newStoredImageMessage(sessionID, "m1")
+		require.NoError(t, repo.Save(context.Background(), message))
+
+		// Act
+		messages, err := repo.ListByChat(context.Background(), sessionID, message.ChatJID, nil, 0)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+}
+
+func TestMessageRepository_Delete(t *testing.T) {
+	t.Run("should delete only the messages of the given session", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		deleted := session.NewSessionID()
+		kept := session.NewSessionID()
+		require.NoError(t, repo.Save(ctx, newStoredImageMessage(deleted, "3EB0AAA")))
+		require.NoError(t, repo.Save(ctx, newStoredImageMessage(deleted, "3EB0BBB")))
+		require.NoError(t, repo.Save(ctx, newStoredImageMessage(kept, "3EB0AAA")))
+
+		// Act
+		count, err := repo.DeleteBySession(ctx, deleted)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		_, err = repo.GetByID(ctx, deleted, "3EB0AAA")
+		assert.ErrorIs(t, err, whatsapp.ErrMessageNotFound)
+		_, err = repo.GetByID(ctx, kept, "3EB0AAA")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should delete the messages sent before the given time", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		old := newStoredImageMessage(sessionID, "3EB0OLD")
+		old.Timestamp = time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+		require.NoError(t, repo.Save(ctx, old))
+		require.NoError(t, repo.Save(ctx, newStoredImageMessage(sessionID, "3EB0NEW")))
+
+		// Act
+		count, err := repo.DeleteBefore(ctx, time.Now().Add(-24*time.Hour))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		_, err = repo.GetByID(ctx, sessionID, "3EB0OLD")
+		assert.ErrorIs(t, err, whatsapp.ErrMessageNotFound)
+		_, err = repo.GetByID(ctx, sessionID, "3EB0NEW")
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,64 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/infra/repository"
+)
+
+func TestPairingAuditRepository_ListBySessionID(t *testing.T) {
+	t.Run("should return attempts of the session newest first", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewPairingAuditRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		otherSessionID := session.NewSessionID()
+
+		timeout := session.NewPairingAttempt(sessionID, session.PairingOutcomeTimeout, session.PairingMethodQR, "", "req-1", "QR code expired without being scanned")
+		timeout.CreatedAt = time.Now().Add(-time.Minute)
+		success := session.NewPairingAttempt(sessionID, session.PairingOutcomeSuccess, session.PairingMethodQR, "5511999999999:12@s.whatsapp.net", "req-2", "")
+		other := session.NewPairingAttempt(otherSessionID, session.PairingOutcomeFailed, session.PairingMethodPhone, "", "req-3", "rejected")
+
+		require.NoError(t, repo.Create(ctx, timeout))
+		require.NoError(t, repo.Create(ctx, success))
+		require.NoError(t, repo.Create(ctx, other))
+
+		// Act
+		attempts, total, err := repo.ListBySessionID(ctx, sessionID, 10, 0)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, attempts, 2)
+		assert.Equal(t, session.PairingOutcomeSuccess, attempts[0].Outcome)
+		assert.Equal(t, "5511999999999:12@s.whatsapp.net", attempts[0].JID)
+		assert.Equal(t, "req-2", attempts[0].RequestID)
+		assert.Equal(t, session.PairingOutcomeTimeout, attempts[1].Outcome)
+		assert.Equal(t, session.PairingMethodQR, attempts[1].Method)
+	})
+
+	t.Run("should return empty list when session has no attempts", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewPairingAuditRepository(db, &NullLogger{})
+
+		// Act
+		attempts, total, err := repo.ListBySessionID(context.Background(), session.NewSessionID(), 10, 0)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, total)
+		assert.Empty(t, attempts)
+	})
+}
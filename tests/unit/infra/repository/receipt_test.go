@@ -0,0 +1,149 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/repository"
+)
+
+func newReceipt(sessionID session.SessionID, recipient string, status whatsapp.ReceiptStatus, timestamp time.Time) *whatsapp.MessageReceipt {
+	return &whatsapp.MessageReceipt{
+		SessionID:    sessionID,
+		MessageID:    "3EB0C127D7BACB8323A4",
+		ChatJID:      "120363025246125486@g.us",
+		RecipientJID: recipient,
+		Status:       status,
+		Timestamp:    timestamp,
+	}
+}
+
+func TestReceiptRepository_Save(t *testing.T) {
+	t.Run("should keep one receipt per recipient with its furthest status", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewReceiptRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		sent := time.Now().Add(-time.Hour).Truncate(time.Second)
+		alice := "5511999999999@s.whatsapp.net"
+		bob := "5511888888888@s.whatsapp.net"
+
+		// Act
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, alice, whatsapp.ReceiptDelivered, sent)))
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, alice, whatsapp.ReceiptRead, sent.Add(time.Minute))))
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, bob, whatsapp.ReceiptDelivered, sent.Add(2*time.Minute))))
+		receipts, err := repo.ListByMessage(ctx, sessionID, "3EB0C127D7BACB8323A4")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, receipts, 2)
+		assert.Equal(t, alice, receipts[0].RecipientJID)
+		assert.Equal(t, whatsapp.ReceiptRead, receipts[0].Status)
+		assert.True(t, receipts[0].Timestamp.Equal(sent.Add(time.Minute)))
+		assert.Equal(t, bob, receipts[1].RecipientJID)
+		assert.Equal(t, whatsapp.ReceiptDelivered, receipts[1].Status)
+	})
+
+	t.Run("should not move a recipient back to an earlier status", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewReceiptRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		sent := time.Now().Add(-time.Hour).Truncate(time.Second)
+		alice := "5511999999999@s.whatsapp.net"
+
+		// Act
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, alice, whatsapp.ReceiptPlayed, sent.Add(time.Minute))))
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, alice, whatsapp.ReceiptDelivered, sent.Add(2*time.Minute))))
+		receipts, err := repo.ListByMessage(ctx, sessionID, "3EB0C127D7BACB8323A4")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, whatsapp.ReceiptPlayed, receipts[0].Status)
+		assert.True(t, receipts[0].Timestamp.Equal(sent.Add(time.Minute)))
+	})
+
+	t.Run("should keep receipts of other sessions apart", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewReceiptRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, "5511999999999@s.whatsapp.net", whatsapp.ReceiptRead, time.Now())))
+
+		// Act
+		receipts, err := repo.ListByMessage(ctx, session.NewSessionID(), "3EB0C127D7BACB8323A4")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, receipts)
+	})
+}
+
+func TestReceiptRepository_Delete(t *testing.T) {
+	t.Run("should delete only the receipts of the given session", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewReceiptRepository(db, &NullLogger{})
+		ctx := context.Background()
+		deleted := session.NewSessionID()
+		kept := session.NewSessionID()
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, repo.Save(ctx, newReceipt(deleted, "5511999999999@s.whatsapp.net", whatsapp.ReceiptRead, now)))
+		require.NoError(t, repo.Save(ctx, newReceipt(kept, "5511999999999@s.whatsapp.net", whatsapp.ReceiptRead, now)))
+
+		// Act
+		count, err := repo.DeleteBySession(ctx, deleted)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		receipts, err := repo.ListByMessage(ctx, deleted, "3EB0C127D7BACB8323A4")
+		require.NoError(t, err)
+		assert.Empty(t, receipts)
+		receipts, err = repo.ListByMessage(ctx, kept, "3EB0C127D7BACB8323A4")
+		require.NoError(t, err)
+		assert.Len(t, receipts, 1)
+	})
+
+	t.Run("should delete the receipts received before the given time", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewReceiptRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, "5511999999999@s.whatsapp.net", whatsapp.ReceiptRead, now.Add(-48*time.Hour))))
+		require.NoError(t, repo.Save(ctx, newReceipt(sessionID, "5511888888888@s.whatsapp.net", whatsapp.ReceiptRead, now)))
+
+		// Act
+		count, err := repo.DeleteBefore(ctx, now.Add(-24*time.Hour))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		receipts, err := repo.ListByMessage(ctx, sessionID, "3EB0C127D7BACB8323A4")
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, "5511888888888@s.whatsapp.net", receipts[0].RecipientJID)
+	})
+}
@@ -0,0 +1,230 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/repository"
+)
+
+func newScheduledMessage(t *testing.T, sessionID session.SessionID, sendAt, now time.Time) *whatsapp.ScheduledMessage {
+	message, err := whatsapp.NewScheduledMessage(sessionID, "5511999999999@s.whatsapp.net", "Lembrete", sendAt, now)
+	require.NoError(t, err)
+	return message
+}
+
+func TestScheduledMessageRepository_CreateAndGetByID(t *testing.T) {
+	t.Run("should round-trip a scheduled message within its session", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		now := time.Now().Truncate(time.Second)
+		message := newScheduledMessage(t, sessionID, now.Add(time.Hour), now)
+
+		// Act
+		require.NoError(t, repo.Create(ctx, message))
+		stored, err := repo.GetByID(ctx, sessionID, message.ID)
+		_, otherErr := repo.GetByID(ctx, session.NewSessionID(), message.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, message.To, stored.To)
+		assert.Equal(t, "Lembrete", stored.Body)
+		assert.True(t, stored.SendAt.Equal(message.SendAt))
+		assert.Equal(t, whatsapp.ScheduledMessagePending, stored.Status)
+		assert.ErrorIs(t, otherErr, whatsapp.ErrScheduledMessageNotFound)
+	})
+}
+
+func TestScheduledMessageRepository_ListDue(t *testing.T) {
+	t.Run("should return only pending messages whose send time has passed", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		created := time.Now().Add(-time.Hour)
+		due := newScheduledMessage(t, sessionID, created.Add(time.Minute), created)
+		future := newScheduledMessage(t, sessionID, created.Add(2*time.Hour), created)
+		canceled := newScheduledMessage(t, sessionID, created.Add(time.Minute), created)
+		for _, message := range []*whatsapp.ScheduledMessage{due, future, canceled} {
+			require.NoError(t, repo.Create(ctx, message))
+		}
+		canceled.Status = whatsapp.ScheduledMessageCanceled
+		require.NoError(t, repo.Transition(ctx, canceled, whatsapp.ScheduledMessagePending))
+
+		// Act
+		messages, err := repo.ListDue(ctx, []session.SessionID{sessionID}, time.Now(), 10)
+		all, listErr := repo.ListBySession(ctx, sessionID, "")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, due.ID, messages[0].ID)
+		require.NoError(t, listErr)
+		assert.Len(t, all, 3)
+	})
+}
+
+func TestScheduledMessageRepository_ListDueBySession(t *testing.T) {
+	t.Run("should not let a full batch of blocked sessions hold back the others", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		blocked := session.NewSessionID()
+		connected := session.NewSessionID()
+		created := time.Now().Add(-time.Hour)
+		for i := 0; i < 150; i++ {
+			require.NoError(t, repo.Create(ctx, newScheduledMessage(t, blocked, created.Add(time.Minute), created)))
+		}
+		ready := newScheduledMessage(t, connected, created.Add(2*time.Minute), created)
+		require.NoError(t, repo.Create(ctx, ready))
+
+		// Act
+		messages, err := repo.ListDue(ctx, []session.SessionID{connected}, time.Now(), 100)
+		none, noneErr := repo.ListDue(ctx, nil, time.Now(), 100)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, ready.ID, messages[0].ID)
+		require.NoError(t, noneErr)
+		assert.Empty(t, none)
+	})
+
+	t.Run("should hold back failed sends until their next attempt", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+		now := time.Now()
+		message := newScheduledMessage(t, sessionID, now.Add(-time.Minute), now.Add(-time.Hour))
+		require.NoError(t, repo.Create(ctx, message))
+
+		message.Status = whatsapp.ScheduledMessageSending
+		message.Attempts = 1
+		require.NoError(t, repo.Transition(ctx, message, whatsapp.ScheduledMessagePending))
+		message.RetryAfterFailure(errors.New("failed to send message: timeout"), now)
+		require.NoError(t, repo.Transition(ctx, message, whatsapp.ScheduledMessageSending))
+
+		// Act
+		held, heldErr := repo.ListDue(ctx, []session.SessionID{sessionID}, now, 10)
+		retried, retriedErr := repo.ListDue(ctx, []session.SessionID{sessionID}, message.NextAttemptAt.Add(time.Second), 10)
+
+		// Assert
+		require.NoError(t, heldErr)
+		assert.Empty(t, held)
+		require.NoError(t, retriedErr)
+		require.Len(t, retried, 1)
+		assert.Equal(t, message.ID, retried[0].ID)
+		assert.Equal(t, 1, retried[0].Attempts)
+	})
+}
+
+func TestScheduledMessageRepository_Transition(t *testing.T) {
+	t.Run("should not overwrite a message whose status changed", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		now := time.Now()
+		message := newScheduledMessage(t, session.NewSessionID(), now.Add(time.Hour), now)
+		require.NoError(t, repo.Create(ctx, message))
+
+		message.Status = whatsapp.ScheduledMessageSending
+		message.Attempts = 1
+		require.NoError(t, repo.Transition(ctx, message, whatsapp.ScheduledMessagePending))
+
+		// Act
+		message.Status = whatsapp.ScheduledMessageCanceled
+		err := repo.Transition(ctx, message, whatsapp.ScheduledMessagePending)
+
+		// Assert
+		assert.ErrorIs(t, err, whatsapp.ErrScheduledMessageNotPending)
+		stored, getErr := repo.GetByID(ctx, message.SessionID, message.ID)
+		require.NoError(t, getErr)
+		assert.Equal(t, whatsapp.ScheduledMessageSending, stored.Status)
+		assert.Equal(t, 1, stored.Attempts)
+	})
+}
+
+func TestScheduledMessageRepository_FailInterrupted(t *testing.T) {
+	t.Run("should fail messages left in sending state", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		now := time.Now()
+		sessionID := session.NewSessionID()
+		sending := newScheduledMessage(t, sessionID, now.Add(time.Hour), now)
+		pending := newScheduledMessage(t, sessionID, now.Add(time.Hour), now)
+		require.NoError(t, repo.Create(ctx, sending))
+		require.NoError(t, repo.Create(ctx, pending))
+		sending.Status = whatsapp.ScheduledMessageSending
+		require.NoError(t, repo.Transition(ctx, sending, whatsapp.ScheduledMessagePending))
+
+		// Act
+		count, err := repo.FailInterrupted(ctx, "interrupted")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		failed, err := repo.ListBySession(ctx, sessionID, whatsapp.ScheduledMessageFailed)
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		assert.Equal(t, sending.ID, failed[0].ID)
+		assert.Equal(t, "interrupted", failed[0].Error)
+	})
+}
+
+func TestScheduledMessageRepository_DeleteBySession(t *testing.T) {
+	t.Run("should delete only the messages of the given session", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewScheduledMessageRepository(db, &NullLogger{})
+		ctx := context.Background()
+		now := time.Now()
+		deleted := session.NewSessionID()
+		kept := session.NewSessionID()
+		require.NoError(t, repo.Create(ctx, newScheduledMessage(t, deleted, now.Add(time.Hour), now)))
+		require.NoError(t, repo.Create(ctx, newScheduledMessage(t, kept, now.Add(time.Hour), now)))
+
+		// Act
+		count, err := repo.DeleteBySession(ctx, deleted)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		messages, err := repo.ListBySession(ctx, deleted, "")
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+		messages, err = repo.ListBySession(ctx, kept, "")
+		require.NoError(t, err)
+		assert.Len(t, messages, 1)
+	})
+}
@@ -306,6 +306,86 @@ func TestSessionRepository_GetByName(t *testing.T) {
 }
 
 func TestSessionRepository_Update(t *testing.T) {
+	t.Run("should persist the sender allow-list", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewSessionRepository(db, &NullLogger{})
+		sess := session.NewSession("allowed-senders-test")
+		ctx := context.Background()
+		require.NoError(t, repo.Create(ctx, sess))
+		require.NoError(t, sess.SetAllowedSenders([]string{"5511999999999@s.whatsapp.net", "123456789@lid"}))
+
+		// Act
+		err := repo.Update(ctx, sess)
+
+		// Assert
+		require.NoError(t, err)
+		retrievedSess, err := repo.GetByID(ctx, sess.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"5511999999999@s.whatsapp.net", "123456789@lid"}, retrievedSess.AllowedSenders())
+		assert.True(t, retrievedSess.AllowsSender("123456789@lid"))
+		assert.False(t, retrievedSess.AllowsSender("5511888888888@s.whatsapp.net"))
+	})
+
+	t.Run("should persist the send rate limit override", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewSessionRepository(db, &NullLogger{})
+		sess := session.NewSession("send-rate-test")
+		ctx := context.Background()
+		require.NoError(t, repo.Create(ctx, sess))
+		require.NoError(t, sess.SetSendRateLimit(&session.SendRateLimit{PerSecond: 0.5, Burst: 3}))
+
+		// Act
+		err := repo.Update(ctx, sess)
+
+		// Assert
+		require.NoError(t, err)
+		retrievedSess, err := repo.GetByID(ctx, sess.ID())
+		require.NoError(t, err)
+		require.NotNil(t, retrievedSess.SendRateLimit())
+		assert.Equal(t, session.SendRateLimit{PerSecond: 0.5, Burst: 3}, *retrievedSess.SendRateLimit())
+
+		// Act - back to the global limit
+		require.NoError(t, retrievedSess.SetSendRateLimit(nil))
+		require.NoError(t, repo.Update(ctx, retrievedSess))
+
+		// Assert
+		retrievedSess, err = repo.GetByID(ctx, sess.ID())
+		require.NoError(t, err)
+		assert.Nil(t, retrievedSess.SendRateLimit())
+	})
+
+	t.Run("should persist the QR code expiry and clear it with the code", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+
+		repo := repository.NewSessionRepository(db, &NullLogger{})
+		sess := session.NewSession("qr-expiry-test")
+		ctx := context.Background()
+		require.NoError(t, repo.Create(ctx, sess))
+		sess.SetQRCode("2@qr-code")
+
+		// Act
+		require.NoError(t, repo.Update(ctx, sess))
+		stored, err := repo.GetByID(ctx, sess.ID())
+		require.NoError(t, err)
+		sess.ClearQRCode()
+		require.NoError(t, repo.Update(ctx, sess))
+		cleared, err := repo.GetByID(ctx, sess.ID())
+		require.NoError(t, err)
+
+		// Assert
+		assert.True(t, stored.HasValidQRCode())
+		assert.WithinDuration(t, sess.UpdatedAt().Add(session.QRCodeLifetime), stored.QRCodeExpiresAt(), 2*time.Second)
+		assert.True(t, cleared.QRCodeExpiresAt().IsZero())
+	})
+
 	t.Run("should update session successfully", func(t *testing.T) {
 		// Arrange
 		db := setupTestDB(t)
@@ -543,6 +623,97 @@ func TestSessionRepository_List(t *testing.T) {
 	})
 }
 
+func TestSessionRepository_ListWithFilter(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	seed := func(t *testing.T, repo session.Repository) {
+		fixtures := []struct {
+			name     string
+			isActive bool
+			created  time.Time
+		}{
+			{"sales-team", true, base},
+			{"support", false, base.Add(24 * time.Hour)},
+			{"Sales_backup", false, base.Add(48 * time.Hour)},
+			{"marketing", true, base.Add(72 * time.Hour)},
+		}
+		for _, f := range fixtures {
+			sess := session.RestoreSession(session.NewSessionID(), f.name, "", session.StatusDisconnected, "", "", "", f.isActive, f.created, f.created)
+			require.NoError(t, repo.Create(context.Background(), sess))
+		}
+	}
+	names := func(sessions []*session.Session) []string {
+		result := make([]string, 0, len(sessions))
+		for _, sess := range sessions {
+			result = append(result, sess.Name())
+		}
+		return result
+	}
+
+	t.Run("should match names case-insensitively and treat wildcards literally", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+		repo := repository.NewSessionRepository(db, &NullLogger{})
+		seed(t, repo)
+		ctx := context.Background()
+
+		// Act
+		matched, total, err := repo.ListWithFilter(ctx, session.ListFilter{NameContains: "SALES"}, session.ListOptions{Sort: session.SortByName, Order: session.SortOrderAsc})
+		underscore, _, underscoreErr := repo.ListWithFilter(ctx, session.ListFilter{NameContains: "s_b"}, session.ListOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.ElementsMatch(t, []string{"sales-team", "Sales_backup"}, names(matched))
+		assert.NoError(t, underscoreErr)
+		assert.Equal(t, []string{"Sales_backup"}, names(underscore))
+	})
+
+	t.Run("should filter by activity and creation range", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+		repo := repository.NewSessionRepository(db, &NullLogger{})
+		seed(t, repo)
+		isActive := false
+		after := base.Add(12 * time.Hour)
+		before := base.Add(60 * time.Hour)
+
+		// Act
+		result, total, err := repo.ListWithFilter(context.Background(), session.ListFilter{
+			IsActive:      &isActive,
+			CreatedAfter:  &after,
+			CreatedBefore: &before,
+		}, session.ListOptions{Sort: session.SortByCreatedAt, Order: session.SortOrderAsc})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []string{"support", "Sales_backup"}, names(result))
+	})
+
+	t.Run("should count every match regardless of pagination", func(t *testing.T) {
+		// Arrange
+		db := setupTestDB(t)
+		defer db.Close()
+		repo := repository.NewSessionRepository(db, &NullLogger{})
+		seed(t, repo)
+
+		// Act
+		result, total, err := repo.ListWithFilter(context.Background(), session.ListFilter{}, session.ListOptions{
+			Limit:  2,
+			Offset: 1,
+			Sort:   session.SortByCreatedAt,
+			Order:  session.SortOrderDesc,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, []string{"Sales_backup", "support"}, names(result))
+	})
+}
+
 func TestSessionRepository_GetByStatus(t *testing.T) {
 	t.Run("should get sessions by status", func(t *testing.T) {
 		// Arrange
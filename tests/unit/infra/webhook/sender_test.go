@@ -0,0 +1,283 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/webhook"
+	"wazmeow/pkg/logger"
+)
+
+func newTestConfig(url string) *config.WebhookConfig {
+	return &config.WebhookConfig{
+		URL:                 url,
+		Timeout:             time.Second,
+		MaxIdleConnsPerHost: 4,
+		QueueSize:           10,
+		Workers:             1,
+	}
+}
+
+func newTestEvent() *whatsapp.Event {
+	return &whatsapp.Event{
+		ID:        "event-1",
+		Type:      whatsapp.EventTypeConnected,
+		SessionID: session.NewSessionID(),
+		Timestamp: time.Now(),
+		Data:      map[string]interface{}{"jid": "5511999999999@s.whatsapp.net"},
+	}
+}
+
+func TestSender_SendWebhook(t *testing.T) {
+	t.Run("should post the event as JSON", func(t *testing.T) {
+		// Arrange
+		var received webhook.Payload
+		var contentType, custom string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			contentType = r.Header.Get("Content-Type")
+			custom = r.Header.Get("X-Custom")
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sender := webhook.NewSender(newTestConfig(server.URL), &logger.NoopLogger{})
+		defer sender.Close()
+		sender.SetHeaders(map[string]string{"X-Custom": "value"})
+		event := newTestEvent()
+
+		// Act
+		err := sender.SendWebhook(event)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", contentType)
+		assert.Equal(t, "value", custom)
+		assert.Equal(t, "event-1", received.ID)
+		assert.Equal(t, "connected", received.Type)
+		assert.Equal(t, event.SessionID.String(), received.SessionID)
+		assert.True(t, sender.IsHealthy())
+		assert.Equal(t, int64(1), sender.GetStats().TotalSent)
+	})
+
+	t.Run("should reuse the connection across deliveries", func(t *testing.T) {
+		// Arrange
+		var mu sync.Mutex
+		remoteAddrs := map[string]bool{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			remoteAddrs[r.RemoteAddr] = true
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		}))
+		defer server.Close()
+
+		sender := webhook.NewSender(newTestConfig(server.URL), &logger.NoopLogger{})
+		defer sender.Close()
+
+		// Act
+		for i := 0; i < 5; i++ {
+			require.NoError(t, sender.SendWebhook(newTestEvent()))
+		}
+
+		// Assert
+		assert.Len(t, remoteAddrs, 1)
+	})
+
+	t.Run("should give up on a slow receiver after the timeout", func(t *testing.T) {
+		// Arrange
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		cfg := newTestConfig(server.URL)
+		cfg.Timeout = 100 * time.Millisecond
+		sender := webhook.NewSender(cfg, &logger.NoopLogger{})
+		defer sender.Close()
+
+		// Act
+		start := time.Now()
+		err := sender.SendWebhook(newTestEvent())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.False(t, sender.IsHealthy())
+		assert.Equal(t, int64(1), sender.GetStats().TotalFailed)
+	})
+
+	t.Run("should retry failed deliveries according to the retry policy", func(t *testing.T) {
+		// Arrange
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		sender := webhook.NewSender(newTestConfig(server.URL), &logger.NoopLogger{})
+		defer sender.Close()
+		sender.SetRetryPolicy(2, time.Millisecond)
+
+		// Act
+		err := sender.SendWebhook(newTestEvent())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+}
+
+func TestSender_Stats(t *testing.T) {
+	t.Run("should count delivered, failed and retried events by session", func(t *testing.T) {
+		// Arrange
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The first event succeeds on its second attempt, the second event never does
+			if n := calls.Add(1); n == 1 || n > 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		sender := webhook.NewSender(newTestConfig(server.URL), &logger.NoopLogger{})
+		defer sender.Close()
+		sender.SetRetryPolicy(1, time.Millisecond)
+		delivered := newTestEvent()
+		failed := newTestEvent()
+
+		// Act
+		deliveredErr := sender.SendWebhook(delivered)
+		failedErr := sender.SendWebhook(failed)
+		stats := sender.GetStats()
+
+		// Assert
+		require.NoError(t, deliveredErr)
+		require.Error(t, failedErr)
+		assert.Equal(t, int64(1), stats.TotalSent)
+		assert.Equal(t, int64(1), stats.TotalFailed)
+		assert.Equal(t, int64(2), stats.TotalRetried)
+		assert.Equal(t, &whatsapp.WebhookSessionStats{Delivered: 1, Retried: 1}, stats.BySession[delivered.SessionID])
+		assert.Equal(t, &whatsapp.WebhookSessionStats{Failed: 1, Retried: 1}, stats.BySession[failed.SessionID])
+		assert.Equal(t, int64(4), stats.Latency.Count)
+		assert.Len(t, stats.Latency.Counts, len(whatsapp.WebhookLatencyBuckets)+1)
+		assert.Equal(t, 10, stats.QueueCapacity)
+	})
+}
+
+func TestSender_Format(t *testing.T) {
+	newMessageEvent := func(sentAt time.Time) *whatsapp.Event {
+		return &whatsapp.Event{
+			ID:        "event-2",
+			Type:      whatsapp.EventTypeMessage,
+			SessionID: session.NewSessionID(),
+			Timestamp: time.Now(),
+			Data: map[string]interface{}{
+				"id":        "3EB0C767D26A1D6A3F1A",
+				"from":      "5511999999999@s.whatsapp.net",
+				"type":      "text",
+				"body":      "hello",
+				"timestamp": sentAt,
+			},
+		}
+	}
+
+	t.Run("should deliver messages as a flat payload in the simple format", func(t *testing.T) {
+		// Arrange
+		var received map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&received)
+		}))
+		defer server.Close()
+
+		cfg := newTestConfig(server.URL)
+		cfg.Format = webhook.FormatSimple
+		sender := webhook.NewSender(cfg, &logger.NoopLogger{})
+		defer sender.Close()
+		sentAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		// Act
+		err := sender.SendWebhook(newMessageEvent(sentAt))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"from":      "5511999999999@s.whatsapp.net",
+			"text":      "hello",
+			"type":      "text",
+			"timestamp": "2024-01-01T12:00:00Z",
+		}, received)
+	})
+
+	t.Run("should keep the rich payload for messages by default and for other events", func(t *testing.T) {
+		// Arrange
+		var received []webhook.Payload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var payload webhook.Payload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			received = append(received, payload)
+		}))
+		defer server.Close()
+
+		sender := webhook.NewSender(newTestConfig(server.URL), &logger.NoopLogger{})
+		defer sender.Close()
+
+		// Act
+		richErr := sender.SendWebhook(newMessageEvent(time.Now()))
+		sender.SetFormat(webhook.FormatSimple)
+		otherErr := sender.SendWebhook(newTestEvent())
+
+		// Assert
+		require.NoError(t, richErr)
+		require.NoError(t, otherErr)
+		require.Len(t, received, 2)
+		assert.Equal(t, "message", received[0].Type)
+		assert.Equal(t, "hello", received[0].Data.(map[string]interface{})["body"])
+		assert.Equal(t, "connected", received[1].Type)
+	})
+}
+
+func TestSender_SendWebhookAsync(t *testing.T) {
+	t.Run("should deliver queued events before closing", func(t *testing.T) {
+		// Arrange
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+		}))
+		defer server.Close()
+
+		sender := webhook.NewSender(newTestConfig(server.URL), &logger.NoopLogger{})
+
+		// Act
+		for i := 0; i < 3; i++ {
+			sender.SendWebhookAsync(newTestEvent())
+		}
+		sender.Close()
+
+		// Assert
+		assert.Equal(t, int32(3), calls.Load())
+
+		// Events queued after closing are dropped
+		sender.SendWebhookAsync(newTestEvent())
+		assert.Equal(t, int32(3), calls.Load())
+	})
+}
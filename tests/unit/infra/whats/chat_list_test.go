@@ -0,0 +1,65 @@
+package whats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mau.fi/whatsmeow/proto/waHistorySync"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestToChatSummaries(t *testing.T) {
+	sessionID := session.NewSessionID()
+
+	t.Run("should convert conversations with their name, last message time and unread count", func(t *testing.T) {
+		// Arrange
+		conversations := []*waHistorySync.Conversation{
+			{
+				ID:               proto.String("120363025246125486@g.us"),
+				Name:             proto.String("Família"),
+				LastMsgTimestamp: proto.Uint64(1704110400),
+				UnreadCount:      proto.Uint32(3),
+			},
+			{
+				ID:                    proto.String("5511999999999@s.whatsapp.net"),
+				DisplayName:           proto.String("Maria"),
+				ConversationTimestamp: proto.Uint64(1704106800),
+			},
+		}
+
+		// Act
+		chats := whats.ToChatSummaries(sessionID, conversations)
+
+		// Assert
+		require.Len(t, chats, 2)
+		assert.Equal(t, sessionID, chats[0].SessionID)
+		assert.Equal(t, "120363025246125486@g.us", chats[0].JID)
+		assert.Equal(t, "Família", chats[0].Name)
+		assert.True(t, chats[0].LastMessageAt.Equal(time.Unix(1704110400, 0)))
+		assert.Equal(t, 3, chats[0].UnreadCount)
+		assert.Equal(t, "Maria", chats[1].Name)
+		assert.True(t, chats[1].LastMessageAt.Equal(time.Unix(1704106800, 0)))
+		assert.Zero(t, chats[1].UnreadCount)
+	})
+
+	t.Run("should skip conversations without an ID and leave unknown times zero", func(t *testing.T) {
+		// Arrange
+		conversations := []*waHistorySync.Conversation{
+			{Name: proto.String("Sem ID")},
+			{ID: proto.String("5511988888888@s.whatsapp.net")},
+		}
+
+		// Act
+		chats := whats.ToChatSummaries(sessionID, conversations)
+
+		// Assert
+		require.Len(t, chats, 1)
+		assert.Equal(t, "5511988888888@s.whatsapp.net", chats[0].JID)
+		assert.True(t, chats[0].LastMessageAt.IsZero())
+	})
+}
@@ -0,0 +1,278 @@
+package whats_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+	"wazmeow/pkg/logger"
+)
+
+// newTestClient creates an unpaired client backed by an in-memory store
+func newTestClient(t *testing.T, name string, qrWaitTimeout time.Duration) *whats.Client {
+	t.Helper()
+
+	container, db, err := whats.OpenStore("sqlite3", "file:"+name+"?mode=memory&cache=shared&_foreign_keys=on", &logger.NoopLogger{})
+	require.NoError(t, err)
+	t.Cleanup(func() { container.Close() })
+	_, err = whats.UpgradeStore(context.Background(), container, db)
+	require.NoError(t, err)
+
+	waClient, err := whats.NewClient(session.NewSessionID(), container, "", "", whats.ClientOptions{QRWaitTimeout: qrWaitTimeout}, &logger.NoopLogger{})
+	require.NoError(t, err)
+	return waClient.(*whats.Client)
+}
+
+// newMessageEvent creates an incoming text message event from a group member
+func newMessageEvent(id string) *events.Message {
+	return &events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{
+				Chat:    types.NewJID("120363000000000000", types.GroupServer),
+				Sender:  types.JID{User: "5511999999999", Device: 3, Server: types.DefaultUserServer},
+				IsGroup: true,
+			},
+			ID:        id,
+			Timestamp: time.Date(2025, 8, 1, 12, 30, 0, 0, time.UTC),
+		},
+		Message: &waE2E.Message{Conversation: proto.String("oi")},
+	}
+}
+
+// recordingEventHandler records the messages and errors it is notified of; other events are not expected
+type recordingEventHandler struct {
+	whatsapp.EventHandler
+
+	name     string
+	mu       sync.Mutex
+	calls    *[]string
+	messages []*whatsapp.Message
+}
+
+func (h *recordingEventHandler) OnMessage(sessionID session.SessionID, message *whatsapp.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.messages = append(h.messages, message)
+	if h.calls != nil {
+		*h.calls = append(*h.calls, h.name)
+	}
+}
+
+func (h *recordingEventHandler) OnError(sessionID session.SessionID, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.calls != nil {
+		*h.calls = append(*h.calls, h.name)
+	}
+}
+
+func (h *recordingEventHandler) received() []*whatsapp.Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*whatsapp.Message(nil), h.messages...)
+}
+
+// Run with -race to check the QR state locking
+func TestClientQRState(t *testing.T) {
+	t.Run("should serve QR codes while QR events are being handled", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_qr_state", 0)
+		ctx := context.Background()
+
+		// Act
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				client.HandleEvent(&events.QR{Codes: []string{fmt.Sprintf("2@qr-code-%d", i)}})
+			}
+			client.HandleEvent(&events.QR{Codes: []string{"2@final-qr-code"}})
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				client.GenerateQR(ctx)
+			}
+		}()
+		wg.Wait()
+
+		// Assert
+		qr, err := client.GenerateQR(ctx)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(qr, "data:image/png;base64,"))
+		assert.Equal(t, "2@final-qr-code", client.GetQRCode())
+	})
+
+	t.Run("should report monitoring while the QR channel is open", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_qr_monitoring", 0)
+		ctx := context.Background()
+		qrChan := make(chan whatsmeow.QRChannelItem)
+
+		// Act
+		client.MonitorQRChannel(qrChan)
+		notReady, notReadyErr := client.GenerateQR(ctx)
+		qrChan <- whatsmeow.QRChannelItem{Event: "code", Code: "2@monitored-qr-code"}
+		close(qrChan)
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrQRNotReady, notReadyErr)
+		assert.Empty(t, notReady)
+		assert.Eventually(t, func() bool {
+			_, err := client.GenerateQR(ctx)
+			return err != nil
+		}, time.Second, 10*time.Millisecond, "monitoring should stop once the channel closes")
+	})
+
+	t.Run("should clear the QR code when the QR channel times out", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_qr_channel_timeout", 0)
+		qrChan := make(chan whatsmeow.QRChannelItem)
+		client.MonitorQRChannel(qrChan)
+		qrChan <- whatsmeow.QRChannelItem{Event: "code", Code: "2@expiring-qr-code"}
+
+		// Act
+		qrChan <- whatsmeow.QRChannelItem{Event: "timeout"}
+		close(qrChan)
+
+		// Assert
+		assert.Eventually(t, func() bool {
+			return client.GetQRCode() == ""
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should wait for the first QR code while monitoring", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_qr_wait", 5*time.Second)
+		ctx := context.Background()
+		qrChan := make(chan whatsmeow.QRChannelItem)
+		defer close(qrChan)
+		client.MonitorQRChannel(qrChan)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			qrChan <- whatsmeow.QRChannelItem{Event: "code", Code: "2@late-qr-code"}
+		}()
+
+		// Act
+		qr, err := client.GenerateQR(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(qr, "data:image/png;base64,"))
+	})
+
+	t.Run("should stop waiting when the QR wait timeout expires", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_qr_wait_timeout", 50*time.Millisecond)
+		qrChan := make(chan whatsmeow.QRChannelItem)
+		defer close(qrChan)
+		client.MonitorQRChannel(qrChan)
+
+		// Act
+		qr, err := client.GenerateQR(context.Background())
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrQRNotReady, err)
+		assert.Empty(t, qr)
+	})
+}
+
+func TestClientMessageEvent(t *testing.T) {
+	t.Run("should deliver incoming messages to OnMessage", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "message_event_deliver", 0)
+		handler := &recordingEventHandler{}
+		client.AddEventHandler(handler)
+
+		// Act
+		client.HandleEvent(newMessageEvent("3EB0C0FFEE"))
+
+		// Assert
+		messages := handler.received()
+		require.Len(t, messages, 1)
+		assert.Equal(t, "3EB0C0FFEE", messages[0].ID)
+		assert.Equal(t, "120363000000000000@g.us", messages[0].Chat)
+		assert.Equal(t, "5511999999999@s.whatsapp.net", messages[0].Sender)
+		assert.Equal(t, whatsapp.MessageTypeText, messages[0].Type)
+		assert.Equal(t, "oi", messages[0].Body)
+		assert.Equal(t, time.Date(2025, 8, 1, 12, 30, 0, 0, time.UTC), messages[0].Timestamp)
+		assert.False(t, messages[0].IsFromMe)
+	})
+
+	t.Run("should not deliver messages from senders outside the allow-list", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "message_event_filtered", 0)
+		handler := &recordingEventHandler{}
+		client.AddEventHandler(handler)
+		client.SetAllowedSenders([]string{"5511888888888@s.whatsapp.net"})
+
+		// Act
+		client.HandleEvent(newMessageEvent("3EB0C0FFEE"))
+
+		// Assert
+		assert.Empty(t, handler.received())
+	})
+}
+
+func TestClientEventHandlers(t *testing.T) {
+	t.Run("should notify every added handler of client events", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_event_handlers_fan_out", 0)
+		var calls []string
+		client.AddEventHandler(&recordingEventHandler{name: "database", calls: &calls})
+		client.AddEventHandler(&recordingEventHandler{name: "webhook", calls: &calls})
+
+		// Act
+		client.HandleEvent(newMessageEvent("fan-out"))
+
+		// Assert
+		assert.Equal(t, []string{"database", "webhook"}, calls)
+	})
+
+	t.Run("should keep the other handlers when one is removed", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_event_handlers_remove", 0)
+		var calls []string
+		remove := client.AddEventHandler(&recordingEventHandler{name: "database", calls: &calls})
+		client.AddEventHandler(&recordingEventHandler{name: "webhook", calls: &calls})
+
+		// Act
+		remove()
+		client.HandleEvent(newMessageEvent("remove"))
+
+		// Assert
+		assert.Equal(t, []string{"webhook"}, calls)
+	})
+
+	t.Run("should replace and clear handlers through the single handler methods", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t, "client_event_handlers_single", 0)
+		var calls []string
+		client.AddEventHandler(&recordingEventHandler{name: "database", calls: &calls})
+
+		// Act
+		client.SetEventHandler(&recordingEventHandler{name: "single", calls: &calls})
+		client.HandleEvent(newMessageEvent("single"))
+		client.RemoveEventHandler()
+		client.HandleEvent(newMessageEvent("cleared"))
+
+		// Assert
+		assert.Equal(t, []string{"single"}, calls)
+	})
+}
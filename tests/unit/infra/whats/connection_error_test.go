@@ -0,0 +1,108 @@
+package whats_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestClassifyConnectError(t *testing.T) {
+	dialErr := fmt.Errorf("failed to connect: %w", fmt.Errorf("couldn't dial whatsapp web websocket: %w",
+		&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}))
+
+	t.Run("should blame the network for dial failures without a proxy", func(t *testing.T) {
+		// Act
+		connErr := whats.ClassifyConnectError(dialErr, false)
+
+		// Assert
+		assert.Equal(t, whatsapp.ConnectionFailureNetwork, connErr.Reason)
+		assert.ErrorIs(t, connErr, dialErr)
+	})
+
+	t.Run("should blame the proxy for dial failures through it", func(t *testing.T) {
+		// Act
+		connErr := whats.ClassifyConnectError(dialErr, true)
+
+		// Assert
+		assert.Equal(t, whatsapp.ConnectionFailureProxy, connErr.Reason)
+	})
+
+	t.Run("should recognize socks proxy errors", func(t *testing.T) {
+		// Arrange
+		err := &net.OpError{Op: "socks connect", Net: "tcp", Err: errors.New("general SOCKS server failure")}
+
+		// Act
+		connErr := whats.ClassifyConnectError(err, false)
+
+		// Assert
+		assert.Equal(t, whatsapp.ConnectionFailureProxy, connErr.Reason)
+	})
+
+	t.Run("should report other failures as unknown", func(t *testing.T) {
+		// Act
+		connErr := whats.ClassifyConnectError(errors.New("unexpected"), false)
+
+		// Assert
+		assert.Equal(t, whatsapp.ConnectionFailureUnknown, connErr.Reason)
+	})
+}
+
+func TestConnectFailureError(t *testing.T) {
+	t.Run("should map the reasons sent by WhatsApp", func(t *testing.T) {
+		cases := map[events.ConnectFailureReason]whatsapp.ConnectionFailureReason{
+			events.ConnectFailureTempBanned:     whatsapp.ConnectionFailureBanned,
+			events.ConnectFailureUnknownLogout:  whatsapp.ConnectionFailureBanned,
+			events.ConnectFailureLoggedOut:      whatsapp.ConnectionFailureNotPaired,
+			events.ConnectFailureMainDeviceGone: whatsapp.ConnectionFailureNotPaired,
+			events.ConnectFailureClientOutdated: whatsapp.ConnectionFailureClientOutdated,
+			events.ConnectFailureGeneric:        whatsapp.ConnectionFailureUnknown,
+		}
+
+		for reason, expected := range cases {
+			// Act
+			connErr := whats.ConnectFailureError(reason)
+
+			// Assert
+			assert.Equal(t, expected, connErr.Reason, reason.String())
+		}
+	})
+}
+
+func TestConnectOutcome(t *testing.T) {
+	t.Run("should deliver the result to the waiter", func(t *testing.T) {
+		// Arrange
+		outcome := whats.NewConnectOutcome()
+		result := outcome.Expect()
+		connErr := whatsapp.NewConnectionError(whatsapp.ConnectionFailureBanned, nil)
+
+		// Act
+		outcome.Resolve(connErr)
+		outcome.Resolve(nil)
+
+		// Assert
+		require.Len(t, result, 1)
+		assert.Equal(t, connErr, <-result)
+	})
+
+	t.Run("should drop results nobody waits for", func(t *testing.T) {
+		// Arrange
+		outcome := whats.NewConnectOutcome()
+		outcome.Resolve(nil)
+		result := outcome.Expect()
+		outcome.Cancel()
+
+		// Act
+		outcome.Resolve(nil)
+
+		// Assert
+		assert.Len(t, result, 0)
+	})
+}
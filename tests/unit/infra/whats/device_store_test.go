@@ -0,0 +1,145 @@
+package whats_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mau.fi/whatsmeow/proto/waAdv"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+	"wazmeow/pkg/logger"
+)
+
+// openTestStore opens an upgraded in-memory WhatsApp store
+func openTestStore(t *testing.T, name string) (*sqlstore.Container, *sql.DB) {
+	t.Helper()
+
+	container, db, err := whats.OpenStore("sqlite3", "file:"+name+"?mode=memory&cache=shared&_foreign_keys=on", &logger.NoopLogger{})
+	require.NoError(t, err)
+	t.Cleanup(func() { container.Close() })
+
+	_, err = whats.UpgradeStore(context.Background(), container, db)
+	require.NoError(t, err)
+	return container, db
+}
+
+// createPairedDevice stores a device with signal state, as left by a successful pairing
+func createPairedDevice(t *testing.T, container *sqlstore.Container) *store.Device {
+	t.Helper()
+	ctx := context.Background()
+
+	device := container.NewDevice()
+	jid := types.NewADJID("5511999999999", 0, 12)
+	device.ID = &jid
+	device.LID = types.NewJID("123456789", types.HiddenUserServer)
+	device.PushName = "Backup Test"
+	device.Account = &waAdv.ADVSignedDeviceIdentity{
+		Details:             []byte("details"),
+		AccountSignature:    bytes.Repeat([]byte{1}, 64),
+		AccountSignatureKey: bytes.Repeat([]byte{2}, 32),
+		DeviceSignature:     bytes.Repeat([]byte{3}, 64),
+	}
+	require.NoError(t, device.Save(ctx))
+
+	require.NoError(t, device.Sessions.PutSession(ctx, "5511888888888.0:0", []byte("signal-session")))
+	require.NoError(t, device.Identities.PutIdentity(ctx, "5511888888888.0:0", [32]byte{9}))
+	_, err := device.PreKeys.GetOrGenPreKeys(ctx, 3)
+	require.NoError(t, err)
+	require.NoError(t, device.ChatSettings.PutArchived(ctx, types.NewJID("5511888888888", types.DefaultUserServer), true))
+	require.NoError(t, device.PrivacyTokens.PutPrivacyTokens(ctx, store.PrivacyToken{
+		User:      types.NewJID("5511888888888", types.DefaultUserServer),
+		Token:     []byte("token"),
+		Timestamp: time.Unix(1700000000, 0),
+	}))
+
+	return device
+}
+
+func TestDeviceStore(t *testing.T) {
+	t.Run("should restore a deleted device with its signal state", func(t *testing.T) {
+		// Arrange
+		container, db := openTestStore(t, "devicestore_roundtrip")
+		device := createPairedDevice(t, container)
+		deviceStore := whats.NewDeviceStore(container, db)
+		ctx := context.Background()
+
+		data, err := deviceStore.ExportDevice(ctx, device.ID.String())
+		require.NoError(t, err)
+		require.NoError(t, deviceStore.DeleteDevice(ctx, device.ID.String()))
+
+		// Act
+		jid, err := deviceStore.ImportDevice(ctx, data)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, device.ID.String(), jid)
+
+		restored, err := container.GetDevice(ctx, *device.ID)
+		require.NoError(t, err)
+		require.NotNil(t, restored)
+		assert.Equal(t, device.NoiseKey.Priv, restored.NoiseKey.Priv)
+		assert.Equal(t, device.IdentityKey.Priv, restored.IdentityKey.Priv)
+		assert.Equal(t, device.SignedPreKey.Signature, restored.SignedPreKey.Signature)
+		assert.Equal(t, device.RegistrationID, restored.RegistrationID)
+		assert.Equal(t, device.LID, restored.LID)
+		assert.Equal(t, device.Account.AccountSignature, restored.Account.AccountSignature)
+
+		session, err := restored.Sessions.GetSession(ctx, "5511888888888.0:0")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("signal-session"), session)
+
+		preKey, err := restored.PreKeys.GetPreKey(ctx, 1)
+		require.NoError(t, err)
+		require.NotNil(t, preKey)
+
+		settings, err := restored.ChatSettings.GetChatSettings(ctx, types.NewJID("5511888888888", types.DefaultUserServer))
+		require.NoError(t, err)
+		assert.True(t, settings.Archived)
+
+		token, err := restored.PrivacyTokens.GetPrivacyToken(ctx, types.NewJID("5511888888888", types.DefaultUserServer))
+		require.NoError(t, err)
+		require.NotNil(t, token)
+		assert.Equal(t, []byte("token"), token.Token)
+	})
+
+	t.Run("should not overwrite an existing device", func(t *testing.T) {
+		// Arrange
+		container, db := openTestStore(t, "devicestore_existing")
+		device := createPairedDevice(t, container)
+		deviceStore := whats.NewDeviceStore(container, db)
+		ctx := context.Background()
+
+		data, err := deviceStore.ExportDevice(ctx, device.ID.String())
+		require.NoError(t, err)
+
+		// Act
+		_, err = deviceStore.ImportDevice(ctx, data)
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrDeviceAlreadyExists, err)
+	})
+
+	t.Run("should reject unknown devices and malformed backups", func(t *testing.T) {
+		// Arrange
+		container, db := openTestStore(t, "devicestore_invalid")
+		deviceStore := whats.NewDeviceStore(container, db)
+		ctx := context.Background()
+
+		// Act
+		_, exportErr := deviceStore.ExportDevice(ctx, "5511777777777:3@s.whatsapp.net")
+		_, importErr := deviceStore.ImportDevice(ctx, []byte(`{"version":1,"device":{"jid":"5511777777777:3@s.whatsapp.net"}}`))
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrDeviceNotFound, exportErr)
+		assert.Equal(t, whatsapp.ErrInvalidDeviceBackup, importErr)
+	})
+}
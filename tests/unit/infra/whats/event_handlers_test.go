@@ -0,0 +1,111 @@
+package whats_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/whats"
+)
+
+// Run with -race to check the handler locking
+func TestEventHandlerChain(t *testing.T) {
+	sessionID := session.NewSessionID()
+
+	t.Run("should notify the base handler first and then added handlers in order", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		chain := whats.NewEventHandlerChain(&recordingEventHandler{name: "base", calls: &calls})
+		chain.Add(&recordingEventHandler{name: "first", calls: &calls})
+		chain.Add(&recordingEventHandler{name: "second", calls: &calls})
+
+		// Act
+		chain.OnError(sessionID, errors.New("boom"))
+
+		// Assert
+		assert.Equal(t, []string{"base", "first", "second"}, calls)
+	})
+
+	t.Run("should stop notifying a removed handler", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		chain := whats.NewEventHandlerChain(&recordingEventHandler{name: "base", calls: &calls})
+		remove := chain.Add(&recordingEventHandler{name: "first", calls: &calls})
+		chain.Add(&recordingEventHandler{name: "second", calls: &calls})
+
+		// Act
+		remove()
+		remove()
+		chain.OnError(sessionID, errors.New("boom"))
+
+		// Assert
+		assert.Equal(t, []string{"base", "second"}, calls)
+	})
+
+	t.Run("should keep the base handler when the added handlers are replaced", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		chain := whats.NewEventHandlerChain(&recordingEventHandler{name: "base", calls: &calls})
+		chain.Add(&recordingEventHandler{name: "first", calls: &calls})
+
+		// Act
+		chain.Replace(&recordingEventHandler{name: "replacement", calls: &calls})
+		chain.OnError(sessionID, errors.New("boom"))
+		chain.Replace(nil)
+		chain.OnError(sessionID, errors.New("boom"))
+
+		// Assert
+		assert.Equal(t, []string{"base", "replacement", "base"}, calls)
+	})
+}
+
+func TestManagerEventHandlersConcurrency(t *testing.T) {
+	t.Run("should create clients and dispatch events while handlers are swapped", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "manager_event_handlers", &config.WhatsAppConfig{})
+
+		var calls []string
+		added := &recordingEventHandler{name: "added", calls: &calls}
+
+		// Act
+		var wg sync.WaitGroup
+		for worker := 0; worker < 4; worker++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 10; i++ {
+					client, err := manager.CreateClient(session.NewSessionID())
+					if !assert.NoError(t, err) {
+						return
+					}
+					client.(*whats.Client).HandleEvent(newMessageEvent("concurrent"))
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				manager.SetGlobalEventHandler(&recordingEventHandler{name: "global"})
+				remove := manager.AddGlobalEventHandler(&recordingEventHandler{name: "extra"})
+				remove()
+				manager.RemoveGlobalEventHandler()
+			}
+		}()
+		wg.Wait()
+
+		manager.AddGlobalEventHandler(added)
+		client, err := manager.CreateClient(session.NewSessionID())
+		require.NoError(t, err)
+		client.(*whats.Client).HandleEvent(newMessageEvent("after-swap"))
+
+		// Assert
+		assert.Equal(t, 41, manager.GetStats().TotalClients)
+		assert.Equal(t, []string{"added"}, calls)
+	})
+}
@@ -0,0 +1,83 @@
+package whats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestToForwardedMessage(t *testing.T) {
+	media := &whatsapp.MediaInfo{
+		MimeType:      "image/jpeg",
+		FileLength:    2048,
+		URL:           "https://mmg.whatsapp.net/o1/v/t62/abc",
+		DirectPath:    "/o1/v/t62/abc",
+		MediaKey:      []byte("media-key"),
+		FileSHA256:    []byte("file-sha"),
+		FileEncSHA256: []byte("file-enc-sha"),
+	}
+
+	t.Run("should flag text messages as forwarded", func(t *testing.T) {
+		// Arrange
+		stored := &whatsapp.StoredMessage{Type: whatsapp.MessageTypeText, Body: "olá"}
+
+		// Act
+		message, err := whats.ToForwardedMessage(stored)
+
+		// Assert
+		require.NoError(t, err)
+		text := message.GetExtendedTextMessage()
+		require.NotNil(t, text)
+		assert.Equal(t, "olá", text.GetText())
+		assert.True(t, text.GetContextInfo().GetIsForwarded())
+		assert.Equal(t, uint32(1), text.GetContextInfo().GetForwardingScore())
+	})
+
+	t.Run("should reuse the stored upload metadata of media messages", func(t *testing.T) {
+		// Arrange
+		stored := &whatsapp.StoredMessage{Type: whatsapp.MessageTypeImage, Body: "legenda", Media: media}
+
+		// Act
+		message, err := whats.ToForwardedMessage(stored)
+
+		// Assert
+		require.NoError(t, err)
+		image := message.GetImageMessage()
+		require.NotNil(t, image)
+		assert.Equal(t, media.DirectPath, image.GetDirectPath())
+		assert.Equal(t, media.URL, image.GetURL())
+		assert.Equal(t, media.MediaKey, image.GetMediaKey())
+		assert.Equal(t, media.FileEncSHA256, image.GetFileEncSHA256())
+		assert.Equal(t, media.FileLength, image.GetFileLength())
+		assert.Equal(t, "legenda", image.GetCaption())
+		assert.True(t, image.GetContextInfo().GetIsForwarded())
+	})
+
+	t.Run("should reject media messages without upload metadata", func(t *testing.T) {
+		// Arrange
+		stored := &whatsapp.StoredMessage{Type: whatsapp.MessageTypeVideo}
+
+		// Act
+		message, err := whats.ToForwardedMessage(stored)
+
+		// Assert
+		assert.Nil(t, message)
+		assert.ErrorIs(t, err, whatsapp.ErrMessageHasNoMedia)
+	})
+
+	t.Run("should reject message types stored without their content", func(t *testing.T) {
+		// Arrange
+		stored := &whatsapp.StoredMessage{Type: whatsapp.MessageTypePoll, Body: "Qual horário?"}
+
+		// Act
+		message, err := whats.ToForwardedMessage(stored)
+
+		// Assert
+		assert.Nil(t, message)
+		assert.ErrorIs(t, err, whatsapp.ErrMessageNotForwardable)
+	})
+}
@@ -0,0 +1,81 @@
+package whats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mau.fi/whatsmeow/proto/waSyncAction"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestLabelTracker(t *testing.T) {
+	edit := func(name string, color int32, deleted bool) *waSyncAction.LabelEditAction {
+		return &waSyncAction.LabelEditAction{
+			Name:    proto.String(name),
+			Color:   proto.Int32(color),
+			Deleted: proto.Bool(deleted),
+		}
+	}
+
+	t.Run("should list labels ordered by numeric ID", func(t *testing.T) {
+		// Arrange
+		tracker := whats.NewLabelTracker()
+		tracker.Apply("10", edit("Pago", 4, false))
+		tracker.Apply("2", edit("Novo cliente", 1, false))
+
+		// Act
+		labels := tracker.List()
+
+		// Assert
+		assert.Equal(t, []*whatsapp.Label{
+			{ID: "2", Name: "Novo cliente", Color: 1},
+			{ID: "10", Name: "Pago", Color: 4},
+		}, labels)
+		assert.Equal(t, "11", tracker.NextID())
+	})
+
+	t.Run("should drop deleted labels", func(t *testing.T) {
+		// Arrange
+		tracker := whats.NewLabelTracker()
+		tracker.Apply("1", edit("Pendente", 2, false))
+
+		// Act
+		tracker.Apply("1", edit("Pendente", 2, true))
+
+		// Assert
+		_, ok := tracker.Get("1")
+		assert.False(t, ok)
+		assert.Empty(t, tracker.List())
+		assert.Equal(t, "1", tracker.NextID())
+	})
+}
+
+func TestIsDroppedAppStateEvent(t *testing.T) {
+	t.Run("should keep label edits replayed by a full sync", func(t *testing.T) {
+		// Act & Assert
+		assert.False(t, whats.IsDroppedAppStateEvent(&events.LabelEdit{LabelID: "1", FromFullSync: true}))
+	})
+
+	t.Run("should drop other mutations replayed by a full sync", func(t *testing.T) {
+		// Act & Assert
+		assert.True(t, whats.IsDroppedAppStateEvent(&events.Contact{FromFullSync: true}))
+		assert.True(t, whats.IsDroppedAppStateEvent(&events.Mute{FromFullSync: true}))
+		assert.True(t, whats.IsDroppedAppStateEvent(&events.LabelAssociationChat{FromFullSync: true}))
+	})
+
+	t.Run("should keep live mutations and other events", func(t *testing.T) {
+		// Act & Assert
+		assert.False(t, whats.IsDroppedAppStateEvent(&events.Mute{}))
+		assert.False(t, whats.IsDroppedAppStateEvent(&events.Archive{}))
+		assert.False(t, whats.IsDroppedAppStateEvent(&events.Connected{}))
+	})
+
+	t.Run("should drop the raw app state event", func(t *testing.T) {
+		// Act & Assert
+		assert.True(t, whats.IsDroppedAppStateEvent(&events.AppState{}))
+	})
+}
@@ -0,0 +1,122 @@
+package whats_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/whats"
+	"wazmeow/pkg/logger"
+)
+
+// missingSessionRepository reports every session as missing
+type missingSessionRepository struct {
+	session.Repository
+}
+
+func (r *missingSessionRepository) GetByID(ctx context.Context, id session.SessionID) (*session.Session, error) {
+	return nil, session.ErrSessionNotFound
+}
+
+// newStartedManager creates a started manager backed by an in-memory device store
+func newStartedManager(t *testing.T, name string, cfg *config.WhatsAppConfig) *whats.Manager {
+	t.Helper()
+
+	container, db, err := whats.OpenStore("sqlite3", "file:"+name+"?mode=memory&cache=shared&_foreign_keys=on", &logger.NoopLogger{})
+	require.NoError(t, err)
+	t.Cleanup(func() { container.Close() })
+	_, err = whats.UpgradeStore(context.Background(), container, db)
+	require.NoError(t, err)
+
+	manager := whats.NewManager(cfg, container, &missingSessionRepository{}, nil, nil, nil, nil, nil, nil, &logger.NoopLogger{}).(*whats.Manager)
+	require.NoError(t, manager.Start(context.Background()))
+	t.Cleanup(func() { manager.Stop(context.Background()) })
+	return manager
+}
+
+func TestManagerMaxSessions(t *testing.T) {
+	t.Run("should refuse a new client once the limit is reached", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "max-sessions-refuse", &config.WhatsAppConfig{MaxSessions: 1})
+		first := session.NewSessionID()
+		_, err := manager.CreateClient(first)
+		require.NoError(t, err)
+
+		// Act
+		_, err = manager.CreateClient(session.NewSessionID())
+
+		// Assert
+		assert.ErrorIs(t, err, whatsapp.ErrSessionLimitReached)
+		assert.Contains(t, err.Error(), "maximum of 1 concurrent sessions")
+		assert.Equal(t, 1, manager.GetStats().TotalClients)
+		assert.Equal(t, 1, manager.GetStats().MaxSessions)
+	})
+
+	t.Run("should return the existing client of a session at the limit", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "max-sessions-existing", &config.WhatsAppConfig{MaxSessions: 1})
+		sessionID := session.NewSessionID()
+		client, err := manager.CreateClient(sessionID)
+		require.NoError(t, err)
+
+		// Act
+		again, err := manager.CreateClient(sessionID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Same(t, client, again)
+	})
+
+	t.Run("should free a slot when a client is removed", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "max-sessions-free", &config.WhatsAppConfig{MaxSessions: 1})
+		first := session.NewSessionID()
+		_, err := manager.CreateClient(first)
+		require.NoError(t, err)
+		require.NoError(t, manager.RemoveClient(first))
+
+		// Act
+		_, err = manager.CreateClient(session.NewSessionID())
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("should let only one of concurrent creations take the last slot", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "max-sessions-race", &config.WhatsAppConfig{MaxSessions: 1})
+		const attempts = 5
+		errs := make(chan error, attempts)
+		var wg sync.WaitGroup
+
+		// Act
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := manager.CreateClient(session.NewSessionID())
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		// Assert
+		created := 0
+		for err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.True(t, errors.Is(err, whatsapp.ErrSessionLimitReached))
+		}
+		assert.Equal(t, 1, created)
+	})
+}
@@ -0,0 +1,168 @@
+package whats_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/whats"
+	"wazmeow/pkg/logger"
+)
+
+func TestManagerAcquireConnect(t *testing.T) {
+	newManager := func() whatsapp.Manager {
+		return whats.NewManager(&config.WhatsAppConfig{}, nil, nil, nil, nil, nil, nil, nil, nil, &logger.NoopLogger{})
+	}
+
+	t.Run("should reject a second connect of the same session until released", func(t *testing.T) {
+		// Arrange
+		manager := newManager()
+		sessionID := session.NewSessionID()
+
+		// Act
+		release, err := manager.AcquireConnect(sessionID)
+		require.NoError(t, err)
+		_, conflictErr := manager.AcquireConnect(sessionID)
+		release()
+		releaseAgain, retryErr := manager.AcquireConnect(sessionID)
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrConnectInProgress, conflictErr)
+		assert.NoError(t, retryErr)
+		releaseAgain()
+	})
+
+	t.Run("should guard sessions independently", func(t *testing.T) {
+		// Arrange
+		manager := newManager()
+
+		// Act
+		releaseFirst, firstErr := manager.AcquireConnect(session.NewSessionID())
+		releaseSecond, secondErr := manager.AcquireConnect(session.NewSessionID())
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.NoError(t, secondErr)
+		releaseFirst()
+		releaseSecond()
+	})
+
+	t.Run("should ignore repeated releases", func(t *testing.T) {
+		// Arrange
+		manager := newManager()
+		sessionID := session.NewSessionID()
+		release, err := manager.AcquireConnect(sessionID)
+		require.NoError(t, err)
+		release()
+		holder, err := manager.AcquireConnect(sessionID)
+		require.NoError(t, err)
+
+		// Act
+		release()
+		_, conflictErr := manager.AcquireConnect(sessionID)
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrConnectInProgress, conflictErr)
+		holder()
+	})
+}
+
+// pruneRecorder records the cutoffs of the DeleteBefore calls of the stored data repositories
+type pruneRecorder struct {
+	mu      sync.Mutex
+	cutoffs map[string][]time.Time
+}
+
+func (r *pruneRecorder) record(name string, before time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cutoffs[name] = append(r.cutoffs[name], before)
+}
+
+func (r *pruneRecorder) snapshot() map[string][]time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot := make(map[string][]time.Time, len(r.cutoffs))
+	for name, cutoffs := range r.cutoffs {
+		snapshot[name] = append([]time.Time(nil), cutoffs...)
+	}
+	return snapshot
+}
+
+type pruneMessageRepository struct {
+	whatsapp.MessageRepository
+	recorder *pruneRecorder
+}
+
+func (r *pruneMessageRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	r.recorder.record("messages", before)
+	return 1, nil
+}
+
+type pruneReceiptRepository struct {
+	whatsapp.ReceiptRepository
+	recorder *pruneRecorder
+}
+
+func (r *pruneReceiptRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	r.recorder.record("receipts", before)
+	return 0, nil
+}
+
+type pruneChatRepository struct {
+	whatsapp.ChatRepository
+	recorder *pruneRecorder
+}
+
+func (r *pruneChatRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	r.recorder.record("chats", before)
+	return 0, nil
+}
+
+func TestManagerMessageRetention(t *testing.T) {
+	newManager := func(retention time.Duration, recorder *pruneRecorder) whatsapp.Manager {
+		return whats.NewManager(&config.WhatsAppConfig{MessageRetention: retention}, nil, nil, nil,
+			&pruneMessageRepository{recorder: recorder},
+			&pruneReceiptRepository{recorder: recorder},
+			&pruneChatRepository{recorder: recorder},
+			nil, nil, &logger.NoopLogger{})
+	}
+
+	t.Run("should delete stored data older than the retention on start", func(t *testing.T) {
+		// Arrange
+		recorder := &pruneRecorder{cutoffs: make(map[string][]time.Time)}
+		manager := newManager(24*time.Hour, recorder)
+		started := time.Now()
+
+		// Act
+		require.NoError(t, manager.Start(context.Background()))
+		require.NoError(t, manager.Stop(context.Background()))
+
+		// Assert
+		cutoffs := recorder.snapshot()
+		for _, name := range []string{"messages", "receipts", "chats"} {
+			require.Len(t, cutoffs[name], 1, name)
+			assert.WithinDuration(t, started.Add(-24*time.Hour), cutoffs[name][0], time.Minute, name)
+		}
+	})
+
+	t.Run("should keep stored data when no retention is configured", func(t *testing.T) {
+		// Arrange
+		recorder := &pruneRecorder{cutoffs: make(map[string][]time.Time)}
+		manager := newManager(0, recorder)
+
+		// Act
+		require.NoError(t, manager.Start(context.Background()))
+		require.NoError(t, manager.Stop(context.Background()))
+
+		// Assert
+		assert.Empty(t, recorder.snapshot())
+	})
+}
@@ -0,0 +1,111 @@
+package whats_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/infra/config"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestOperationTracker(t *testing.T) {
+	t.Run("should return at once when nothing is in flight", func(t *testing.T) {
+		// Arrange
+		var tracker whats.OperationTracker
+
+		// Act
+		remaining := tracker.Wait(context.Background())
+
+		// Assert
+		assert.Equal(t, 0, remaining)
+	})
+
+	t.Run("should wait until every operation ends", func(t *testing.T) {
+		// Arrange
+		var tracker whats.OperationTracker
+		first := tracker.Begin()
+		second := tracker.Begin()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			first()
+			first()
+			second()
+		}()
+
+		// Act
+		remaining := tracker.Wait(context.Background())
+
+		// Assert
+		assert.Equal(t, 0, remaining)
+		assert.Equal(t, 0, tracker.Count())
+	})
+
+	t.Run("should report the operations left when the context ends", func(t *testing.T) {
+		// Arrange
+		var tracker whats.OperationTracker
+		done := tracker.Begin()
+		defer done()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		remaining := tracker.Wait(ctx)
+
+		// Assert
+		assert.Equal(t, 1, remaining)
+	})
+}
+
+func TestManagerStopDrainsSends(t *testing.T) {
+	t.Run("should wait for in-flight sends before closing clients", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "manager_stop_drain", &config.WhatsAppConfig{})
+		client, err := manager.CreateClient(session.NewSessionID())
+		require.NoError(t, err)
+
+		done := client.(*whats.Client).BeginSend()
+		finished := make(chan struct{})
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			close(finished)
+			done()
+		}()
+
+		// Act
+		err = manager.Stop(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		select {
+		case <-finished:
+		default:
+			t.Fatal("manager stopped before the in-flight send finished")
+		}
+		assert.False(t, manager.IsRunning())
+	})
+
+	t.Run("should abandon sends still in flight at the deadline", func(t *testing.T) {
+		// Arrange
+		manager := newStartedManager(t, "manager_stop_abandon", &config.WhatsAppConfig{})
+		client, err := manager.CreateClient(session.NewSessionID())
+		require.NoError(t, err)
+
+		done := client.(*whats.Client).BeginSend()
+		defer done()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		// Act
+		err = manager.Stop(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, manager.IsRunning())
+		assert.Empty(t, manager.ListClients())
+	})
+}
@@ -0,0 +1,28 @@
+package whats_test
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/infra/whats"
+)
+
+func TestQRImageOptions(t *testing.T) {
+	t.Run("should render QR images with the configured size", func(t *testing.T) {
+		// Arrange
+		options := whats.QRImageOptions{Size: 512, Level: "highest"}
+
+		// Act
+		data, err := options.Encode("2@configured-qr-code")
+
+		// Assert
+		require.NoError(t, err)
+		image, err := png.DecodeConfig(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, 512, image.Width)
+	})
+}
@@ -0,0 +1,65 @@
+package whats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestToMessageReceipts(t *testing.T) {
+	sessionID := session.NewSessionID()
+	timestamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newReceipt := func(receiptType types.ReceiptType, isFromMe bool) *events.Receipt {
+		return &events.Receipt{
+			MessageSource: types.MessageSource{
+				Chat:     types.NewJID("5511999999999", types.DefaultUserServer),
+				Sender:   types.NewADJID("5511999999999", 0, 3),
+				IsFromMe: isFromMe,
+			},
+			MessageIDs: []string{"3EB0A", "3EB0B"},
+			Timestamp:  timestamp,
+			Type:       receiptType,
+		}
+	}
+
+	t.Run("should create one receipt per message with the recipient's phone JID", func(t *testing.T) {
+		// Act
+		receipts := whats.ToMessageReceipts(sessionID, newReceipt(types.ReceiptTypeRead, false))
+
+		// Assert
+		require.Len(t, receipts, 2)
+		assert.Equal(t, "3EB0A", receipts[0].MessageID)
+		assert.Equal(t, "3EB0B", receipts[1].MessageID)
+		assert.Equal(t, "5511999999999@s.whatsapp.net", receipts[0].RecipientJID)
+		assert.Equal(t, whatsapp.ReceiptRead, receipts[0].Status)
+		assert.True(t, receipts[0].Timestamp.Equal(timestamp))
+	})
+
+	t.Run("should map delivered and played receipts", func(t *testing.T) {
+		// Act
+		delivered := whats.ToMessageReceipts(sessionID, newReceipt(types.ReceiptTypeDelivered, false))
+		played := whats.ToMessageReceipts(sessionID, newReceipt(types.ReceiptTypePlayed, false))
+
+		// Assert
+		assert.Equal(t, whatsapp.ReceiptDelivered, delivered[0].Status)
+		assert.Equal(t, whatsapp.ReceiptPlayed, played[0].Status)
+	})
+
+	t.Run("should ignore receipts from own devices and other receipt types", func(t *testing.T) {
+		// Act
+		own := whats.ToMessageReceipts(sessionID, newReceipt(types.ReceiptTypeReadSelf, true))
+		retry := whats.ToMessageReceipts(sessionID, newReceipt(types.ReceiptTypeRetry, false))
+
+		// Assert
+		assert.Empty(t, own)
+		assert.Empty(t, retry)
+	})
+}
@@ -0,0 +1,56 @@
+package whats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestReceiveStats(t *testing.T) {
+	t.Run("should count received messages globally and per session", func(t *testing.T) {
+		// Arrange
+		stats := whats.NewReceiveStats()
+		first := session.NewSessionID()
+		second := session.NewSessionID()
+
+		// Act
+		stats.Record(first)
+		stats.Record(first)
+		stats.Record(second)
+
+		// Assert
+		assert.Equal(t, int64(2), stats.Count(first))
+		assert.Equal(t, int64(1), stats.Count(second))
+		assert.Equal(t, int64(3), stats.Total())
+	})
+
+	t.Run("should drop forgotten sessions from the total", func(t *testing.T) {
+		// Arrange
+		stats := whats.NewReceiveStats()
+		kept := session.NewSessionID()
+		removed := session.NewSessionID()
+		stats.Record(kept)
+		stats.Record(removed)
+
+		// Act
+		stats.Forget(removed)
+
+		// Assert
+		assert.Equal(t, int64(1), stats.Total())
+		assert.Equal(t, int64(0), stats.Count(removed))
+	})
+
+	t.Run("should ignore records on a nil counter", func(t *testing.T) {
+		// Arrange
+		var stats *whats.ReceiveStats
+
+		// Act
+		stats.Record(session.NewSessionID())
+
+		// Assert
+		assert.Equal(t, int64(0), stats.Total())
+	})
+}
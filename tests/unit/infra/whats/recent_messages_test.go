@@ -0,0 +1,110 @@
+package whats_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestRecentMessageBuffer(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	message := func(i int) *whatsapp.Message {
+		return &whatsapp.Message{ID: fmt.Sprintf("3EB0%d", i)}
+	}
+	ids := func(page *whatsapp.RecentMessages) []string {
+		result := make([]string, 0, len(page.Messages))
+		for _, recent := range page.Messages {
+			result = append(result, recent.Message.ID)
+		}
+		return result
+	}
+
+	t.Run("should not create a buffer when disabled", func(t *testing.T) {
+		// Act
+		buffer := whats.NewRecentMessageBuffer(whats.RecentMessageOptions{Size: 0})
+
+		// Assert
+		assert.Nil(t, buffer)
+	})
+
+	t.Run("should keep only the latest messages, oldest first", func(t *testing.T) {
+		// Arrange
+		buffer := whats.NewRecentMessageBuffer(whats.RecentMessageOptions{Size: 3})
+
+		// Act
+		for i := 1; i <= 5; i++ {
+			buffer.Add(message(i), now)
+		}
+		page := buffer.Since(0, now)
+
+		// Assert
+		assert.Equal(t, []string{"3EB03", "3EB04", "3EB05"}, ids(page))
+		assert.Equal(t, uint64(5), page.NextCursor)
+	})
+
+	t.Run("should return only messages newer than the cursor", func(t *testing.T) {
+		// Arrange
+		buffer := whats.NewRecentMessageBuffer(whats.RecentMessageOptions{Size: 10})
+		buffer.Add(message(1), now)
+		first := buffer.Since(0, now)
+		buffer.Add(message(2), now)
+		buffer.Add(message(3), now)
+
+		// Act
+		page := buffer.Since(first.NextCursor, now)
+		empty := buffer.Since(page.NextCursor, now)
+
+		// Assert
+		assert.Equal(t, []string{"3EB02", "3EB03"}, ids(page))
+		assert.Empty(t, empty.Messages)
+		assert.Equal(t, page.NextCursor, empty.NextCursor)
+	})
+
+	t.Run("should return every message for a cursor ahead of the buffer", func(t *testing.T) {
+		// Arrange
+		buffer := whats.NewRecentMessageBuffer(whats.RecentMessageOptions{Size: 10})
+		buffer.Add(message(1), now)
+
+		// Act
+		page := buffer.Since(42, now)
+
+		// Assert
+		assert.Equal(t, []string{"3EB01"}, ids(page))
+	})
+
+	t.Run("should skip messages older than the retention", func(t *testing.T) {
+		// Arrange
+		buffer := whats.NewRecentMessageBuffer(whats.RecentMessageOptions{Size: 10, Retention: time.Minute})
+		buffer.Add(message(1), now)
+		buffer.Add(message(2), now.Add(time.Minute))
+
+		// Act
+		page := buffer.Since(0, now.Add(90*time.Second))
+
+		// Assert
+		assert.Equal(t, []string{"3EB02"}, ids(page))
+	})
+
+	t.Run("should drop messages but keep advancing cursors after clearing", func(t *testing.T) {
+		// Arrange
+		buffer := whats.NewRecentMessageBuffer(whats.RecentMessageOptions{Size: 10})
+		buffer.Add(message(1), now)
+		buffer.Clear()
+
+		// Act
+		cleared := buffer.Since(0, now)
+		buffer.Add(message(2), now)
+		page := buffer.Since(cleared.NextCursor, now)
+
+		// Assert
+		assert.Empty(t, cleared.Messages)
+		require.Len(t, page.Messages, 1)
+		assert.Equal(t, uint64(2), page.Messages[0].Cursor)
+	})
+}
@@ -0,0 +1,91 @@
+package whats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	"wazmeow/internal/infra/whats"
+)
+
+func TestSendStats(t *testing.T) {
+	t.Run("should count sent messages by type globally and per session", func(t *testing.T) {
+		// Arrange
+		stats := whats.NewSendStats()
+		first := session.NewSessionID()
+		second := session.NewSessionID()
+
+		// Act
+		stats.Record(first, whatsapp.MessageTypeText)
+		stats.Record(first, whatsapp.MessageTypeText)
+		stats.Record(first, whatsapp.MessageTypeImage)
+		stats.Record(second, whatsapp.MessageTypeText)
+		snapshot := stats.Snapshot()
+
+		// Assert
+		assert.Equal(t, int64(3), snapshot.ByType[whatsapp.MessageTypeText])
+		assert.Equal(t, int64(1), snapshot.ByType[whatsapp.MessageTypeImage])
+		assert.Equal(t, int64(4), snapshot.Total())
+		assert.Equal(t, int64(2), snapshot.BySession[first][whatsapp.MessageTypeText])
+		assert.Equal(t, int64(1), snapshot.BySession[second][whatsapp.MessageTypeText])
+	})
+
+	t.Run("should report every message type even when nothing was sent", func(t *testing.T) {
+		// Act
+		snapshot := whats.NewSendStats().Snapshot()
+
+		// Assert
+		assert.Len(t, snapshot.ByType, len(whatsapp.MessageTypes))
+		assert.Equal(t, int64(0), snapshot.Total())
+		assert.Empty(t, snapshot.BySession)
+	})
+
+	t.Run("should drop forgotten sessions from the totals", func(t *testing.T) {
+		// Arrange
+		stats := whats.NewSendStats()
+		kept := session.NewSessionID()
+		removed := session.NewSessionID()
+		stats.Record(kept, whatsapp.MessageTypeDocument)
+		stats.Record(removed, whatsapp.MessageTypeDocument)
+
+		// Act
+		stats.Forget(removed)
+		snapshot := stats.Snapshot()
+
+		// Assert
+		assert.Equal(t, int64(1), snapshot.ByType[whatsapp.MessageTypeDocument])
+		assert.NotContains(t, snapshot.BySession, removed)
+	})
+
+	t.Run("should return copies that do not change with later sends", func(t *testing.T) {
+		// Arrange
+		stats := whats.NewSendStats()
+		sessionID := session.NewSessionID()
+		stats.Record(sessionID, whatsapp.MessageTypeText)
+		snapshot := stats.Snapshot()
+
+		// Act
+		stats.Record(sessionID, whatsapp.MessageTypeText)
+
+		// Assert
+		assert.Equal(t, int64(1), snapshot.BySession[sessionID][whatsapp.MessageTypeText])
+	})
+
+	t.Run("should count the messages of all types sent by a session", func(t *testing.T) {
+		// Arrange
+		stats := whats.NewSendStats()
+		sessionID := session.NewSessionID()
+		stats.Record(sessionID, whatsapp.MessageTypeText)
+		stats.Record(sessionID, whatsapp.MessageTypeImage)
+		stats.Record(session.NewSessionID(), whatsapp.MessageTypeText)
+
+		// Act
+		count := stats.Count(sessionID)
+
+		// Assert
+		assert.Equal(t, int64(2), count)
+		assert.Equal(t, int64(0), stats.Count(session.NewSessionID()))
+	})
+}
@@ -0,0 +1,89 @@
+package whats_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/infra/whats"
+	"wazmeow/pkg/logger"
+)
+
+func TestStoreDialect(t *testing.T) {
+	t.Run("should enable foreign keys for file-based SQLite databases", func(t *testing.T) {
+		// Act
+		dialect, address, err := whats.StoreDialect("sqlite", "./data/test.db")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "sqlite3", dialect)
+		assert.Equal(t, "./data/test.db?_foreign_keys=on", address)
+	})
+
+	t.Run("should keep in-memory SQLite and postgres addresses", func(t *testing.T) {
+		// Act
+		_, memoryAddress, memoryErr := whats.StoreDialect("sqlite3", "file::memory:")
+		pgDialect, pgAddress, pgErr := whats.StoreDialect("postgresql", "postgres://localhost/wazmeow")
+
+		// Assert
+		require.NoError(t, memoryErr)
+		require.NoError(t, pgErr)
+		assert.Equal(t, "file::memory:", memoryAddress)
+		assert.Equal(t, "postgres", pgDialect)
+		assert.Equal(t, "postgres://localhost/wazmeow", pgAddress)
+	})
+
+	t.Run("should reject unsupported drivers", func(t *testing.T) {
+		_, _, err := whats.StoreDialect("mysql", "root@/wazmeow")
+		assert.Error(t, err)
+	})
+}
+
+func TestUpgradeStore(t *testing.T) {
+	t.Run("should create the schema and report the latest version", func(t *testing.T) {
+		// Arrange
+		store, db, err := whats.OpenStore("sqlite3", "file:whatsstoretest?mode=memory&cache=shared&_foreign_keys=on", &logger.NoopLogger{})
+		require.NoError(t, err)
+		defer store.Close()
+		ctx := context.Background()
+
+		_, err = whats.GetStoreSchema(ctx, db)
+		require.Error(t, err, "version table should not exist before the upgrade")
+
+		// Act
+		schema, err := whats.UpgradeStore(ctx, store, db)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, schema.IsReady())
+		assert.Positive(t, schema.LatestVersion)
+		assert.Equal(t, schema.LatestVersion, schema.Version)
+
+		current, err := whats.GetStoreSchema(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, schema, current)
+	})
+
+	t.Run("should fail when SQLite foreign keys are disabled", func(t *testing.T) {
+		// Arrange
+		store, db, err := whats.OpenStore("sqlite3", "file:whatsstorenofk?mode=memory&cache=shared&_foreign_keys=off", &logger.NoopLogger{})
+		require.NoError(t, err)
+		defer store.Close()
+
+		// Act
+		schema, err := whats.UpgradeStore(context.Background(), store, db)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, schema)
+	})
+}
+
+func TestStoreSchema_IsReady(t *testing.T) {
+	t.Run("should not be ready when behind the latest version", func(t *testing.T) {
+		schema := &whats.StoreSchema{Version: 3, LatestVersion: 10}
+		assert.False(t, schema.IsReady())
+	})
+}
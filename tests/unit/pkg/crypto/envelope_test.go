@@ -0,0 +1,76 @@
+package crypto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/pkg/crypto"
+)
+
+func TestEnvelope(t *testing.T) {
+	t.Run("should decrypt with the same passphrase", func(t *testing.T) {
+		// Arrange
+		plaintext := []byte(`{"secret":"device keys"}`)
+
+		// Act
+		envelope, err := crypto.Seal("correct horse", plaintext)
+		require.NoError(t, err)
+		opened, err := crypto.Open("correct horse", envelope)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, opened)
+		assert.NotContains(t, string(envelope.Data), "device keys")
+	})
+
+	t.Run("should survive a JSON round trip", func(t *testing.T) {
+		// Arrange
+		envelope, err := crypto.Seal("correct horse", []byte("payload"))
+		require.NoError(t, err)
+
+		encoded, err := json.Marshal(envelope)
+		require.NoError(t, err)
+		var decoded crypto.Envelope
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+		// Act
+		opened, err := crypto.Open("correct horse", &decoded)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []byte("payload"), opened)
+	})
+
+	t.Run("should fail with a wrong passphrase or tampered data", func(t *testing.T) {
+		// Arrange
+		envelope, err := crypto.Seal("correct horse", []byte("payload"))
+		require.NoError(t, err)
+
+		// Act
+		_, wrongErr := crypto.Open("battery staple", envelope)
+		envelope.Data[0] ^= 0xff
+		_, tamperedErr := crypto.Open("correct horse", envelope)
+
+		// Assert
+		assert.Equal(t, crypto.ErrDecryptionFailed, wrongErr)
+		assert.Equal(t, crypto.ErrDecryptionFailed, tamperedErr)
+	})
+
+	t.Run("should reject short passphrases and unsafe parameters", func(t *testing.T) {
+		// Arrange
+		envelope, err := crypto.Seal("correct horse", []byte("payload"))
+		require.NoError(t, err)
+		envelope.N = 1 << 30
+
+		// Act
+		_, sealErr := crypto.Seal("short", []byte("payload"))
+		_, openErr := crypto.Open("correct horse", envelope)
+
+		// Assert
+		assert.Equal(t, crypto.ErrPassphraseTooShort, sealErr)
+		assert.Equal(t, crypto.ErrInvalidEnvelope, openErr)
+	})
+}
@@ -0,0 +1,134 @@
+package logger_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/pkg/logger"
+)
+
+// entryRecorder collects entries posted to a test webhook endpoint
+type entryRecorder struct {
+	mu      sync.Mutex
+	entries []map[string]interface{}
+}
+
+func (r *entryRecorder) handler(w http.ResponseWriter, req *http.Request) {
+	var entry map[string]interface{}
+	if err := json.NewDecoder(req.Body).Decode(&entry); err == nil {
+		r.mu.Lock()
+		r.entries = append(r.entries, entry)
+		r.mu.Unlock()
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (r *entryRecorder) snapshot() []map[string]interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]map[string]interface{}(nil), r.entries...)
+}
+
+func newHookLogger(t *testing.T) logger.HookLogger {
+	log, ok := logger.New(&logger.Config{Level: "debug", Output: "file", FilePath: t.TempDir() + "/test.log"}).(logger.HookLogger)
+	require.True(t, ok)
+	return log
+}
+
+func TestWebhookHook(t *testing.T) {
+	t.Run("should forward error logs with fields and error", func(t *testing.T) {
+		// Arrange
+		recorder := &entryRecorder{}
+		server := httptest.NewServer(http.HandlerFunc(recorder.handler))
+		defer server.Close()
+
+		log := newHookLogger(t)
+		log.AddHook(logger.NewWebhookHook(server.URL, time.Second))
+
+		// Act
+		log.WithField("component", "whatsapp").ErrorWithError("connection lost", errors.New("socket closed"), logger.Fields{
+			"session_id": "abc",
+		})
+
+		// Assert
+		require.Eventually(t, func() bool { return len(recorder.snapshot()) == 1 }, 2*time.Second, 10*time.Millisecond)
+		entry := recorder.snapshot()[0]
+		assert.Equal(t, "error", entry["level"])
+		assert.Equal(t, "connection lost", entry["message"])
+		assert.Equal(t, "socket closed", entry["error"])
+		fields, ok := entry["fields"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "whatsapp", fields["component"])
+		assert.Equal(t, "abc", fields["session_id"])
+	})
+
+	t.Run("should not forward levels the hook does not handle", func(t *testing.T) {
+		// Arrange
+		recorder := &entryRecorder{}
+		server := httptest.NewServer(http.HandlerFunc(recorder.handler))
+		defer server.Close()
+
+		log := newHookLogger(t)
+		log.AddHook(logger.NewWebhookHook(server.URL, time.Second))
+
+		// Act
+		log.Info("session connected")
+		log.Warn("reconnecting")
+		log.Error("sentinel")
+
+		// Assert
+		require.Eventually(t, func() bool { return len(recorder.snapshot()) == 1 }, 2*time.Second, 10*time.Millisecond)
+		assert.Equal(t, "sentinel", recorder.snapshot()[0]["message"])
+	})
+
+	t.Run("should stop forwarding after the hook is removed", func(t *testing.T) {
+		// Arrange
+		recorder := &entryRecorder{}
+		server := httptest.NewServer(http.HandlerFunc(recorder.handler))
+		defer server.Close()
+
+		log := newHookLogger(t)
+		hook := logger.NewWebhookHook(server.URL, time.Second)
+		log.AddHook(hook)
+		log.Error("first")
+		require.Eventually(t, func() bool { return len(recorder.snapshot()) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+		// Act
+		log.RemoveHook(hook)
+		log.Error("second")
+		time.Sleep(100 * time.Millisecond)
+
+		// Assert
+		assert.Len(t, recorder.snapshot(), 1)
+	})
+
+	t.Run("should not block logging when the endpoint is slow", func(t *testing.T) {
+		// Arrange
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		log := newHookLogger(t)
+		log.AddHook(logger.NewWebhookHook(server.URL, 5*time.Second))
+
+		// Act
+		start := time.Now()
+		for i := 0; i < 1000; i++ {
+			log.Error("burst")
+		}
+
+		// Assert
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
@@ -0,0 +1,75 @@
+package utils_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/shared/utils"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestPrepareProfilePicture(t *testing.T) {
+	t.Run("should crop and resize to a square JPEG", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 1200, 800))
+		for y := 0; y < 800; y++ {
+			for x := 0; x < 1200; x++ {
+				src.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+			}
+		}
+
+		data, err := utils.PrepareProfilePicture(encodePNG(t, src))
+
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(data), utils.MaxProfilePictureBytes)
+
+		img, format, err := image.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, utils.ProfilePictureSize, img.Bounds().Dx())
+		assert.Equal(t, utils.ProfilePictureSize, img.Bounds().Dy())
+	})
+
+	t.Run("should not upscale small images", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 100, 300)), nil))
+
+		data, err := utils.PrepareProfilePicture(buf.Bytes())
+
+		require.NoError(t, err)
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, 100, cfg.Width)
+		assert.Equal(t, 100, cfg.Height)
+	})
+
+	t.Run("should composite transparency over white", func(t *testing.T) {
+		data, err := utils.PrepareProfilePicture(encodePNG(t, image.NewNRGBA(image.Rect(0, 0, 10, 10))))
+
+		require.NoError(t, err)
+		img, err := jpeg.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		r, g, b, _ := img.At(5, 5).RGBA()
+		assert.Greater(t, r, uint32(0xf000))
+		assert.Greater(t, g, uint32(0xf000))
+		assert.Greater(t, b, uint32(0xf000))
+	})
+
+	t.Run("should reject data that is not an image", func(t *testing.T) {
+		_, err := utils.PrepareProfilePicture([]byte("not an image"))
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"wazmeow/internal/shared/utils"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		name        string
+		phone       string
+		countryCode string
+		expected    string
+	}{
+		{"international with plus is kept", "+55 (11) 99999-9999", "1", "5511999999999"},
+		{"international with 00 is kept", "0055 11 99999-9999", "1", "5511999999999"},
+		{"national number gets country code", "(11) 99999-9999", "55", "5511999999999"},
+		{"trunk prefix is replaced by country code", "011 99999-9999", "55", "5511999999999"},
+		{"number already starting with country code is kept", "5511999999999", "55", "5511999999999"},
+		{"national number starting like country code gets it", "55999999999", "55", "5555999999999"},
+		{"country code with plus is accepted", "2025550123", "+1", "12025550123"},
+		{"without country code only cleans", "11 99999-9999", "", "11999999999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utils.NormalizePhoneNumber(tt.phone, tt.countryCode))
+		})
+	}
+}
+
+func TestResolveCountryCode(t *testing.T) {
+	assert.Equal(t, "351", utils.ResolveCountryCode("+351", "55"))
+	assert.Equal(t, "55", utils.ResolveCountryCode("", "55"))
+	assert.Equal(t, "", utils.ResolveCountryCode("", ""))
+}
+
+func TestFormatWhatsAppJID(t *testing.T) {
+	assert.Equal(t, "5511999999999@s.whatsapp.net", utils.FormatWhatsAppJID("11 99999-9999", "55"))
+	assert.Equal(t, "120363025246125486@g.us", utils.FormatWhatsAppJID("120363025246125486@g.us", "55"))
+}
+
+func TestFormatWhatsAppJID_InternationalFormats(t *testing.T) {
+	tests := []struct {
+		name        string
+		phone       string
+		countryCode string
+		expected    string
+	}{
+		{"brazil with plus, spaces and dashes", "+55 11 99999-9999", "", "5511999999999@s.whatsapp.net"},
+		{"us with plus, dots and parentheses", "+1 (202) 555.0123", "55", "12025550123@s.whatsapp.net"},
+		{"uk with 00 prefix", "0044 20 7946 0958", "55", "442079460958@s.whatsapp.net"},
+		{"portugal national number with country code", "912 345 678", "351", "351912345678@s.whatsapp.net"},
+		{"germany with trunk prefix", "030 1234-5678", "49", "493012345678@s.whatsapp.net"},
+		{"india with surrounding spaces", "  +91 98765 43210  ", "", "919876543210@s.whatsapp.net"},
+		{"legacy contact server", "5511999999999@c.us", "55", "5511999999999@s.whatsapp.net"},
+		{"contact JID is kept", "5511999999999@s.whatsapp.net", "1", "5511999999999@s.whatsapp.net"},
+		{"lid JID is kept", "123456789012345@lid", "55", "123456789012345@lid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utils.FormatWhatsAppJID(tt.phone, tt.countryCode))
+		})
+	}
+}
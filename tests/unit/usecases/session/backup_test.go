@@ -0,0 +1,143 @@
+package usecases_session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	sessionUC "wazmeow/internal/usecases/session"
+	"wazmeow/pkg/crypto"
+)
+
+// fakeDeviceStore keeps serialized devices in memory
+type fakeDeviceStore struct {
+	devices map[string][]byte
+}
+
+func newFakeDeviceStore() *fakeDeviceStore {
+	return &fakeDeviceStore{devices: make(map[string][]byte)}
+}
+
+func (f *fakeDeviceStore) ExportDevice(ctx context.Context, jid string) ([]byte, error) {
+	data, ok := f.devices[jid]
+	if !ok {
+		return nil, whatsapp.ErrDeviceNotFound
+	}
+	return data, nil
+}
+
+func (f *fakeDeviceStore) ImportDevice(ctx context.Context, data []byte) (string, error) {
+	jid := "5511999999999:12@s.whatsapp.net"
+	if _, exists := f.devices[jid]; exists {
+		return "", whatsapp.ErrDeviceAlreadyExists
+	}
+	f.devices[jid] = data
+	return jid, nil
+}
+
+func (f *fakeDeviceStore) DeleteDevice(ctx context.Context, jid string) error {
+	delete(f.devices, jid)
+	return nil
+}
+
+func newBackupMocks() (*MockSessionRepository, *MockLogger, *MockValidator) {
+	mockRepo := new(MockSessionRepository)
+	mockLogger := new(MockLogger)
+	mockValidator := new(MockValidator)
+
+	mockValidator.On("Validate", mock.Anything).Return(nil)
+	mockLogger.On("InfoWithFields", mock.Anything, mock.Anything).Return().Maybe()
+	mockLogger.On("WarnWithFields", mock.Anything, mock.Anything).Return().Maybe()
+	mockLogger.On("ErrorWithError", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+	return mockRepo, mockLogger, mockValidator
+}
+
+func TestSessionBackupUseCases(t *testing.T) {
+	jid := "5511999999999:12@s.whatsapp.net"
+
+	t.Run("should restore an exported session without pairing", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+		original := session.RestoreSession(session.NewSessionID(), "source-session", "Vendas", session.StatusConnected, jid, "", "socks5://10.0.0.1:1080", true, now, now)
+		deviceStore := newFakeDeviceStore()
+		deviceStore.devices[jid] = []byte(`{"device":"keys"}`)
+		ctx := context.Background()
+
+		exportRepo, exportLogger, exportValidator := newBackupMocks()
+		exportRepo.On("GetByID", ctx, original.ID()).Return(original, nil)
+		exportUC := sessionUC.NewExportBackupUseCase(exportRepo, deviceStore, exportLogger, exportValidator)
+
+		exported, err := exportUC.Execute(ctx, sessionUC.ExportBackupRequest{SessionID: original.ID(), Passphrase: "correct horse"})
+		require.NoError(t, err)
+
+		// Moving to a new instance: the device only exists in the backup
+		delete(deviceStore.devices, jid)
+
+		importRepo, importLogger, importValidator := newBackupMocks()
+		importRepo.On("ExistsByName", ctx, "migrated-session").Return(false, nil)
+		importRepo.On("Create", ctx, mock.AnythingOfType("*session.Session")).Return(nil)
+		importUC := sessionUC.NewImportBackupUseCase(importRepo, deviceStore, importLogger, importValidator)
+
+		// Act
+		result, err := importUC.Execute(ctx, sessionUC.ImportBackupRequest{
+			Backup:     exported.Backup,
+			Passphrase: "correct horse",
+			Name:       "migrated-session",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "migrated-session", result.Session.Name())
+		assert.Equal(t, "Vendas", result.Session.DisplayName())
+		assert.Equal(t, jid, result.Session.WaJID())
+		assert.Equal(t, session.StatusDisconnected, result.Session.Status())
+		assert.Equal(t, "socks5://10.0.0.1:1080", result.Session.ProxyURL())
+		assert.NotEqual(t, original.ID(), result.Session.ID())
+		assert.Equal(t, []byte(`{"device":"keys"}`), deviceStore.devices[jid])
+		importRepo.AssertExpectations(t)
+	})
+
+	t.Run("should not export sessions without a paired device", func(t *testing.T) {
+		// Arrange
+		unpaired := session.NewSession("unpaired-session")
+		ctx := context.Background()
+
+		mockRepo, mockLogger, mockValidator := newBackupMocks()
+		mockRepo.On("GetByID", ctx, unpaired.ID()).Return(unpaired, nil)
+		useCase := sessionUC.NewExportBackupUseCase(mockRepo, newFakeDeviceStore(), mockLogger, mockValidator)
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ExportBackupRequest{SessionID: unpaired.ID(), Passphrase: "correct horse"})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionNotPaired, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should reject a wrong passphrase before touching the store", func(t *testing.T) {
+		// Arrange
+		envelope, err := crypto.Seal("correct horse", []byte(`{"version":1}`))
+		require.NoError(t, err)
+
+		mockRepo, mockLogger, mockValidator := newBackupMocks()
+		useCase := sessionUC.NewImportBackupUseCase(mockRepo, newFakeDeviceStore(), mockLogger, mockValidator)
+
+		// Act
+		result, err := useCase.Execute(context.Background(), sessionUC.ImportBackupRequest{
+			Backup:     envelope,
+			Passphrase: "battery staple",
+		})
+
+		// Assert
+		assert.Equal(t, crypto.ErrDecryptionFailed, err)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,145 @@
+package usecases_session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"wazmeow/internal/domain/session"
+	sessionUC "wazmeow/internal/usecases/session"
+	"wazmeow/pkg/validator"
+)
+
+func newBatchCreateUseCase(mockRepo *MockSessionRepository, mockLogger *MockLogger, mockValidator *MockValidator) *sessionUC.BatchCreateUseCase {
+	return sessionUC.NewBatchCreateUseCase(
+		sessionUC.NewCreateUseCase(mockRepo, mockLogger, mockValidator),
+		sessionUC.NewSetProxyUseCase(mockRepo, mockLogger, mockValidator),
+		mockLogger,
+		mockValidator,
+	)
+}
+
+func TestBatchCreateUseCase(t *testing.T) {
+	t.Run("should create all sessions of the batch", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		mockValidator := new(MockValidator)
+		useCase := newBatchCreateUseCase(mockRepo, mockLogger, mockValidator)
+		ctx := context.Background()
+
+		req := sessionUC.BatchCreateRequest{Items: []sessionUC.BatchCreateItem{
+			{Name: "session-one"},
+			{Name: "session-two"},
+		}}
+
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+		mockRepo.On("GetByName", ctx, mock.AnythingOfType("string")).Return(nil, session.ErrSessionNotFound)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*session.Session")).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 0, result.Failed)
+		assert.Len(t, result.Results, 2)
+		assert.Equal(t, "session-one", result.Results[0].Session.Name())
+		assert.Equal(t, 1, result.Results[1].Index)
+		assert.Equal(t, "session-two", result.Results[1].Session.Name())
+		mockRepo.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("should keep going when a name already exists", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		mockValidator := new(MockValidator)
+		useCase := newBatchCreateUseCase(mockRepo, mockLogger, mockValidator)
+		ctx := context.Background()
+
+		existing := session.NewSession("taken")
+		req := sessionUC.BatchCreateRequest{Items: []sessionUC.BatchCreateItem{
+			{Name: "taken"},
+			{Name: "fresh"},
+		}}
+
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+		mockRepo.On("GetByName", ctx, "taken").Return(existing, nil)
+		mockRepo.On("GetByName", ctx, "fresh").Return(nil, session.ErrSessionNotFound)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*session.Session")).Return(nil)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, session.ErrSessionAlreadyExists, result.Results[0].Error)
+		assert.Nil(t, result.Results[0].Session)
+		assert.NoError(t, result.Results[1].Error)
+		assert.Equal(t, "fresh", result.Results[1].Session.Name())
+	})
+
+	t.Run("should fail duplicate names within the batch", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		mockValidator := new(MockValidator)
+		useCase := newBatchCreateUseCase(mockRepo, mockLogger, mockValidator)
+		ctx := context.Background()
+
+		req := sessionUC.BatchCreateRequest{Items: []sessionUC.BatchCreateItem{
+			{Name: "twin"},
+			{Name: "twin"},
+		}}
+
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+		mockRepo.On("GetByName", ctx, "twin").Return(nil, session.ErrSessionNotFound).Once()
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*session.Session")).Return(nil).Once()
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.NoError(t, result.Results[0].Error)
+		assert.Equal(t, session.ErrSessionAlreadyExists, result.Results[1].Error)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject an oversized batch", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		mockValidator := new(MockValidator)
+		useCase := newBatchCreateUseCase(mockRepo, mockLogger, mockValidator)
+		ctx := context.Background()
+
+		req := sessionUC.BatchCreateRequest{Items: make([]sessionUC.BatchCreateItem, sessionUC.MaxBatchCreateSize+1)}
+		validationErr := validator.ValidationErrors{
+			validator.ValidationError{Field: "items", Tag: "max", Message: "too many items"},
+		}
+
+		mockValidator.On("Validate", req).Return(validationErr)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), validationErr, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,102 @@
+package usecases_session
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	sessionUC "wazmeow/internal/usecases/session"
+)
+
+func TestConnectAllUseCase(t *testing.T) {
+	t.Run("should report per-session outcomes and counts", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewConnectAllUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+
+		connected := session.NewSession("connected-now")
+		already := session.NewSession("already-connected")
+		failing := session.NewSession("failing")
+		connectErr := errors.New("dial tcp: connection refused")
+
+		mockManager.On("ConnectAll", ctx).Return([]whatsapp.BulkResult{
+			{SessionID: connected.ID(), Changed: true, Status: whatsapp.StatusConnected},
+			{SessionID: already.ID(), Status: whatsapp.StatusConnected},
+			{SessionID: failing.ID(), Status: whatsapp.StatusDisconnected, Error: connectErr},
+		}, nil)
+		mockRepo.On("GetByID", ctx, connected.ID()).Return(connected, nil)
+		mockRepo.On("GetByID", ctx, already.ID()).Return(already, nil)
+		mockRepo.On("GetByID", ctx, failing.ID()).Return(nil, session.ErrSessionNotFound)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 1, result.Changed)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, "connected-now", result.Results[0].Name)
+		assert.False(t, result.Results[1].Changed)
+		assert.Equal(t, connectErr, result.Results[2].Error)
+		assert.Empty(t, result.Results[2].Name)
+		mockManager.AssertExpectations(t)
+	})
+
+	t.Run("should fail when the manager fails", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewConnectAllUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+
+		mockManager.On("ConnectAll", ctx).Return(nil, whatsapp.ErrManagerNotRunning)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), whatsapp.ErrManagerNotRunning, mock.Anything).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx)
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrManagerNotRunning, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDisconnectAllUseCase(t *testing.T) {
+	t.Run("should count disconnected sessions", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewDisconnectAllUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+
+		sess := session.NewSession("maintenance")
+		mockManager.On("DisconnectAll", ctx).Return([]whatsapp.BulkResult{
+			{SessionID: sess.ID(), Changed: true, Status: whatsapp.StatusDisconnected},
+		}, nil)
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Total)
+		assert.Equal(t, 1, result.Changed)
+		assert.Equal(t, 0, result.Failed)
+		assert.Equal(t, "maintenance", result.Results[0].Name)
+		assert.Equal(t, whatsapp.StatusDisconnected, result.Results[0].Status)
+	})
+}
@@ -0,0 +1,128 @@
+package usecases_session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	sessionUC "wazmeow/internal/usecases/session"
+)
+
+func TestConnectStatusUseCase(t *testing.T) {
+	t.Run("should return the current pairing status", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		mockClient := new(MockWhatsAppClient)
+
+		useCase := sessionUC.NewConnectStatusUseCase(mockRepo, mockWAManager, mockLogger)
+		sess := session.NewSession("test-session")
+		sess.SetConnecting()
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("GetPairingStatus").Return(&whatsapp.PairingStatus{
+			State:  whatsapp.PairingStateQRReady,
+			QRCode: "data:image/png;base64,abc",
+		})
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectStatusRequest{SessionID: sess.ID()})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, whatsapp.PairingStateQRReady, result.Status.State)
+		assert.Equal(t, "data:image/png;base64,abc", result.Status.QRCode)
+		mockClient.AssertNumberOfCalls(t, "GetPairingStatus", 1)
+	})
+
+	t.Run("should wait until the attempt leaves the pending state", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		mockClient := new(MockWhatsAppClient)
+
+		useCase := sessionUC.NewConnectStatusUseCase(mockRepo, mockWAManager, mockLogger)
+		sess := session.NewSession("test-session")
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("GetPairingStatus").Return(&whatsapp.PairingStatus{State: whatsapp.PairingStatePending}).Twice()
+		mockClient.On("GetPairingStatus").Return(&whatsapp.PairingStatus{
+			State:       whatsapp.PairingStateCodeReady,
+			PairingCode: "ABCD-EFGH",
+		})
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectStatusRequest{
+			SessionID:   sess.ID(),
+			WaitTimeout: 5 * time.Second,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, whatsapp.PairingStateCodeReady, result.Status.State)
+		assert.Equal(t, "ABCD-EFGH", result.Status.PairingCode)
+	})
+
+	t.Run("should stop waiting when the timeout expires", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		mockClient := new(MockWhatsAppClient)
+
+		useCase := sessionUC.NewConnectStatusUseCase(mockRepo, mockWAManager, mockLogger)
+		sess := session.NewSession("test-session")
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("GetPairingStatus").Return(&whatsapp.PairingStatus{State: whatsapp.PairingStatePending})
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectStatusRequest{
+			SessionID:   sess.ID(),
+			WaitTimeout: 600 * time.Millisecond,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, whatsapp.PairingStatePending, result.Status.State)
+	})
+
+	t.Run("should fail when no connection was requested", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewConnectStatusUseCase(mockRepo, mockWAManager, mockLogger)
+		sess := session.NewSession("test-session")
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(nil, whatsapp.ErrClientNotFound)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectStatusRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, session.ErrConnectionNotStarted, err)
+	})
+}
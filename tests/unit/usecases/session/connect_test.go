@@ -2,10 +2,13 @@ package usecases_session
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"wazmeow/internal/domain/session"
 	"wazmeow/internal/domain/whatsapp"
@@ -48,8 +51,8 @@ func (m *MockWhatsAppManager) Start(ctx context.Context) error {
 	return args.Error(0)
 }
 
-func (m *MockWhatsAppManager) Stop() error {
-	args := m.Called()
+func (m *MockWhatsAppManager) Stop(ctx context.Context) error {
+	args := m.Called(ctx)
 	return args.Error(0)
 }
 
@@ -63,6 +66,35 @@ func (m *MockWhatsAppManager) HealthCheck() error {
 	return args.Error(0)
 }
 
+func (m *MockWhatsAppManager) ConnectAll(ctx context.Context) ([]whatsapp.BulkResult, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]whatsapp.BulkResult), args.Error(1)
+}
+
+func (m *MockWhatsAppManager) DisconnectAll(ctx context.Context) ([]whatsapp.BulkResult, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]whatsapp.BulkResult), args.Error(1)
+}
+
+func (m *MockWhatsAppManager) RestartClient(sessionID session.SessionID) error {
+	args := m.Called(sessionID)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppManager) AcquireConnect(sessionID session.SessionID) (func(), error) {
+	args := m.Called(sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(func()), args.Error(1)
+}
+
 // MockWhatsAppClient is a mock implementation of whatsapp.Client
 type MockWhatsAppClient struct {
 	mock.Mock
@@ -81,6 +113,24 @@ func (m *MockWhatsAppClient) Disconnect(ctx context.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockWhatsAppClient) Logout(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) GetNewsletterInfo(ctx context.Context, newsletterJID string) (*whatsapp.NewsletterInfo, error) {
+	args := m.Called(ctx, newsletterJID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.NewsletterInfo), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) SendNewsletterMessage(ctx context.Context, newsletterJID, text string) error {
+	args := m.Called(ctx, newsletterJID, text)
+	return args.Error(0)
+}
+
 func (m *MockWhatsAppClient) IsConnected() bool {
 	args := m.Called()
 	return args.Bool(0)
@@ -91,14 +141,24 @@ func (m *MockWhatsAppClient) GetConnectionStatus() whatsapp.ConnectionStatus {
 	return args.Get(0).(whatsapp.ConnectionStatus)
 }
 
+func (m *MockWhatsAppClient) GetQRCode() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockWhatsAppClient) QRCodeExpiresAt() time.Time {
+	args := m.Called()
+	return args.Get(0).(time.Time)
+}
+
 func (m *MockWhatsAppClient) GenerateQR(ctx context.Context) (string, error) {
 	args := m.Called(ctx)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockWhatsAppClient) PairPhone(ctx context.Context, phoneNumber string) error {
-	args := m.Called(ctx, phoneNumber)
-	return args.Error(0)
+func (m *MockWhatsAppClient) PairPhone(ctx context.Context, phoneNumber string, opts whatsapp.PairPhoneOptions) (string, error) {
+	args := m.Called(ctx, phoneNumber, opts)
+	return args.String(0), args.Error(1)
 }
 
 func (m *MockWhatsAppClient) IsAuthenticated() bool {
@@ -106,6 +166,11 @@ func (m *MockWhatsAppClient) IsAuthenticated() bool {
 	return args.Bool(0)
 }
 
+func (m *MockWhatsAppClient) SetProxy(ctx context.Context, proxyURL string) error {
+	args := m.Called(ctx, proxyURL)
+	return args.Error(0)
+}
+
 func (m *MockWhatsAppClient) GetSessionID() session.SessionID {
 	args := m.Called()
 	return args.Get(0).(session.SessionID)
@@ -124,9 +189,33 @@ func (m *MockWhatsAppClient) GetDeviceInfo() *whatsapp.DeviceInfo {
 	return args.Get(0).(*whatsapp.DeviceInfo)
 }
 
-func (m *MockWhatsAppClient) SendMessage(ctx context.Context, to, message string) error {
+func (m *MockWhatsAppClient) GetSyncStatus() *whatsapp.SyncStatus {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*whatsapp.SyncStatus)
+}
+
+func (m *MockWhatsAppClient) GetPairingStatus() *whatsapp.PairingStatus {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*whatsapp.PairingStatus)
+}
+
+func (m *MockWhatsAppClient) GetHealth() *whatsapp.ClientHealth {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*whatsapp.ClientHealth)
+}
+
+func (m *MockWhatsAppClient) SendMessage(ctx context.Context, to, message string) (string, error) {
 	args := m.Called(ctx, to, message)
-	return args.Error(0)
+	return args.String(0), args.Error(1)
 }
 
 func (m *MockWhatsAppClient) SendImage(ctx context.Context, to, imagePath, caption string) error {
@@ -139,14 +228,221 @@ func (m *MockWhatsAppClient) SendDocument(ctx context.Context, to, documentPath,
 	return args.Error(0)
 }
 
+func (m *MockWhatsAppClient) SendPoll(ctx context.Context, to, question string, options []string, selectableCount int) (string, error) {
+	args := m.Called(ctx, to, question, options, selectableCount)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) SendSticker(ctx context.Context, to string, stickerData []byte) (string, error) {
+	args := m.Called(ctx, to, stickerData)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) SendButtons(ctx context.Context, to, body string, buttons []whatsapp.Button) (string, error) {
+	args := m.Called(ctx, to, body, buttons)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) SendList(ctx context.Context, to, body, buttonText string, sections []whatsapp.ListSection) (string, error) {
+	args := m.Called(ctx, to, body, buttonText, sections)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) ArchiveChat(ctx context.Context, chatJID string, archive bool) (*whatsapp.ChatState, error) {
+	args := m.Called(ctx, chatJID, archive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.ChatState), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) MuteChat(ctx context.Context, chatJID string, until time.Time) (*whatsapp.ChatState, error) {
+	args := m.Called(ctx, chatJID, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.ChatState), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) DeleteChat(ctx context.Context, chatJID string) error {
+	args := m.Called(ctx, chatJID)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) ClearChat(ctx context.Context, chatJID string) error {
+	args := m.Called(ctx, chatJID)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) GetChats(ctx context.Context) (*whatsapp.ChatList, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.ChatList), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) GetLabels(ctx context.Context) ([]*whatsapp.Label, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*whatsapp.Label), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) CreateLabel(ctx context.Context, name string, color int) (*whatsapp.Label, error) {
+	args := m.Called(ctx, name, color)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.Label), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) LabelChat(ctx context.Context, chatJID, labelID string, labeled bool) error {
+	args := m.Called(ctx, chatJID, labelID, labeled)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) LabelMessage(ctx context.Context, chatJID, messageID, labelID string, labeled bool) error {
+	args := m.Called(ctx, chatJID, messageID, labelID, labeled)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) MarkRead(ctx context.Context, chatJID, senderJID string, messageIDs []string) error {
+	args := m.Called(ctx, chatJID, senderJID, messageIDs)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) MarkChatUnread(ctx context.Context, chatJID string) (*whatsapp.ChatState, error) {
+	args := m.Called(ctx, chatJID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.ChatState), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) DownloadMedia(ctx context.Context, messageID string) (*whatsapp.MediaContent, error) {
+	args := m.Called(ctx, messageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.MediaContent), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) ForwardMessage(ctx context.Context, fromChat, messageID, toChat string) (string, error) {
+	args := m.Called(ctx, fromChat, messageID, toChat)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) GetJoinedGroups(ctx context.Context) ([]*whatsapp.GroupInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*whatsapp.GroupInfo), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) GetGroupInfo(ctx context.Context, groupJID string) (*whatsapp.GroupInfo, error) {
+	args := m.Called(ctx, groupJID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.GroupInfo), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) UpdateGroupParticipants(ctx context.Context, groupJID string, participants []string, action string) ([]*whatsapp.ParticipantUpdateResult, error) {
+	args := m.Called(ctx, groupJID, participants, action)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*whatsapp.ParticipantUpdateResult), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) GetContacts(ctx context.Context) ([]*whatsapp.ContactInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*whatsapp.ContactInfo), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) IsOnWhatsApp(ctx context.Context, phones []string) ([]*whatsapp.NumberCheckResult, error) {
+	args := m.Called(ctx, phones)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*whatsapp.NumberCheckResult), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) GetProfilePicture(ctx context.Context, jid string, preview bool) (*whatsapp.ProfilePictureInfo, error) {
+	args := m.Called(ctx, jid, preview)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.ProfilePictureInfo), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) GetBusinessProfile(ctx context.Context, jid string) (*whatsapp.BusinessProfile, error) {
+	args := m.Called(ctx, jid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.BusinessProfile), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) SetProfilePicture(ctx context.Context, imageData []byte) (string, error) {
+	args := m.Called(ctx, imageData)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWhatsAppClient) SendPresence(ctx context.Context, state whatsapp.PresenceState) error {
+	args := m.Called(ctx, state)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) HasSentPresence() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+func (m *MockWhatsAppClient) SubscribePresence(ctx context.Context, jid string) error {
+	args := m.Called(ctx, jid)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) SendChatPresence(ctx context.Context, chatJID string, state whatsapp.ChatPresenceState) error {
+	args := m.Called(ctx, chatJID, state)
+	return args.Error(0)
+}
+
+func (m *MockWhatsAppClient) RecentMessages(since uint64) (*whatsapp.RecentMessages, error) {
+	args := m.Called(since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*whatsapp.RecentMessages), args.Error(1)
+}
+
 func (m *MockWhatsAppClient) SetEventHandler(handler whatsapp.EventHandler) {
 	m.Called(handler)
 }
 
+func (m *MockWhatsAppClient) AddEventHandler(handler whatsapp.EventHandler) func() {
+	args := m.Called(handler)
+	if remove := args.Get(0); remove != nil {
+		return remove.(func())
+	}
+	return func() {}
+}
+
 func (m *MockWhatsAppClient) RemoveEventHandler() {
 	m.Called()
 }
 
+func (m *MockWhatsAppClient) SetAllowedSenders(senders []string) {
+	m.Called(senders)
+}
+
 func (m *MockWhatsAppClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -171,6 +467,7 @@ func TestConnectUseCase(t *testing.T) {
 
 		// Mock expectations
 		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("AcquireConnect", sess.ID()).Return(func() {}, nil)
 		mockWAManager.On("GetClient", sess.ID()).Return(nil, whatsapp.ErrClientNotFound)
 		mockWAManager.On("CreateClient", sess.ID()).Return(mockClient, nil)
 		mockClient.On("Connect", ctx).Return(&whatsapp.ConnectionResult{
@@ -195,4 +492,128 @@ func TestConnectUseCase(t *testing.T) {
 		mockClient.AssertExpectations(t)
 		mockLogger.AssertExpectations(t)
 	})
+
+	t.Run("should log out a session whose device was unlinked", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		mockClient := new(MockWhatsAppClient)
+
+		useCase := sessionUC.NewConnectUseCase(mockRepo, mockWAManager, mockLogger)
+
+		now := time.Now()
+		sess := session.RestoreSession(session.NewSessionID(), "unlinked-session", "", session.StatusDisconnected,
+			"5511999999999@s.whatsapp.net", "", "", true, now, now)
+		ctx := context.Background()
+		connErr := whatsapp.NewConnectionError(whatsapp.ConnectionFailureNotPaired, errors.New("connection failure: 401"))
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("AcquireConnect", sess.ID()).Return(func() {}, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("Connect", ctx).Return(nil, connErr)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*session.Session")).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.Nil(t, result)
+		var gotErr *whatsapp.ConnectionError
+		require.True(t, errors.As(err, &gotErr))
+		assert.Equal(t, whatsapp.ConnectionFailureNotPaired, gotErr.Reason)
+		assert.Equal(t, session.StatusDisconnected, sess.Status())
+		assert.Equal(t, session.DisconnectReasonLoggedOut, sess.DisconnectReason())
+		assert.Empty(t, sess.WaJID())
+	})
+
+	t.Run("should reject a connect while another one is in progress", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewConnectUseCase(mockRepo, mockWAManager, mockLogger)
+
+		sess := session.NewSession("test-session")
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("AcquireConnect", sess.ID()).Return(nil, whatsapp.ErrConnectInProgress)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.Equal(t, whatsapp.ErrConnectInProgress, err)
+		assert.Nil(t, result)
+		assert.Equal(t, session.StatusDisconnected, sess.Status())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockWAManager.AssertNotCalled(t, "CreateClient", mock.Anything)
+	})
+
+	t.Run("should recreate the client of a session stored as connected without one", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockClient := new(MockWhatsAppClient)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewConnectUseCase(mockRepo, mockWAManager, mockLogger)
+
+		now := time.Now()
+		sess := session.RestoreSession(session.NewSessionID(), "stale-session", "", session.StatusConnected, "5511999999999@s.whatsapp.net", "", "", true, now, now)
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(nil, whatsapp.ErrClientNotFound)
+		mockWAManager.On("AcquireConnect", sess.ID()).Return(func() {}, nil)
+		mockWAManager.On("CreateClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("Connect", ctx).Return(&whatsapp.ConnectionResult{
+			JID:    "5511999999999@s.whatsapp.net",
+			Status: whatsapp.StatusConnected,
+		}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*session.Session")).Return(nil)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, session.StatusConnected, result.Session.Status())
+		mockWAManager.AssertCalled(t, "CreateClient", sess.ID())
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("should reject a session that is connected with a live client", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockClient := new(MockWhatsAppClient)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewConnectUseCase(mockRepo, mockWAManager, mockLogger)
+
+		now := time.Now()
+		sess := session.RestoreSession(session.NewSessionID(), "live-session", "", session.StatusConnected, "5511999999999@s.whatsapp.net", "", "", true, now, now)
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ConnectRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionAlreadyConnected, err)
+		assert.Nil(t, result)
+		mockWAManager.AssertNotCalled(t, "AcquireConnect", mock.Anything)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
 }
@@ -195,3 +195,56 @@ func TestCreateUseCase(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 }
+
+func TestCreateUseCase_ValidateOnly(t *testing.T) {
+	t.Run("should return the session that would be created without persisting it", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		mockValidator := new(MockValidator)
+
+		useCase := sessionUC.NewCreateUseCase(mockRepo, mockLogger, mockValidator)
+
+		req := sessionUC.CreateRequest{Name: "test-session"}
+		ctx := context.Background()
+
+		mockValidator.On("Validate", req).Return(nil)
+		mockRepo.On("GetByName", ctx, "test-session").Return(nil, session.ErrSessionNotFound)
+
+		// Act
+		result, err := useCase.ValidateOnly(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "test-session", result.Session.Name())
+		assert.Equal(t, session.StatusDisconnected, result.Session.Status())
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		mockValidator.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should report a name that is already taken", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		mockValidator := new(MockValidator)
+
+		useCase := sessionUC.NewCreateUseCase(mockRepo, mockLogger, mockValidator)
+
+		req := sessionUC.CreateRequest{Name: "test-session"}
+		ctx := context.Background()
+		existing := session.NewSession("test-session")
+
+		mockValidator.On("Validate", req).Return(nil)
+		mockRepo.On("GetByName", ctx, "test-session").Return(existing, nil)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.ValidateOnly(ctx, req)
+
+		// Assert
+		assert.ErrorIs(t, err, session.ErrSessionAlreadyExists)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
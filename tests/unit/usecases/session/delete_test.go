@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 	sessionUC "wazmeow/internal/usecases/session"
+	"wazmeow/pkg/logger"
 )
 
 func TestDeleteUseCase(t *testing.T) {
@@ -19,7 +21,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockWAManager := new(MockWhatsAppManager)
 		mockLogger := new(MockLogger)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		// Create a disconnected session
 		sess := session.NewSession("test-session")
@@ -33,6 +35,7 @@ func TestDeleteUseCase(t *testing.T) {
 		// Mock expectations
 		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
 		// No GetClient call for disconnected sessions
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
 		mockRepo.On("Delete", ctx, sess.ID()).Return(nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
@@ -58,7 +61,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockLogger := new(MockLogger)
 		mockClient := new(MockWhatsAppClient)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		// Create a connected session
 		sess := session.NewSession("test-session")
@@ -102,7 +105,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockWAManager := new(MockWhatsAppManager)
 		mockLogger := new(MockLogger)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		sessionID := session.NewSessionID()
 		req := sessionUC.DeleteRequest{
@@ -136,7 +139,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockWAManager := new(MockWhatsAppManager)
 		mockLogger := new(MockLogger)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		// Create a disconnected session
 		sess := session.NewSession("test-session")
@@ -151,6 +154,7 @@ func TestDeleteUseCase(t *testing.T) {
 		// Mock expectations
 		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
 		// No GetClient call for disconnected sessions
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
 		mockRepo.On("Delete", ctx, sess.ID()).Return(deleteErr)
 		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), deleteErr, mock.AnythingOfType("logger.Fields")).Return()
 
@@ -175,7 +179,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockLogger := new(MockLogger)
 		mockClient := new(MockWhatsAppClient)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		// Create a connected session
 		sess := session.NewSession("test-session")
@@ -221,7 +225,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockLogger := new(MockLogger)
 		mockClient := new(MockWhatsAppClient)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		// Create a connected session
 		sess := session.NewSession("test-session")
@@ -266,7 +270,7 @@ func TestDeleteUseCase(t *testing.T) {
 		mockWAManager := new(MockWhatsAppManager)
 		mockLogger := new(MockLogger)
 
-		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, mockLogger)
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), nil, mockLogger)
 
 		// Create a connecting session
 		sess := session.NewSession("test-session")
@@ -280,6 +284,7 @@ func TestDeleteUseCase(t *testing.T) {
 
 		// Mock expectations - connecting session doesn't need disconnection
 		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("RemoveClient", sess.ID()).Return(nil)
 		mockRepo.On("Delete", ctx, sess.ID()).Return(nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
@@ -295,4 +300,173 @@ func TestDeleteUseCase(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 		mockLogger.AssertExpectations(t)
 	})
+
+	t.Run("should delete the stored device along with the session", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		deviceStore := newFakeDeviceStore()
+
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, deviceStore, nil, mockLogger)
+
+		// Create a paired session that is currently disconnected
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("5511999999999:12@s.whatsapp.net"))
+		sess.Disconnect()
+		deviceStore.devices[sess.WaJID()] = []byte("device")
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
+		mockRepo.On("Delete", ctx, sess.ID()).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.DeleteRequest{SessionID: sess.ID()})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, sess.ID(), result.SessionID)
+		assert.NotContains(t, deviceStore.devices, sess.WaJID())
+		mockRepo.AssertCalled(t, "Delete", ctx, sess.ID())
+		mockWAManager.AssertExpectations(t)
+	})
+
+	t.Run("should keep the session when the device cannot be deleted", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		deviceErr := assert.AnError
+
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, failingDeviceStore{fakeDeviceStore: newFakeDeviceStore(), err: deviceErr}, nil, mockLogger)
+
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("5511999999999:12@s.whatsapp.net"))
+		sess.Disconnect()
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), deviceErr, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.DeleteRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.ErrorIs(t, err, deviceErr)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Delete", ctx, sess.ID())
+	})
+
+	t.Run("should ignore devices that no longer exist", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, failingDeviceStore{fakeDeviceStore: newFakeDeviceStore(), err: whatsapp.ErrDeviceNotFound}, nil, mockLogger)
+
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("5511999999999:12@s.whatsapp.net"))
+		sess.Disconnect()
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
+		mockRepo.On("Delete", ctx, sess.ID()).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		_, err := useCase.Execute(ctx, sessionUC.DeleteRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should purge the stored data of the session", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		messages := &fakeSessionDataRepository{count: 3}
+		chats := &fakeSessionDataRepository{count: 1}
+
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), []whatsapp.SessionDataRepository{messages, chats}, mockLogger)
+
+		sess := session.NewSession("test-session")
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
+		mockRepo.On("Delete", ctx, sess.ID()).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.MatchedBy(func(fields logger.Fields) bool {
+			return fields["purged"] == 4
+		})).Return()
+
+		// Act
+		_, err := useCase.Execute(ctx, sessionUC.DeleteRequest{SessionID: sess.ID()})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []session.SessionID{sess.ID()}, messages.deleted)
+		assert.Equal(t, []session.SessionID{sess.ID()}, chats.deleted)
+		mockRepo.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("should keep the session when its data cannot be purged", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		purgeErr := assert.AnError
+
+		useCase := sessionUC.NewDeleteUseCase(mockRepo, mockWAManager, newFakeDeviceStore(), []whatsapp.SessionDataRepository{&fakeSessionDataRepository{err: purgeErr}}, mockLogger)
+
+		sess := session.NewSession("test-session")
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("RemoveClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), purgeErr, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.DeleteRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.ErrorIs(t, err, purgeErr)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Delete", ctx, sess.ID())
+	})
+}
+
+// fakeSessionDataRepository records the sessions it purges, deleting count rows for each
+type fakeSessionDataRepository struct {
+	count   int
+	err     error
+	deleted []session.SessionID
+}
+
+func (f *fakeSessionDataRepository) DeleteBySession(ctx context.Context, sessionID session.SessionID) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.deleted = append(f.deleted, sessionID)
+	return f.count, nil
+}
+
+// failingDeviceStore fails every device deletion with err
+type failingDeviceStore struct {
+	*fakeDeviceStore
+	err error
+}
+
+func (f failingDeviceStore) DeleteDevice(ctx context.Context, jid string) error {
+	return f.err
 }
@@ -2,6 +2,7 @@ package usecases_session
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
 	sessionUC "wazmeow/internal/usecases/session"
 )
 
@@ -49,6 +51,7 @@ func TestDisconnectUseCase(t *testing.T) {
 		assert.Equal(t, sess, result.Session)
 		assert.Equal(t, session.StatusDisconnected, result.Session.Status())
 		assert.False(t, result.Session.IsActive())
+		assert.Equal(t, session.DisconnectReasonRequested, result.Session.DisconnectReason())
 		assert.NotEmpty(t, result.Message)
 
 		// Verify mocks
@@ -301,4 +304,84 @@ func TestDisconnectUseCase(t *testing.T) {
 		mockClient.AssertExpectations(t)
 		mockLogger.AssertExpectations(t)
 	})
+
+	t.Run("should log out the device and clear the JID when requested", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		mockClient := new(MockWhatsAppClient)
+		useCase := sessionUC.NewDisconnectUseCase(mockRepo, mockWAManager, mockLogger)
+		ctx := context.Background()
+
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("Logout", ctx).Return(nil)
+		mockRepo.On("Update", ctx, sess).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.DisconnectRequest{SessionID: sess.ID(), Logout: true})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, session.StatusDisconnected, result.Session.Status())
+		assert.Equal(t, session.DisconnectReasonLoggedOut, result.Session.DisconnectReason())
+		assert.Empty(t, result.Session.WaJID())
+		mockClient.AssertNotCalled(t, "Disconnect", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should refuse a full logout without a client", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewDisconnectUseCase(mockRepo, mockWAManager, mockLogger)
+		ctx := context.Background()
+
+		sess := session.NewSession("test-session")
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(nil, whatsapp.ErrClientNotFound)
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.DisconnectRequest{SessionID: sess.ID(), Logout: true})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionNotConnected, err)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep the session unchanged when the logout fails", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockWAManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		mockClient := new(MockWhatsAppClient)
+		useCase := sessionUC.NewDisconnectUseCase(mockRepo, mockWAManager, mockLogger)
+		ctx := context.Background()
+		logoutErr := errors.New("logout request timed out")
+
+		sess := session.NewSession("test-session")
+		require.NoError(t, sess.Connect("test@s.whatsapp.net"))
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockWAManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("Logout", ctx).Return(logoutErr)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), logoutErr, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.DisconnectRequest{SessionID: sess.ID(), Logout: true})
+
+		// Assert
+		assert.Equal(t, logoutErr, err)
+		assert.Nil(t, result)
+		assert.Equal(t, session.StatusConnected, sess.Status())
+		assert.Equal(t, "test@s.whatsapp.net", sess.WaJID())
+	})
 }
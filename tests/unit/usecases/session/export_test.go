@@ -0,0 +1,94 @@
+package usecases_session
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"wazmeow/internal/domain/session"
+	sessionUC "wazmeow/internal/usecases/session"
+)
+
+func TestExportUseCase(t *testing.T) {
+	t.Run("should page through all sessions in batches", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewExportUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+
+		firstPage := make([]*session.Session, 100)
+		for i := range firstPage {
+			firstPage[i] = session.NewSession("session-export")
+		}
+		secondPage := []*session.Session{session.NewSession("session-last")}
+
+		mockRepo.On("List", ctx, 100, 0).Return(firstPage, 101, nil)
+		mockRepo.On("List", ctx, 100, 100).Return(secondPage, 101, nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		var batches [][]*session.Session
+
+		// Act
+		result, err := useCase.Execute(ctx, func(sessions []*session.Session) error {
+			batches = append(batches, sessions)
+			return nil
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 101, result.Exported)
+		assert.Len(t, batches, 2)
+		assert.Equal(t, secondPage, batches[1])
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should not call write when there are no sessions", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewExportUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+
+		mockRepo.On("List", ctx, 100, 0).Return([]*session.Session{}, 0, nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, func(sessions []*session.Session) error {
+			t.Fatal("write should not be called")
+			return nil
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Exported)
+	})
+
+	t.Run("should stop when writing fails", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+
+		useCase := sessionUC.NewExportUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+		writeErr := errors.New("client went away")
+
+		mockRepo.On("List", ctx, 100, 0).Return([]*session.Session{session.NewSession("session-1")}, 1, nil)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), writeErr, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, func(sessions []*session.Session) error {
+			return writeErr
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, writeErr)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -3,6 +3,7 @@ package usecases_session
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,7 +36,7 @@ func TestListUseCase(t *testing.T) {
 		totalCount := 3
 
 		// Mock expectations
-		mockRepo.On("List", ctx, 10, 0).Return(sessions, totalCount, nil)
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(10, 0)).Return(sessions, totalCount, nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
 		// Act
@@ -72,7 +73,7 @@ func TestListUseCase(t *testing.T) {
 		totalCount := 0
 
 		// Mock expectations - should use default limit of 10
-		mockRepo.On("List", ctx, 10, 0).Return(sessions, totalCount, nil)
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(10, 0)).Return(sessions, totalCount, nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
 		// Act
@@ -106,7 +107,7 @@ func TestListUseCase(t *testing.T) {
 		totalCount := 0
 
 		// Mock expectations - should use maximum limit of 100
-		mockRepo.On("List", ctx, 100, 0).Return(sessions, totalCount, nil)
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(100, 0)).Return(sessions, totalCount, nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
 		// Act
@@ -140,7 +141,7 @@ func TestListUseCase(t *testing.T) {
 		totalCount := 0
 
 		// Mock expectations - should use offset 0
-		mockRepo.On("List", ctx, 10, 0).Return(sessions, totalCount, nil)
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(10, 0)).Return(sessions, totalCount, nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
 		// Act
@@ -172,7 +173,7 @@ func TestListUseCase(t *testing.T) {
 		repoErr := assert.AnError
 
 		// Mock expectations
-		mockRepo.On("List", ctx, 10, 0).Return(nil, 0, repoErr)
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(10, 0)).Return(nil, 0, repoErr)
 		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), repoErr, mock.AnythingOfType("logger.Fields")).Return()
 
 		// Act
@@ -206,7 +207,7 @@ func TestListUseCase(t *testing.T) {
 		totalCount := 0
 
 		// Mock expectations
-		mockRepo.On("List", ctx, 10, 0).Return(sessions, totalCount, nil)
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(10, 0)).Return(sessions, totalCount, nil)
 		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
 
 		// Act
@@ -222,6 +223,90 @@ func TestListUseCase(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 		mockLogger.AssertExpectations(t)
 	})
+
+	t.Run("should pass the filter and sorting to the repository and echo them back", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewListUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+
+		isActive := true
+		createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		filter := session.ListFilter{NameContains: "sales", IsActive: &isActive, CreatedAfter: &createdAfter}
+		options := session.ListOptions{Limit: 10, Offset: 0, Sort: session.SortByName, Order: session.SortOrderAsc}
+		sessions := []*session.Session{session.NewSession("sales-1")}
+
+		mockRepo.On("ListWithFilter", ctx, filter, options).Return(sessions, 1, nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ListRequest{Filter: filter, Sort: "name", Order: "ASC"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, sessions, result.Sessions)
+		assert.Equal(t, filter, result.Filter)
+		assert.Equal(t, session.SortByName, result.Sort)
+		assert.Equal(t, session.SortOrderAsc, result.Order)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should ignore pagination when all sessions are requested", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewListUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+		sessions := []*session.Session{session.NewSession("session-1")}
+
+		mockRepo.On("ListWithFilter", ctx, session.ListFilter{}, defaultListOptions(0, 0)).Return(sessions, 1, nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.ListRequest{Limit: 20, Offset: 40, All: true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Limit)
+		assert.Equal(t, 0, result.Offset)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject invalid sorting and date ranges", func(t *testing.T) {
+		after := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		cases := []struct {
+			name string
+			req  sessionUC.ListRequest
+			err  error
+		}{
+			{"sort field", sessionUC.ListRequest{Sort: "status"}, session.ErrInvalidSortField},
+			{"sort order", sessionUC.ListRequest{Order: "up"}, session.ErrInvalidSortOrder},
+			{"date range", sessionUC.ListRequest{Filter: session.ListFilter{CreatedAfter: &after, CreatedBefore: &before}}, session.ErrInvalidDateRange},
+		}
+
+		for _, tc := range cases {
+			// Arrange
+			mockRepo := new(MockSessionRepository)
+			mockLogger := new(MockLogger)
+			useCase := sessionUC.NewListUseCase(mockRepo, mockLogger)
+
+			// Act
+			result, err := useCase.Execute(context.Background(), tc.req)
+
+			// Assert
+			assert.Equal(t, tc.err, err, tc.name)
+			assert.Nil(t, result, tc.name)
+			mockRepo.AssertNotCalled(t, "ListWithFilter", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
+
+// defaultListOptions returns the repository options used when the request sets no sorting
+func defaultListOptions(limit, offset int) session.ListOptions {
+	return session.ListOptions{Limit: limit, Offset: offset, Sort: session.SortByCreatedAt, Order: session.SortOrderDesc}
 }
 
 func TestListUseCaseByStatus(t *testing.T) {
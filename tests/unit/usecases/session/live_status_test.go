@@ -0,0 +1,95 @@
+package usecases_session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	sessionUC "wazmeow/internal/usecases/session"
+)
+
+func TestLiveStatusUseCase(t *testing.T) {
+	storedAsConnected := func() *session.Session {
+		now := time.Now()
+		return session.RestoreSession(session.NewSessionID(), "stale", "", session.StatusConnected, "5511999999999@s.whatsapp.net", "", "", true, now, now)
+	}
+
+	t.Run("should report the client status even when the database disagrees", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockClient := new(MockWhatsAppClient)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewLiveStatusUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sess := storedAsConnected()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("GetConnectionStatus").Return(whatsapp.StatusDisconnected)
+		mockClient.On("IsConnected").Return(false)
+		mockClient.On("IsAuthenticated").Return(true)
+		mockClient.On("GetJID").Return("5511999999999:12@s.whatsapp.net")
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.LiveStatusRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "disconnected", result.Status)
+		assert.False(t, result.IsConnected)
+		assert.True(t, result.IsAuthenticated)
+		assert.Equal(t, "5511999999999:12@s.whatsapp.net", result.JID)
+		assert.Equal(t, sessionUC.StatusSourceClient, result.Source)
+	})
+
+	t.Run("should fall back to the persisted session without a client", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewLiveStatusUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sess := storedAsConnected()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockManager.On("GetClient", sess.ID()).Return(nil, whatsapp.ErrClientNotFound)
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.LiveStatusRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "connected", result.Status)
+		assert.False(t, result.IsConnected)
+		assert.True(t, result.IsAuthenticated)
+		assert.Equal(t, sess.WaJID(), result.JID)
+		assert.Equal(t, sessionUC.StatusSourceDatabase, result.Source)
+	})
+
+	t.Run("should fail when the session does not exist", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewLiveStatusUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+
+		mockRepo.On("GetByID", ctx, sessionID).Return(nil, session.ErrSessionNotFound)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), session.ErrSessionNotFound, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.LiveStatusRequest{SessionID: sessionID})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionNotFound, err)
+		assert.Nil(t, result)
+		mockManager.AssertNotCalled(t, "GetClient", mock.Anything)
+	})
+}
@@ -55,6 +55,14 @@ func (m *MockSessionRepository) List(ctx context.Context, limit, offset int) ([]
 	return args.Get(0).([]*session.Session), args.Int(1), args.Error(2)
 }
 
+func (m *MockSessionRepository) ListWithFilter(ctx context.Context, filter session.ListFilter, options session.ListOptions) ([]*session.Session, int, error) {
+	args := m.Called(ctx, filter, options)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*session.Session), args.Int(1), args.Error(2)
+}
+
 func (m *MockSessionRepository) GetByStatus(ctx context.Context, status session.Status, limit, offset int) ([]*session.Session, int, error) {
 	args := m.Called(ctx, status, limit, offset)
 	if args.Get(0) == nil {
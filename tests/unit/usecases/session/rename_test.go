@@ -0,0 +1,101 @@
+package usecases_session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"wazmeow/internal/domain/session"
+	sessionUC "wazmeow/internal/usecases/session"
+)
+
+func TestRenameUseCase(t *testing.T) {
+	t.Run("should rename the session", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRenameUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+		sess := session.NewSession("old-name")
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockRepo.On("GetByName", ctx, "new-name").Return(nil, session.ErrSessionNotFound)
+		mockRepo.On("Update", ctx, sess).Return(nil)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RenameRequest{SessionID: sess.ID(), Name: " new-name "})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "new-name", result.Session.Name())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject a name used by another session", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRenameUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+		sess := session.NewSession("old-name")
+		other := session.NewSession("taken")
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockRepo.On("GetByName", ctx, "taken").Return(other, nil)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RenameRequest{SessionID: sess.ID(), Name: "taken"})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionAlreadyExists, err)
+		assert.Nil(t, result)
+		assert.Equal(t, "old-name", sess.Name())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject names that break the naming rules", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRenameUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+		sess := session.NewSession("old-name")
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockRepo.On("GetByName", ctx, mock.AnythingOfType("string")).Return(nil, session.ErrSessionNotFound)
+		mockLogger.On("WarnWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		_, shortErr := useCase.Execute(ctx, sessionUC.RenameRequest{SessionID: sess.ID(), Name: "ab"})
+		_, charsErr := useCase.Execute(ctx, sessionUC.RenameRequest{SessionID: sess.ID(), Name: "loja@centro"})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionNameTooShort, shortErr)
+		assert.Equal(t, session.ErrInvalidSessionNameChars, charsErr)
+		assert.Equal(t, "old-name", sess.Name())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not touch the repository when the name is unchanged", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRenameUseCase(mockRepo, mockLogger)
+		ctx := context.Background()
+		sess := session.NewSession("same-name")
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RenameRequest{SessionID: sess.ID(), Name: "same-name"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, sess, result.Session)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
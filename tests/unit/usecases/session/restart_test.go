@@ -0,0 +1,117 @@
+package usecases_session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	sessionUC "wazmeow/internal/usecases/session"
+)
+
+func TestRestartUseCase(t *testing.T) {
+	pairedSession := func() *session.Session {
+		now := time.Now()
+		return session.RestoreSession(session.NewSessionID(), "paired", "", session.StatusConnected, "5511999999999@s.whatsapp.net", "", "", true, now, now)
+	}
+
+	t.Run("should restart a loaded client", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockClient := new(MockWhatsAppClient)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRestartUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sess := pairedSession()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockManager.On("RestartClient", sess.ID()).Return(nil).Once()
+		mockManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("GetConnectionStatus").Return(whatsapp.StatusConnected)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RestartRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, whatsapp.StatusConnected, result.Status)
+		assert.Equal(t, sess.ID(), result.Session.ID())
+		mockManager.AssertNotCalled(t, "CreateClient", mock.Anything)
+		mockManager.AssertExpectations(t)
+	})
+
+	t.Run("should recreate the client of a paired session after a server restart", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockClient := new(MockWhatsAppClient)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRestartUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sess := pairedSession()
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockManager.On("RestartClient", sess.ID()).Return(whatsapp.ErrClientNotFound).Once()
+		mockManager.On("CreateClient", sess.ID()).Return(mockClient, nil).Once()
+		mockManager.On("RestartClient", sess.ID()).Return(nil).Once()
+		mockManager.On("GetClient", sess.ID()).Return(mockClient, nil)
+		mockClient.On("GetConnectionStatus").Return(whatsapp.StatusConnected)
+		mockLogger.On("InfoWithFields", mock.AnythingOfType("string"), mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RestartRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, whatsapp.StatusConnected, result.Status)
+		mockManager.AssertExpectations(t)
+	})
+
+	t.Run("should not create a client for an unpaired session", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRestartUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sess := session.NewSession("unpaired")
+
+		mockRepo.On("GetByID", ctx, sess.ID()).Return(sess, nil)
+		mockManager.On("RestartClient", sess.ID()).Return(whatsapp.ErrClientNotFound)
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RestartRequest{SessionID: sess.ID()})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionNotPaired, err)
+		assert.Nil(t, result)
+		mockManager.AssertNotCalled(t, "CreateClient", mock.Anything)
+	})
+
+	t.Run("should fail when the session does not exist", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(MockSessionRepository)
+		mockManager := new(MockWhatsAppManager)
+		mockLogger := new(MockLogger)
+		useCase := sessionUC.NewRestartUseCase(mockRepo, mockManager, mockLogger)
+		ctx := context.Background()
+		sessionID := session.NewSessionID()
+
+		mockRepo.On("GetByID", ctx, sessionID).Return(nil, session.ErrSessionNotFound)
+		mockLogger.On("ErrorWithError", mock.AnythingOfType("string"), session.ErrSessionNotFound, mock.AnythingOfType("logger.Fields")).Return()
+
+		// Act
+		result, err := useCase.Execute(ctx, sessionUC.RestartRequest{SessionID: sessionID})
+
+		// Assert
+		assert.Equal(t, session.ErrSessionNotFound, err)
+		assert.Nil(t, result)
+		mockManager.AssertNotCalled(t, "RestartClient", mock.Anything)
+	})
+}
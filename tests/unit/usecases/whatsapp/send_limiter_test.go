@@ -0,0 +1,129 @@
+package usecases_whatsapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"wazmeow/internal/domain/session"
+	"wazmeow/internal/domain/whatsapp"
+	whatsappUC "wazmeow/internal/usecases/whatsapp"
+)
+
+func TestSendLimiter(t *testing.T) {
+	t.Run("should send without limit when disabled", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{}, 0)
+		sess := session.NewSession("limiter-disabled")
+		ctx := context.Background()
+
+		// Act & Assert
+		for i := 0; i < 100; i++ {
+			require.NoError(t, limiter.Wait(ctx, sess))
+		}
+	})
+
+	t.Run("should let the burst through and refuse beyond it with retry after", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{PerSecond: 1, Burst: 3}, 0)
+		sess := session.NewSession("limiter-burst")
+		ctx := context.Background()
+
+		// Act
+		for i := 0; i < 3; i++ {
+			require.NoError(t, limiter.Wait(ctx, sess))
+		}
+		err := limiter.Wait(ctx, sess)
+
+		// Assert
+		var rateErr *whatsapp.SendRateLimitError
+		require.True(t, errors.As(err, &rateErr))
+		assert.ErrorIs(t, err, whatsapp.ErrSendRateLimited)
+		assert.Greater(t, rateErr.RetryAfter, time.Duration(0))
+		assert.LessOrEqual(t, rateErr.RetryAfter, time.Second)
+	})
+
+	t.Run("should queue sends that fit within the max wait", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{PerSecond: 20, Burst: 1}, time.Second)
+		sess := session.NewSession("limiter-queue")
+		ctx := context.Background()
+		require.NoError(t, limiter.Wait(ctx, sess))
+
+		// Act
+		start := time.Now()
+		err := limiter.Wait(ctx, sess)
+
+		// Assert
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("should keep a separate bucket per session", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{PerSecond: 1, Burst: 1}, 0)
+		first := session.NewSession("limiter-first")
+		second := session.NewSession("limiter-second")
+		ctx := context.Background()
+		require.NoError(t, limiter.Wait(ctx, first))
+
+		// Act & Assert
+		assert.ErrorIs(t, limiter.Wait(ctx, first), whatsapp.ErrSendRateLimited)
+		assert.NoError(t, limiter.Wait(ctx, second))
+	})
+
+	t.Run("should apply the session override instead of the global limit", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{PerSecond: 1, Burst: 1}, 0)
+		limited := session.NewSession("limiter-override")
+		require.NoError(t, limited.SetSendRateLimit(&session.SendRateLimit{PerSecond: 1, Burst: 5}))
+		unlimited := session.NewSession("limiter-unlimited")
+		require.NoError(t, unlimited.SetSendRateLimit(&session.SendRateLimit{}))
+		ctx := context.Background()
+
+		// Act & Assert
+		for i := 0; i < 5; i++ {
+			require.NoError(t, limiter.Wait(ctx, limited))
+		}
+		assert.ErrorIs(t, limiter.Wait(ctx, limited), whatsapp.ErrSendRateLimited)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, limiter.Wait(ctx, unlimited))
+		}
+	})
+
+	t.Run("should stop waiting when the context ends", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{PerSecond: 0.1, Burst: 1}, time.Minute)
+		sess := session.NewSession("limiter-cancel")
+		require.NoError(t, limiter.Wait(context.Background(), sess))
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		// Act
+		err := limiter.Wait(ctx, sess)
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("should apply a changed global limit to the next sends", func(t *testing.T) {
+		// Arrange
+		limiter := whatsappUC.NewSendLimiter(session.SendRateLimit{PerSecond: 1, Burst: 1}, 0)
+		sess := session.NewSession("limiter-reload")
+		ctx := context.Background()
+		require.NoError(t, limiter.Wait(ctx, sess))
+		require.Error(t, limiter.Wait(ctx, sess))
+
+		// Act
+		limiter.SetGlobal(session.SendRateLimit{}, 0)
+
+		// Assert
+		for i := 0; i < 10; i++ {
+			require.NoError(t, limiter.Wait(ctx, sess))
+		}
+	})
+}